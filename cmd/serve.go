@@ -16,6 +16,8 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/raystack/frontier/core/accessrequest"
+	"github.com/raystack/frontier/core/accessreview"
 	"github.com/raystack/frontier/core/aggregates/orgbilling"
 	"github.com/raystack/frontier/core/aggregates/orginvoices"
 	"github.com/raystack/frontier/core/aggregates/orgpats"
@@ -28,12 +30,14 @@ import (
 	"github.com/raystack/frontier/core/aggregates/userorgs"
 	"github.com/raystack/frontier/core/aggregates/userprojects"
 	"github.com/raystack/frontier/core/auditrecord"
+	"github.com/raystack/frontier/core/certification"
 
 	"github.com/raystack/frontier/core/kyc"
 	"github.com/raystack/frontier/core/prospect"
 	"github.com/raystack/frontier/core/userpat"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/gorilla/securecookie"
 	"github.com/jackc/pgx/v5"
 	"github.com/stripe/stripe-go/v79"
 
@@ -46,6 +50,7 @@ import (
 	"github.com/raystack/frontier/core/webhook"
 
 	"github.com/raystack/frontier/core/event"
+	"github.com/raystack/frontier/core/explain"
 
 	"github.com/raystack/frontier/billing/invoice"
 
@@ -72,6 +77,10 @@ import (
 	"github.com/raystack/frontier/core/serviceuser"
 
 	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/raystack/frontier/core/authenticate/loginalert"
+	"github.com/raystack/frontier/core/authenticate/mfa"
+	"github.com/raystack/frontier/core/authenticate/ratelimit"
+	"github.com/raystack/frontier/core/authenticate/refreshtoken"
 	"github.com/raystack/frontier/core/authenticate/token"
 
 	"github.com/raystack/frontier/pkg/server"
@@ -88,6 +97,7 @@ import (
 	_ "github.com/authzed/authzed-go/proto/authzed/api/v0"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
 	"github.com/raystack/frontier/core/authenticate/session"
 	"github.com/raystack/frontier/core/metaschema"
 
@@ -104,6 +114,7 @@ import (
 	"github.com/raystack/frontier/core/role"
 	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/internal/api"
+	"github.com/raystack/frontier/internal/store/blob"
 	"github.com/raystack/frontier/internal/store/postgres"
 	"github.com/raystack/frontier/internal/store/spicedb"
 	"github.com/raystack/frontier/pkg/db"
@@ -243,6 +254,104 @@ func StartServer(logger *slog.Logger, cfg *config.Frontier) error {
 		}
 	}()
 
+	if err := deps.PolicyService.Init(ctx); err != nil {
+		logger.Warn("policy reaper initialization failed", "err", err)
+	}
+	defer func() {
+		logger.Debug("cleaning up policy reaper")
+		if err := deps.PolicyService.Close(); err != nil {
+			logger.Warn("policy reaper cleanup failed", "err", err)
+		}
+	}()
+
+	if err := deps.CertificationService.Init(ctx); err != nil {
+		logger.Warn("certification campaigns initialization failed", "err", err)
+	}
+	defer func() {
+		logger.Debug("cleaning up certification campaigns")
+		if err := deps.CertificationService.Close(); err != nil {
+			logger.Warn("certification campaigns cleanup failed", "err", err)
+		}
+	}()
+
+	if err := deps.RateLimitService.Init(ctx); err != nil {
+		logger.Warn("rate limit service initialization failed", "err", err)
+	}
+	defer func() {
+		logger.Debug("cleaning up rate limit service")
+		if err := deps.RateLimitService.Close(); err != nil {
+			logger.Warn("rate limit service cleanup failed", "err", err)
+		}
+	}()
+
+	if err := deps.OIDCProviderService.Init(ctx); err != nil {
+		logger.Warn("openid provider initialization failed", "err", err)
+	}
+	defer func() {
+		logger.Debug("cleaning up openid provider")
+		if err := deps.OIDCProviderService.Close(); err != nil {
+			logger.Warn("openid provider cleanup failed", "err", err)
+		}
+	}()
+
+	if err := deps.TokenKeyStore.Init(ctx); err != nil {
+		logger.Warn("token signing key store initialization failed", "err", err)
+	}
+	defer func() {
+		logger.Debug("cleaning up token signing key store")
+		if err := deps.TokenKeyStore.Close(); err != nil {
+			logger.Warn("token signing key store cleanup failed", "err", err)
+		}
+	}()
+
+	if err := deps.TokenRevocationStore.Init(ctx); err != nil {
+		logger.Warn("token revocation store initialization failed", "err", err)
+	}
+	defer func() {
+		logger.Debug("cleaning up token revocation store")
+		if err := deps.TokenRevocationStore.Close(); err != nil {
+			logger.Warn("token revocation store cleanup failed", "err", err)
+		}
+	}()
+
+	if err := deps.RefreshTokenService.Init(ctx); err != nil {
+		logger.Warn("refresh token service initialization failed", "err", err)
+	}
+	defer func() {
+		logger.Debug("cleaning up refresh token service")
+		if err := deps.RefreshTokenService.Close(); err != nil {
+			logger.Warn("refresh token service cleanup failed", "err", err)
+		}
+	}()
+
+	if err := deps.AuditArchiveService.Init(ctx); err != nil {
+		logger.Warn("audit record archival initialization failed", "err", err)
+	}
+	defer func() {
+		logger.Debug("cleaning up audit record archival")
+		if err := deps.AuditArchiveService.Close(); err != nil {
+			logger.Warn("audit record archival cleanup failed", "err", err)
+		}
+	}()
+
+	// delivery of queued webhook events, including ones left over from a
+	// previous run of the server
+	if err := deps.WebhookService.Init(ctx); err != nil {
+		logger.Warn("webhook delivery initialization failed", "err", err)
+	}
+	defer func() {
+		logger.Debug("cleaning up webhook delivery")
+		if err := deps.WebhookService.Close(); err != nil {
+			logger.Warn("webhook delivery cleanup failed", "err", err)
+		}
+	}()
+	defer func() {
+		logger.Debug("flushing audit sinks")
+		if err := deps.AuditService.Close(); err != nil {
+			logger.Warn("audit sinks cleanup failed", "err", err)
+		}
+	}()
+
 	// periodic cleanup of expired invitations (removes the row + both SpiceDB tuples)
 	if err := deps.InvitationService.InitInvitationCleanup(ctx); err != nil {
 		logger.Warn("invitation cleanup initialization failed", "err", err)
@@ -342,6 +451,7 @@ func buildAPIDependencies(
 		return api.Deps{}, fmt.Errorf("failed to load additional traits: %w", err)
 	}
 	preferenceService := preference.NewService(postgres.NewPreferenceRepository(dbc), traits)
+	preferenceService.SetEncryptionKey([]byte(cfg.App.PreferencesEncryptionKey))
 
 	var tokenKeySet jwk.Set
 	if len(cfg.App.Authentication.Token.RSAPath) > 0 {
@@ -362,9 +472,20 @@ func buildAPIDependencies(
 			tokenKeySet = ks
 		}
 	}
+	tokenRevocationStore := token.NewRevocationStore(logger, postgres.NewTokenRevocationRepository(dbc),
+		cfg.App.Authentication.Token.Validity, cfg.App.Authentication.Token.RevocationSyncInterval)
+	tokenKeyStore := setupTokenKeyStore(cfg.App.Authentication, dbc, logger)
 	tokenService := token.NewService(tokenKeySet, cfg.App.Authentication.Token.Issuer,
-		cfg.App.Authentication.Token.Validity)
-	sessionService := session.NewService(logger, postgres.NewSessionRepository(logger, dbc), cfg.App.Authentication.Session.Validity)
+		cfg.App.Authentication.Token.Validity, tokenRevocationStore)
+	if cfg.App.Authentication.Token.KeyRotation.Enabled {
+		tokenService = token.NewServiceWithKeys(tokenKeyStore, cfg.App.Authentication.Token.Issuer,
+			cfg.App.Authentication.Token.Validity, tokenRevocationStore)
+	}
+	sessionService := session.NewService(logger, postgres.NewSessionRepository(logger, dbc),
+		cfg.App.Authentication.Session.Validity, cfg.App.Authentication.Session.Policy)
+	sessionService.SetTokenRevoker(tokenRevocationStore)
+	refreshTokenService := refreshtoken.NewService(logger, cfg.App.Authentication.Token.RefreshToken,
+		postgres.NewRefreshTokenRepository(dbc), sessionService)
 
 	namespaceRepository := postgres.NewNamespaceRepository(dbc)
 	namespaceService := namespace.NewService(namespaceRepository)
@@ -437,7 +558,7 @@ func buildAPIDependencies(
 	// permission deletion prunes the deleted slug from role definitions; wired
 	// back here because role.Service depends on permission.Service
 	permissionService.SetRoleService(roleService)
-	policyService := policy.NewService(policyPGRepository, relationService, roleService)
+	policyService := policy.NewService(logger, cfg.App.Policy, policyPGRepository, relationService, roleService)
 	userService := user.NewService(userRepository, relationService, sessionService, auditRecordRepository)
 	patValidator := userpat.NewValidator(logger, userPATRepo, cfg.App.PAT)
 	authnService := authenticate.NewService(logger, cfg.App.Authentication,
@@ -446,6 +567,15 @@ func buildAPIDependencies(
 	organizationService := organization.NewService(organizationRepository, relationService, userService,
 		authnService, policyService, preferenceService, roleService)
 	authnService.SetOrgService(organizationService)
+	mfaService := mfa.NewService(logger, cfg.App.Authentication.MFA,
+		postgres.NewMFARepository(dbc, []byte(cfg.App.Authentication.MFA.EncryptionKey)))
+	authnService.SetMFAService(mfaService)
+	var rateLimitRepository ratelimit.Repository = ratelimit.NewMemoryRepository()
+	if cfg.App.Authentication.RateLimit.Store == ratelimit.StorePostgres {
+		rateLimitRepository = postgres.NewRateLimitRepository(dbc)
+	}
+	rateLimitService := ratelimit.NewService(logger, cfg.App.Authentication.RateLimit, rateLimitRepository, auditRecordRepository)
+	authnService.SetRateLimiter(rateLimitService)
 	projectRepository := postgres.NewProjectRepository(dbc)
 	projectService := project.NewService(projectRepository, relationService, policyService, authnService)
 
@@ -457,6 +587,25 @@ func buildAPIDependencies(
 	serviceUserService.SetMembershipService(membershipService)
 	groupService.SetMembershipService(membershipService)
 	projectService.SetMembershipService(membershipService)
+	authnService.SetMembershipService(membershipService)
+	// organizations of a user can demand a stricter session policy
+	sessionService.SetPolicyProvider(authnService)
+	sessionService.SetAuditRecordRepository(auditRecordRepository)
+	loginAlertService := loginalert.NewService(logger, cfg.App.Authentication.LoginAlert, sessionService, userService, mailDialer,
+		securecookie.New([]byte(cfg.App.Authentication.Session.HashSecretKey), []byte(cfg.App.Authentication.Session.BlockSecretKey)),
+		auditRecordRepository)
+	sessionService.SetLoginNotifier(loginAlertService)
+
+	accessRequestService := accessrequest.NewService(logger, cfg.App.AccessRequest, postgres.NewAccessRequestRepository(dbc),
+		policyService, relationService, roleService, organizationService, projectService, groupService, auditRecordRepository)
+
+	explainService := explain.NewService(relationService, policyService, roleService, organizationService,
+		projectService, groupService)
+	accessReviewService := accessreview.NewService(relationService, explainService, policyService, roleService,
+		organizationService, projectService, groupService, userService, serviceUserService)
+	certificationService := certification.NewService(logger, cfg.App.Certification,
+		postgres.NewCertificationRepository(dbc), policyService, relationService, organizationService, projectService,
+		groupService, userService, mailDialer, dbc, auditRecordRepository)
 
 	orgKycRepository := postgres.NewOrgKycRepository(dbc)
 	orgKycService := kyc.NewService(orgKycRepository)
@@ -493,6 +642,8 @@ func buildAPIDependencies(
 
 	domainRepository := postgres.NewDomainRepository(logger, dbc)
 	domainService := domain.NewService(logger, domainRepository, userService, organizationService, membershipService)
+	authnService.SetDomainService(domainService)
+	authnService.SetPreferenceService(preferenceService)
 
 	metaschemaRepository := postgres.NewMetaSchemaRepository(logger, dbc)
 	metaschemaService := metaschema.NewService(metaschemaRepository, logger, cfg.App.Metaschema.RefreshInterval)
@@ -501,6 +652,16 @@ func buildAPIDependencies(
 	membershipService.SetUserPATService(userPATService)
 	patAlertService := userpat.NewAlertService(userPATRepo, userService, organizationService, mailDialer, dbc, cfg.App.PAT.Alert, logger, auditRecordRepository)
 	auditRecordService := auditrecord.NewService(auditRecordRepository, userService, serviceUserService, sessionService, userPATService)
+	auditArchiveService, err := setupAuditArchive(cfg.App.AuditRecords.Retention, dbc, logger)
+	if err != nil {
+		return api.Deps{}, err
+	}
+
+	oidcProviderService, err := setupOIDCProvider(cfg.App.Authentication.OIDCProvider, dbc, logger,
+		tokenKeySet != nil || cfg.App.Authentication.Token.KeyRotation.Enabled, tokenService, userService)
+	if err != nil {
+		return api.Deps{}, err
+	}
 
 	orgPATsRepository := postgres.NewOrgPATsRepository(dbc)
 	orgPATsService := orgpats.NewService(orgPATsRepository, projectService)
@@ -606,10 +767,20 @@ func buildAPIDependencies(
 	logPublisher := event.NewChanPublisher(eventChannel)
 	logListener := event.NewChanListener(eventChannel, eventProcessor)
 
-	webhookService := webhook.NewService(postgres.NewWebhookEndpointRepository(dbc, []byte(cfg.App.Webhook.EncryptionKey)))
+	webhookService := webhook.NewService(
+		postgres.NewWebhookEndpointRepository(dbc, []byte(cfg.App.Webhook.EncryptionKey)),
+		postgres.NewWebhookDeliveryRepository(dbc),
+		auditRecordService,
+		cfg.App.Webhook.Delivery,
+	)
+	auditSinks, err := audit.NewSinkRegistry(cfg.App.Audit.Sinks)
+	if err != nil {
+		return api.Deps{}, fmt.Errorf("failed to create audit sinks: %w", err)
+	}
 	auditService := audit.NewService("frontier",
 		auditRepository, webhookService,
 		audit.WithLogPublisher(logPublisher),
+		audit.WithSinks(auditSinks),
 		audit.WithIgnoreList(cfg.Log.IgnoredAuditEvents),
 	)
 
@@ -660,9 +831,21 @@ func buildAPIDependencies(
 		UserOrgsService:                  userOrgsService,
 		UserProjectsService:              userProjectsService,
 		AuditRecordService:               auditRecordService,
+		AuditArchiveService:              auditArchiveService,
 		UserPATService:                   userPATService,
 		PATAlertService:                  patAlertService,
+		OIDCProviderService:              oidcProviderService,
+		RefreshTokenService:              refreshTokenService,
+		TokenRevocationStore:             tokenRevocationStore,
+		TokenKeyStore:                    tokenKeyStore,
 		MembershipService:                membershipService,
+		MFAService:                       mfaService,
+		RateLimitService:                 rateLimitService,
+		LoginAlertService:                loginAlertService,
+		AccessRequestService:             accessRequestService,
+		ExplainService:                   explainService,
+		AccessReviewService:              accessReviewService,
+		CertificationService:             certificationService,
 	}
 	return dependencies, nil
 }
@@ -718,6 +901,49 @@ func getStripeClient(logger *slog.Logger, cfg *config.Frontier) *client.API {
 	return stripeClient
 }
 
+// setupAuditArchive opens the bucket audit records past their retention
+// period are archived to
+func setupAuditArchive(cfg auditrecord.RetentionConfig, dbc *db.Client, logger *slog.Logger) (*auditrecord.ArchiveService, error) {
+	if cfg.Enabled && strings.TrimSpace(cfg.StoragePath) == "" {
+		return nil, errors.New("app.audit_records.retention.storage_path is required to archive audit records")
+	}
+	bucket, err := blob.NewStore(context.Background(), cfg.StoragePath, cfg.StorageSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit record archive storage: %w", err)
+	}
+	return auditrecord.NewArchiveService(postgres.NewAuditRecordArchiveRepository(dbc),
+		blob.NewAuditRecordArchiveStore(bucket), dbc, cfg, logger), nil
+}
+
+// setupTokenKeyStore creates the store of rotated signing keys, replaced
+// keys stay published till the longest lived token they signed expires
+func setupTokenKeyStore(cfg authenticate.Config, dbc *db.Client, logger *slog.Logger) *token.KeyStore {
+	retention := cfg.Token.Validity
+	if cfg.OIDCProvider.Enabled && cfg.OIDCProvider.AccessTokenValidity > retention {
+		retention = cfg.OIDCProvider.AccessTokenValidity
+	}
+	return token.NewKeyStore(logger, cfg.Token.KeyRotation,
+		postgres.NewSigningKeyRepository(dbc, []byte(cfg.Token.KeyRotation.EncryptionKey)), dbc, retention)
+}
+
+func setupOIDCProvider(cfg oidcprovider.Config, dbc *db.Client, logger *slog.Logger,
+	signingKeys bool, tokenService token.Service, userService *user.Service) (*oidcprovider.Service, error) {
+	if cfg.Enabled {
+		if !signingKeys {
+			return nil, errors.New("app.authentication.token keys are required to sign tokens of the openid provider")
+		}
+		if cfg.Issuer == "" || cfg.LoginURL == "" || cfg.ConsentURL == "" {
+			return nil, errors.New("app.authentication.oidc_provider issuer, login_url and consent_url are required")
+		}
+	}
+	return oidcprovider.NewService(logger, cfg,
+		postgres.NewOAuthClientRepository(dbc),
+		postgres.NewOAuthAuthorizationRepository(dbc),
+		postgres.NewOAuthConsentRepository(dbc),
+		postgres.NewOAuthRefreshTokenRepository(dbc),
+		tokenService, userService), nil
+}
+
 func setupDB(cfg db.Config, logger *slog.Logger) (dbc *db.Client, err error) {
 	// Force every goqu dataset to use prepared statements ($N placeholders +
 	// separate args) instead of inlining values into the SQL string.
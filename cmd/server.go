@@ -2,16 +2,23 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"time"
 
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/pkg/profile"
 
 	"github.com/raystack/frontier/pkg/utils"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/raystack/frontier/config"
+	"github.com/raystack/frontier/core/auditrecord"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	"github.com/raystack/frontier/internal/store/postgres"
 	frontierlogger "github.com/raystack/frontier/pkg/logger"
 	cli "github.com/spf13/cobra"
 
@@ -33,6 +40,10 @@ func ServerCommand() *cli.Command {
 			$ frontier server migrate-rollback
 			$ frontier server migrate-rollback -c ./config.yaml
 			$ frontier server keygen
+			$ frontier server rotate-keys -c ./config.yaml
+			$ frontier server audit-verify --org <org-id> -c ./config.yaml
+			$ frontier server audit-archive list --org <org-id> -c ./config.yaml
+			$ frontier server oauth-client list --org <org-id> -c ./config.yaml
 		`),
 	}
 
@@ -41,6 +52,10 @@ func ServerCommand() *cli.Command {
 	cmd.AddCommand(serverMigrateCommand())
 	cmd.AddCommand(serverMigrateRollbackCommand())
 	cmd.AddCommand(serverGenRSACommand())
+	cmd.AddCommand(serverRotateKeysCommand())
+	cmd.AddCommand(serverAuditVerifyCommand())
+	cmd.AddCommand(serverAuditArchiveCommand())
+	cmd.AddCommand(serverOAuthClientCommand())
 
 	return cmd
 }
@@ -164,18 +179,433 @@ func serverMigrateRollbackCommand() *cli.Command {
 
 func serverGenRSACommand() *cli.Command {
 	var numOfKeys int
+	var algorithm string
 	c := &cli.Command{
-		Use:     "keygen",
-		Short:   "Generate 2 rsa keys as jwks for auth token generation",
-		Example: "frontier server keygen",
+		Use:   "keygen",
+		Short: "Generate 2 keys as jwks for auth token generation",
+		Example: heredoc.Doc(`
+			$ frontier server keygen
+			$ frontier server keygen --algorithm ES256
+		`),
 		RunE: func(c *cli.Command, args []string) error {
-			keySet, err := utils.CreateJWKs(numOfKeys)
-			if err != nil {
+			var alg jwa.SignatureAlgorithm
+			if err := alg.Accept(algorithm); err != nil {
 				return err
 			}
+			keySet := jwk.NewSet()
+			for ; numOfKeys > 0; numOfKeys-- {
+				key, err := utils.CreateJWK(alg)
+				if err != nil {
+					return err
+				}
+				if err := keySet.AddKey(key); err != nil {
+					return err
+				}
+			}
 			return json.NewEncoder(os.Stdout).Encode(keySet)
 		},
 	}
 	c.Flags().IntVarP(&numOfKeys, "keys", "k", 2, "num of keys to generate")
+	c.Flags().StringVarP(&algorithm, "algorithm", "a", "RS256", "signing algorithm of the keys, one of RS256, ES256 or EdDSA")
+	return c
+}
+
+func serverRotateKeysCommand() *cli.Command {
+	var configFile string
+	c := &cli.Command{
+		Use:   "rotate-keys",
+		Short: "Replace the key signing access tokens",
+		Long: heredoc.Doc(`
+			Generate a key that replaces the key signing access tokens, see
+			app.authentication.token.key_rotation. The key is published at once
+			and signs tokens after a sync interval, once every running server
+			loaded it. Prints the kid of the key and when it signs tokens.
+			The replaced key stays in the jwks till the tokens it signed expire.
+		`),
+		Example: "frontier server rotate-keys -c ./config.yaml",
+		RunE: func(c *cli.Command, args []string) error {
+			appConfig, err := config.Load(configFile)
+			if err != nil {
+				return err
+			}
+			if !appConfig.App.Authentication.Token.KeyRotation.Enabled {
+				return errors.New("app.authentication.token.key_rotation is not enabled")
+			}
+			logger := frontierlogger.InitLogger(appConfig.Log)
+			slog.SetDefault(logger)
+
+			dbClient, err := setupDB(appConfig.DB, logger)
+			if err != nil {
+				return err
+			}
+			defer dbClient.Close()
+
+			keyStore := setupTokenKeyStore(appConfig.App.Authentication, dbClient, logger)
+			key, err := keyStore.Replace(c.Context())
+			if err != nil {
+				return err
+			}
+			if key.ActivateAt != nil {
+				fmt.Printf("%s\t%s\n", key.ID, key.ActivateAt.Format(time.RFC3339))
+				return nil
+			}
+			fmt.Println(key.ID)
+			return nil
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	return c
+}
+
+func serverAuditVerifyCommand() *cli.Command {
+	var configFile, orgID, since, until string
+	c := &cli.Command{
+		Use:   "audit-verify",
+		Short: "Verify the hash chain of the audit records of an organization",
+		Long: heredoc.Doc(`
+			Walk the audit records of an organization in sequence and report the
+			first record that was removed or modified. The last verified sequence
+			and hash are printed, keep them to detect records removed later from
+			the end of the chain.
+		`),
+		Example: "frontier server audit-verify --org <org-id> --since 2026-01-01T00:00:00Z -c ./config.yaml",
+		RunE: func(c *cli.Command, args []string) error {
+			var sinceTime, untilTime time.Time
+			var err error
+			if since != "" {
+				if sinceTime, err = time.Parse(time.RFC3339, since); err != nil {
+					return fmt.Errorf("invalid since: %w", err)
+				}
+			}
+			if until != "" {
+				if untilTime, err = time.Parse(time.RFC3339, until); err != nil {
+					return fmt.Errorf("invalid until: %w", err)
+				}
+			}
+
+			appConfig, err := config.Load(configFile)
+			if err != nil {
+				return err
+			}
+			logger := frontierlogger.InitLogger(appConfig.Log)
+			slog.SetDefault(logger)
+
+			dbClient, err := setupDB(appConfig.DB, logger)
+			if err != nil {
+				return err
+			}
+			defer dbClient.Close()
+
+			auditRecordService := auditrecord.NewService(postgres.NewAuditRecordRepository(dbClient), nil, nil, nil, nil)
+			result, err := auditRecordService.VerifyChain(c.Context(), orgID, sinceTime, untilTime)
+			if err != nil {
+				return err
+			}
+
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(result); err != nil {
+				return err
+			}
+			if !result.Valid() {
+				return fmt.Errorf("audit chain is broken at sequence %d: %s", result.Break.Seq, result.Break.Reason)
+			}
+			return nil
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	c.Flags().StringVar(&orgID, "org", "", "organization id")
+	c.Flags().StringVar(&since, "since", "", "verify records created at or after the time, in RFC3339")
+	c.Flags().StringVar(&until, "until", "", "verify records created before the time, in RFC3339")
+	_ = c.MarkFlagRequired("org")
+	return c
+}
+
+func serverAuditArchiveCommand() *cli.Command {
+	c := &cli.Command{
+		Use:   "audit-archive",
+		Short: "Manage audit records archived to blob storage",
+		Long: heredoc.Doc(`
+			Audit records past their retention period are archived to
+			app.audit_records.retention.storage_path as compressed NDJSON files,
+			one or more per organization and day.
+		`),
+		Example: heredoc.Doc(`
+			$ frontier server audit-archive list --org <org-id> -c ./config.yaml
+			$ frontier server audit-archive show <archive-id> -c ./config.yaml
+			$ frontier server audit-archive restore <archive-id> -c ./config.yaml
+		`),
+	}
+	c.AddCommand(serverAuditArchiveListCommand())
+	c.AddCommand(serverAuditArchiveShowCommand())
+	c.AddCommand(serverAuditArchiveRestoreCommand())
+	return c
+}
+
+func serverAuditArchiveListCommand() *cli.Command {
+	var configFile, orgID, since, until string
+	c := &cli.Command{
+		Use:   "list",
+		Short: "List the archives holding records created in a range",
+		RunE: func(c *cli.Command, args []string) error {
+			filter := auditrecord.ArchiveFilter{OrgID: orgID}
+			var err error
+			if since != "" {
+				if filter.Since, err = time.Parse(time.RFC3339, since); err != nil {
+					return fmt.Errorf("invalid since: %w", err)
+				}
+			}
+			if until != "" {
+				if filter.Until, err = time.Parse(time.RFC3339, until); err != nil {
+					return fmt.Errorf("invalid until: %w", err)
+				}
+			}
+
+			return withAuditArchiveService(configFile, func(archiveService *auditrecord.ArchiveService) error {
+				archives, err := archiveService.ListArchives(c.Context(), filter)
+				if err != nil {
+					return err
+				}
+				encoder := json.NewEncoder(os.Stdout)
+				for _, archive := range archives {
+					if err := encoder.Encode(archive); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	c.Flags().StringVar(&orgID, "org", "", "organization id")
+	c.Flags().StringVar(&since, "since", "", "list archives with records created at or after the time, in RFC3339")
+	c.Flags().StringVar(&until, "until", "", "list archives with records created before the time, in RFC3339")
+	return c
+}
+
+func serverAuditArchiveShowCommand() *cli.Command {
+	var configFile string
+	c := &cli.Command{
+		Use:   "show <archive-id>",
+		Short: "Print the records of an archive as NDJSON",
+		Args:  cli.ExactArgs(1),
+		RunE: func(c *cli.Command, args []string) error {
+			return withAuditArchiveService(configFile, func(archiveService *auditrecord.ArchiveService) error {
+				_, records, err := archiveService.ReadArchive(c.Context(), args[0])
+				if err != nil {
+					return err
+				}
+				encoder := json.NewEncoder(os.Stdout)
+				for _, record := range records {
+					if err := encoder.Encode(record); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	return c
+}
+
+func serverAuditArchiveRestoreCommand() *cli.Command {
+	var configFile string
+	c := &cli.Command{
+		Use:   "restore <archive-id>",
+		Short: "Move the records of an archive back to the database",
+		Long: heredoc.Doc(`
+			Move the records of an archive back to the database and delete the
+			archive. Records still past their retention period are archived
+			again by the next run of the retention job.
+		`),
+		Args: cli.ExactArgs(1),
+		RunE: func(c *cli.Command, args []string) error {
+			return withAuditArchiveService(configFile, func(archiveService *auditrecord.ArchiveService) error {
+				archive, err := archiveService.RestoreArchive(c.Context(), args[0])
+				if err != nil {
+					return err
+				}
+				fmt.Printf("restored %d audit records of archive %s\n", archive.RecordCount, archive.ID)
+				return nil
+			})
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	return c
+}
+
+func withAuditArchiveService(configFile string, fn func(*auditrecord.ArchiveService) error) error {
+	appConfig, err := config.Load(configFile)
+	if err != nil {
+		return err
+	}
+	logger := frontierlogger.InitLogger(appConfig.Log)
+	slog.SetDefault(logger)
+
+	retention := appConfig.App.AuditRecords.Retention
+	if retention.StoragePath == "" {
+		return fmt.Errorf("app.audit_records.retention.storage_path is not configured")
+	}
+	dbClient, err := setupDB(appConfig.DB, logger)
+	if err != nil {
+		return err
+	}
+	defer dbClient.Close()
+
+	archiveService, err := setupAuditArchive(retention, dbClient, logger)
+	if err != nil {
+		return err
+	}
+	defer archiveService.Close()
+	return fn(archiveService)
+}
+
+func serverOAuthClientCommand() *cli.Command {
+	c := &cli.Command{
+		Use:   "oauth-client",
+		Short: "Manage the apps of organizations signing in users with frontier",
+		Long: heredoc.Doc(`
+			OAuth clients sign in users with frontier acting as an OpenID
+			provider, see app.authentication.oidc_provider.
+		`),
+		Example: heredoc.Doc(`
+			$ frontier server oauth-client create --org <org-id> --name wiki --redirect-uri https://wiki.example.com/callback -c ./config.yaml
+			$ frontier server oauth-client list --org <org-id> -c ./config.yaml
+			$ frontier server oauth-client rotate-secret <client-id> -c ./config.yaml
+			$ frontier server oauth-client delete <client-id> -c ./config.yaml
+		`),
+	}
+	c.AddCommand(serverOAuthClientCreateCommand())
+	c.AddCommand(serverOAuthClientListCommand())
+	c.AddCommand(serverOAuthClientRotateSecretCommand())
+	c.AddCommand(serverOAuthClientDeleteCommand())
+	return c
+}
+
+func serverOAuthClientCreateCommand() *cli.Command {
+	var configFile string
+	var client oidcprovider.Client
+	c := &cli.Command{
+		Use:   "create",
+		Short: "Register a client and print its secret",
+		RunE: func(c *cli.Command, args []string) error {
+			return withOIDCProviderService(configFile, func(provider *oidcprovider.Service) error {
+				created, secret, err := provider.CreateClient(c.Context(), client)
+				if err != nil {
+					return err
+				}
+				return json.NewEncoder(os.Stdout).Encode(map[string]any{
+					"client_id":     created.ID,
+					"client_secret": secret,
+					"redirect_uris": created.RedirectURIs,
+					"scopes":        created.Scopes,
+					"public":        created.Public,
+				})
+			})
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	c.Flags().StringVar(&client.OrgID, "org", "", "organization id")
+	c.Flags().StringVar(&client.Name, "name", "", "name shown to users on the consent screen")
+	c.Flags().StringSliceVar(&client.RedirectURIs, "redirect-uri", nil, "allowed redirect uri, can be repeated")
+	c.Flags().StringSliceVar(&client.Scopes, "scope", nil, "allowed scope, defaults to openid, profile and email")
+	c.Flags().BoolVar(&client.Public, "public", false, "client can't keep a secret and must use PKCE")
+	return c
+}
+
+func serverOAuthClientListCommand() *cli.Command {
+	var configFile, orgID string
+	c := &cli.Command{
+		Use:   "list",
+		Short: "List the clients of an organization",
+		RunE: func(c *cli.Command, args []string) error {
+			return withOIDCProviderService(configFile, func(provider *oidcprovider.Service) error {
+				clients, err := provider.ListClients(c.Context(), orgID)
+				if err != nil {
+					return err
+				}
+				encoder := json.NewEncoder(os.Stdout)
+				for _, client := range clients {
+					if err := encoder.Encode(client); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	c.Flags().StringVar(&orgID, "org", "", "organization id")
 	return c
 }
+
+func serverOAuthClientRotateSecretCommand() *cli.Command {
+	var configFile string
+	c := &cli.Command{
+		Use:   "rotate-secret <client-id>",
+		Short: "Replace the secret of a client and print the new one",
+		Args:  cli.ExactArgs(1),
+		RunE: func(c *cli.Command, args []string) error {
+			return withOIDCProviderService(configFile, func(provider *oidcprovider.Service) error {
+				secret, err := provider.RotateClientSecret(c.Context(), args[0])
+				if err != nil {
+					return err
+				}
+				fmt.Println(secret)
+				return nil
+			})
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	return c
+}
+
+func serverOAuthClientDeleteCommand() *cli.Command {
+	var configFile string
+	c := &cli.Command{
+		Use:   "delete <client-id>",
+		Short: "Delete a client along with its consents and refresh tokens",
+		Args:  cli.ExactArgs(1),
+		RunE: func(c *cli.Command, args []string) error {
+			return withOIDCProviderService(configFile, func(provider *oidcprovider.Service) error {
+				return provider.DeleteClient(c.Context(), args[0])
+			})
+		},
+	}
+
+	c.Flags().StringVarP(&configFile, "config", "c", "", "config file path")
+	return c
+}
+
+// withOIDCProviderService runs fn with a provider that can only manage
+// clients, it doesn't sign tokens
+func withOIDCProviderService(configFile string, fn func(*oidcprovider.Service) error) error {
+	appConfig, err := config.Load(configFile)
+	if err != nil {
+		return err
+	}
+	logger := frontierlogger.InitLogger(appConfig.Log)
+	slog.SetDefault(logger)
+
+	dbClient, err := setupDB(appConfig.DB, logger)
+	if err != nil {
+		return err
+	}
+	defer dbClient.Close()
+
+	return fn(oidcprovider.NewService(logger, appConfig.App.Authentication.OIDCProvider,
+		postgres.NewOAuthClientRepository(dbClient),
+		postgres.NewOAuthAuthorizationRepository(dbClient),
+		postgres.NewOAuthConsentRepository(dbClient),
+		postgres.NewOAuthRefreshTokenRepository(dbClient),
+		nil, nil))
+}
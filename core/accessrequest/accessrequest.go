@@ -0,0 +1,63 @@
+package accessrequest
+
+import (
+	"context"
+	"time"
+)
+
+type State string
+
+func (s State) String() string {
+	return string(s)
+}
+
+const (
+	Pending   State = "pending"
+	Approved  State = "approved"
+	Denied    State = "denied"
+	Cancelled State = "cancelled"
+)
+
+type Repository interface {
+	Create(ctx context.Context, request AccessRequest) (AccessRequest, error)
+	Get(ctx context.Context, id string) (AccessRequest, error)
+	List(ctx context.Context, flt Filter) ([]AccessRequest, error)
+	// Review records the decision on a pending request, it returns
+	// ErrNotPending when the request was already decided
+	Review(ctx context.Context, request AccessRequest) (AccessRequest, error)
+}
+
+// AccessRequest is a request of a user for a role on an organization,
+// project or group for a limited time. Approving it creates a policy
+// expiring after the requested duration.
+type AccessRequest struct {
+	ID           string
+	OrgID        string
+	ResourceID   string
+	ResourceType string
+	RoleID       string
+	RequesterID  string
+	// Duration is how long the role is granted for once approved
+	Duration      time.Duration
+	Justification string
+	State         State
+
+	// ReviewerID is the user who approved, denied or cancelled the request
+	ReviewerID    string
+	ReviewComment string
+	ReviewedAt    time.Time
+	// PolicyID is the policy granted by the approval, it expires at ExpiresAt
+	PolicyID  string
+	ExpiresAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Filter struct {
+	OrgID        string
+	ResourceID   string
+	ResourceType string
+	RequesterID  string
+	State        State
+}
@@ -0,0 +1,8 @@
+package accessrequest
+
+import "time"
+
+type Config struct {
+	// MaxDuration is the longest a role can be requested for
+	MaxDuration time.Duration `yaml:"max_duration" mapstructure:"max_duration" default:"168h"`
+}
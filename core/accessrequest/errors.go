@@ -0,0 +1,19 @@
+package accessrequest
+
+import "errors"
+
+var (
+	ErrNotExist        = errors.New("access request doesn't exist")
+	ErrInvalidID       = errors.New("access request id is invalid")
+	ErrInvalidDetail   = errors.New("access request needs a justification and a duration")
+	ErrDurationTooLong = errors.New("access request duration is longer than allowed")
+	ErrInvalidResource = errors.New("access can only be requested on an organization, project or group")
+	ErrInvalidRole     = errors.New("role can't be requested on the resource")
+	ErrNotOrgMember    = errors.New("access can only be requested by members of the organization")
+	ErrConflict        = errors.New("a pending access request for the role already exists")
+	ErrAlreadyGranted  = errors.New("user already holds the role on the resource")
+	ErrNotPending      = errors.New("access request is already decided")
+	ErrSelfReview      = errors.New("access request can't be reviewed by its requester")
+	ErrNotReviewer     = errors.New("user can't review access requests of the resource")
+	ErrNotRequester    = errors.New("access request can only be cancelled by its requester")
+)
@@ -0,0 +1,420 @@
+package accessrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/auditrecord"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	pkgauditrecord "github.com/raystack/frontier/pkg/auditrecord"
+	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/raystack/frontier/pkg/utils"
+)
+
+// policyMetadataKey links the policy granted by an approval to its request
+const policyMetadataKey = "access_request_id"
+
+type PolicyService interface {
+	Create(ctx context.Context, pol policy.Policy) (policy.Policy, error)
+	List(ctx context.Context, flt policy.Filter) ([]policy.Policy, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type RelationService interface {
+	CheckPermission(ctx context.Context, rel relation.Relation) (bool, error)
+}
+
+type RoleService interface {
+	Get(ctx context.Context, idOrName string) (role.Role, error)
+}
+
+type OrgService interface {
+	Get(ctx context.Context, idOrName string) (organization.Organization, error)
+}
+
+type ProjectService interface {
+	Get(ctx context.Context, idOrName string) (project.Project, error)
+}
+
+type GroupService interface {
+	Get(ctx context.Context, idOrName string) (group.Group, error)
+}
+
+type AuditRecordRepository interface {
+	Create(ctx context.Context, auditRecord auditrecord.AuditRecord) (auditrecord.AuditRecord, error)
+}
+
+type Service struct {
+	log                   *slog.Logger
+	config                Config
+	repository            Repository
+	policyService         PolicyService
+	relationService       RelationService
+	roleService           RoleService
+	orgService            OrgService
+	projectService        ProjectService
+	groupService          GroupService
+	auditRecordRepository AuditRecordRepository
+	Now                   func() time.Time
+}
+
+func NewService(logger *slog.Logger, config Config, repository Repository, policyService PolicyService,
+	relationService RelationService, roleService RoleService, orgService OrgService, projectService ProjectService,
+	groupService GroupService, auditRecordRepository AuditRecordRepository) *Service {
+	return &Service{
+		log:                   logger,
+		config:                config,
+		repository:            repository,
+		policyService:         policyService,
+		relationService:       relationService,
+		roleService:           roleService,
+		orgService:            orgService,
+		projectService:        projectService,
+		groupService:          groupService,
+		auditRecordRepository: auditRecordRepository,
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+// resource is the organization, project or group access is requested on
+type resource struct {
+	ID        string
+	Namespace string
+	Title     string
+	OrgID     string
+}
+
+func (s *Service) getResource(ctx context.Context, namespace, id string) (resource, error) {
+	switch namespace {
+	case schema.OrganizationNamespace:
+		org, err := s.orgService.Get(ctx, id)
+		if err != nil {
+			return resource{}, err
+		}
+		return resource{ID: org.ID, Namespace: namespace, Title: org.Title, OrgID: org.ID}, nil
+	case schema.ProjectNamespace:
+		prj, err := s.projectService.Get(ctx, id)
+		if err != nil {
+			return resource{}, err
+		}
+		return resource{ID: prj.ID, Namespace: namespace, Title: prj.Title, OrgID: prj.Organization.ID}, nil
+	case schema.GroupNamespace:
+		grp, err := s.groupService.Get(ctx, id)
+		if err != nil {
+			return resource{}, err
+		}
+		return resource{ID: grp.ID, Namespace: namespace, Title: grp.Title, OrgID: grp.OrganizationID}, nil
+	}
+	return resource{}, ErrInvalidResource
+}
+
+func (s *Service) Get(ctx context.Context, id string) (AccessRequest, error) {
+	if strings.TrimSpace(id) == "" {
+		return AccessRequest{}, ErrInvalidID
+	}
+	return s.repository.Get(ctx, id)
+}
+
+func (s *Service) List(ctx context.Context, flt Filter) ([]AccessRequest, error) {
+	return s.repository.List(ctx, flt)
+}
+
+// Create files a request of a member of the organization for a role on the
+// resource, it stays pending till a reviewer decides on it
+func (s *Service) Create(ctx context.Context, request AccessRequest) (AccessRequest, error) {
+	request.Justification = strings.TrimSpace(request.Justification)
+	if request.Justification == "" || request.Duration <= 0 {
+		return AccessRequest{}, ErrInvalidDetail
+	}
+	if s.config.MaxDuration > 0 && request.Duration > s.config.MaxDuration {
+		return AccessRequest{}, ErrDurationTooLong
+	}
+
+	res, err := s.getResource(ctx, request.ResourceType, request.ResourceID)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+	orgPolicies, err := s.policyService.List(ctx, policy.Filter{
+		OrgID:         res.OrgID,
+		PrincipalID:   request.RequesterID,
+		PrincipalType: schema.UserPrincipal,
+	})
+	if err != nil {
+		return AccessRequest{}, err
+	}
+	if len(orgPolicies) == 0 {
+		return AccessRequest{}, ErrNotOrgMember
+	}
+	requestedRole, err := s.roleService.Get(ctx, request.RoleID)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+	if !slices.Contains(requestedRole.Scopes, res.Namespace) ||
+		(requestedRole.OrgID != res.OrgID && !utils.IsNullUUID(requestedRole.OrgID)) {
+		return AccessRequest{}, ErrInvalidRole
+	}
+	if granted, err := s.permanentlyGranted(ctx, res, requestedRole.ID, request.RequesterID); err != nil {
+		return AccessRequest{}, err
+	} else if granted {
+		return AccessRequest{}, ErrAlreadyGranted
+	}
+
+	request.OrgID = res.OrgID
+	request.ResourceID = res.ID
+	request.RoleID = requestedRole.ID
+	request.State = Pending
+	created, err := s.repository.Create(ctx, request)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+	s.audit(ctx, pkgauditrecord.AccessRequestCreatedEvent, audit.AccessRequestCreatedEvent, res, created)
+	return created, nil
+}
+
+// permanentlyGranted reports if the user already holds the role on the
+// resource without an expiry, an approval would make it expire
+func (s *Service) permanentlyGranted(ctx context.Context, res resource, roleID, userID string) (bool, error) {
+	policies, err := s.policyService.List(ctx, resourcePolicyFilter(res, policy.Filter{
+		RoleID:        roleID,
+		PrincipalID:   userID,
+		PrincipalType: schema.UserPrincipal,
+	}))
+	if err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(policies, func(pol policy.Policy) bool {
+		return pol.ExpiresAt.IsZero()
+	}), nil
+}
+
+func resourcePolicyFilter(res resource, flt policy.Filter) policy.Filter {
+	switch res.Namespace {
+	case schema.OrganizationNamespace:
+		flt.OrgID = res.ID
+	case schema.ProjectNamespace:
+		flt.ProjectID = res.ID
+	case schema.GroupNamespace:
+		flt.GroupID = res.ID
+	}
+	return flt
+}
+
+// IsReviewer reports if the user can decide on access requests of the
+// resource, it needs the permission to manage its policies
+func (s *Service) IsReviewer(ctx context.Context, namespace, id, userID string) (bool, error) {
+	permission := schema.PolicyManagePermission
+	switch namespace {
+	case schema.OrganizationNamespace, schema.ProjectNamespace:
+	case schema.GroupNamespace:
+		permission = group.AdminPermission
+	default:
+		return false, ErrInvalidResource
+	}
+	return s.relationService.CheckPermission(ctx, relation.Relation{
+		Subject: relation.Subject{
+			ID:        userID,
+			Namespace: schema.UserPrincipal,
+		},
+		Object: relation.Object{
+			ID:        id,
+			Namespace: namespace,
+		},
+		RelationName: permission,
+	})
+}
+
+// review loads a pending request the reviewer can decide on
+func (s *Service) review(ctx context.Context, id, reviewerID string) (AccessRequest, resource, error) {
+	request, err := s.Get(ctx, id)
+	if err != nil {
+		return AccessRequest{}, resource{}, err
+	}
+	if request.State != Pending {
+		return AccessRequest{}, resource{}, ErrNotPending
+	}
+	if request.RequesterID == reviewerID {
+		return AccessRequest{}, resource{}, ErrSelfReview
+	}
+	res, err := s.getResource(ctx, request.ResourceType, request.ResourceID)
+	if err != nil {
+		return AccessRequest{}, resource{}, err
+	}
+	ok, err := s.IsReviewer(ctx, res.Namespace, res.ID, reviewerID)
+	if err != nil {
+		return AccessRequest{}, resource{}, err
+	}
+	if !ok {
+		return AccessRequest{}, resource{}, ErrNotReviewer
+	}
+	return request, res, nil
+}
+
+// Approve grants the role to the requester with a policy expiring after the
+// requested duration, the policy reaper deletes it once it expires
+func (s *Service) Approve(ctx context.Context, id, reviewerID, comment string) (AccessRequest, error) {
+	request, res, err := s.review(ctx, id, reviewerID)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+	if granted, err := s.permanentlyGranted(ctx, res, request.RoleID, request.RequesterID); err != nil {
+		return AccessRequest{}, err
+	} else if granted {
+		return AccessRequest{}, ErrAlreadyGranted
+	}
+
+	now := s.Now()
+	pol, err := s.policyService.Create(ctx, policy.Policy{
+		RoleID:        request.RoleID,
+		ResourceID:    res.ID,
+		ResourceType:  res.Namespace,
+		PrincipalID:   request.RequesterID,
+		PrincipalType: schema.UserPrincipal,
+		ExpiresAt:     now.Add(request.Duration),
+		Metadata:      metadata.Metadata{policyMetadataKey: request.ID},
+	})
+	if err != nil {
+		return AccessRequest{}, fmt.Errorf("grant access request %s: %w", request.ID, err)
+	}
+
+	request.State = Approved
+	request.ReviewerID = reviewerID
+	request.ReviewComment = strings.TrimSpace(comment)
+	request.ReviewedAt = now
+	request.PolicyID = pol.ID
+	request.ExpiresAt = pol.ExpiresAt
+	reviewed, err := s.repository.Review(ctx, request)
+	if err != nil {
+		// the request was decided meanwhile, take back the grant
+		if errors.Is(err, ErrNotPending) {
+			if delErr := s.policyService.Delete(ctx, pol.ID); delErr != nil {
+				return AccessRequest{}, errors.Join(err, delErr)
+			}
+		}
+		return AccessRequest{}, err
+	}
+	s.audit(ctx, pkgauditrecord.AccessRequestApprovedEvent, audit.AccessRequestApprovedEvent, res, reviewed)
+	return reviewed, nil
+}
+
+func (s *Service) Deny(ctx context.Context, id, reviewerID, comment string) (AccessRequest, error) {
+	request, res, err := s.review(ctx, id, reviewerID)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+
+	request.State = Denied
+	request.ReviewerID = reviewerID
+	request.ReviewComment = strings.TrimSpace(comment)
+	request.ReviewedAt = s.Now()
+	reviewed, err := s.repository.Review(ctx, request)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+	s.audit(ctx, pkgauditrecord.AccessRequestDeniedEvent, audit.AccessRequestDeniedEvent, res, reviewed)
+	return reviewed, nil
+}
+
+// Cancel withdraws a pending request, only its requester can cancel it
+func (s *Service) Cancel(ctx context.Context, id, requesterID string) (AccessRequest, error) {
+	request, err := s.Get(ctx, id)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+	if request.RequesterID != requesterID {
+		return AccessRequest{}, ErrNotRequester
+	}
+	if request.State != Pending {
+		return AccessRequest{}, ErrNotPending
+	}
+	res, err := s.getResource(ctx, request.ResourceType, request.ResourceID)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+
+	request.State = Cancelled
+	request.ReviewerID = requesterID
+	request.ReviewedAt = s.Now()
+	reviewed, err := s.repository.Review(ctx, request)
+	if err != nil {
+		return AccessRequest{}, err
+	}
+	s.audit(ctx, pkgauditrecord.AccessRequestCancelledEvent, audit.AccessRequestCancelledEvent, res, reviewed)
+	return reviewed, nil
+}
+
+// audit writes the step of the request to both audit stores, the auditor
+// log is also published to the webhooks
+func (s *Service) audit(ctx context.Context, event pkgauditrecord.Event, legacyEvent audit.EventName, res resource, request AccessRequest) {
+	attrs := map[string]string{
+		"resource_id":   res.ID,
+		"resource_type": res.Namespace,
+		"role_id":       request.RoleID,
+		"requester_id":  request.RequesterID,
+		"duration":      request.Duration.String(),
+		"justification": request.Justification,
+		"state":         request.State.String(),
+	}
+	if request.ReviewerID != "" {
+		attrs["reviewer_id"] = request.ReviewerID
+		attrs["comment"] = request.ReviewComment
+	}
+	if request.PolicyID != "" {
+		attrs["policy_id"] = request.PolicyID
+		attrs["expires_at"] = request.ExpiresAt.Format(time.RFC3339)
+	}
+
+	targetMetadata := metadata.Metadata{}
+	for k, v := range attrs {
+		targetMetadata[k] = v
+	}
+	if _, err := s.auditRecordRepository.Create(ctx, auditrecord.AuditRecord{
+		Event: event,
+		Resource: auditrecord.Resource{
+			ID:   res.ID,
+			Type: auditResourceType(res.Namespace),
+			Name: res.Title,
+		},
+		Target: &auditrecord.Target{
+			ID:       request.ID,
+			Type:     pkgauditrecord.AccessRequestType,
+			Metadata: targetMetadata,
+		},
+		OrgID:      res.OrgID,
+		OccurredAt: s.Now(),
+	}); err != nil {
+		s.log.WarnContext(ctx, "failed to create audit record", "error", err, "event", event,
+			"access_request_id", request.ID)
+	}
+
+	if err := audit.GetAuditor(ctx, res.OrgID).LogWithAttrs(legacyEvent, audit.Target{
+		ID:   request.ID,
+		Type: pkgauditrecord.AccessRequestType.String(),
+	}, attrs); err != nil {
+		s.log.WarnContext(ctx, "failed to write audit log", "error", err, "event", legacyEvent,
+			"access_request_id", request.ID)
+	}
+}
+
+func auditResourceType(namespace string) pkgauditrecord.EntityType {
+	switch namespace {
+	case schema.ProjectNamespace:
+		return pkgauditrecord.ProjectType
+	case schema.GroupNamespace:
+		return pkgauditrecord.GroupType
+	}
+	return pkgauditrecord.OrganizationType
+}
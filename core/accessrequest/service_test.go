@@ -0,0 +1,289 @@
+package accessrequest_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/accessrequest"
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/auditrecord"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	pkgauditrecord "github.com/raystack/frontier/pkg/auditrecord"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type repository struct {
+	requests map[string]accessrequest.AccessRequest
+}
+
+func (r *repository) Create(ctx context.Context, request accessrequest.AccessRequest) (accessrequest.AccessRequest, error) {
+	for _, existing := range r.requests {
+		if existing.State == accessrequest.Pending && existing.RequesterID == request.RequesterID &&
+			existing.ResourceID == request.ResourceID && existing.RoleID == request.RoleID {
+			return accessrequest.AccessRequest{}, accessrequest.ErrConflict
+		}
+	}
+	request.ID = uuid.NewString()
+	r.requests[request.ID] = request
+	return request, nil
+}
+
+func (r *repository) Get(ctx context.Context, id string) (accessrequest.AccessRequest, error) {
+	request, ok := r.requests[id]
+	if !ok {
+		return accessrequest.AccessRequest{}, accessrequest.ErrNotExist
+	}
+	return request, nil
+}
+
+func (r *repository) List(ctx context.Context, flt accessrequest.Filter) ([]accessrequest.AccessRequest, error) {
+	var requests []accessrequest.AccessRequest
+	for _, request := range r.requests {
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+func (r *repository) Review(ctx context.Context, request accessrequest.AccessRequest) (accessrequest.AccessRequest, error) {
+	if r.requests[request.ID].State != accessrequest.Pending {
+		return accessrequest.AccessRequest{}, accessrequest.ErrNotPending
+	}
+	r.requests[request.ID] = request
+	return request, nil
+}
+
+type policyService struct {
+	policies []policy.Policy
+}
+
+func (p *policyService) Create(ctx context.Context, pol policy.Policy) (policy.Policy, error) {
+	pol.ID = uuid.NewString()
+	p.policies = append(p.policies, pol)
+	return pol, nil
+}
+
+func (p *policyService) List(ctx context.Context, flt policy.Filter) ([]policy.Policy, error) {
+	var policies []policy.Policy
+	for _, pol := range p.policies {
+		if pol.PrincipalID != flt.PrincipalID {
+			continue
+		}
+		if (flt.OrgID != "" && pol.ResourceID != flt.OrgID) ||
+			(flt.ProjectID != "" && pol.ResourceID != flt.ProjectID) ||
+			(flt.RoleID != "" && pol.RoleID != flt.RoleID) {
+			continue
+		}
+		policies = append(policies, pol)
+	}
+	return policies, nil
+}
+
+func (p *policyService) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+// relationService grants policymanage to the listed users
+type relationService map[string]bool
+
+func (r relationService) CheckPermission(ctx context.Context, rel relation.Relation) (bool, error) {
+	return r[rel.Subject.ID], nil
+}
+
+type roleService map[string]role.Role
+
+func (r roleService) Get(ctx context.Context, idOrName string) (role.Role, error) {
+	rl, ok := r[idOrName]
+	if !ok {
+		return role.Role{}, role.ErrNotExist
+	}
+	return rl, nil
+}
+
+type orgService struct{}
+
+func (orgService) Get(ctx context.Context, id string) (organization.Organization, error) {
+	return organization.Organization{ID: id, Title: "Acme"}, nil
+}
+
+type projectService struct {
+	orgID string
+}
+
+func (p projectService) Get(ctx context.Context, id string) (project.Project, error) {
+	return project.Project{ID: id, Title: "Production", Organization: organization.Organization{ID: p.orgID}}, nil
+}
+
+type groupService struct{}
+
+func (groupService) Get(ctx context.Context, id string) (group.Group, error) {
+	return group.Group{}, group.ErrNotExist
+}
+
+type auditRecordRepository struct {
+	records []auditrecord.AuditRecord
+}
+
+func (r *auditRecordRepository) Create(ctx context.Context, record auditrecord.AuditRecord) (auditrecord.AuditRecord, error) {
+	r.records = append(r.records, record)
+	return record, nil
+}
+
+func TestService(t *testing.T) {
+	ctx := audit.SetContextWithService(context.Background(),
+		audit.NewService("test", audit.NewNoopRepository(), audit.NewNoopWebhookService()))
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.NewString()
+	projectID := uuid.NewString()
+	requesterID := uuid.NewString()
+	reviewerID := uuid.NewString()
+	roles := roleService{
+		"app_project_owner": {ID: uuid.NewString(), Name: "app_project_owner", OrgID: schema.PlatformOrgID.String(),
+			Scopes: []string{schema.ProjectNamespace}},
+		"app_organization_owner": {ID: uuid.NewString(), Name: "app_organization_owner", OrgID: schema.PlatformOrgID.String(),
+			Scopes: []string{schema.OrganizationNamespace}},
+	}
+
+	type fixture struct {
+		svc      *accessrequest.Service
+		policies *policyService
+		audits   *auditRecordRepository
+	}
+	newService := func(t *testing.T) fixture {
+		policies := &policyService{policies: []policy.Policy{
+			{ID: uuid.NewString(), ResourceID: orgID, ResourceType: schema.OrganizationNamespace, PrincipalID: requesterID},
+		}}
+		audits := &auditRecordRepository{}
+		svc := accessrequest.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)),
+			accessrequest.Config{MaxDuration: 24 * time.Hour}, &repository{requests: map[string]accessrequest.AccessRequest{}},
+			policies, relationService{reviewerID: true}, roles, orgService{}, projectService{orgID: orgID}, groupService{}, audits)
+		svc.Now = func() time.Time { return now }
+		return fixture{svc: svc, policies: policies, audits: audits}
+	}
+	newRequest := func() accessrequest.AccessRequest {
+		return accessrequest.AccessRequest{
+			ResourceID:    projectID,
+			ResourceType:  schema.ProjectNamespace,
+			RoleID:        "app_project_owner",
+			RequesterID:   requesterID,
+			Duration:      4 * time.Hour,
+			Justification: "incident INC-42",
+		}
+	}
+
+	t.Run("should reject invalid requests", func(t *testing.T) {
+		f := newService(t)
+
+		request := newRequest()
+		request.Justification = " "
+		_, err := f.svc.Create(ctx, request)
+		assert.ErrorIs(t, err, accessrequest.ErrInvalidDetail)
+
+		request = newRequest()
+		request.Duration = 48 * time.Hour
+		_, err = f.svc.Create(ctx, request)
+		assert.ErrorIs(t, err, accessrequest.ErrDurationTooLong)
+
+		request = newRequest()
+		request.RoleID = "app_organization_owner"
+		_, err = f.svc.Create(ctx, request)
+		assert.ErrorIs(t, err, accessrequest.ErrInvalidRole)
+
+		request = newRequest()
+		request.RequesterID = uuid.NewString()
+		_, err = f.svc.Create(ctx, request)
+		assert.ErrorIs(t, err, accessrequest.ErrNotOrgMember)
+
+		request = newRequest()
+		request.ResourceType = schema.GroupNamespace
+		_, err = f.svc.Create(ctx, request)
+		assert.ErrorIs(t, err, group.ErrNotExist)
+		assert.Empty(t, f.audits.records)
+	})
+
+	t.Run("should grant the role till the requested duration ends once approved", func(t *testing.T) {
+		f := newService(t)
+
+		created, err := f.svc.Create(ctx, newRequest())
+		require.NoError(t, err)
+		assert.Equal(t, accessrequest.Pending, created.State)
+		assert.Equal(t, orgID, created.OrgID)
+		assert.Equal(t, roles["app_project_owner"].ID, created.RoleID)
+
+		_, err = f.svc.Create(ctx, newRequest())
+		assert.ErrorIs(t, err, accessrequest.ErrConflict)
+
+		_, err = f.svc.Approve(ctx, created.ID, requesterID, "")
+		assert.ErrorIs(t, err, accessrequest.ErrSelfReview)
+		_, err = f.svc.Approve(ctx, created.ID, uuid.NewString(), "")
+		assert.ErrorIs(t, err, accessrequest.ErrNotReviewer)
+
+		approved, err := f.svc.Approve(ctx, created.ID, reviewerID, " approved for the incident ")
+		require.NoError(t, err)
+		assert.Equal(t, accessrequest.Approved, approved.State)
+		assert.Equal(t, reviewerID, approved.ReviewerID)
+		assert.Equal(t, "approved for the incident", approved.ReviewComment)
+		assert.Equal(t, now.Add(4*time.Hour), approved.ExpiresAt)
+
+		granted := f.policies.policies[len(f.policies.policies)-1]
+		assert.Equal(t, approved.PolicyID, granted.ID)
+		assert.Equal(t, projectID, granted.ResourceID)
+		assert.Equal(t, schema.UserPrincipal, granted.PrincipalType)
+		assert.Equal(t, now.Add(4*time.Hour), granted.ExpiresAt)
+		assert.Equal(t, created.ID, granted.Metadata["access_request_id"])
+
+		_, err = f.svc.Deny(ctx, created.ID, reviewerID, "")
+		assert.ErrorIs(t, err, accessrequest.ErrNotPending)
+
+		require.Len(t, f.audits.records, 2)
+		assert.Equal(t, pkgauditrecord.AccessRequestCreatedEvent, f.audits.records[0].Event)
+		assert.Equal(t, pkgauditrecord.AccessRequestApprovedEvent, f.audits.records[1].Event)
+		assert.Equal(t, pkgauditrecord.ProjectType, f.audits.records[1].Resource.Type)
+		assert.Equal(t, approved.PolicyID, f.audits.records[1].Target.Metadata["policy_id"])
+	})
+
+	t.Run("should not let a permanent role be requested", func(t *testing.T) {
+		f := newService(t)
+		f.policies.policies = append(f.policies.policies, policy.Policy{
+			ID: uuid.NewString(), RoleID: roles["app_project_owner"].ID, ResourceID: projectID,
+			ResourceType: schema.ProjectNamespace, PrincipalID: requesterID,
+		})
+
+		_, err := f.svc.Create(ctx, newRequest())
+		assert.ErrorIs(t, err, accessrequest.ErrAlreadyGranted)
+	})
+
+	t.Run("should deny or cancel a request without a grant", func(t *testing.T) {
+		f := newService(t)
+		policyCount := len(f.policies.policies)
+
+		created, err := f.svc.Create(ctx, newRequest())
+		require.NoError(t, err)
+		denied, err := f.svc.Deny(ctx, created.ID, reviewerID, "use the runbook")
+		require.NoError(t, err)
+		assert.Equal(t, accessrequest.Denied, denied.State)
+
+		created, err = f.svc.Create(ctx, newRequest())
+		require.NoError(t, err)
+		_, err = f.svc.Cancel(ctx, created.ID, reviewerID)
+		assert.ErrorIs(t, err, accessrequest.ErrNotRequester)
+		cancelled, err := f.svc.Cancel(ctx, created.ID, requesterID)
+		require.NoError(t, err)
+		assert.Equal(t, accessrequest.Cancelled, cancelled.State)
+
+		assert.Len(t, f.policies.policies, policyCount)
+		require.Len(t, f.audits.records, 4)
+		assert.Equal(t, pkgauditrecord.AccessRequestDeniedEvent, f.audits.records[1].Event)
+		assert.Equal(t, pkgauditrecord.AccessRequestCancelledEvent, f.audits.records[3].Event)
+	})
+}
@@ -0,0 +1,65 @@
+package accessreview
+
+import (
+	"time"
+
+	"github.com/raystack/frontier/core/relation"
+)
+
+const (
+	// CSVContentType is the content type of the exported reports
+	CSVContentType = "text/csv"
+
+	DefaultLimit = 50
+	MaxLimit     = 1000
+)
+
+// Grant is a role a principal holds on a resource through a policy, or the
+// platform superuser access of the principal
+type Grant struct {
+	PolicyID      string
+	ResourceID    string
+	ResourceType  string
+	ResourceTitle string
+	OrgID         string
+	RoleID        string
+	RoleName      string
+	Permissions   []string
+	// GroupID and GroupTitle are set when the role is granted to a group
+	// of the principal
+	GroupID    string
+	GroupTitle string
+	Superuser  bool
+	ExpiresAt  time.Time
+}
+
+// Principal is a user or service user with the grants of its access
+type Principal struct {
+	ID     string
+	Type   string
+	Name   string
+	Grants []Grant
+}
+
+// Page selects a page of a report, a zero limit selects DefaultLimit
+type Page struct {
+	Offset int
+	Limit  int
+}
+
+// PrincipalReport is the effective access of a principal, Total counts the
+// grants across all the pages
+type PrincipalReport struct {
+	Principal Principal
+	Total     int
+}
+
+// ResourceReport lists the principals with a permission on a resource and
+// the grants they hold it through, Total counts the principals across all
+// the pages
+type ResourceReport struct {
+	Object     relation.Object
+	Permission string
+	Principals []Principal
+	Total      int
+}
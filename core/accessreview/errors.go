@@ -0,0 +1,9 @@
+package accessreview
+
+import "errors"
+
+var (
+	ErrInvalidDetail    = errors.New("a principal, or a resource and a permission, are required for an access review")
+	ErrInvalidPrincipal = errors.New("access can only be reviewed for users and service users")
+	ErrNotAllowed       = errors.New("user can't review the access")
+)
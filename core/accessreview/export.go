@@ -0,0 +1,100 @@
+package accessreview
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/raystack/frontier/core/relation"
+)
+
+var csvHeaders = []string{
+	"Principal ID", "Principal Type", "Principal Name", "Resource Type", "Resource ID", "Resource Title",
+	"Organization ID", "Role ID", "Role Name", "Permissions", "Policy ID", "Group ID", "Group Title",
+	"Superuser", "Expires At",
+}
+
+// ExportPrincipal writes every grant of the principal as csv, it is
+// authorized like ReviewPrincipal
+func (s Service) ExportPrincipal(ctx context.Context, requesterID string, principal relation.Subject,
+	orgID string) (io.Reader, string, error) {
+	grants, err := s.authorizedPrincipalGrants(ctx, requesterID, principal, orgID)
+	if err != nil {
+		return nil, "", err
+	}
+	name, err := s.principalName(ctx, principal)
+	if err != nil {
+		return nil, "", err
+	}
+	return streamCSV(func(w *csv.Writer) error {
+		return writePrincipal(w, Principal{
+			ID:     principal.ID,
+			Type:   principal.Namespace,
+			Name:   name,
+			Grants: grants,
+		})
+	}), CSVContentType, nil
+}
+
+// ExportResource writes the grants of every principal with the permission
+// on the object as csv, it is authorized like ReviewResource. The principals
+// are explained while the csv is read.
+func (s Service) ExportResource(ctx context.Context, requesterID string, object relation.Object,
+	permission string) (io.Reader, string, error) {
+	object, subjects, err := s.authorizedSubjects(ctx, requesterID, object, permission)
+	if err != nil {
+		return nil, "", err
+	}
+	lookup := s.newLookup()
+	return streamCSV(func(w *csv.Writer) error {
+		for _, subject := range subjects {
+			principal, err := s.resourcePrincipal(ctx, lookup, object, subject, permission)
+			if err != nil {
+				return err
+			}
+			if err := writePrincipal(w, principal); err != nil {
+				return err
+			}
+			w.Flush()
+		}
+		return nil
+	}), CSVContentType, nil
+}
+
+// streamCSV writes the headers and the rows of write to the returned reader,
+// a failure while writing is returned by the reader
+func streamCSV(write func(w *csv.Writer) error) io.Reader {
+	pr, pw := io.Pipe()
+	go func() {
+		w := csv.NewWriter(pw)
+		err := w.Write(csvHeaders)
+		if err == nil {
+			err = write(w)
+		}
+		w.Flush()
+		if err == nil {
+			err = w.Error()
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+func writePrincipal(w *csv.Writer, principal Principal) error {
+	for _, grant := range principal.Grants {
+		var expiresAt string
+		if !grant.ExpiresAt.IsZero() {
+			expiresAt = grant.ExpiresAt.UTC().Format("2006-01-02 15:04:05.000 MST")
+		}
+		if err := w.Write([]string{
+			principal.ID, principal.Type, principal.Name, grant.ResourceType, grant.ResourceID, grant.ResourceTitle,
+			grant.OrgID, grant.RoleID, grant.RoleName, strings.Join(grant.Permissions, ";"), grant.PolicyID,
+			grant.GroupID, grant.GroupTitle, strconv.FormatBool(grant.Superuser), expiresAt,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
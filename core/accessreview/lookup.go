@@ -0,0 +1,105 @@
+package accessreview
+
+import (
+	"context"
+
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+)
+
+type resource struct {
+	title string
+	orgID string
+}
+
+// lookup caches the roles and resources of a review, the policies of a
+// report mostly share them
+type lookup struct {
+	svc       Service
+	roles     map[string]role.Role
+	resources map[string]resource
+}
+
+func (s Service) newLookup() *lookup {
+	return &lookup{
+		svc:       s,
+		roles:     map[string]role.Role{},
+		resources: map[string]resource{},
+	}
+}
+
+func (l *lookup) grant(ctx context.Context, pol policy.Policy) (Grant, error) {
+	rl, err := l.role(ctx, pol.RoleID)
+	if err != nil {
+		return Grant{}, err
+	}
+	res, err := l.resource(ctx, pol.ResourceType, pol.ResourceID)
+	if err != nil {
+		return Grant{}, err
+	}
+	grant := Grant{
+		PolicyID:      pol.ID,
+		ResourceID:    pol.ResourceID,
+		ResourceType:  pol.ResourceType,
+		ResourceTitle: res.title,
+		OrgID:         res.orgID,
+		RoleID:        pol.RoleID,
+		RoleName:      rl.Name,
+		Permissions:   rl.Permissions,
+		ExpiresAt:     pol.ExpiresAt,
+	}
+	if pol.PrincipalType == schema.GroupPrincipal {
+		grp, err := l.resource(ctx, schema.GroupNamespace, pol.PrincipalID)
+		if err != nil {
+			return Grant{}, err
+		}
+		grant.GroupID = pol.PrincipalID
+		grant.GroupTitle = grp.title
+	}
+	return grant, nil
+}
+
+func (l *lookup) role(ctx context.Context, id string) (role.Role, error) {
+	if rl, ok := l.roles[id]; ok {
+		return rl, nil
+	}
+	rl, err := l.svc.roleService.Get(ctx, id)
+	if err != nil {
+		return role.Role{}, ignoreNotExist(err)
+	}
+	l.roles[id] = rl
+	return rl, nil
+}
+
+// resource returns the title and the organization of an organization,
+// project or group, other resources are left empty
+func (l *lookup) resource(ctx context.Context, namespace, id string) (resource, error) {
+	key := schema.JoinNamespaceAndResourceID(namespace, id)
+	if res, ok := l.resources[key]; ok {
+		return res, nil
+	}
+	var res resource
+	switch namespace {
+	case schema.OrganizationNamespace:
+		org, err := l.svc.orgService.GetRaw(ctx, id)
+		if err != nil {
+			return resource{}, ignoreNotExist(err)
+		}
+		res = resource{title: org.Title, orgID: org.ID}
+	case schema.ProjectNamespace:
+		proj, err := l.svc.projectService.Get(ctx, id)
+		if err != nil {
+			return resource{}, ignoreNotExist(err)
+		}
+		res = resource{title: proj.Title, orgID: proj.Organization.ID}
+	case schema.GroupNamespace:
+		grp, err := l.svc.groupService.Get(ctx, id)
+		if err != nil {
+			return resource{}, ignoreNotExist(err)
+		}
+		res = resource{title: grp.Title, orgID: grp.OrganizationID}
+	}
+	l.resources[key] = res
+	return res, nil
+}
@@ -0,0 +1,368 @@
+package accessreview
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/raystack/frontier/core/explain"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/core/serviceuser"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+)
+
+type RelationService interface {
+	CheckPermission(ctx context.Context, rel relation.Relation) (bool, error)
+	LookupSubjects(ctx context.Context, rel relation.Relation) ([]string, error)
+}
+
+type ExplainService interface {
+	CanExplain(ctx context.Context, userID string, object relation.Object) (bool, error)
+	Trace(ctx context.Context, object relation.Object, subject relation.Subject, permission string) (explain.Explanation, error)
+}
+
+type PolicyService interface {
+	List(ctx context.Context, flt policy.Filter) ([]policy.Policy, error)
+}
+
+type RoleService interface {
+	Get(ctx context.Context, idOrName string) (role.Role, error)
+}
+
+type OrgService interface {
+	GetRaw(ctx context.Context, idOrName string) (organization.Organization, error)
+}
+
+type ProjectService interface {
+	Get(ctx context.Context, idOrName string) (project.Project, error)
+}
+
+type GroupService interface {
+	Get(ctx context.Context, id string) (group.Group, error)
+}
+
+type UserService interface {
+	GetByID(ctx context.Context, id string) (user.User, error)
+}
+
+type ServiceUserService interface {
+	Get(ctx context.Context, id string) (serviceuser.ServiceUser, error)
+}
+
+// principalTypes are the principals listed on the report of a resource
+var principalTypes = []string{schema.UserPrincipal, schema.ServiceUserPrincipal}
+
+type Service struct {
+	relationService    RelationService
+	explainService     ExplainService
+	policyService      PolicyService
+	roleService        RoleService
+	orgService         OrgService
+	projectService     ProjectService
+	groupService       GroupService
+	userService        UserService
+	serviceUserService ServiceUserService
+}
+
+func NewService(relationService RelationService, explainService ExplainService, policyService PolicyService,
+	roleService RoleService, orgService OrgService, projectService ProjectService, groupService GroupService,
+	userService UserService, serviceUserService ServiceUserService) *Service {
+	return &Service{
+		relationService:    relationService,
+		explainService:     explainService,
+		policyService:      policyService,
+		roleService:        roleService,
+		orgService:         orgService,
+		projectService:     projectService,
+		groupService:       groupService,
+		userService:        userService,
+		serviceUserService: serviceUserService,
+	}
+}
+
+// ReviewPrincipal returns the roles the principal holds through its own
+// policies and the policies of its groups, and its superuser access. The
+// orgID limits the grants to the resources of an organization. Users can
+// review themselves, superusers anyone and the users managing the policies
+// of the organization anyone within it.
+func (s Service) ReviewPrincipal(ctx context.Context, requesterID string, principal relation.Subject,
+	orgID string, page Page) (PrincipalReport, error) {
+	grants, err := s.authorizedPrincipalGrants(ctx, requesterID, principal, orgID)
+	if err != nil {
+		return PrincipalReport{}, err
+	}
+	name, err := s.principalName(ctx, principal)
+	if err != nil {
+		return PrincipalReport{}, err
+	}
+	start, end := page.bounds(len(grants))
+	return PrincipalReport{
+		Principal: Principal{
+			ID:     principal.ID,
+			Type:   principal.Namespace,
+			Name:   name,
+			Grants: grants[start:end],
+		},
+		Total: len(grants),
+	}, nil
+}
+
+// ReviewResource returns the users and service users with the permission on
+// the object and the grants they hold it through, it can be reviewed by
+// superusers and the users managing the policies of the object
+func (s Service) ReviewResource(ctx context.Context, requesterID string, object relation.Object,
+	permission string, page Page) (ResourceReport, error) {
+	object, subjects, err := s.authorizedSubjects(ctx, requesterID, object, permission)
+	if err != nil {
+		return ResourceReport{}, err
+	}
+	start, end := page.bounds(len(subjects))
+	report := ResourceReport{
+		Object:     object,
+		Permission: permission,
+		Principals: make([]Principal, 0, end-start),
+		Total:      len(subjects),
+	}
+	lookup := s.newLookup()
+	for _, subject := range subjects[start:end] {
+		principal, err := s.resourcePrincipal(ctx, lookup, object, subject, permission)
+		if err != nil {
+			return ResourceReport{}, err
+		}
+		report.Principals = append(report.Principals, principal)
+	}
+	return report, nil
+}
+
+func (s Service) authorizedPrincipalGrants(ctx context.Context, requesterID string, principal relation.Subject,
+	orgID string) ([]Grant, error) {
+	if principal.ID == "" {
+		return nil, ErrInvalidDetail
+	}
+	if principal.Namespace != schema.UserPrincipal && principal.Namespace != schema.ServiceUserPrincipal {
+		return nil, ErrInvalidPrincipal
+	}
+	if principal.ID != requesterID || principal.Namespace != schema.UserPrincipal {
+		object := relation.Object{ID: schema.PlatformID, Namespace: schema.PlatformNamespace}
+		if orgID != "" {
+			object = relation.Object{ID: orgID, Namespace: schema.OrganizationNamespace}
+		}
+		ok, err := s.explainService.CanExplain(ctx, requesterID, object)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, ErrNotAllowed
+		}
+	}
+	return s.principalGrants(ctx, s.newLookup(), principal, orgID)
+}
+
+func (s Service) authorizedSubjects(ctx context.Context, requesterID string, object relation.Object,
+	permission string) (relation.Object, []relation.Subject, error) {
+	if object.Namespace == "" || object.ID == "" || strings.TrimSpace(permission) == "" {
+		return relation.Object{}, nil, ErrInvalidDetail
+	}
+	object, err := s.resolveObject(ctx, object)
+	if err != nil {
+		return relation.Object{}, nil, err
+	}
+	ok, err := s.explainService.CanExplain(ctx, requesterID, object)
+	if err != nil {
+		return relation.Object{}, nil, err
+	}
+	if !ok {
+		return relation.Object{}, nil, ErrNotAllowed
+	}
+
+	var subjects []relation.Subject
+	for _, principalType := range principalTypes {
+		ids, err := s.relationService.LookupSubjects(ctx, relation.Relation{
+			Object:       object,
+			Subject:      relation.Subject{Namespace: principalType},
+			RelationName: permission,
+		})
+		if err != nil {
+			return relation.Object{}, nil, err
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			subjects = append(subjects, relation.Subject{ID: id, Namespace: principalType})
+		}
+	}
+	return object, subjects, nil
+}
+
+// principalGrants lists the grants of the principal, superuser access first
+// and then by resource and role
+func (s Service) principalGrants(ctx context.Context, lookup *lookup, principal relation.Subject,
+	orgID string) ([]Grant, error) {
+	policies, err := s.policyService.List(ctx, policy.Filter{
+		PrincipalID:   principal.ID,
+		PrincipalType: principal.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var groupIDs []string
+	for _, pol := range policies {
+		if pol.ResourceType == schema.GroupNamespace {
+			groupIDs = append(groupIDs, pol.ResourceID)
+		}
+	}
+	if len(groupIDs) > 0 {
+		groupPolicies, err := s.policyService.List(ctx, policy.Filter{
+			PrincipalIDs:  groupIDs,
+			PrincipalType: schema.GroupPrincipal,
+		})
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, groupPolicies...)
+	}
+
+	var grants []Grant
+	superuser, err := s.relationService.CheckPermission(ctx, relation.Relation{
+		Subject:      principal,
+		Object:       relation.Object{ID: schema.PlatformID, Namespace: schema.PlatformNamespace},
+		RelationName: schema.PlatformSudoPermission,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if superuser {
+		grants = append(grants, superuserGrant())
+	}
+	for _, pol := range policies {
+		grant, err := lookup.grant(ctx, pol)
+		if err != nil {
+			return nil, err
+		}
+		if orgID != "" && grant.OrgID != orgID {
+			continue
+		}
+		grants = append(grants, grant)
+	}
+	sort.SliceStable(grants, func(i, j int) bool {
+		a, b := grants[i], grants[j]
+		if a.Superuser != b.Superuser {
+			return a.Superuser
+		}
+		if a.ResourceType != b.ResourceType {
+			return a.ResourceType < b.ResourceType
+		}
+		if a.ResourceID != b.ResourceID {
+			return a.ResourceID < b.ResourceID
+		}
+		return a.RoleName < b.RoleName
+	})
+	return grants, nil
+}
+
+// resourcePrincipal explains how the subject holds the permission on the
+// object and returns the grants on its path
+func (s Service) resourcePrincipal(ctx context.Context, lookup *lookup, object relation.Object,
+	subject relation.Subject, permission string) (Principal, error) {
+	name, err := s.principalName(ctx, subject)
+	if err != nil {
+		return Principal{}, err
+	}
+	principal := Principal{
+		ID:   subject.ID,
+		Type: subject.Namespace,
+		Name: name,
+	}
+	explanation, err := s.explainService.Trace(ctx, object, subject, permission)
+	if err != nil {
+		return Principal{}, err
+	}
+	for _, step := range explanation.Path {
+		switch step.Type {
+		case explain.SuperuserStep:
+			principal.Grants = append(principal.Grants, superuserGrant())
+		case explain.PolicyStep:
+			grant, err := lookup.grant(ctx, step.Policy)
+			if err != nil {
+				return Principal{}, err
+			}
+			principal.Grants = append(principal.Grants, grant)
+		}
+	}
+	return principal, nil
+}
+
+func (s Service) principalName(ctx context.Context, principal relation.Subject) (string, error) {
+	switch principal.Namespace {
+	case schema.UserPrincipal:
+		usr, err := s.userService.GetByID(ctx, principal.ID)
+		if err != nil {
+			return "", ignoreNotExist(err)
+		}
+		return usr.Email, nil
+	case schema.ServiceUserPrincipal:
+		su, err := s.serviceUserService.Get(ctx, principal.ID)
+		if err != nil {
+			return "", ignoreNotExist(err)
+		}
+		return su.Title, nil
+	}
+	return "", nil
+}
+
+// resolveObject turns the name of an organization or a project into its id
+func (s Service) resolveObject(ctx context.Context, object relation.Object) (relation.Object, error) {
+	switch object.Namespace {
+	case schema.OrganizationNamespace:
+		org, err := s.orgService.GetRaw(ctx, object.ID)
+		if err != nil {
+			return relation.Object{}, err
+		}
+		object.ID = org.ID
+	case schema.ProjectNamespace:
+		proj, err := s.projectService.Get(ctx, object.ID)
+		if err != nil {
+			return relation.Object{}, err
+		}
+		object.ID = proj.ID
+	}
+	return object, nil
+}
+
+func superuserGrant() Grant {
+	return Grant{
+		ResourceID:   schema.PlatformID,
+		ResourceType: schema.PlatformNamespace,
+		Superuser:    true,
+	}
+}
+
+// bounds returns the slice bounds of the page within n items
+func (p Page) bounds(n int) (int, int) {
+	limit := p.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+	start := min(max(p.Offset, 0), n)
+	return start, min(start+limit, n)
+}
+
+// ignoreNotExist leaves the details of entities deleted while their
+// policies remain empty instead of failing the whole review
+func ignoreNotExist(err error) error {
+	if errors.Is(err, user.ErrNotExist) || errors.Is(err, serviceuser.ErrNotExist) ||
+		errors.Is(err, group.ErrNotExist) || errors.Is(err, project.ErrNotExist) ||
+		errors.Is(err, organization.ErrNotExist) || errors.Is(err, role.ErrNotExist) {
+		return nil
+	}
+	return err
+}
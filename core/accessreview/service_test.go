@@ -0,0 +1,251 @@
+package accessreview_test
+
+import (
+	"context"
+	"encoding/csv"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/accessreview"
+	"github.com/raystack/frontier/core/explain"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/core/serviceuser"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type relationService struct {
+	superusers map[string]bool
+	subjects   map[string][]string
+}
+
+func (r relationService) CheckPermission(ctx context.Context, rel relation.Relation) (bool, error) {
+	return r.superusers[rel.Subject.ID], nil
+}
+
+func (r relationService) LookupSubjects(ctx context.Context, rel relation.Relation) ([]string, error) {
+	return r.subjects[rel.Subject.Namespace], nil
+}
+
+// explainService lets the listed users explain and returns the listed path
+// of a subject
+type explainService struct {
+	managers map[string]bool
+	paths    map[string][]explain.Step
+}
+
+func (e explainService) CanExplain(ctx context.Context, userID string, object relation.Object) (bool, error) {
+	return e.managers[userID+"@"+object.ID], nil
+}
+
+func (e explainService) Trace(ctx context.Context, object relation.Object, subject relation.Subject,
+	permission string) (explain.Explanation, error) {
+	path := e.paths[subject.ID]
+	return explain.Explanation{Object: object, Subject: subject, Permission: permission, Allowed: len(path) > 0, Path: path}, nil
+}
+
+type policyService []policy.Policy
+
+func (p policyService) List(ctx context.Context, flt policy.Filter) ([]policy.Policy, error) {
+	var policies []policy.Policy
+	for _, pol := range p {
+		if pol.PrincipalType != flt.PrincipalType {
+			continue
+		}
+		if pol.PrincipalID == flt.PrincipalID || slices.Contains(flt.PrincipalIDs, pol.PrincipalID) {
+			policies = append(policies, pol)
+		}
+	}
+	return policies, nil
+}
+
+type roleService map[string]role.Role
+
+func (r roleService) Get(ctx context.Context, id string) (role.Role, error) {
+	rl, ok := r[id]
+	if !ok {
+		return role.Role{}, role.ErrNotExist
+	}
+	return rl, nil
+}
+
+type orgService struct{}
+
+func (orgService) GetRaw(ctx context.Context, id string) (organization.Organization, error) {
+	return organization.Organization{ID: id, Title: "Acme"}, nil
+}
+
+type projectService struct {
+	orgID string
+}
+
+func (p projectService) Get(ctx context.Context, id string) (project.Project, error) {
+	return project.Project{ID: id, Title: "Production", Organization: organization.Organization{ID: p.orgID}}, nil
+}
+
+type groupService struct {
+	orgID string
+}
+
+func (g groupService) Get(ctx context.Context, id string) (group.Group, error) {
+	return group.Group{ID: id, Title: "SRE", OrganizationID: g.orgID}, nil
+}
+
+type userService struct{}
+
+func (userService) GetByID(ctx context.Context, id string) (user.User, error) {
+	return user.User{ID: id, Email: id + "@acme.dev"}, nil
+}
+
+type serviceUserService struct{}
+
+func (serviceUserService) Get(ctx context.Context, id string) (serviceuser.ServiceUser, error) {
+	return serviceuser.ServiceUser{}, serviceuser.ErrNotExist
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	orgID := uuid.NewString()
+	otherOrgID := uuid.NewString()
+	projectID := uuid.NewString()
+	groupID := uuid.NewString()
+	userID := uuid.NewString()
+	adminID := uuid.NewString()
+	roles := roleService{
+		"member":  {ID: "member", Name: "app_group_member", Permissions: []string{"app_group_get"}},
+		"viewer":  {ID: "viewer", Name: "app_organization_viewer", Permissions: []string{"app_organization_get"}},
+		"manager": {ID: "manager", Name: "app_project_manager", Permissions: []string{"app_project_get", "app_project_update"}},
+	}
+	membership := policy.Policy{ID: uuid.NewString(), RoleID: "member", ResourceID: groupID,
+		ResourceType: schema.GroupNamespace, PrincipalID: userID, PrincipalType: schema.UserPrincipal}
+	viaGroup := policy.Policy{ID: uuid.NewString(), RoleID: "viewer", ResourceID: orgID,
+		ResourceType: schema.OrganizationNamespace, PrincipalID: groupID, PrincipalType: schema.GroupPrincipal}
+	direct := policy.Policy{ID: uuid.NewString(), RoleID: "manager", ResourceID: projectID,
+		ResourceType: schema.ProjectNamespace, PrincipalID: userID, PrincipalType: schema.UserPrincipal}
+	elsewhere := policy.Policy{ID: uuid.NewString(), RoleID: "viewer", ResourceID: otherOrgID,
+		ResourceType: schema.OrganizationNamespace, PrincipalID: userID, PrincipalType: schema.UserPrincipal}
+	policies := policyService{membership, viaGroup, direct, elsewhere}
+
+	newService := func(relations relationService, explains explainService) *accessreview.Service {
+		return accessreview.NewService(relations, explains, policies, roles, orgService{},
+			projectService{orgID: orgID}, groupService{orgID: orgID}, userService{}, serviceUserService{})
+	}
+	user := relation.Subject{ID: userID, Namespace: schema.UserPrincipal}
+
+	t.Run("should list the grants of a principal through its policies and groups", func(t *testing.T) {
+		svc := newService(relationService{}, explainService{})
+
+		report, err := svc.ReviewPrincipal(ctx, userID, user, "", accessreview.Page{})
+		require.NoError(t, err)
+		assert.Equal(t, 4, report.Total)
+		assert.Equal(t, userID+"@acme.dev", report.Principal.Name)
+		require.Len(t, report.Principal.Grants, 4)
+		assert.Equal(t, membership.ID, report.Principal.Grants[0].PolicyID)
+		assert.Equal(t, direct.ID, report.Principal.Grants[3].PolicyID)
+		assert.Equal(t, []string{"app_project_get", "app_project_update"}, report.Principal.Grants[3].Permissions)
+
+		viaGroupGrant := report.Principal.Grants[slices.IndexFunc(report.Principal.Grants, func(g accessreview.Grant) bool {
+			return g.PolicyID == viaGroup.ID
+		})]
+		assert.Equal(t, groupID, viaGroupGrant.GroupID)
+		assert.Equal(t, "SRE", viaGroupGrant.GroupTitle)
+		assert.Equal(t, "app_organization_viewer", viaGroupGrant.RoleName)
+
+		report, err = svc.ReviewPrincipal(ctx, userID, user, "", accessreview.Page{Offset: 3, Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, 4, report.Total)
+		assert.Len(t, report.Principal.Grants, 1)
+	})
+
+	t.Run("should limit the review of others to managers", func(t *testing.T) {
+		svc := newService(relationService{superusers: map[string]bool{userID: true}},
+			explainService{managers: map[string]bool{adminID + "@" + orgID: true}})
+
+		_, err := svc.ReviewPrincipal(ctx, adminID, user, "", accessreview.Page{})
+		assert.ErrorIs(t, err, accessreview.ErrNotAllowed)
+		_, err = svc.ReviewPrincipal(ctx, adminID, user, otherOrgID, accessreview.Page{})
+		assert.ErrorIs(t, err, accessreview.ErrNotAllowed)
+
+		report, err := svc.ReviewPrincipal(ctx, adminID, user, orgID, accessreview.Page{})
+		require.NoError(t, err)
+		// the grants in the other organization are left out
+		require.Len(t, report.Principal.Grants, 4)
+		assert.True(t, report.Principal.Grants[0].Superuser)
+		for _, grant := range report.Principal.Grants[1:] {
+			assert.Equal(t, orgID, grant.OrgID)
+		}
+
+		_, err = svc.ReviewPrincipal(ctx, adminID, relation.Subject{ID: groupID, Namespace: schema.GroupPrincipal},
+			orgID, accessreview.Page{})
+		assert.ErrorIs(t, err, accessreview.ErrInvalidPrincipal)
+	})
+
+	t.Run("should list the principals with a permission on a resource", func(t *testing.T) {
+		serviceUserID := uuid.NewString()
+		svc := newService(relationService{subjects: map[string][]string{
+			schema.UserPrincipal:        {userID},
+			schema.ServiceUserPrincipal: {serviceUserID},
+		}}, explainService{
+			managers: map[string]bool{adminID + "@" + projectID: true},
+			paths: map[string][]explain.Step{
+				userID: {
+					{Type: explain.GroupStep, Namespace: schema.GroupNamespace, ID: groupID},
+					{Type: explain.PolicyStep, Namespace: schema.RoleBindingNamespace, ID: viaGroup.ID, Policy: viaGroup},
+					{Type: explain.ResourceStep, Namespace: schema.OrganizationNamespace, ID: orgID},
+					{Type: explain.ResourceStep, Namespace: schema.ProjectNamespace, ID: projectID},
+				},
+				serviceUserID: {
+					{Type: explain.SuperuserStep, Namespace: schema.PlatformNamespace, ID: schema.PlatformID},
+					{Type: explain.ResourceStep, Namespace: schema.ProjectNamespace, ID: projectID},
+				},
+			},
+		})
+		object := relation.Object{ID: projectID, Namespace: schema.ProjectNamespace}
+
+		_, err := svc.ReviewResource(ctx, userID, object, schema.DeletePermission, accessreview.Page{})
+		assert.ErrorIs(t, err, accessreview.ErrNotAllowed)
+
+		report, err := svc.ReviewResource(ctx, adminID, object, schema.DeletePermission, accessreview.Page{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Total)
+		require.Len(t, report.Principals, 2)
+		assert.Equal(t, userID, report.Principals[0].ID)
+		require.Len(t, report.Principals[0].Grants, 1)
+		assert.Equal(t, viaGroup.ID, report.Principals[0].Grants[0].PolicyID)
+		assert.Equal(t, groupID, report.Principals[0].Grants[0].GroupID)
+		assert.Equal(t, schema.ServiceUserPrincipal, report.Principals[1].Type)
+		require.Len(t, report.Principals[1].Grants, 1)
+		assert.True(t, report.Principals[1].Grants[0].Superuser)
+
+		reader, contentType, err := svc.ExportResource(ctx, adminID, object, schema.DeletePermission)
+		require.NoError(t, err)
+		assert.Equal(t, accessreview.CSVContentType, contentType)
+		rows, err := csv.NewReader(reader).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		assert.Equal(t, "Principal ID", rows[0][0])
+		assert.Equal(t, []string{userID, schema.UserPrincipal, userID + "@acme.dev", schema.OrganizationNamespace, orgID,
+			"Acme", orgID, "viewer", "app_organization_viewer", "app_organization_get", viaGroup.ID, groupID, "SRE",
+			"false", ""}, rows[1])
+		assert.Equal(t, "true", rows[2][13])
+	})
+
+	t.Run("should export the grants of a principal", func(t *testing.T) {
+		svc := newService(relationService{}, explainService{})
+
+		reader, _, err := svc.ExportPrincipal(ctx, userID, user, orgID)
+		require.NoError(t, err)
+		rows, err := csv.NewReader(reader).ReadAll()
+		require.NoError(t, err)
+		// the headers and the three grants within the organization
+		assert.Len(t, rows, 4)
+	})
+}
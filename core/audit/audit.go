@@ -76,6 +76,11 @@ const (
 	PolicyCreatedEvent EventName = "app.policy.created"
 	PolicyDeletedEvent EventName = "app.policy.deleted"
 
+	AccessRequestCreatedEvent   EventName = "app.accessrequest.created"
+	AccessRequestApprovedEvent  EventName = "app.accessrequest.approved"
+	AccessRequestDeniedEvent    EventName = "app.accessrequest.denied"
+	AccessRequestCancelledEvent EventName = "app.accessrequest.cancelled"
+
 	OrgCreatedEvent           EventName = "app.organization.created"
 	OrgUpdatedEvent           EventName = "app.organization.updated"
 	OrgDeletedEvent           EventName = "app.organization.deleted"
@@ -0,0 +1,68 @@
+package audit
+
+import "time"
+
+const (
+	SinkTypeFile = "file"
+	SinkTypeOTLP = "otlp"
+	SinkTypeHTTP = "http"
+)
+
+type Config struct {
+	// Sinks receive every audit log in addition to the audit repository
+	Sinks []SinkConfig `yaml:"sinks" mapstructure:"sinks"`
+}
+
+type SinkConfig struct {
+	// Name identifies the sink in logs and metrics and must be unique. It
+	// defaults to the type, followed by the position of the sink when an
+	// earlier sink already has that name.
+	Name string `yaml:"name" mapstructure:"name"`
+	// Type is one of file, otlp or http, or a type added with RegisterSinkType
+	Type string `yaml:"type" mapstructure:"type"`
+
+	// BufferSize is the number of logs held for the sink, logs arriving while
+	// the buffer is full are dropped
+	BufferSize int `yaml:"buffer_size" mapstructure:"buffer_size" default:"1000"`
+	// BatchSize is the maximum number of logs written at once
+	BatchSize int `yaml:"batch_size" mapstructure:"batch_size" default:"100"`
+	// FlushInterval is the longest a log waits for its batch to fill
+	FlushInterval time.Duration `yaml:"flush_interval" mapstructure:"flush_interval" default:"5s"`
+	// MaxRetries is the number of times a failed batch is written again
+	// before it is dropped, a negative value disables retries
+	MaxRetries int `yaml:"max_retries" mapstructure:"max_retries" default:"3"`
+
+	File FileSinkConfig `yaml:"file" mapstructure:"file"`
+	OTLP OTLPSinkConfig `yaml:"otlp" mapstructure:"otlp"`
+	HTTP HTTPSinkConfig `yaml:"http" mapstructure:"http"`
+}
+
+type FileSinkConfig struct {
+	// Path of the JSON lines file
+	Path string `yaml:"path" mapstructure:"path"`
+	// MaxSizeMB is the size at which the file is rotated
+	MaxSizeMB int `yaml:"max_size_mb" mapstructure:"max_size_mb" default:"100"`
+	// MaxBackups is the number of rotated files kept, named <path>.1 to <path>.N
+	MaxBackups int `yaml:"max_backups" mapstructure:"max_backups" default:"5"`
+}
+
+type OTLPSinkConfig struct {
+	// Endpoint is the OTLP/HTTP logs URL, e.g. http://collector:4318/v1/logs
+	Endpoint string            `yaml:"endpoint" mapstructure:"endpoint"`
+	Headers  map[string]string `yaml:"headers" mapstructure:"headers"`
+	Timeout  time.Duration     `yaml:"timeout" mapstructure:"timeout" default:"10s"`
+}
+
+const (
+	HTTPSinkFormatJSON      = "json"
+	HTTPSinkFormatKafkaREST = "kafka_rest"
+)
+
+type HTTPSinkConfig struct {
+	URL     string            `yaml:"url" mapstructure:"url"`
+	Headers map[string]string `yaml:"headers" mapstructure:"headers"`
+	Timeout time.Duration     `yaml:"timeout" mapstructure:"timeout" default:"10s"`
+	// Format is json, posting a JSON array of logs, or kafka_rest, posting the
+	// records of a Kafka REST proxy produce request keyed by organization
+	Format string `yaml:"format" mapstructure:"format" default:"json"`
+}
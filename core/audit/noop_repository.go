@@ -33,3 +33,9 @@ func NewNoopWebhookService() *NoopWebhookService {
 func (s NoopWebhookService) Publish(ctx context.Context, e webhook.Event) error {
 	return nil
 }
+
+func (s NoopWebhookService) Deliveries(ctx context.Context, e webhook.Event) ([]webhook.Delivery, error) {
+	return nil, nil
+}
+
+func (s NoopWebhookService) Notify() {}
@@ -15,12 +15,20 @@ type Repository interface {
 	GetByID(context.Context, string) (Log, error)
 }
 
+// OutboxRepository stores a log together with the webhook deliveries of its
+// event in one transaction
+type OutboxRepository interface {
+	CreateWithDeliveries(ctx context.Context, l *Log, deliveries []webhook.Delivery) error
+}
+
 type Publisher interface {
 	Publish(context.Context, Log)
 }
 
 type WebhookService interface {
 	Publish(ctx context.Context, e webhook.Event) error
+	Deliveries(ctx context.Context, e webhook.Event) ([]webhook.Delivery, error)
+	Notify()
 }
 
 type Option func(*Service)
@@ -43,6 +51,13 @@ func WithLogPublisher(p Publisher) Option {
 	}
 }
 
+// WithSinks sends every log to the sinks of the registry
+func WithSinks(r *SinkRegistry) Option {
+	return func(s *Service) {
+		s.sinks = r
+	}
+}
+
 func WithIgnoreList(items []string) Option {
 	return func(s *Service) {
 		s.ignoreList = items
@@ -53,6 +68,7 @@ type Service struct {
 	source         string
 	repository     Repository
 	publisher      Publisher
+	sinks          *SinkRegistry
 	webhookService WebhookService
 
 	ignoreList        []string
@@ -78,25 +94,66 @@ func (s *Service) Create(ctx context.Context, l *Log) error {
 	if l.ID == "" {
 		l.ID = uuid.NewString()
 	}
-	err := s.repository.Create(ctx, l)
-	if err != nil {
+	// platform logs belong to the nil org, set before the webhook event is
+	// built so its payload matches the stored log
+	if l.OrgID == "" {
+		l.OrgID = uuid.Nil.String()
+	}
+	if err := s.store(ctx, l); err != nil {
 		return err
 	}
 
+	if s.sinks != nil {
+		s.sinks.Publish(*l)
+	}
 	if s.publisher != nil {
 		if !slices.Contains(s.ignoreList, l.Action) {
 			s.publisher.Publish(ctx, *l)
 		}
 	}
-	if err := s.webhookService.Publish(ctx, webhook.Event{
+	return nil
+}
+
+// store records the log and queues its webhook deliveries. A repository
+// supporting it stores both in one transaction, otherwise the deliveries are
+// queued after the log and are lost if the process stops in between.
+func (s *Service) store(ctx context.Context, l *Log) error {
+	outbox, ok := s.repository.(OutboxRepository)
+	if !ok {
+		if err := s.repository.Create(ctx, l); err != nil {
+			return err
+		}
+		return s.webhookService.Publish(ctx, toWebhookEvent(l))
+	}
+
+	deliveries, err := s.webhookService.Deliveries(ctx, toWebhookEvent(l))
+	if err != nil {
+		return err
+	}
+	if err := outbox.CreateWithDeliveries(ctx, l, deliveries); err != nil {
+		return err
+	}
+	if len(deliveries) > 0 {
+		s.webhookService.Notify()
+	}
+	return nil
+}
+
+func toWebhookEvent(l *Log) webhook.Event {
+	return webhook.Event{
 		ID:        l.ID,
 		Action:    l.Action,
 		Data:      TransformToEventData(l),
 		CreatedAt: l.CreatedAt,
-	}); err != nil {
-		return err
 	}
-	return nil
+}
+
+// Close flushes the logs buffered for the sinks
+func (s *Service) Close() error {
+	if s.sinks == nil {
+		return nil
+	}
+	return s.sinks.Close()
 }
 
 func (s *Service) List(ctx context.Context, flt Filter) ([]Log, error) {
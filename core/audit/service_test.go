@@ -1,12 +1,17 @@
 package audit
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
+	"github.com/raystack/frontier/core/webhook"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -117,3 +122,82 @@ func TestTransformToEventData(t *testing.T) {
 		})
 	}
 }
+
+type outboxRepository struct {
+	NoopRepository
+	err        error
+	logs       []Log
+	deliveries []webhook.Delivery
+}
+
+func (r *outboxRepository) CreateWithDeliveries(_ context.Context, l *Log, deliveries []webhook.Delivery) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.logs = append(r.logs, *l)
+	r.deliveries = append(r.deliveries, deliveries...)
+	return nil
+}
+
+type webhookService struct {
+	published []webhook.Event
+	notified  int
+}
+
+func (s *webhookService) Publish(_ context.Context, e webhook.Event) error {
+	s.published = append(s.published, e)
+	return nil
+}
+
+func (s *webhookService) Deliveries(_ context.Context, e webhook.Event) ([]webhook.Delivery, error) {
+	return []webhook.Delivery{{ID: "d1", EventID: e.ID, Action: e.Action}}, nil
+}
+
+func (s *webhookService) Notify() {
+	s.notified++
+}
+
+func TestServiceCreateQueuesDeliveriesWithLog(t *testing.T) {
+	t.Run("stores the deliveries in the transaction of the log", func(t *testing.T) {
+		repository := &outboxRepository{}
+		webhooks := &webhookService{}
+		s := NewService("frontier", repository, webhooks)
+
+		err := s.Create(context.Background(), &Log{Source: "frontier", Action: "organization.create"})
+		require.NoError(t, err)
+		require.Len(t, repository.logs, 1)
+		require.Len(t, repository.deliveries, 1)
+		assert.Equal(t, repository.logs[0].ID, repository.deliveries[0].EventID)
+		assert.Empty(t, webhooks.published)
+		assert.Equal(t, 1, webhooks.notified)
+	})
+
+	t.Run("doesn't wake the worker up when the log isn't stored", func(t *testing.T) {
+		repository := &outboxRepository{err: errors.New("db down")}
+		webhooks := &webhookService{}
+		s := NewService("frontier", repository, webhooks)
+
+		err := s.Create(context.Background(), &Log{Source: "frontier", Action: "organization.create"})
+		assert.Error(t, err)
+		assert.Zero(t, webhooks.notified)
+	})
+
+	t.Run("sends platform logs with the org id of the stored log", func(t *testing.T) {
+		webhooks := &webhookService{}
+		s := NewService("frontier", NewNoopRepository(), webhooks)
+
+		err := s.Create(context.Background(), &Log{Source: "frontier", Action: "user.created"})
+		require.NoError(t, err)
+		require.Len(t, webhooks.published, 1)
+		assert.Equal(t, uuid.Nil.String(), webhooks.published[0].Data["org_id"])
+	})
+
+	t.Run("publishes after the log without an outbox", func(t *testing.T) {
+		webhooks := &webhookService{}
+		s := NewService("frontier", NewNoopRepository(), webhooks)
+
+		err := s.Create(context.Background(), &Log{Source: "frontier", Action: "organization.create"})
+		require.NoError(t, err)
+		assert.Len(t, webhooks.published, 1)
+	})
+}
@@ -0,0 +1,251 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/raystack/frontier/internal/metrics"
+)
+
+// Sink writes batches of audit logs to an external system
+type Sink interface {
+	Write(ctx context.Context, logs []Log) error
+	Close() error
+}
+
+// SinkFactory creates a sink from its configuration
+type SinkFactory func(cfg SinkConfig) (Sink, error)
+
+var (
+	sinkFactoriesMu sync.RWMutex
+	sinkFactories   = map[string]SinkFactory{
+		SinkTypeFile: NewFileSink,
+		SinkTypeOTLP: NewOTLPSink,
+		SinkTypeHTTP: NewHTTPSink,
+	}
+)
+
+// RegisterSinkType makes a sink type available to the sink configuration
+func RegisterSinkType(name string, factory SinkFactory) {
+	sinkFactoriesMu.Lock()
+	defer sinkFactoriesMu.Unlock()
+	sinkFactories[name] = factory
+}
+
+// SinkRegistry fans audit logs out to the configured sinks. Every sink has
+// its own buffer and worker, so a slow or failing sink doesn't hold up the
+// others or the caller.
+type SinkRegistry struct {
+	sinks []*bufferedSink
+}
+
+// NewSinkRegistry creates the configured sinks and starts their workers
+func NewSinkRegistry(configs []SinkConfig) (*SinkRegistry, error) {
+	registry := &SinkRegistry{}
+	names := make(map[string]bool, len(configs))
+	for i, cfg := range configs {
+		// the name labels the metrics of the sink, unnamed sinks of the same
+		// type are told apart by their position
+		if cfg.Name == "" {
+			cfg.Name = cfg.Type
+			if names[cfg.Name] {
+				cfg.Name = fmt.Sprintf("%s-%d", cfg.Type, i)
+			}
+		}
+		if names[cfg.Name] {
+			registry.Close()
+			return nil, fmt.Errorf("%w: audit sink name %q is used more than once", ErrInvalidDetail, cfg.Name)
+		}
+		names[cfg.Name] = true
+		sinkFactoriesMu.RLock()
+		factory, ok := sinkFactories[cfg.Type]
+		sinkFactoriesMu.RUnlock()
+		if !ok {
+			registry.Close()
+			return nil, fmt.Errorf("%w: unknown audit sink type %q", ErrInvalidDetail, cfg.Type)
+		}
+		sink, err := factory(cfg)
+		if err != nil {
+			registry.Close()
+			return nil, fmt.Errorf("failed to create audit sink %s: %w", cfg.Name, err)
+		}
+		registry.sinks = append(registry.sinks, newBufferedSink(cfg, sink))
+	}
+	return registry, nil
+}
+
+// Publish queues the log for every sink without blocking
+func (r *SinkRegistry) Publish(l Log) {
+	for _, s := range r.sinks {
+		s.enqueue(l)
+	}
+}
+
+// Close flushes the buffered logs and closes the sinks
+func (r *SinkRegistry) Close() error {
+	var errs []error
+	for _, s := range r.sinks {
+		errs = append(errs, s.close())
+	}
+	return errors.Join(errs...)
+}
+
+type bufferedSink struct {
+	name          string
+	sink          Sink
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    int
+
+	mu     sync.RWMutex
+	closed bool
+	queue  chan Log
+	done   chan struct{}
+}
+
+func newBufferedSink(cfg SinkConfig, sink Sink) *bufferedSink {
+	b := &bufferedSink{
+		name:          cfg.Name,
+		sink:          sink,
+		batchSize:     cfg.BatchSize,
+		flushInterval: cfg.FlushInterval,
+		// a negative value disables retries
+		maxRetries: max(cfg.MaxRetries, 0),
+		queue:      make(chan Log, cfg.BufferSize),
+		done:       make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// enqueue drops the log when the buffer is full, audit logging shouldn't
+// slow down the request that produced it
+func (b *bufferedSink) enqueue(l Log) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return
+	}
+	select {
+	case b.queue <- l:
+		if metrics.AuditSinkQueueLength != nil {
+			metrics.AuditSinkQueueLength.WithLabelValues(b.name).Inc()
+		}
+	default:
+		b.count("dropped", 1)
+		slog.Warn("audit sink buffer is full, dropping log", "sink", b.name, "id", l.ID)
+	}
+}
+
+func (b *bufferedSink) run() {
+	defer close(b.done)
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Log, 0, b.batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		b.write(batch)
+		batch = make([]Log, 0, b.batchSize)
+	}
+	for {
+		select {
+		case l, ok := <-b.queue:
+			if !ok {
+				flush()
+				return
+			}
+			if metrics.AuditSinkQueueLength != nil {
+				metrics.AuditSinkQueueLength.WithLabelValues(b.name).Dec()
+			}
+			batch = append(batch, l)
+			if len(batch) >= b.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+func (b *bufferedSink) write(batch []Log) {
+	backoff := time.Second
+	var err error
+	for attempt := 0; attempt <= b.maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		if metrics.AuditSinkWriteLatency != nil {
+			record := metrics.AuditSinkWriteLatency(b.name)
+			err = b.sink.Write(context.Background(), batch)
+			record()
+		} else {
+			err = b.sink.Write(context.Background(), batch)
+		}
+		if err == nil {
+			b.count("written", len(batch))
+			return
+		}
+		slog.Warn("failed to write audit logs to sink", "sink", b.name, "attempt", attempt+1, "error", err)
+	}
+	b.count("failed", len(batch))
+	slog.Error("dropping audit logs after failed writes", "sink", b.name, "count", len(batch), "error", err)
+}
+
+func (b *bufferedSink) count(result string, n int) {
+	if metrics.AuditSinkLogs != nil {
+		metrics.AuditSinkLogs.WithLabelValues(b.name, result).Add(float64(n))
+	}
+}
+
+func (b *bufferedSink) close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	close(b.queue)
+	b.mu.Unlock()
+
+	<-b.done
+	return b.sink.Close()
+}
+
+// logRecord is the JSON representation of a log written to sinks
+type logRecord struct {
+	ID        string            `json:"id"`
+	OrgID     string            `json:"org_id,omitempty"`
+	Source    string            `json:"source"`
+	Action    string            `json:"action"`
+	Actor     recordEntity      `json:"actor"`
+	Target    recordEntity      `json:"target"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+type recordEntity struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+func toLogRecord(l Log) logRecord {
+	return logRecord{
+		ID:        l.ID,
+		OrgID:     l.OrgID,
+		Source:    l.Source,
+		Action:    l.Action,
+		Actor:     recordEntity(l.Actor),
+		Target:    recordEntity(l.Target),
+		Metadata:  l.Metadata,
+		CreatedAt: l.CreatedAt,
+	}
+}
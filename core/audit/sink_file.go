@@ -0,0 +1,126 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileSink appends logs as JSON lines to a file, rotating it once it
+// outgrows the configured size
+type FileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	file file
+	size int64
+}
+
+// file is the part of *os.File the sink writes through
+type file interface {
+	Write(b []byte) (int, error)
+	Sync() error
+	Truncate(size int64) error
+	Close() error
+}
+
+func NewFileSink(cfg SinkConfig) (Sink, error) {
+	if cfg.File.Path == "" {
+		return nil, fmt.Errorf("%w: file sink needs a path", ErrInvalidDetail)
+	}
+	if err := os.MkdirAll(filepath.Dir(cfg.File.Path), 0o755); err != nil {
+		return nil, err
+	}
+	s := &FileSink{
+		path:       cfg.File.Path,
+		maxSize:    int64(cfg.File.MaxSizeMB) * 1024 * 1024,
+		maxBackups: cfg.File.MaxBackups,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+// Write appends the batch as a whole. A batch failing part way is truncated
+// off the file, so a retry doesn't write its first logs twice.
+func (s *FileSink) Write(ctx context.Context, logs []Log) error {
+	var batch []byte
+	for _, l := range logs {
+		line, err := json.Marshal(toLogRecord(l))
+		if err != nil {
+			return err
+		}
+		batch = append(batch, line...)
+		batch = append(batch, '\n')
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.size > 0 && s.size+int64(len(batch)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return fmt.Errorf("failed to rotate %s: %w", s.path, err)
+		}
+	}
+	if _, err := s.file.Write(batch); err != nil {
+		return s.discard(err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return s.discard(err)
+	}
+	s.size += int64(len(batch))
+	return nil
+}
+
+// discard truncates the file to its size before the failed write
+func (s *FileSink) discard(err error) error {
+	if truncateErr := s.file.Truncate(s.size); truncateErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to truncate %s: %w", s.path, truncateErr))
+	}
+	return err
+}
+
+// rotate shifts <path>.N-1 to <path>.N down to <path> to <path>.1, dropping
+// the oldest file
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		err := os.Rename(fmt.Sprintf("%s.%d", s.path, i), fmt.Sprintf("%s.%d", s.path, i+1))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	if err := os.Rename(s.path, s.path+".1"); err != nil {
+		return err
+	}
+	return s.open()
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
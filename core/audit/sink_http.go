@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const kafkaRESTContentType = "application/vnd.kafka.json.v2+json"
+
+// HTTPSink posts batches of logs to an HTTP endpoint
+type HTTPSink struct {
+	url     string
+	headers map[string]string
+	format  string
+	client  *http.Client
+}
+
+func NewHTTPSink(cfg SinkConfig) (Sink, error) {
+	if cfg.HTTP.URL == "" {
+		return nil, fmt.Errorf("%w: http sink needs a url", ErrInvalidDetail)
+	}
+	switch cfg.HTTP.Format {
+	case HTTPSinkFormatJSON, HTTPSinkFormatKafkaREST:
+	default:
+		return nil, fmt.Errorf("%w: unknown http sink format %q", ErrInvalidDetail, cfg.HTTP.Format)
+	}
+	return &HTTPSink{
+		url:     cfg.HTTP.URL,
+		headers: cfg.HTTP.Headers,
+		format:  cfg.HTTP.Format,
+		client:  &http.Client{Timeout: cfg.HTTP.Timeout},
+	}, nil
+}
+
+type kafkaRESTRecord struct {
+	Key   string    `json:"key,omitempty"`
+	Value logRecord `json:"value"`
+}
+
+func (s *HTTPSink) Write(ctx context.Context, logs []Log) error {
+	var body any
+	contentType := "application/json"
+	switch s.format {
+	case HTTPSinkFormatKafkaREST:
+		// keyed by organization so the logs of an organization stay ordered
+		// within a partition
+		records := make([]kafkaRESTRecord, 0, len(logs))
+		for _, l := range logs {
+			records = append(records, kafkaRESTRecord{Key: l.OrgID, Value: toLogRecord(l)})
+		}
+		body = map[string]any{"records": records}
+		contentType = kafkaRESTContentType
+	default:
+		records := make([]logRecord, 0, len(logs))
+		for _, l := range logs {
+			records = append(records, toLogRecord(l))
+		}
+		body = records
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return postSinkPayload(ctx, s.client, s.url, contentType, s.headers, payload)
+}
+
+func (s *HTTPSink) Close() error {
+	return nil
+}
+
+func postSinkPayload(ctx context.Context, client *http.Client, url, contentType string, headers map[string]string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, snippet)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -0,0 +1,254 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mcuadros/go-defaults"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSink struct {
+	mu      sync.Mutex
+	batches [][]Log
+	block   chan struct{}
+	closed  bool
+}
+
+func (s *fakeSink) Write(_ context.Context, logs []Log) error {
+	if s.block != nil {
+		<-s.block
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.batches = append(s.batches, logs)
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func testLog(id string) Log {
+	return Log{
+		ID:        id,
+		OrgID:     "org-1",
+		Source:    "frontier",
+		Action:    "app.user.created",
+		Actor:     Actor{ID: "u0", Type: "app/user"},
+		Target:    Target{ID: "u1", Type: "app/user", Name: "john"},
+		CreatedAt: time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestBufferedSink(t *testing.T) {
+	t.Run("writes full batches and flushes the rest on close", func(t *testing.T) {
+		sink := &fakeSink{}
+		b := newBufferedSink(SinkConfig{Name: "fake", BufferSize: 10, BatchSize: 2, FlushInterval: time.Hour}, sink)
+		for _, id := range []string{"1", "2", "3"} {
+			b.enqueue(testLog(id))
+		}
+		require.NoError(t, b.close())
+
+		require.Len(t, sink.batches, 2)
+		assert.Len(t, sink.batches[0], 2)
+		assert.Len(t, sink.batches[1], 1)
+		assert.True(t, sink.closed)
+
+		// logs after close are ignored
+		b.enqueue(testLog("4"))
+	})
+
+	t.Run("flushes a partial batch after the interval", func(t *testing.T) {
+		sink := &fakeSink{}
+		b := newBufferedSink(SinkConfig{Name: "fake", BufferSize: 10, BatchSize: 100, FlushInterval: 10 * time.Millisecond}, sink)
+		defer b.close()
+		b.enqueue(testLog("1"))
+		assert.Eventually(t, func() bool {
+			sink.mu.Lock()
+			defer sink.mu.Unlock()
+			return len(sink.batches) == 1
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("drops logs while the buffer is full", func(t *testing.T) {
+		sink := &fakeSink{block: make(chan struct{})}
+		b := newBufferedSink(SinkConfig{Name: "fake", BufferSize: 1, BatchSize: 1, FlushInterval: time.Hour}, sink)
+		for i := 0; i < 5; i++ {
+			b.enqueue(testLog("1"))
+		}
+		close(sink.block)
+		require.NoError(t, b.close())
+
+		var written int
+		for _, batch := range sink.batches {
+			written += len(batch)
+		}
+		// one log held by the worker and one in the buffer at most
+		assert.LessOrEqual(t, written, 2)
+	})
+}
+
+func TestNewSinkRegistry(t *testing.T) {
+	_, err := NewSinkRegistry([]SinkConfig{{Type: "kafka"}})
+	assert.ErrorIs(t, err, ErrInvalidDetail)
+
+	_, err = NewSinkRegistry([]SinkConfig{{Type: SinkTypeHTTP}})
+	assert.ErrorIs(t, err, ErrInvalidDetail)
+
+	sink := &fakeSink{}
+	RegisterSinkType("fake", func(SinkConfig) (Sink, error) { return sink, nil })
+	// the config loader fills in the defaults
+	cfg := Config{Sinks: []SinkConfig{{Type: "fake"}, {Type: "fake"}, {Type: "fake", Name: "audit"}}}
+	defaults.SetDefaults(&cfg)
+	registry, err := NewSinkRegistry(cfg.Sinks)
+	require.NoError(t, err)
+	// unnamed sinks of the same type don't share their metric labels
+	assert.Equal(t, []string{"fake", "fake-1", "audit"}, []string{
+		registry.sinks[0].name, registry.sinks[1].name, registry.sinks[2].name,
+	})
+	registry.Publish(testLog("1"))
+	require.NoError(t, registry.Close())
+	assert.Len(t, sink.batches, 3)
+
+	cfg = Config{Sinks: []SinkConfig{{Type: "fake", Name: "audit"}, {Type: "fake", Name: "audit"}}}
+	defaults.SetDefaults(&cfg)
+	_, err = NewSinkRegistry(cfg.Sinks)
+	assert.ErrorIs(t, err, ErrInvalidDetail)
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	sink, err := NewFileSink(SinkConfig{File: FileSinkConfig{Path: path, MaxSizeMB: 1, MaxBackups: 2}})
+	require.NoError(t, err)
+	fileSink := sink.(*FileSink)
+	// rotate after every few lines
+	fileSink.maxSize = 600
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, sink.Write(context.Background(), []Log{testLog("1"), testLog("2")}))
+	}
+	require.NoError(t, sink.Close())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	var record map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+	assert.Equal(t, "app.user.created", record["action"])
+	assert.Equal(t, "u1", record["target"].(map[string]any)["id"])
+
+	assert.FileExists(t, path+".1")
+	assert.FileExists(t, path+".2")
+	assert.NoFileExists(t, path+".3")
+}
+
+// shortFile writes half of the first write before failing
+type shortFile struct {
+	*os.File
+	failed bool
+}
+
+func (f *shortFile) Write(b []byte) (int, error) {
+	if f.failed {
+		return f.File.Write(b)
+	}
+	f.failed = true
+	n, _ := f.File.Write(b[:len(b)/2])
+	return n, io.ErrShortWrite
+}
+
+func TestFileSinkRetriesPartialWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(SinkConfig{File: FileSinkConfig{Path: path, MaxSizeMB: 1, MaxBackups: 2}})
+	require.NoError(t, err)
+	fileSink := sink.(*FileSink)
+	fileSink.file = &shortFile{File: fileSink.file.(*os.File)}
+
+	batch := []Log{testLog("1"), testLog("2"), testLog("3")}
+	assert.ErrorIs(t, sink.Write(context.Background(), batch), io.ErrShortWrite)
+	require.NoError(t, sink.Write(context.Background(), batch))
+	require.NoError(t, sink.Close())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 3)
+	for i, line := range lines {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		assert.Equal(t, batch[i].ID, record["id"])
+	}
+}
+
+func TestHTTPSinks(t *testing.T) {
+	var contentType string
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	t.Run("json", func(t *testing.T) {
+		sink, err := NewHTTPSink(SinkConfig{HTTP: HTTPSinkConfig{URL: srv.URL, Format: HTTPSinkFormatJSON}})
+		require.NoError(t, err)
+		require.NoError(t, sink.Write(context.Background(), []Log{testLog("1"), testLog("2")}))
+		var records []map[string]any
+		require.NoError(t, json.Unmarshal(body, &records))
+		assert.Len(t, records, 2)
+		assert.Equal(t, "application/json", contentType)
+	})
+
+	t.Run("kafka rest", func(t *testing.T) {
+		sink, err := NewHTTPSink(SinkConfig{HTTP: HTTPSinkConfig{URL: srv.URL, Format: HTTPSinkFormatKafkaREST}})
+		require.NoError(t, err)
+		require.NoError(t, sink.Write(context.Background(), []Log{testLog("1")}))
+		var req struct {
+			Records []struct {
+				Key   string         `json:"key"`
+				Value map[string]any `json:"value"`
+			} `json:"records"`
+		}
+		require.NoError(t, json.Unmarshal(body, &req))
+		require.Len(t, req.Records, 1)
+		assert.Equal(t, "org-1", req.Records[0].Key)
+		assert.Equal(t, "1", req.Records[0].Value["id"])
+		assert.Equal(t, kafkaRESTContentType, contentType)
+	})
+
+	t.Run("otlp", func(t *testing.T) {
+		sink, err := NewOTLPSink(SinkConfig{OTLP: OTLPSinkConfig{Endpoint: srv.URL}})
+		require.NoError(t, err)
+		require.NoError(t, sink.Write(context.Background(), []Log{testLog("1")}))
+		var req otlpExportRequest
+		require.NoError(t, json.Unmarshal(body, &req))
+		require.Len(t, req.ResourceLogs, 1)
+		assert.Equal(t, otlpString("service.name", "frontier"), req.ResourceLogs[0].Resource.Attributes[0])
+		record := req.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+		assert.Equal(t, "1792231200000000000", record.TimeUnixNano)
+		assert.Equal(t, "app.user.created", record.EventName)
+		assert.Contains(t, record.Attributes, otlpString("audit.target.id", "u1"))
+	})
+
+	t.Run("reports a failed request", func(t *testing.T) {
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer failing.Close()
+		sink, err := NewHTTPSink(SinkConfig{HTTP: HTTPSinkConfig{URL: failing.URL, Format: HTTPSinkFormatJSON}})
+		require.NoError(t, err)
+		assert.Error(t, sink.Write(context.Background(), []Log{testLog("1")}))
+	})
+}
@@ -0,0 +1,358 @@
+package auditrecord
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	archiveLockKey    = "audit-record-archival"
+	archiveKeyPrefix  = "audit_records"
+	partitionPageSize = 100
+)
+
+// Archive is a compressed NDJSON file in blob storage holding the expired
+// records of an organization created on a single day
+type Archive struct {
+	ID    string `json:"id"`
+	OrgID string `json:"org_id"`
+	Key   string `json:"key"`
+	// FirstCreatedAt and LastCreatedAt are the creation times of the oldest
+	// and newest record in the archive
+	FirstCreatedAt time.Time `json:"first_created_at"`
+	LastCreatedAt  time.Time `json:"last_created_at"`
+	RecordCount    int64     `json:"record_count"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type ArchiveFilter struct {
+	OrgID string
+	// Since and Until select the archives holding records created in the range
+	Since time.Time
+	Until time.Time
+}
+
+// Partition is the expired records of an organization created on a day
+type Partition struct {
+	OrgID   string
+	Day     time.Time
+	Records int64
+}
+
+// RetentionCutoff expires the records matching the event and organization
+// created before Before. Empty event or organization match any, and a zero
+// Before keeps the records forever.
+type RetentionCutoff struct {
+	Event  string
+	OrgID  string
+	Before time.Time
+}
+
+type ArchiveRepository interface {
+	// ListExpiredPartitions returns the oldest partitions with expired
+	// records, the first matching cutoff applies to a record
+	ListExpiredPartitions(ctx context.Context, cutoffs []RetentionCutoff, limit int) ([]Partition, error)
+	// ListExpired returns the expired records of the partition in chain order
+	ListExpired(ctx context.Context, partition Partition, cutoffs []RetentionCutoff, limit int) ([]AuditRecord, error)
+	// Archive records the archive and replaces its records with chain entries
+	Archive(ctx context.Context, archive Archive, records []AuditRecord) (Archive, error)
+	// Restore inserts the records of the archive back and removes the archive
+	Restore(ctx context.Context, archive Archive, records []AuditRecord) error
+	GetArchive(ctx context.Context, id string) (Archive, error)
+	ListArchives(ctx context.Context, filter ArchiveFilter) ([]Archive, error)
+}
+
+// ArchiveStore holds the gzipped NDJSON files of the archives by their key
+type ArchiveStore interface {
+	Write(ctx context.Context, key string, data []byte) error
+	Read(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+	Close() error
+}
+
+// Locker acquires distributed locks via Postgres advisory locks.
+type Locker interface {
+	TryLock(ctx context.Context, id string) (*db.Lock, error)
+}
+
+// ArchiveService moves audit records past their retention period to blob
+// storage. Archived records leave their chain fields behind, so the chain of
+// an organization can still be verified.
+type ArchiveService struct {
+	repository ArchiveRepository
+	store      ArchiveStore
+	locker     Locker
+	config     RetentionConfig
+	logger     *slog.Logger
+	cron       *cron.Cron
+	now        func() time.Time
+}
+
+func NewArchiveService(repository ArchiveRepository, store ArchiveStore, locker Locker, config RetentionConfig, logger *slog.Logger) *ArchiveService {
+	return &ArchiveService{
+		repository: repository,
+		store:      store,
+		locker:     locker,
+		config:     config,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+func (s *ArchiveService) Init(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	s.cron = cron.New(cron.WithChain(
+		cron.SkipIfStillRunning(cron.DefaultLogger),
+		cron.Recover(cron.DefaultLogger),
+	))
+	_, err := s.cron.AddFunc(s.config.Schedule, func() {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		if err := s.Run(ctx); err != nil {
+			s.logger.ErrorContext(ctx, "audit record archival failed", "error", err)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to schedule audit record archival: %w", err)
+	}
+	s.cron.Start()
+	return nil
+}
+
+func (s *ArchiveService) Close() error {
+	if s.cron != nil {
+		<-s.cron.Stop().Done()
+	}
+	return s.store.Close()
+}
+
+// Run archives every expired record, a run is skipped while another
+// instance holds the lock
+func (s *ArchiveService) Run(ctx context.Context) error {
+	lock, err := s.locker.TryLock(ctx, archiveLockKey)
+	if err != nil {
+		if errors.Is(err, db.ErrLockBusy) {
+			return nil
+		}
+		return err
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil {
+			s.logger.WarnContext(ctx, "failed to release audit record archival lock", "error", err)
+		}
+	}()
+
+	cutoffs := s.cutoffs()
+	if len(cutoffs) == 0 {
+		return nil
+	}
+	for {
+		partitions, err := s.repository.ListExpiredPartitions(ctx, cutoffs, partitionPageSize)
+		if err != nil {
+			return err
+		}
+		for _, partition := range partitions {
+			if err := s.archivePartition(ctx, partition, cutoffs); err != nil {
+				return fmt.Errorf("failed to archive records of %s on %s: %w",
+					partition.OrgID, partition.Day.Format(time.DateOnly), err)
+			}
+		}
+		if len(partitions) < partitionPageSize {
+			return nil
+		}
+	}
+}
+
+// cutoffs resolves the retention periods to creation times, ordered from the
+// most specific rule to the default period
+func (s *ArchiveService) cutoffs() []RetentionCutoff {
+	now := s.now()
+	rules := make([]RetentionRule, len(s.config.Rules))
+	copy(rules, s.config.Rules)
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].specificity() > rules[j].specificity()
+	})
+
+	var cutoffs []RetentionCutoff
+	expires := false
+	for _, rule := range rules {
+		cutoff := RetentionCutoff{Event: rule.Event, OrgID: rule.OrgID}
+		if rule.Period > 0 {
+			cutoff.Before = now.Add(-rule.Period)
+			expires = true
+		}
+		cutoffs = append(cutoffs, cutoff)
+	}
+	if s.config.Period > 0 {
+		cutoffs = append(cutoffs, RetentionCutoff{Before: now.Add(-s.config.Period)})
+		expires = true
+	}
+	if !expires {
+		return nil
+	}
+	return cutoffs
+}
+
+func (s *ArchiveService) archivePartition(ctx context.Context, partition Partition, cutoffs []RetentionCutoff) error {
+	for {
+		records, err := s.repository.ListExpired(ctx, partition, cutoffs, s.config.BatchSize)
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+
+		data, err := encodeArchive(records)
+		if err != nil {
+			return err
+		}
+		archive := Archive{
+			ID:             uuid.New().String(),
+			OrgID:          partition.OrgID,
+			FirstCreatedAt: records[0].CreatedAt,
+			LastCreatedAt:  records[0].CreatedAt,
+			RecordCount:    int64(len(records)),
+		}
+		for _, record := range records {
+			if record.CreatedAt.Before(archive.FirstCreatedAt) {
+				archive.FirstCreatedAt = record.CreatedAt
+			}
+			if record.CreatedAt.After(archive.LastCreatedAt) {
+				archive.LastCreatedAt = record.CreatedAt
+			}
+		}
+		archive.Key = archiveKey(partition, archive.ID)
+
+		// the archive is written before the records are deleted, a failed
+		// delete leaves an unreferenced file instead of losing records
+		if err := s.store.Write(ctx, archive.Key, data); err != nil {
+			return fmt.Errorf("failed to write archive: %w", err)
+		}
+		if _, err := s.repository.Archive(ctx, archive, records); err != nil {
+			return err
+		}
+		s.logger.InfoContext(ctx, "archived audit records", "org_id", archive.OrgID,
+			"archive_id", archive.ID, "key", archive.Key, "records", archive.RecordCount)
+
+		if len(records) < s.config.BatchSize {
+			return nil
+		}
+	}
+}
+
+// archiveKey partitions the archives by organization and day, e.g.
+// audit_records/<org-id>/2026/10/17/<archive-id>.ndjson.gz
+func archiveKey(partition Partition, id string) string {
+	return path.Join(archiveKeyPrefix, partition.OrgID,
+		partition.Day.UTC().Format("2006/01/02"), id+".ndjson.gz")
+}
+
+func (s *ArchiveService) ListArchives(ctx context.Context, filter ArchiveFilter) ([]Archive, error) {
+	return s.repository.ListArchives(ctx, filter)
+}
+
+// ReadArchive returns the records of an archive, each chained record is
+// checked against its hash
+func (s *ArchiveService) ReadArchive(ctx context.Context, id string) (Archive, []AuditRecord, error) {
+	archive, err := s.repository.GetArchive(ctx, id)
+	if err != nil {
+		return Archive{}, nil, err
+	}
+	data, err := s.store.Read(ctx, archive.Key)
+	if err != nil {
+		return Archive{}, nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	records, err := decodeArchive(data)
+	if err != nil {
+		return Archive{}, nil, err
+	}
+	if int64(len(records)) != archive.RecordCount {
+		return Archive{}, nil, fmt.Errorf("%w: expected %d records, found %d",
+			ErrArchiveCorrupt, archive.RecordCount, len(records))
+	}
+	for _, record := range records {
+		if record.ChainSeq == 0 {
+			continue
+		}
+		hash, err := ChainHash(record)
+		if err != nil {
+			return Archive{}, nil, err
+		}
+		if hash != record.Hash {
+			return Archive{}, nil, fmt.Errorf("%w: record %s was modified", ErrArchiveCorrupt, record.ID)
+		}
+	}
+	return archive, records, nil
+}
+
+// RestoreArchive moves the records of an archive back to the database and
+// deletes the archive. Records still past their retention period are
+// archived again by the next run.
+func (s *ArchiveService) RestoreArchive(ctx context.Context, id string) (Archive, error) {
+	archive, records, err := s.ReadArchive(ctx, id)
+	if err != nil {
+		return Archive{}, err
+	}
+	if err := s.repository.Restore(ctx, archive, records); err != nil {
+		return Archive{}, err
+	}
+	if err := s.store.Delete(ctx, archive.Key); err != nil {
+		s.logger.WarnContext(ctx, "failed to delete restored audit record archive",
+			"archive_id", archive.ID, "key", archive.Key, "error", err)
+	}
+	return archive, nil
+}
+
+func encodeArchive(records []AuditRecord) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(zw)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return nil, fmt.Errorf("failed to encode audit record %s: %w", record.ID, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeArchive(data []byte) ([]AuditRecord, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrArchiveCorrupt, err)
+	}
+	defer zr.Close()
+
+	var records []AuditRecord
+	decoder := json.NewDecoder(bufio.NewReader(zr))
+	for {
+		var record AuditRecord
+		if err := decoder.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+			return nil, fmt.Errorf("%w: %w", ErrArchiveCorrupt, err)
+		}
+		records = append(records, record)
+	}
+}
@@ -0,0 +1,260 @@
+package auditrecord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeArchiveRepository keeps the records of a single partition in memory
+type fakeArchiveRepository struct {
+	records  []AuditRecord
+	archives map[string]Archive
+	archived map[string][]AuditRecord
+}
+
+func (r *fakeArchiveRepository) ListExpiredPartitions(ctx context.Context, cutoffs []RetentionCutoff, limit int) ([]Partition, error) {
+	return nil, nil
+}
+
+func (r *fakeArchiveRepository) ListExpired(ctx context.Context, partition Partition, cutoffs []RetentionCutoff, limit int) ([]AuditRecord, error) {
+	return r.records[:min(limit, len(r.records))], nil
+}
+
+func (r *fakeArchiveRepository) Archive(ctx context.Context, archive Archive, records []AuditRecord) (Archive, error) {
+	r.archives[archive.ID] = archive
+	r.archived[archive.ID] = records
+	r.records = r.records[len(records):]
+	return archive, nil
+}
+
+func (r *fakeArchiveRepository) Restore(ctx context.Context, archive Archive, records []AuditRecord) error {
+	delete(r.archives, archive.ID)
+	r.records = append(r.records, records...)
+	return nil
+}
+
+func (r *fakeArchiveRepository) GetArchive(ctx context.Context, id string) (Archive, error) {
+	archive, ok := r.archives[id]
+	if !ok {
+		return Archive{}, ErrArchiveNotFound
+	}
+	return archive, nil
+}
+
+func (r *fakeArchiveRepository) ListArchives(ctx context.Context, filter ArchiveFilter) ([]Archive, error) {
+	var archives []Archive
+	for _, archive := range r.archives {
+		archives = append(archives, archive)
+	}
+	return archives, nil
+}
+
+func archiveTestRecords(t *testing.T, n int) []AuditRecord {
+	t.Helper()
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	var records []AuditRecord
+	prevHash := ""
+	for i := 1; i <= n; i++ {
+		record := AuditRecord{
+			ID:         "record-" + strings.Repeat("x", i),
+			Event:      "app.user.created",
+			Actor:      Actor{ID: "actor-1", Type: "app/user", Metadata: metadata.Metadata{}},
+			Resource:   Resource{ID: "user-1", Type: "app/user", Metadata: metadata.Metadata{"n": float64(i)}},
+			OrgID:      "org-1",
+			OccurredAt: day.Add(time.Duration(i) * time.Minute),
+			CreatedAt:  day.Add(time.Duration(i) * time.Minute),
+			Metadata:   metadata.Metadata{},
+			ChainSeq:   int64(i),
+			PrevHash:   prevHash,
+		}
+		hash, err := ChainHash(record)
+		require.NoError(t, err)
+		record.Hash = hash
+		prevHash = hash
+		records = append(records, record)
+	}
+	return records
+}
+
+type fakeArchiveStore struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func (s *fakeArchiveStore) Write(_ context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.files == nil {
+		s.files = map[string][]byte{}
+	}
+	s.files[key] = data
+	return nil
+}
+
+func (s *fakeArchiveStore) Read(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.files[key]
+	if !ok {
+		return nil, fmt.Errorf("archive %s doesn't exist", key)
+	}
+	return data, nil
+}
+
+func (s *fakeArchiveStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.files, key)
+	return nil
+}
+
+func (s *fakeArchiveStore) Close() error {
+	return nil
+}
+
+func newTestArchiveService(repo ArchiveRepository, config RetentionConfig) *ArchiveService {
+	svc := NewArchiveService(repo, &fakeArchiveStore{}, nil, config, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	svc.now = func() time.Time { return time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC) }
+	return svc
+}
+
+func TestArchiveService_cutoffs(t *testing.T) {
+	now := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	t.Run("no period keeps records forever", func(t *testing.T) {
+		svc := newTestArchiveService(nil, RetentionConfig{
+			Rules: []RetentionRule{{Event: "app.user.listed"}},
+		})
+		assert.Nil(t, svc.cutoffs())
+	})
+
+	t.Run("most specific rule first and default last", func(t *testing.T) {
+		svc := newTestArchiveService(nil, RetentionConfig{
+			Period: 365 * day,
+			Rules: []RetentionRule{
+				{Event: "app.user.listed", Period: 30 * day},
+				{OrgID: "org-1"},
+				{OrgID: "org-1", Event: "app.user.listed", Period: 90 * day},
+			},
+		})
+		assert.Equal(t, []RetentionCutoff{
+			{OrgID: "org-1", Event: "app.user.listed", Before: now.Add(-90 * day)},
+			{OrgID: "org-1"},
+			{Event: "app.user.listed", Before: now.Add(-30 * day)},
+			{Before: now.Add(-365 * day)},
+		}, svc.cutoffs())
+	})
+}
+
+func TestArchiveService_archivePartition(t *testing.T) {
+	ctx := context.Background()
+	records := archiveTestRecords(t, 5)
+	repo := &fakeArchiveRepository{
+		records:  records,
+		archives: map[string]Archive{},
+		archived: map[string][]AuditRecord{},
+	}
+	svc := newTestArchiveService(repo, RetentionConfig{BatchSize: 2})
+	partition := Partition{OrgID: "org-1", Day: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)}
+
+	require.NoError(t, svc.archivePartition(ctx, partition, nil))
+	assert.Empty(t, repo.records)
+	require.Len(t, repo.archives, 3, "5 records in batches of 2")
+
+	var archived int64
+	for id, archive := range repo.archives {
+		assert.True(t, strings.HasPrefix(archive.Key, "audit_records/org-1/2025/03/01/"), archive.Key)
+		assert.False(t, archive.LastCreatedAt.Before(archive.FirstCreatedAt))
+
+		gotArchive, got, err := svc.ReadArchive(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, archive, gotArchive)
+		assert.Len(t, got, int(archive.RecordCount))
+		for i, record := range got {
+			assert.Equal(t, repo.archived[id][i].Hash, record.Hash)
+			assert.True(t, repo.archived[id][i].CreatedAt.Equal(record.CreatedAt))
+		}
+		archived += archive.RecordCount
+	}
+	assert.EqualValues(t, 5, archived)
+
+	t.Run("restore moves records back", func(t *testing.T) {
+		for id := range repo.archives {
+			archive, err := svc.RestoreArchive(ctx, id)
+			require.NoError(t, err)
+			_, err = svc.store.Read(ctx, archive.Key)
+			assert.Error(t, err, "restored archive should be deleted")
+		}
+		assert.Len(t, repo.records, 5)
+		assert.Empty(t, repo.archives)
+	})
+}
+
+func TestArchiveService_ReadArchive(t *testing.T) {
+	ctx := context.Background()
+	records := archiveTestRecords(t, 3)
+
+	tests := []struct {
+		name    string
+		data    func() []byte
+		count   int64
+		wantErr error
+	}{
+		{
+			name: "modified record",
+			data: func() []byte {
+				modified := append([]AuditRecord{}, records...)
+				modified[1].Actor.Name = "mallory"
+				data, _ := encodeArchive(modified)
+				return data
+			},
+			count:   3,
+			wantErr: ErrArchiveCorrupt,
+		},
+		{
+			name: "missing record",
+			data: func() []byte {
+				data, _ := encodeArchive(records[:2])
+				return data
+			},
+			count:   3,
+			wantErr: ErrArchiveCorrupt,
+		},
+		{
+			name:    "not an archive",
+			data:    func() []byte { return []byte("{}") },
+			count:   1,
+			wantErr: ErrArchiveCorrupt,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeArchiveRepository{archives: map[string]Archive{
+				"archive-1": {ID: "archive-1", Key: "archive-1.ndjson.gz", RecordCount: tt.count},
+			}}
+			svc := newTestArchiveService(repo, RetentionConfig{})
+			require.NoError(t, svc.store.Write(ctx, "archive-1.ndjson.gz", tt.data()))
+
+			_, _, err := svc.ReadArchive(ctx, "archive-1")
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+		})
+	}
+
+	t.Run("unknown archive", func(t *testing.T) {
+		svc := newTestArchiveService(&fakeArchiveRepository{archives: map[string]Archive{}}, RetentionConfig{})
+		_, _, err := svc.ReadArchive(ctx, "archive-2")
+		assert.ErrorIs(t, err, ErrArchiveNotFound)
+	})
+}
@@ -0,0 +1,226 @@
+package auditrecord
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/raystack/frontier/pkg/metadata"
+)
+
+const chainPageSize = 1000
+
+// ChainFilter selects the chained records of an organization in sequence order
+type ChainFilter struct {
+	OrgID string
+	// Since and Until bound the creation time of the records, zero values
+	// leave the range open
+	Since time.Time
+	Until time.Time
+	// AfterSeq returns only the records after the sequence number
+	AfterSeq int64
+	Limit    int
+}
+
+// ChainBreak is the first record of a chain that fails verification
+type ChainBreak struct {
+	Seq      int64  `json:"seq"`
+	RecordID string `json:"record_id,omitempty"`
+	Reason   string `json:"reason"`
+}
+
+// ChainVerification is the result of walking the hash chain of an organization
+type ChainVerification struct {
+	OrgID   string `json:"org_id"`
+	Checked int    `json:"checked"`
+	// LastSeq and LastHash are the last verified record of the range. The
+	// hashes aren't keyed, so a chain rewritten in the database verifies
+	// again. Storing them outside frontier is what allows detecting that,
+	// along with records removed from the end of the chain.
+	LastSeq  int64       `json:"last_seq"`
+	LastHash string      `json:"last_hash"`
+	Break    *ChainBreak `json:"break,omitempty"`
+}
+
+// Valid is true when no break was found
+func (v ChainVerification) Valid() bool {
+	return v.Break == nil
+}
+
+// chainEntry is the canonical form of a record that is hashed, the field
+// order is fixed and the maps are encoded with sorted keys
+type chainEntry struct {
+	Seq            int64             `json:"seq"`
+	PrevHash       string            `json:"prev_hash"`
+	ID             string            `json:"id"`
+	IdempotencyKey string            `json:"idempotency_key"`
+	Event          string            `json:"event"`
+	Actor          Actor             `json:"actor"`
+	Resource       Resource          `json:"resource"`
+	Target         Target            `json:"target"`
+	OrgID          string            `json:"org_id"`
+	OrgName        string            `json:"org_name"`
+	RequestID      string            `json:"request_id"`
+	OccurredAt     string            `json:"occurred_at"`
+	CreatedAt      string            `json:"created_at"`
+	Metadata       metadata.Metadata `json:"metadata"`
+}
+
+// ChainHash is the sha256 hash of the record including the hash of the
+// previous record, which links the records of an organization. Timestamps
+// are compared at the microsecond precision they are stored with, and empty
+// metadata or target hash the same as missing ones.
+func ChainHash(record AuditRecord) (string, error) {
+	entry := chainEntry{
+		Seq:            record.ChainSeq,
+		PrevHash:       record.PrevHash,
+		ID:             record.ID,
+		IdempotencyKey: record.IdempotencyKey,
+		Event:          record.Event.String(),
+		Actor:          record.Actor,
+		Resource:       record.Resource,
+		OrgID:          record.OrgID,
+		OrgName:        record.OrgName,
+		OccurredAt:     chainTime(record.OccurredAt),
+		CreatedAt:      chainTime(record.CreatedAt),
+		Metadata:       chainMetadata(record.Metadata),
+	}
+	entry.Actor.Metadata = chainMetadata(entry.Actor.Metadata)
+	entry.Resource.Metadata = chainMetadata(entry.Resource.Metadata)
+	if record.Target != nil {
+		entry.Target = *record.Target
+	}
+	entry.Target.Metadata = chainMetadata(entry.Target.Metadata)
+	if record.RequestID != nil {
+		entry.RequestID = *record.RequestID
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit record: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func chainTime(t time.Time) string {
+	return t.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano)
+}
+
+func chainMetadata(m metadata.Metadata) metadata.Metadata {
+	if m == nil {
+		return metadata.Metadata{}
+	}
+	return m
+}
+
+// VerifyChain walks the chained records of the organization created in the
+// range and reports the first record whose sequence, previous hash or own
+// hash doesn't match. A range starting after the first record is verified
+// against the record before it.
+func (s *Service) VerifyChain(ctx context.Context, orgID string, since, until time.Time) (ChainVerification, error) {
+	result := ChainVerification{OrgID: orgID}
+	filter := ChainFilter{
+		OrgID: orgID,
+		Since: since,
+		Until: until,
+		Limit: chainPageSize,
+	}
+
+	var prev *AuditRecord
+	for {
+		records, err := s.repository.ListChain(ctx, filter)
+		if err != nil {
+			return ChainVerification{}, err
+		}
+		for i := range records {
+			record := records[i]
+			if prev == nil && record.ChainSeq > 1 {
+				anchor, err := s.chainAnchor(ctx, orgID, record.ChainSeq)
+				if err != nil {
+					return ChainVerification{}, err
+				}
+				if anchor == nil {
+					result.Break = &ChainBreak{
+						Seq:    record.ChainSeq - 1,
+						Reason: "record is missing",
+					}
+					return result, nil
+				}
+				prev = anchor
+			}
+
+			brk, err := verifyChainLink(prev, record)
+			if err != nil {
+				return ChainVerification{}, err
+			}
+			if brk != nil {
+				result.Break = brk
+				return result, nil
+			}
+			result.Checked++
+			result.LastSeq = record.ChainSeq
+			result.LastHash = record.Hash
+			prev = &record
+		}
+		if len(records) < filter.Limit {
+			return result, nil
+		}
+		filter.AfterSeq = records[len(records)-1].ChainSeq
+	}
+}
+
+// chainAnchor returns the record before the sequence number, or nil if it
+// doesn't exist
+func (s *Service) chainAnchor(ctx context.Context, orgID string, seq int64) (*AuditRecord, error) {
+	records, err := s.repository.ListChain(ctx, ChainFilter{
+		OrgID:    orgID,
+		AfterSeq: seq - 2,
+		Limit:    1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || records[0].ChainSeq != seq-1 {
+		return nil, nil
+	}
+	return &records[0], nil
+}
+
+// verifyChainLink checks the record against the record before it, prev is
+// nil for the first record of the chain
+func verifyChainLink(prev *AuditRecord, record AuditRecord) (*ChainBreak, error) {
+	expectedSeq, expectedPrevHash := int64(1), ""
+	if prev != nil {
+		expectedSeq, expectedPrevHash = prev.ChainSeq+1, prev.Hash
+	}
+
+	brk := &ChainBreak{Seq: record.ChainSeq, RecordID: record.ID}
+	switch {
+	case record.ChainSeq != expectedSeq:
+		brk.Seq = expectedSeq
+		brk.RecordID = ""
+		brk.Reason = "record is missing"
+		return brk, nil
+	case record.PrevHash != expectedPrevHash:
+		brk.Reason = "previous hash doesn't match the previous record"
+		return brk, nil
+	}
+
+	// archived records are verified against their archive when it is read
+	if record.ArchiveID != "" {
+		return nil, nil
+	}
+	hash, err := ChainHash(record)
+	if err != nil {
+		return nil, err
+	}
+	if hash != record.Hash {
+		brk.Reason = "hash doesn't match the record"
+		return brk, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,205 @@
+package auditrecord_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/core/auditrecord"
+	"github.com/raystack/frontier/core/auditrecord/mocks"
+	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const chainOrgID = "9f3b2a1d-5e6f-4c8e-8b7a-4f2b3c9e7d1a"
+
+// buildChain returns n records linked the way the repository stores them
+func buildChain(t *testing.T, n int) []auditrecord.AuditRecord {
+	t.Helper()
+	createdAt := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	records := make([]auditrecord.AuditRecord, 0, n)
+	prevHash := ""
+	for i := 1; i <= n; i++ {
+		record := auditrecord.AuditRecord{
+			ID:    "record-" + string(rune('a'+i)),
+			Event: "app.user.created",
+			Actor: auditrecord.Actor{
+				ID:   "actor-1",
+				Type: "app/user",
+				Name: "john",
+			},
+			Resource: auditrecord.Resource{
+				ID:   "user-1",
+				Type: "app/user",
+			},
+			OrgID:      chainOrgID,
+			OccurredAt: createdAt.Add(time.Duration(i) * time.Second),
+			CreatedAt:  createdAt.Add(time.Duration(i) * time.Second),
+			Metadata:   metadata.Metadata{"count": float64(i)},
+			ChainSeq:   int64(i),
+			PrevHash:   prevHash,
+		}
+		hash, err := auditrecord.ChainHash(record)
+		assert.NoError(t, err)
+		record.Hash = hash
+		prevHash = hash
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestChainHash(t *testing.T) {
+	record := buildChain(t, 1)[0]
+	hash := record.Hash
+
+	t.Run("ignores the stored hash", func(t *testing.T) {
+		record := record
+		record.Hash = "something else"
+		got, err := auditrecord.ChainHash(record)
+		assert.NoError(t, err)
+		assert.Equal(t, hash, got)
+	})
+
+	t.Run("normalizes empty metadata, target and time precision", func(t *testing.T) {
+		record := record
+		record.Target = &auditrecord.Target{Metadata: metadata.Metadata{}}
+		record.Actor.Metadata = metadata.Metadata{}
+		record.CreatedAt = record.CreatedAt.Add(300 * time.Nanosecond).In(time.FixedZone("IST", 19800))
+		got, err := auditrecord.ChainHash(record)
+		assert.NoError(t, err)
+		assert.Equal(t, hash, got)
+	})
+
+	t.Run("changes with the record", func(t *testing.T) {
+		for name, modify := range map[string]func(*auditrecord.AuditRecord){
+			"event":     func(r *auditrecord.AuditRecord) { r.Event = "app.user.deleted" },
+			"actor":     func(r *auditrecord.AuditRecord) { r.Actor.Name = "jane" },
+			"metadata":  func(r *auditrecord.AuditRecord) { r.Metadata = metadata.Metadata{"count": float64(2)} },
+			"prev hash": func(r *auditrecord.AuditRecord) { r.PrevHash = "abc" },
+			"sequence":  func(r *auditrecord.AuditRecord) { r.ChainSeq = 2 },
+			"time":      func(r *auditrecord.AuditRecord) { r.OccurredAt = r.OccurredAt.Add(time.Microsecond) },
+		} {
+			t.Run(name, func(t *testing.T) {
+				record := record
+				modify(&record)
+				got, err := auditrecord.ChainHash(record)
+				assert.NoError(t, err)
+				assert.NotEqual(t, hash, got)
+			})
+		}
+	})
+}
+
+func TestService_VerifyChain(t *testing.T) {
+	ctx := context.Background()
+	since := time.Date(2026, 10, 1, 10, 0, 3, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		setup       func(repo *mocks.Repository, chain []auditrecord.AuditRecord)
+		since       time.Time
+		wantBreak   *auditrecord.ChainBreak
+		wantChecked int
+		wantLastSeq int64
+	}{
+		{
+			name: "valid chain",
+			setup: func(repo *mocks.Repository, chain []auditrecord.AuditRecord) {
+				repo.EXPECT().ListChain(ctx, mock.Anything).Return(chain, nil).Once()
+			},
+			wantChecked: 5,
+			wantLastSeq: 5,
+		},
+		{
+			name: "modified record",
+			setup: func(repo *mocks.Repository, chain []auditrecord.AuditRecord) {
+				chain[2].Actor.Name = "mallory"
+				repo.EXPECT().ListChain(ctx, mock.Anything).Return(chain, nil).Once()
+			},
+			wantBreak:   &auditrecord.ChainBreak{Seq: 3, RecordID: "record-d", Reason: "hash doesn't match the record"},
+			wantChecked: 2,
+			wantLastSeq: 2,
+		},
+		{
+			name: "removed record",
+			setup: func(repo *mocks.Repository, chain []auditrecord.AuditRecord) {
+				repo.EXPECT().ListChain(ctx, mock.Anything).Return(append(chain[:3:3], chain[4]), nil).Once()
+			},
+			wantBreak:   &auditrecord.ChainBreak{Seq: 4, Reason: "record is missing"},
+			wantChecked: 3,
+			wantLastSeq: 3,
+		},
+		{
+			name: "rehashed record",
+			setup: func(repo *mocks.Repository, chain []auditrecord.AuditRecord) {
+				chain[1].Actor.Name = "mallory"
+				chain[1].Hash, _ = auditrecord.ChainHash(chain[1])
+				repo.EXPECT().ListChain(ctx, mock.Anything).Return(chain, nil).Once()
+			},
+			wantBreak:   &auditrecord.ChainBreak{Seq: 3, RecordID: "record-d", Reason: "previous hash doesn't match the previous record"},
+			wantChecked: 2,
+			wantLastSeq: 2,
+		},
+		{
+			name: "archived record",
+			setup: func(repo *mocks.Repository, chain []auditrecord.AuditRecord) {
+				chain[1] = auditrecord.AuditRecord{
+					ID:        chain[1].ID,
+					OrgID:     chain[1].OrgID,
+					CreatedAt: chain[1].CreatedAt,
+					ChainSeq:  chain[1].ChainSeq,
+					PrevHash:  chain[1].PrevHash,
+					Hash:      chain[1].Hash,
+					ArchiveID: "archive-1",
+				}
+				repo.EXPECT().ListChain(ctx, mock.Anything).Return(chain, nil).Once()
+			},
+			wantChecked: 5,
+			wantLastSeq: 5,
+		},
+		{
+			name:  "range verified against the record before it",
+			since: since,
+			setup: func(repo *mocks.Repository, chain []auditrecord.AuditRecord) {
+				repo.EXPECT().ListChain(ctx, mock.MatchedBy(func(f auditrecord.ChainFilter) bool {
+					return f.Since.Equal(since) && f.AfterSeq == 0
+				})).Return(chain[2:], nil).Once()
+				repo.EXPECT().ListChain(ctx, auditrecord.ChainFilter{
+					OrgID:    chainOrgID,
+					AfterSeq: 1,
+					Limit:    1,
+				}).Return(chain[1:2], nil).Once()
+			},
+			wantChecked: 3,
+			wantLastSeq: 5,
+		},
+		{
+			name:  "record before the range removed",
+			since: since,
+			setup: func(repo *mocks.Repository, chain []auditrecord.AuditRecord) {
+				repo.EXPECT().ListChain(ctx, mock.MatchedBy(func(f auditrecord.ChainFilter) bool {
+					return f.Since.Equal(since)
+				})).Return(chain[2:], nil).Once()
+				repo.EXPECT().ListChain(ctx, mock.MatchedBy(func(f auditrecord.ChainFilter) bool {
+					return f.Since.IsZero() && f.AfterSeq == 1
+				})).Return(chain[2:3], nil).Once()
+			},
+			wantBreak: &auditrecord.ChainBreak{Seq: 2, Reason: "record is missing"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewRepository(t)
+			tt.setup(repo, buildChain(t, 5))
+
+			svc := auditrecord.NewService(repo, nil, nil, nil, nil)
+			got, err := svc.VerifyChain(ctx, chainOrgID, tt.since, time.Time{})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantBreak, got.Break)
+			assert.Equal(t, tt.wantBreak == nil, got.Valid())
+			assert.Equal(t, tt.wantChecked, got.Checked)
+			assert.Equal(t, tt.wantLastSeq, got.LastSeq)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package auditrecord
+
+import "time"
+
+type Config struct {
+	Retention RetentionConfig `yaml:"retention" mapstructure:"retention"`
+}
+
+// RetentionConfig archives audit records older than their retention period to
+// blob storage and deletes them from the database
+type RetentionConfig struct {
+	Enabled  bool   `yaml:"enabled" mapstructure:"enabled" default:"false"`
+	Schedule string `yaml:"schedule" mapstructure:"schedule" default:"@daily"`
+
+	// Period is how long records not matched by a rule are kept, zero keeps
+	// them forever
+	Period time.Duration   `yaml:"period" mapstructure:"period"`
+	Rules  []RetentionRule `yaml:"rules" mapstructure:"rules"`
+
+	// BatchSize is the maximum number of records in an archive
+	BatchSize int `yaml:"batch_size" mapstructure:"batch_size" default:"10000"`
+
+	// StoragePath is the bucket archives are written to, e.g.
+	// gs://bucket-name/audit or file:///var/lib/frontier/audit
+	StoragePath string `yaml:"storage_path" mapstructure:"storage_path"`
+	// StorageSecret is the credential of the bucket, e.g. env://GOOGLE_CREDENTIALS
+	// or file:///etc/frontier/gcs.json
+	StorageSecret string `yaml:"storage_secret" mapstructure:"storage_secret"`
+}
+
+// RetentionRule overrides the retention period of the records of an event,
+// an organization or an event of an organization. The most specific rule
+// matching a record applies.
+type RetentionRule struct {
+	Event  string        `yaml:"event" mapstructure:"event"`
+	OrgID  string        `yaml:"org_id" mapstructure:"org_id"`
+	Period time.Duration `yaml:"period" mapstructure:"period"`
+}
+
+// specificity orders rules from the most specific, rules of an organization
+// win over rules of an event
+func (r RetentionRule) specificity() int {
+	switch {
+	case r.OrgID != "" && r.Event != "":
+		return 3
+	case r.OrgID != "":
+		return 2
+	case r.Event != "":
+		return 1
+	}
+	return 0
+}
@@ -8,4 +8,6 @@ var (
 	ErrNotFound               = errors.New("audit record not found")
 	ErrRepositoryBadInput     = errors.New("invalid repository input")
 	ErrActorNotFound          = errors.New("actor not found")
+	ErrArchiveNotFound        = errors.New("audit record archive not found")
+	ErrArchiveCorrupt         = errors.New("audit record archive doesn't match its records")
 )
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	auditrecord "github.com/raystack/frontier/core/auditrecord"
+
 	context "context"
 	io "io"
 
@@ -263,6 +265,65 @@ func (_c *Repository_List_Call) RunAndReturn(run func(context.Context, *rql.Quer
 	return _c
 }
 
+// ListChain provides a mock function with given fields: ctx, filter
+func (_m *Repository) ListChain(ctx context.Context, filter auditrecord.ChainFilter) ([]models.AuditRecord, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChain")
+	}
+
+	var r0 []models.AuditRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, auditrecord.ChainFilter) ([]models.AuditRecord, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, auditrecord.ChainFilter) []models.AuditRecord); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.AuditRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, auditrecord.ChainFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_ListChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChain'
+type Repository_ListChain_Call struct {
+	*mock.Call
+}
+
+// ListChain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter auditrecord.ChainFilter
+func (_e *Repository_Expecter) ListChain(ctx interface{}, filter interface{}) *Repository_ListChain_Call {
+	return &Repository_ListChain_Call{Call: _e.mock.On("ListChain", ctx, filter)}
+}
+
+func (_c *Repository_ListChain_Call) Run(run func(ctx context.Context, filter auditrecord.ChainFilter)) *Repository_ListChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auditrecord.ChainFilter))
+	})
+	return _c
+}
+
+func (_c *Repository_ListChain_Call) Return(_a0 []models.AuditRecord, _a1 error) *Repository_ListChain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_ListChain_Call) RunAndReturn(run func(context.Context, auditrecord.ChainFilter) ([]models.AuditRecord, error)) *Repository_ListChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRepository(t interface {
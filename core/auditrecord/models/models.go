@@ -21,6 +21,16 @@ type AuditRecord struct {
 	CreatedAt      time.Time         `json:"created_at"`
 	Metadata       metadata.Metadata `json:"metadata"`
 	IdempotencyKey string            `json:"idempotency_key"`
+
+	// ChainSeq is the position of the record in the hash chain of its
+	// organization, PrevHash the hash of the record before it and Hash the
+	// hash of this record. They are empty for records created before chaining.
+	ChainSeq int64  `json:"chain_seq,omitempty"`
+	PrevHash string `json:"prev_hash,omitempty"`
+	Hash     string `json:"hash,omitempty"`
+	// ArchiveID is set on the chain entries of archived records, which carry
+	// only the id, organization, creation time and chain fields
+	ArchiveID string `json:"archive_id,omitempty"`
 }
 
 type Actor struct {
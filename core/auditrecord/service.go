@@ -27,6 +27,7 @@ type Repository interface {
 	GetByIdempotencyKey(ctx context.Context, idempotencyKey string) (AuditRecord, error)
 	List(ctx context.Context, query *rql.Query) (AuditRecordsList, error)
 	Export(ctx context.Context, query *rql.Query) (io.Reader, string, error)
+	ListChain(ctx context.Context, filter ChainFilter) ([]AuditRecord, error)
 }
 
 type UserService interface {
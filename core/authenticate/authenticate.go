@@ -21,6 +21,9 @@ const (
 	MailOTPAuthMethod  = AuthMethod(strategy.MailOTPAuthMethod)
 	MailLinkAuthMethod = AuthMethod(strategy.MailLinkAuthMethod)
 	PassKeyAuthMethod  = AuthMethod(strategy.PasskeyAuthMethod)
+	// SSOAuthMethod signs in with the identity provider of the organization
+	// which verified the domain of the user email
+	SSOAuthMethod = AuthMethod("sso")
 )
 
 func (m AuthMethod) String() string {
@@ -46,6 +49,10 @@ const (
 	// PassthroughHeaderClientAssertion is used to authenticate using headers passed by the client
 	// this is non secure way of authenticating client in test environments
 	PassthroughHeaderClientAssertion ClientAssertion = "passthrough_header"
+	// MFAPendingSessionClientAssertion is used to authenticate using session
+	// cookie, accepting sessions waiting for their second factor. Only the
+	// endpoints completing the login allow it.
+	MFAPendingSessionClientAssertion ClientAssertion = "mfa_pending_session"
 )
 
 func (a ClientAssertion) String() string {
@@ -106,6 +113,9 @@ type RegistrationStartRequest struct {
 	// For most cases it could be host of frontier but in case of proxies, this will be proxy public endpoint.
 	// callback_url should be one of the allowed urls configured at instance level
 	CallbackUrl string
+
+	// IPAddress of the client, login attempts are rate limited per ip
+	IPAddress string
 }
 
 type RegistrationFinishRequest struct {
@@ -115,6 +125,9 @@ type RegistrationFinishRequest struct {
 	Code        string
 	State       string
 	StateConfig map[string]any
+
+	// IPAddress of the client, login attempts are rate limited per ip
+	IPAddress string
 }
 
 type RegistrationStartResponse struct {
@@ -126,6 +139,8 @@ type RegistrationStartResponse struct {
 type RegistrationFinishResponse struct {
 	User user.User
 	Flow *Flow
+	// MFARequired is set for users who verify a second factor after the login
+	MFARequired bool
 }
 
 type Principal struct {
@@ -136,6 +151,9 @@ type Principal struct {
 	Type string
 	// AuthVia is the credential type that authenticated this principal
 	AuthVia ClientAssertion
+	// SessionID is the session a user principal is authenticated with,
+	// directly or by an access token issued for the session
+	SessionID string
 
 	User        *user.User
 	ServiceUser *serviceuser.ServiceUser
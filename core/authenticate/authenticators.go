@@ -28,13 +28,34 @@ var authenticators = map[ClientAssertion]AuthenticatorFunc{
 	JWTGrantClientAssertion:          authenticateWithJWTGrant,
 	ClientCredentialsClientAssertion: authenticateWithClientCredentials,
 	PassthroughHeaderClientAssertion: authenticateWithPassthroughHeader,
+	MFAPendingSessionClientAssertion: authenticateWithMFAPendingSession,
 }
 
 // authenticateWithSession extracts user from session cookie.
 // Copied from original GetPrincipal session block.
 func authenticateWithSession(ctx context.Context, s *Service) (Principal, error) {
+	return sessionPrincipal(ctx, s, false)
+}
+
+// authenticateWithMFAPendingSession extracts user from session cookie, the
+// session may still wait for its second factor
+func authenticateWithMFAPendingSession(ctx context.Context, s *Service) (Principal, error) {
+	return sessionPrincipal(ctx, s, true)
+}
+
+func sessionPrincipal(ctx context.Context, s *Service, allowMFAPending bool) (Principal, error) {
 	session, err := s.sessionService.ExtractFromContext(ctx)
 	if err == nil && session.IsValid(s.Now()) && utils.IsValidUUID(session.UserID) {
+		// the login isn't complete till the second factor is verified
+		if session.MFAPending() && !allowMFAPending {
+			return Principal{}, ErrMFARequired
+		}
+		if err := s.sessionService.Track(ctx, session); err != nil {
+			if errors.Is(err, frontiersession.ErrClientMismatch) {
+				return Principal{}, errors.ErrUnauthenticated
+			}
+			return Principal{}, err
+		}
 		// userID is a valid uuid
 		currentUser, err := s.userService.GetByID(ctx, session.UserID)
 		if err != nil {
@@ -42,9 +63,10 @@ func authenticateWithSession(ctx context.Context, s *Service) (Principal, error)
 			return Principal{}, err
 		}
 		return Principal{
-			ID:   currentUser.ID,
-			Type: schema.UserPrincipal,
-			User: &currentUser,
+			ID:        currentUser.ID,
+			Type:      schema.UserPrincipal,
+			SessionID: session.ID.String(),
+			User:      &currentUser,
 		}, nil
 	}
 	if err != nil && !errors.Is(err, frontiersession.ErrNoSession) {
@@ -192,10 +214,12 @@ func authenticateWithAccessToken(ctx context.Context, s *Service) (Principal, er
 			s.log.DebugContext(ctx, "failed to get user", "err", err)
 			return Principal{}, err
 		}
+		sessionID, _ := claims[token.SessionIDClaimKey].(string)
 		return Principal{
-			ID:   currentUser.ID,
-			Type: schema.UserPrincipal,
-			User: &currentUser,
+			ID:        currentUser.ID,
+			Type:      schema.UserPrincipal,
+			SessionID: sessionID,
+			User:      &currentUser,
 		}, nil
 	}
 
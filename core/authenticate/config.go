@@ -3,7 +3,14 @@ package authenticate
 import (
 	"time"
 
+	"github.com/raystack/frontier/core/authenticate/loginalert"
+	"github.com/raystack/frontier/core/authenticate/mfa"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	"github.com/raystack/frontier/core/authenticate/ratelimit"
+	"github.com/raystack/frontier/core/authenticate/refreshtoken"
+	frontiersession "github.com/raystack/frontier/core/authenticate/session"
 	testusers "github.com/raystack/frontier/core/authenticate/test_users"
+	"github.com/raystack/frontier/core/authenticate/token"
 )
 
 type Config struct {
@@ -14,12 +21,40 @@ type Config struct {
 	AuthorizedRedirectURLs []string `yaml:"authorized_redirect_urls" mapstructure:"authorized_redirect_urls" `
 
 	OIDCConfig map[string]OIDCConfig `yaml:"oidc_config" mapstructure:"oidc_config"`
+	SAMLConfig map[string]SAMLConfig `yaml:"saml_config" mapstructure:"saml_config"`
 	Session    SessionConfig         `yaml:"session" mapstructure:"session"`
 	Token      TokenConfig           `yaml:"token" mapstructure:"token"`
 	MailOTP    MailOTPConfig         `yaml:"mail_otp" mapstructure:"mail_otp"`
 	MailLink   MailLinkConfig        `yaml:"mail_link" mapstructure:"mail_link"`
 	PassKey    PassKeyConfig         `yaml:"passkey" mapstructure:"passkey"`
 	TestUsers  testusers.Config      `yaml:"test_users" mapstructure:"test_users"`
+
+	// OIDCProvider lets third party apps sign in users with frontier
+	OIDCProvider oidcprovider.Config `yaml:"oidc_provider" mapstructure:"oidc_provider"`
+
+	// MFA lets users verify a totp of an authenticator app after the login
+	MFA mfa.Config `yaml:"mfa" mapstructure:"mfa"`
+
+	// LoginAlert mails users about logins from a new country or device
+	LoginAlert loginalert.Config `yaml:"login_alert" mapstructure:"login_alert"`
+
+	// RateLimit limits the mail login attempts per email, client ip and
+	// organization
+	RateLimit ratelimit.Config `yaml:"rate_limit" mapstructure:"rate_limit"`
+
+	// StepUp makes sensitive procedures demand a recent authentication of
+	// the session
+	StepUp StepUpConfig `yaml:"step_up" mapstructure:"step_up"`
+}
+
+type StepUpConfig struct {
+	// MaxAge is how long after its last authentication a session can call
+	// the sensitive procedures, step up is disabled when it's zero
+	MaxAge time.Duration `yaml:"max_age" mapstructure:"max_age" default:"0s"`
+	// Procedures are the full names of the sensitive procedures, e.g.
+	// /raystack.frontier.v1beta1.FrontierService/DeleteOrganization, a
+	// built in list is used when it's empty
+	Procedures []string `yaml:"procedures" mapstructure:"procedures"`
 }
 
 type TokenConfig struct {
@@ -29,6 +64,9 @@ type TokenConfig struct {
 	RSAPath string `yaml:"rsa_path" mapstructure:"rsa_path"`
 	// RSABase64 is base64 encoded rsa key, it can contain more than one key as a json array
 	RSABase64 string `yaml:"rsa_base64" mapstructure:"rsa_base64"`
+	// KeyRotation keeps the keys in the database and rotates them, RSAPath
+	// and RSABase64 are not used when it is enabled
+	KeyRotation token.KeyRotationConfig `yaml:"key_rotation" mapstructure:"key_rotation"`
 
 	// Issuer uniquely identifies the service that issued the token
 	// a good example could be fully qualified domain name
@@ -38,6 +76,14 @@ type TokenConfig struct {
 	Validity time.Duration `yaml:"validity" mapstructure:"validity" default:"1h"`
 
 	Claims TokenClaimConfig `yaml:"claims" mapstructure:"claims"`
+
+	// RefreshToken lets sessions renew their access tokens without the cookie
+	RefreshToken refreshtoken.Config `yaml:"refresh_token" mapstructure:"refresh_token"`
+
+	// RevocationSyncInterval is how often the tokens revoked by other
+	// instances are picked up, tokens revoked by this instance are rejected
+	// at once
+	RevocationSyncInterval time.Duration `yaml:"revocation_sync_interval" mapstructure:"revocation_sync_interval" default:"10s"`
 }
 
 type TokenClaimConfig struct {
@@ -57,6 +103,9 @@ type SessionConfig struct {
 	Secure   bool          `mapstructure:"secure" yaml:"secure" default:"false"`
 	// Headers configuration for session metadata collection
 	Headers SessionMetadataHeaders `yaml:"headers" mapstructure:"headers"`
+	// Policy restricts idle sessions, the number of sessions of a user and
+	// the clients a session can be used from
+	Policy frontiersession.Policy `yaml:"policy" mapstructure:"policy"`
 }
 
 type SessionMetadataHeaders struct {
@@ -75,6 +124,38 @@ type OIDCConfig struct {
 	Validity     time.Duration `yaml:"validity" mapstructure:"validity" default:"15m"`
 }
 
+type SAMLConfig struct {
+	// OrgID binds the identity provider to an organization, only the users of
+	// its verified domains can sign in with it and they join the organization
+	OrgID string `yaml:"org_id" mapstructure:"org_id"`
+	// IDPMetadataURL is fetched for the metadata of the identity provider
+	// unless IDPMetadata has the xml metadata inline
+	IDPMetadataURL string `yaml:"idp_metadata_url" mapstructure:"idp_metadata_url"`
+	IDPMetadata    string `yaml:"idp_metadata" mapstructure:"idp_metadata"`
+	// ServiceProviderURL is the public url of the frontier connect server,
+	// the service provider metadata is served at <url>/saml/<name>/metadata
+	// and assertions are consumed at <url>/saml/<name>/acs
+	ServiceProviderURL string `yaml:"sp_url" mapstructure:"sp_url"`
+	// EntityID of the service provider, defaults to its metadata url
+	EntityID string `yaml:"entity_id" mapstructure:"entity_id"`
+	// Certificate and PrivateKey are pem encoded, when set authn requests are
+	// signed and encrypted assertions can be decrypted
+	Certificate string               `yaml:"certificate" mapstructure:"certificate"`
+	PrivateKey  string               `yaml:"private_key" mapstructure:"private_key"`
+	Attributes  SAMLAttributeMapping `yaml:"attributes" mapstructure:"attributes"`
+	Validity    time.Duration        `yaml:"validity" mapstructure:"validity" default:"15m"`
+}
+
+// SAMLAttributeMapping maps the assertion attributes to the user
+type SAMLAttributeMapping struct {
+	// Email attribute, the name id of the subject is used when it's missing
+	Email string `yaml:"email" mapstructure:"email" default:"email"`
+	// Name attribute is the title of the user
+	Name string `yaml:"name" mapstructure:"name" default:"name"`
+	// Metadata maps user metadata keys to attributes
+	Metadata map[string]string `yaml:"metadata" mapstructure:"metadata"`
+}
+
 type MailOTPConfig struct {
 	Subject  string        `yaml:"subject" mapstructure:"subject" default:"Frontier Login - OTP"`
 	Body     string        `yaml:"body" mapstructure:"body" default:"Hi {{.Email}},<br> Please copy/paste the One Time Password in login form.<h2>{{.Otp}}</h2>This code will expire in 10 minutes."`
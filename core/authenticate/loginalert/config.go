@@ -0,0 +1,19 @@
+package loginalert
+
+import "time"
+
+type Config struct {
+	// Enabled mails users about logins from a new country or device
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"false"`
+	// History is how far back the sessions of a user are compared with a
+	// new session
+	History time.Duration `yaml:"history" mapstructure:"history" default:"2160h"`
+	// RevokeURL is the page the revoke link of the mail points to, the token
+	// of the link is passed as the token query parameter. The page asks the
+	// user to confirm and calls the RevokeLoginAlertSession rpc.
+	RevokeURL string `yaml:"revoke_url" mapstructure:"revoke_url" default:"http://localhost:3000/sessions/revoke"`
+	// LinkValidity is how long the revoke link of the mail can be used
+	LinkValidity time.Duration `yaml:"link_validity" mapstructure:"link_validity" default:"72h"`
+	Subject      string        `yaml:"subject" mapstructure:"subject"`
+	Body         string        `yaml:"body" mapstructure:"body"`
+}
@@ -0,0 +1,347 @@
+package loginalert
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"log/slog"
+	"net/url"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/google/uuid"
+	auditmodels "github.com/raystack/frontier/core/auditrecord/models"
+	"github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	pkgauditrecord "github.com/raystack/frontier/pkg/auditrecord"
+	"github.com/raystack/frontier/pkg/mailer"
+	mail "gopkg.in/mail.v2"
+)
+
+const (
+	// ReasonNewCountry is a login from a country the user didn't sign in
+	// from recently
+	ReasonNewCountry = "new_country"
+	// ReasonNewDevice is a login from an os and browser the user didn't sign
+	// in with recently
+	ReasonNewDevice = "new_device"
+
+	linkName = "frontier-login-alert"
+
+	defaultSubject = `New sign-in to your account`
+	defaultBody    = `{{if .User.Title}}Hi {{.User.Title}},{{else}}Hi,{{end}}<br><br>Your account was signed in from a new {{if .NewCountry}}location{{else}}device{{end}} on <b>{{.SignedInAt}}</b>.<br><br>Device: {{if .Browser}}{{.Browser}}{{else}}unknown browser{{end}} on {{if .OperatingSystem}}{{.OperatingSystem}}{{else}}unknown os{{end}}<br>Location: {{if .City}}{{.City}}, {{end}}{{if .Country}}{{.Country}}{{else}}unknown{{end}}<br>IP address: {{if .IPAddress}}{{.IPAddress}}{{else}}unknown{{end}}<br><br>If this was you, you can ignore this mail. If it wasn't, <a href="{{.RevokeURL}}">sign the session out</a> and review the other sessions of your account.`
+)
+
+var (
+	ErrInvalidLink = errors.New("login alert link is invalid or expired")
+)
+
+type SessionService interface {
+	List(ctx context.Context, userID string) ([]*session.Session, error)
+	Get(ctx context.Context, sessionID uuid.UUID) (*session.Session, error)
+	Delete(ctx context.Context, sessionID uuid.UUID) error
+}
+
+type UserService interface {
+	GetByID(ctx context.Context, id string) (user.User, error)
+}
+
+type AuditRecordRepository interface {
+	Create(ctx context.Context, auditRecord auditmodels.AuditRecord) (auditmodels.AuditRecord, error)
+}
+
+// LinkCodec signs and encrypts the revoke links, e.g. a securecookie codec
+type LinkCodec interface {
+	Encode(name string, value any) (string, error)
+	Decode(name, value string, dst any) error
+}
+
+// link is the payload of a revoke link
+type link struct {
+	SessionID string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// Service compares the sessions created on login with the recent sessions
+// of the user and mails the user about logins from a new country or device
+// with a link revoking the session
+type Service struct {
+	log             *slog.Logger
+	config          Config
+	sessionService  SessionService
+	userService     UserService
+	dialer          mailer.Dialer
+	codec           LinkCodec
+	auditRecordRepo AuditRecordRepository
+	Now             func() time.Time
+}
+
+func NewService(logger *slog.Logger, config Config, sessionService SessionService, userService UserService,
+	dialer mailer.Dialer, codec LinkCodec, auditRecordRepo AuditRecordRepository) *Service {
+	return &Service{
+		log:             logger,
+		config:          config,
+		sessionService:  sessionService,
+		userService:     userService,
+		dialer:          dialer,
+		codec:           codec,
+		auditRecordRepo: auditRecordRepo,
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+func (s *Service) Enabled() bool {
+	return s.config.Enabled
+}
+
+// NotifyLogin mails the user when the session is created from a new
+// country or device and records it as suspicious
+func (s *Service) NotifyLogin(ctx context.Context, sess *session.Session) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	reasons, err := s.detect(ctx, sess)
+	if err != nil || len(reasons) == 0 {
+		return err
+	}
+
+	usr, err := s.userService.GetByID(ctx, sess.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if err := s.createAuditRecord(ctx, pkgauditrecord.SessionSuspiciousEvent, sess, usr, map[string]any{
+		"reasons":          strings.Join(reasons, ","),
+		"ip_address":       sess.Metadata.IpAddress,
+		"country":          sess.Metadata.Location.Country,
+		"city":             sess.Metadata.Location.City,
+		"operating_system": sess.Metadata.OperatingSystem,
+		"browser":          sess.Metadata.Browser,
+	}); err != nil {
+		s.log.ErrorContext(ctx, "failed to create audit record for suspicious login", "session_id", sess.ID, "err", err)
+	}
+	return s.sendMail(ctx, sess, usr, reasons)
+}
+
+// detect lists how the session differs from the recent sessions of the
+// user, nothing is detected without recent sessions to compare with
+func (s *Service) detect(ctx context.Context, sess *session.Session) ([]string, error) {
+	sessions, err := s.sessionService.List(ctx, sess.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	since := s.Now().Add(-s.config.History)
+	countries := map[string]bool{}
+	devices := map[string]bool{}
+	recent := 0
+	for _, other := range sessions {
+		if other.ID == sess.ID || other.CreatedAt.Before(since) {
+			continue
+		}
+		recent++
+		if country := other.Metadata.Location.Country; country != "" {
+			countries[country] = true
+		}
+		if device := deviceOf(other.Metadata); device != "" {
+			devices[device] = true
+		}
+	}
+	if recent == 0 {
+		return nil, nil
+	}
+
+	var reasons []string
+	if country := sess.Metadata.Location.Country; country != "" && len(countries) > 0 && !countries[country] {
+		reasons = append(reasons, ReasonNewCountry)
+	}
+	if device := deviceOf(sess.Metadata); device != "" && len(devices) > 0 && !devices[device] {
+		reasons = append(reasons, ReasonNewDevice)
+	}
+	return reasons, nil
+}
+
+func deviceOf(metadata session.SessionMetadata) string {
+	if metadata.OperatingSystem == "" && metadata.Browser == "" {
+		return ""
+	}
+	return metadata.OperatingSystem + "/" + metadata.Browser
+}
+
+type mailTemplateData struct {
+	User            user.User
+	NewCountry      bool
+	NewDevice       bool
+	SignedInAt      string
+	IPAddress       string
+	Country         string
+	City            string
+	OperatingSystem string
+	Browser         string
+	RevokeURL       string
+}
+
+func (s *Service) sendMail(ctx context.Context, sess *session.Session, usr user.User, reasons []string) error {
+	revokeURL, err := s.revokeURL(sess)
+	if err != nil {
+		return err
+	}
+	data := mailTemplateData{
+		User:            usr,
+		SignedInAt:      sess.CreatedAt.Format("January 2, 2006 at 3:04 PM UTC"),
+		IPAddress:       sess.Metadata.IpAddress,
+		Country:         sess.Metadata.Location.Country,
+		City:            sess.Metadata.Location.City,
+		OperatingSystem: sess.Metadata.OperatingSystem,
+		Browser:         sess.Metadata.Browser,
+		RevokeURL:       revokeURL,
+	}
+	for _, reason := range reasons {
+		data.NewCountry = data.NewCountry || reason == ReasonNewCountry
+		data.NewDevice = data.NewDevice || reason == ReasonNewDevice
+	}
+
+	subjectTpl := s.config.Subject
+	if subjectTpl == "" {
+		subjectTpl = defaultSubject
+	}
+	bodyTpl := s.config.Body
+	if bodyTpl == "" {
+		bodyTpl = defaultBody
+	}
+	subject, err := renderTextTemplate(subjectTpl, data)
+	if err != nil {
+		return fmt.Errorf("failed to render subject: %w", err)
+	}
+	body, err := renderHTMLTemplate(bodyTpl, data)
+	if err != nil {
+		return fmt.Errorf("failed to render body: %w", err)
+	}
+
+	msg := mail.NewMessage()
+	msg.SetHeader("From", s.dialer.FromHeader())
+	msg.SetHeader("To", usr.Email)
+	msg.SetHeader("Subject", subject)
+	msg.SetBody("text/html", body)
+	if err := s.dialer.DialAndSend(msg); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	s.log.InfoContext(ctx, "sent login alert", "session_id", sess.ID, "user_id", usr.ID, "reasons", reasons)
+	return nil
+}
+
+func (s *Service) revokeURL(sess *session.Session) (string, error) {
+	token, err := s.codec.Encode(linkName, link{
+		SessionID: sess.ID.String(),
+		UserID:    sess.UserID,
+		ExpiresAt: s.Now().Add(s.config.LinkValidity),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to sign revoke link: %w", err)
+	}
+	revokeURL, err := url.Parse(s.config.RevokeURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid revoke url: %w", err)
+	}
+	query := revokeURL.Query()
+	query.Set("token", token)
+	revokeURL.RawQuery = query.Encode()
+	return revokeURL.String(), nil
+}
+
+// Revoke signs out the session of the revoke link of a login alert
+func (s *Service) Revoke(ctx context.Context, token string) error {
+	var payload link
+	if err := s.codec.Decode(linkName, token, &payload); err != nil {
+		return ErrInvalidLink
+	}
+	sessionID, err := uuid.Parse(payload.SessionID)
+	if err != nil || s.Now().After(payload.ExpiresAt) {
+		return ErrInvalidLink
+	}
+
+	sess, err := s.sessionService.Get(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, session.ErrNoSession) {
+			return ErrInvalidLink
+		}
+		return err
+	}
+	if sess.UserID != payload.UserID {
+		return ErrInvalidLink
+	}
+	// a revoked session stays revoked, opening the link again is fine
+	if sess.DeletedAt != nil {
+		return nil
+	}
+	if err := s.sessionService.Delete(ctx, sessionID); err != nil {
+		return err
+	}
+
+	usr, err := s.userService.GetByID(ctx, sess.UserID)
+	if err != nil {
+		s.log.ErrorContext(ctx, "failed to get user of revoked session", "session_id", sess.ID, "err", err)
+		return nil
+	}
+	if err := s.createAuditRecord(ctx, pkgauditrecord.SessionRevokedEvent, sess, usr, map[string]any{
+		"reason": "login_alert",
+	}); err != nil {
+		s.log.ErrorContext(ctx, "failed to create audit record for revoked session", "session_id", sess.ID, "err", err)
+	}
+	return nil
+}
+
+func (s *Service) createAuditRecord(ctx context.Context, event pkgauditrecord.Event, sess *session.Session,
+	usr user.User, metadata map[string]any) error {
+	if s.auditRecordRepo == nil {
+		return nil
+	}
+	if _, err := s.auditRecordRepo.Create(ctx, auditmodels.AuditRecord{
+		Event: event,
+		Resource: auditmodels.Resource{
+			ID:   usr.ID,
+			Type: pkgauditrecord.UserType,
+			Name: usr.Title,
+		},
+		Target: &auditmodels.Target{
+			ID:       sess.ID.String(),
+			Type:     pkgauditrecord.SessionType,
+			Metadata: metadata,
+		},
+		OrgID:      schema.PlatformOrgID.String(),
+		OccurredAt: s.Now(),
+	}); err != nil {
+		return fmt.Errorf("creating audit record: %w", err)
+	}
+	return nil
+}
+
+func renderTextTemplate(tpl string, data mailTemplateData) (string, error) {
+	t, err := texttemplate.New("subject").Parse(tpl)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func renderHTMLTemplate(tpl string, data mailTemplateData) (string, error) {
+	t, err := htmltemplate.New("body").Parse(tpl)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,184 @@
+package loginalert_test
+
+import (
+	"context"
+	"html"
+	"io"
+	"log/slog"
+	"mime/quotedprintable"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/securecookie"
+	auditmodels "github.com/raystack/frontier/core/auditrecord/models"
+	"github.com/raystack/frontier/core/authenticate/loginalert"
+	"github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/core/user"
+	pkgauditrecord "github.com/raystack/frontier/pkg/auditrecord"
+	"github.com/raystack/frontier/pkg/mailer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	mail "gopkg.in/mail.v2"
+)
+
+type sessionService struct {
+	sessions map[uuid.UUID]*session.Session
+	deleted  []uuid.UUID
+}
+
+func (s *sessionService) List(ctx context.Context, userID string) ([]*session.Session, error) {
+	var sessions []*session.Session
+	for _, sess := range s.sessions {
+		if sess.UserID == userID {
+			sessions = append(sessions, sess)
+		}
+	}
+	return sessions, nil
+}
+
+func (s *sessionService) Get(ctx context.Context, sessionID uuid.UUID) (*session.Session, error) {
+	sess, ok := s.sessions[sessionID]
+	if !ok {
+		return nil, session.ErrNoSession
+	}
+	return sess, nil
+}
+
+func (s *sessionService) Delete(ctx context.Context, sessionID uuid.UUID) error {
+	s.deleted = append(s.deleted, sessionID)
+	now := time.Now()
+	s.sessions[sessionID].DeletedAt = &now
+	return nil
+}
+
+type userService map[string]user.User
+
+func (u userService) GetByID(ctx context.Context, id string) (user.User, error) {
+	return u[id], nil
+}
+
+type auditRecordRepository struct {
+	records []auditmodels.AuditRecord
+}
+
+func (r *auditRecordRepository) Create(ctx context.Context, auditRecord auditmodels.AuditRecord) (auditmodels.AuditRecord, error) {
+	r.records = append(r.records, auditRecord)
+	return auditRecord, nil
+}
+
+func newSession(userID, country, os, browser string, createdAt time.Time) *session.Session {
+	sess := &session.Session{
+		ID:        uuid.New(),
+		UserID:    userID,
+		CreatedAt: createdAt,
+		Metadata: session.SessionMetadata{
+			IpAddress:       "10.0.0.1",
+			OperatingSystem: os,
+			Browser:         browser,
+		},
+	}
+	sess.Metadata.Location.Country = country
+	return sess
+}
+
+func TestService_NotifyLogin(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	userID := uuid.NewString()
+	users := userService{userID: {ID: userID, Email: "john@acme.org", Title: "John"}}
+	codec := securecookie.New(securecookie.GenerateRandomKey(32), securecookie.GenerateRandomKey(32))
+	config := loginalert.Config{
+		Enabled:      true,
+		History:      30 * 24 * time.Hour,
+		RevokeURL:    "https://app.example.com/sessions/revoke?lang=en",
+		LinkValidity: time.Hour,
+		Body:         "{{.RevokeURL}}",
+	}
+	known := newSession(userID, "NL", "Mac OS X", "Chrome", now.Add(-24*time.Hour))
+
+	newService := func(t *testing.T, sessions *sessionService, dialer *mocks.Dialer, auditRepo *auditRecordRepository) *loginalert.Service {
+		svc := loginalert.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), config, sessions, users, dialer, codec, auditRepo)
+		svc.Now = func() time.Time { return now }
+		return svc
+	}
+
+	t.Run("should not alert a login from a known country and device", func(t *testing.T) {
+		sess := newSession(userID, "NL", "Mac OS X", "Chrome", now)
+		sessions := &sessionService{sessions: map[uuid.UUID]*session.Session{known.ID: known, sess.ID: sess}}
+		auditRepo := &auditRecordRepository{}
+
+		err := newService(t, sessions, mocks.NewDialer(t), auditRepo).NotifyLogin(ctx, sess)
+		assert.NoError(t, err)
+		assert.Empty(t, auditRepo.records)
+	})
+
+	t.Run("should not alert the first login of a user", func(t *testing.T) {
+		sess := newSession(userID, "US", "Windows", "Firefox", now)
+		old := newSession(userID, "NL", "Mac OS X", "Chrome", now.Add(-60*24*time.Hour))
+		sessions := &sessionService{sessions: map[uuid.UUID]*session.Session{old.ID: old, sess.ID: sess}}
+		auditRepo := &auditRecordRepository{}
+
+		err := newService(t, sessions, mocks.NewDialer(t), auditRepo).NotifyLogin(ctx, sess)
+		assert.NoError(t, err)
+		assert.Empty(t, auditRepo.records)
+	})
+
+	t.Run("should alert a login from a new country and revoke it with the link", func(t *testing.T) {
+		sess := newSession(userID, "US", "Mac OS X", "Chrome", now)
+		sessions := &sessionService{sessions: map[uuid.UUID]*session.Session{known.ID: known, sess.ID: sess}}
+		auditRepo := &auditRecordRepository{}
+		dialer := mocks.NewDialer(t)
+		var sent *mail.Message
+		dialer.EXPECT().FromHeader().Return("frontier@acme.org")
+		dialer.EXPECT().DialAndSend(mock.Anything).Run(func(m *mail.Message) { sent = m }).Return(nil)
+		svc := newService(t, sessions, dialer, auditRepo)
+
+		require.NoError(t, svc.NotifyLogin(ctx, sess))
+		require.Len(t, auditRepo.records, 1)
+		assert.Equal(t, pkgauditrecord.SessionSuspiciousEvent, auditRepo.records[0].Event)
+		assert.Equal(t, "new_country", auditRepo.records[0].Target.Metadata["reasons"])
+		require.NotNil(t, sent)
+		assert.Equal(t, []string{"john@acme.org"}, sent.GetHeader("To"))
+
+		var raw strings.Builder
+		_, err := sent.WriteTo(&raw)
+		require.NoError(t, err)
+		_, encoded, _ := strings.Cut(raw.String(), "\r\n\r\n")
+		body, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(encoded)))
+		require.NoError(t, err)
+		revokeURL, err := url.Parse(html.UnescapeString(strings.TrimSpace(string(body))))
+		require.NoError(t, err)
+		assert.Equal(t, "app.example.com", revokeURL.Host)
+		assert.Equal(t, "/sessions/revoke", revokeURL.Path)
+		assert.Equal(t, "en", revokeURL.Query().Get("lang"))
+
+		require.NoError(t, svc.Revoke(ctx, revokeURL.Query().Get("token")))
+		assert.Equal(t, []uuid.UUID{sess.ID}, sessions.deleted)
+		assert.Equal(t, pkgauditrecord.SessionRevokedEvent, auditRepo.records[1].Event)
+
+		// the link can be opened again
+		require.NoError(t, svc.Revoke(ctx, revokeURL.Query().Get("token")))
+		assert.Len(t, sessions.deleted, 1)
+	})
+
+	t.Run("should reject a tampered or expired link", func(t *testing.T) {
+		sess := newSession(userID, "US", "Windows", "Firefox", now)
+		sessions := &sessionService{sessions: map[uuid.UUID]*session.Session{sess.ID: sess}}
+		svc := newService(t, sessions, mocks.NewDialer(t), &auditRecordRepository{})
+
+		assert.ErrorIs(t, svc.Revoke(ctx, "tampered"), loginalert.ErrInvalidLink)
+
+		token, err := codec.Encode("frontier-login-alert", struct {
+			SessionID string
+			UserID    string
+			ExpiresAt time.Time
+		}{sess.ID.String(), userID, now.Add(-time.Minute)})
+		require.NoError(t, err)
+		assert.ErrorIs(t, svc.Revoke(ctx, token), loginalert.ErrInvalidLink)
+		assert.Empty(t, sessions.deleted)
+	})
+}
@@ -0,0 +1,42 @@
+package authenticate
+
+import (
+	"context"
+
+	"github.com/raystack/frontier/core/preference"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+)
+
+// mfaRequired tells if the user verifies a second factor after the login.
+// Users with a totp always do, others when one of their organizations
+// requires it and they have to enroll first.
+func (s Service) mfaRequired(ctx context.Context, userID string) (bool, error) {
+	if s.mfaService == nil {
+		return false, nil
+	}
+	enrolled, err := s.mfaService.IsEnrolled(ctx, userID)
+	if err != nil || enrolled {
+		return enrolled, err
+	}
+
+	if s.membershipService == nil || s.preferenceService == nil {
+		return false, nil
+	}
+	orgIDs, err := s.membershipService.ListOrgsByPrincipal(ctx, Principal{
+		ID:   userID,
+		Type: schema.UserPrincipal,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, orgID := range orgIDs {
+		prefs, err := s.preferenceService.LoadOrganizationPreferences(ctx, orgID)
+		if err != nil {
+			return false, err
+		}
+		if prefs[preference.OrganizationMFARequired] == "true" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
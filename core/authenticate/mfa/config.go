@@ -0,0 +1,12 @@
+package mfa
+
+type Config struct {
+	// Issuer names frontier in the authenticator apps of the users
+	Issuer string `yaml:"issuer" mapstructure:"issuer" default:"Frontier"`
+	// EncryptionKey encrypts the totp secrets stored in the database, it
+	// must be 32 bytes long
+	EncryptionKey string `yaml:"encryption_key" mapstructure:"encryption_key" default:"hash-secret-should-be-32-chars--"`
+	// RecoveryCodes is the number of one time recovery codes generated for
+	// users who lose their authenticator
+	RecoveryCodes int `yaml:"recovery_codes" mapstructure:"recovery_codes" default:"10"`
+}
@@ -0,0 +1,55 @@
+package mfa
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrNotEnrolled     = errors.New("totp is not enrolled")
+	ErrAlreadyEnrolled = errors.New("totp is already enrolled")
+	ErrInvalidCode     = errors.New("invalid totp or recovery code")
+)
+
+// TOTP is the time based one time password authenticator of a user. The
+// enrollment is pending till the user confirms it with a first code.
+type TOTP struct {
+	UserID string
+	Secret []byte
+	// LastUsedStep is the time step of the last accepted code, a code isn't
+	// accepted twice
+	LastUsedStep int64
+	ConfirmedAt  *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (t TOTP) IsConfirmed() bool {
+	return t.ConfirmedAt != nil
+}
+
+// Enrollment is the secret of a pending totp the user adds to an
+// authenticator app, URI is rendered as a QR code
+type Enrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type Repository interface {
+	// SetTOTP creates or replaces the pending totp of the user
+	SetTOTP(ctx context.Context, totp TOTP) (TOTP, error)
+	GetTOTP(ctx context.Context, userID string) (TOTP, error)
+	// ConfirmTOTP confirms the enrollment with the step of its first code
+	// and replaces the recovery codes of the user
+	ConfirmTOTP(ctx context.Context, userID string, step int64, codeHashes []string) error
+	// UseTOTPStep records the step of an accepted code, it fails with
+	// ErrInvalidCode if a code of the same or a later step was used
+	UseTOTPStep(ctx context.Context, userID string, step int64) error
+	DeleteTOTP(ctx context.Context, userID string) error
+
+	// UseRecoveryCode marks the unused recovery code used, it fails with
+	// ErrInvalidCode if there is none
+	UseRecoveryCode(ctx context.Context, userID string, codeHash string) error
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error
+}
@@ -0,0 +1,173 @@
+package mfa
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// recoveryCodeLength is the length of a recovery code without its dash,
+// 50 random bits
+const recoveryCodeLength = 10
+
+type Service struct {
+	log    *slog.Logger
+	config Config
+	repo   Repository
+	Now    func() time.Time
+}
+
+func NewService(logger *slog.Logger, config Config, repo Repository) *Service {
+	return &Service{
+		log:    logger,
+		config: config,
+		repo:   repo,
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+// IsEnrolled tells if the user confirmed a totp
+func (s *Service) IsEnrolled(ctx context.Context, userID string) (bool, error) {
+	totp, err := s.repo.GetTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ErrNotEnrolled) {
+			return false, nil
+		}
+		return false, err
+	}
+	return totp.IsConfirmed(), nil
+}
+
+// Enroll generates the secret of a new totp, it replaces a pending one. The
+// totp doesn't protect the user till it's confirmed.
+func (s *Service) Enroll(ctx context.Context, userID, accountName string) (Enrollment, error) {
+	current, err := s.repo.GetTOTP(ctx, userID)
+	if err != nil && !errors.Is(err, ErrNotEnrolled) {
+		return Enrollment{}, err
+	}
+	if err == nil && current.IsConfirmed() {
+		return Enrollment{}, ErrAlreadyEnrolled
+	}
+
+	secret := make([]byte, totpSecretSize)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return Enrollment{}, err
+	}
+	if _, err := s.repo.SetTOTP(ctx, TOTP{
+		UserID: userID,
+		Secret: secret,
+	}); err != nil {
+		return Enrollment{}, err
+	}
+	return Enrollment{
+		Secret: secretEncoding.EncodeToString(secret),
+		URI:    keyURI(s.config.Issuer, accountName, secret),
+	}, nil
+}
+
+// Confirm completes the enrollment with the first code of the authenticator
+// app and returns the recovery codes, they are only shown this once
+func (s *Service) Confirm(ctx context.Context, userID, code string) ([]string, error) {
+	totp, err := s.repo.GetTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if totp.IsConfirmed() {
+		return nil, ErrAlreadyEnrolled
+	}
+	step, ok := verifyTOTP(totp.Secret, normalizeCode(code), s.Now())
+	if !ok {
+		return nil, ErrInvalidCode
+	}
+
+	codes, codeHashes, err := s.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.ConfirmTOTP(ctx, userID, step, codeHashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// Verify checks the second factor of the user, a code of the authenticator
+// app or one of the unused recovery codes
+func (s *Service) Verify(ctx context.Context, userID, code string) error {
+	totp, err := s.repo.GetTOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !totp.IsConfirmed() {
+		return ErrNotEnrolled
+	}
+
+	code = normalizeCode(code)
+	if step, ok := verifyTOTP(totp.Secret, code, s.Now()); ok {
+		return s.repo.UseTOTPStep(ctx, userID, step)
+	}
+	if len(code) != recoveryCodeLength {
+		return ErrInvalidCode
+	}
+	return s.repo.UseRecoveryCode(ctx, userID, hashRecoveryCode(code))
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user once the
+// second factor is verified
+func (s *Service) RegenerateRecoveryCodes(ctx context.Context, userID, code string) ([]string, error) {
+	if err := s.Verify(ctx, userID, code); err != nil {
+		return nil, err
+	}
+	codes, codeHashes, err := s.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.ReplaceRecoveryCodes(ctx, userID, codeHashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// Disable removes the totp and the recovery codes of the user once the
+// second factor is verified
+func (s *Service) Disable(ctx context.Context, userID, code string) error {
+	if err := s.Verify(ctx, userID, code); err != nil {
+		return err
+	}
+	return s.repo.DeleteTOTP(ctx, userID)
+}
+
+// generateRecoveryCodes returns the codes shown to the user formatted as
+// xxxxx-xxxxx along with their hashes stored in the database
+func (s *Service) generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, s.config.RecoveryCodes)
+	codeHashes := make([]string, 0, s.config.RecoveryCodes)
+	for range s.config.RecoveryCodes {
+		codeBytes := make([]byte, 7)
+		if _, err := io.ReadFull(rand.Reader, codeBytes); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(secretEncoding.EncodeToString(codeBytes))[:recoveryCodeLength]
+		codes = append(codes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+		codeHashes = append(codeHashes, hashRecoveryCode(code))
+	}
+	return codes, codeHashes, nil
+}
+
+// normalizeCode drops the separators users type or copy along with a code
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
+
+func hashRecoveryCode(code string) string {
+	hash := sha3.Sum256([]byte(code))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,184 @@
+package mfa
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type repository struct {
+	totps         map[string]TOTP
+	recoveryCodes map[string]map[string]bool
+}
+
+func newRepository() *repository {
+	return &repository{
+		totps:         map[string]TOTP{},
+		recoveryCodes: map[string]map[string]bool{},
+	}
+}
+
+func (r *repository) SetTOTP(ctx context.Context, totp TOTP) (TOTP, error) {
+	r.totps[totp.UserID] = totp
+	return totp, nil
+}
+
+func (r *repository) GetTOTP(ctx context.Context, userID string) (TOTP, error) {
+	totp, ok := r.totps[userID]
+	if !ok {
+		return TOTP{}, ErrNotEnrolled
+	}
+	return totp, nil
+}
+
+func (r *repository) ConfirmTOTP(ctx context.Context, userID string, step int64, codeHashes []string) error {
+	totp := r.totps[userID]
+	now := time.Now()
+	totp.ConfirmedAt = &now
+	totp.LastUsedStep = step
+	r.totps[userID] = totp
+	return r.ReplaceRecoveryCodes(ctx, userID, codeHashes)
+}
+
+func (r *repository) UseTOTPStep(ctx context.Context, userID string, step int64) error {
+	totp := r.totps[userID]
+	if totp.LastUsedStep >= step {
+		return ErrInvalidCode
+	}
+	totp.LastUsedStep = step
+	r.totps[userID] = totp
+	return nil
+}
+
+func (r *repository) DeleteTOTP(ctx context.Context, userID string) error {
+	delete(r.totps, userID)
+	delete(r.recoveryCodes, userID)
+	return nil
+}
+
+func (r *repository) UseRecoveryCode(ctx context.Context, userID string, codeHash string) error {
+	if unused, ok := r.recoveryCodes[userID][codeHash]; !ok || !unused {
+		return ErrInvalidCode
+	}
+	r.recoveryCodes[userID][codeHash] = false
+	return nil
+}
+
+func (r *repository) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	r.recoveryCodes[userID] = map[string]bool{}
+	for _, codeHash := range codeHashes {
+		r.recoveryCodes[userID][codeHash] = true
+	}
+	return nil
+}
+
+func TestTOTPCode(t *testing.T) {
+	// test vectors of RFC 6238 appendix B for SHA1, truncated to 6 digits
+	secret := []byte("12345678901234567890")
+	for unix, code := range map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	} {
+		assert.Equal(t, code, totpCode(secret, totpStep(time.Unix(unix, 0))))
+	}
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	newService := func() (*Service, *repository) {
+		repo := newRepository()
+		svc := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), Config{
+			Issuer:        "Frontier",
+			RecoveryCodes: 10,
+		}, repo)
+		svc.Now = func() time.Time { return now }
+		return svc, repo
+	}
+	enroll := func(t *testing.T, svc *Service, repo *repository) []string {
+		enrollment, err := svc.Enroll(ctx, "user", "alice@example.com")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(enrollment.URI, "otpauth://totp/Frontier:alice@example.com?"))
+		assert.Contains(t, enrollment.URI, "secret="+enrollment.Secret)
+
+		codes, err := svc.Confirm(ctx, "user", totpCode(repo.totps["user"].Secret, totpStep(now)))
+		require.NoError(t, err)
+		return codes
+	}
+
+	t.Run("should confirm the enrollment with a code of the secret", func(t *testing.T) {
+		svc, repo := newService()
+
+		enrolled, err := svc.IsEnrolled(ctx, "user")
+		require.NoError(t, err)
+		assert.False(t, enrolled)
+
+		_, err = svc.Enroll(ctx, "user", "alice@example.com")
+		require.NoError(t, err)
+		_, err = svc.Confirm(ctx, "user", "000000")
+		assert.ErrorIs(t, err, ErrInvalidCode)
+		enrolled, err = svc.IsEnrolled(ctx, "user")
+		require.NoError(t, err)
+		assert.False(t, enrolled)
+
+		codes := enroll(t, svc, repo)
+		assert.Len(t, codes, 10)
+		assert.Regexp(t, "^[a-z2-7]{5}-[a-z2-7]{5}$", codes[0])
+		enrolled, err = svc.IsEnrolled(ctx, "user")
+		require.NoError(t, err)
+		assert.True(t, enrolled)
+
+		_, err = svc.Enroll(ctx, "user", "alice@example.com")
+		assert.ErrorIs(t, err, ErrAlreadyEnrolled)
+	})
+
+	t.Run("should not accept a code twice", func(t *testing.T) {
+		svc, repo := newService()
+		enroll(t, svc, repo)
+		secret := repo.totps["user"].Secret
+
+		// the code confirming the enrollment is used
+		assert.ErrorIs(t, svc.Verify(ctx, "user", totpCode(secret, totpStep(now))), ErrInvalidCode)
+
+		now = now.Add(totpPeriod * time.Second)
+		code := totpCode(secret, totpStep(now))
+		assert.NoError(t, svc.Verify(ctx, "user", code[:3]+" "+code[3:]))
+		assert.ErrorIs(t, svc.Verify(ctx, "user", code), ErrInvalidCode)
+	})
+
+	t.Run("should accept each recovery code once", func(t *testing.T) {
+		svc, repo := newService()
+		codes := enroll(t, svc, repo)
+
+		assert.NoError(t, svc.Verify(ctx, "user", strings.ToUpper(codes[0])))
+		assert.ErrorIs(t, svc.Verify(ctx, "user", codes[0]), ErrInvalidCode)
+		assert.ErrorIs(t, svc.Verify(ctx, "user", "aaaaa-aaaaa"), ErrInvalidCode)
+
+		newCodes, err := svc.RegenerateRecoveryCodes(ctx, "user", codes[1])
+		require.NoError(t, err)
+		assert.ErrorIs(t, svc.Verify(ctx, "user", codes[2]), ErrInvalidCode)
+		assert.NoError(t, svc.Verify(ctx, "user", newCodes[0]))
+	})
+
+	t.Run("should disable the totp with a valid code", func(t *testing.T) {
+		svc, repo := newService()
+		codes := enroll(t, svc, repo)
+
+		assert.ErrorIs(t, svc.Disable(ctx, "user", "aaaaa-aaaaa"), ErrInvalidCode)
+		assert.NoError(t, svc.Disable(ctx, "user", codes[0]))
+		enrolled, err := svc.IsEnrolled(ctx, "user")
+		require.NoError(t, err)
+		assert.False(t, enrolled)
+		assert.ErrorIs(t, svc.Verify(ctx, "user", codes[1]), ErrNotEnrolled)
+	})
+}
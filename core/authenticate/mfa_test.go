@@ -0,0 +1,52 @@
+package authenticate
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/raystack/frontier/core/preference"
+	"github.com/stretchr/testify/assert"
+)
+
+type mfaService map[string]bool
+
+func (m mfaService) IsEnrolled(ctx context.Context, userID string) (bool, error) {
+	return m[userID], nil
+}
+
+type membershipService map[string][]string
+
+func (m membershipService) ListOrgsByPrincipal(ctx context.Context, principal Principal) ([]string, error) {
+	return m[principal.ID], nil
+}
+
+func TestService_MFARequired(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), Config{}, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	required, err := svc.mfaRequired(ctx, "alice")
+	assert.NoError(t, err)
+	assert.False(t, required, "mfa isn't required without the mfa service")
+
+	svc.SetMFAService(mfaService{"alice": true})
+	svc.SetMembershipService(membershipService{
+		"bob":   {"acme"},
+		"carol": {"globex"},
+	})
+	svc.SetPreferenceService(preferenceService{
+		"acme": {preference.OrganizationMFARequired: "true"},
+	})
+
+	for userID, expected := range map[string]bool{
+		"alice": true,
+		"bob":   true,
+		"carol": false,
+		"dave":  false,
+	} {
+		required, err := svc.mfaRequired(ctx, userID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, required, userID)
+	}
+}
@@ -139,6 +139,53 @@ func (_c *SessionService_List_Call) RunAndReturn(run func(context.Context, strin
 	return _c
 }
 
+// Track provides a mock function with given fields: ctx, _a1
+func (_m *SessionService) Track(ctx context.Context, _a1 *session.Session) error {
+	ret := _m.Called(ctx, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Track")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *session.Session) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionService_Track_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Track'
+type SessionService_Track_Call struct {
+	*mock.Call
+}
+
+// Track is a helper method to define mock.On call
+//   - ctx context.Context
+//   - _a1 *session.Session
+func (_e *SessionService_Expecter) Track(ctx interface{}, _a1 interface{}) *SessionService_Track_Call {
+	return &SessionService_Track_Call{Call: _e.mock.On("Track", ctx, _a1)}
+}
+
+func (_c *SessionService_Track_Call) Run(run func(ctx context.Context, _a1 *session.Session)) *SessionService_Track_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*session.Session))
+	})
+	return _c
+}
+
+func (_c *SessionService_Track_Call) Return(_a0 error) *SessionService_Track_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionService_Track_Call) RunAndReturn(run func(context.Context, *session.Session) error) *SessionService_Track_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSessionService creates a new instance of SessionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSessionService(t interface {
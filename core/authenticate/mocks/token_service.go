@@ -194,6 +194,53 @@ func (_c *TokenService_Parse_Call) RunAndReturn(run func(context.Context, []byte
 	return _c
 }
 
+// Revoke provides a mock function with given fields: ctx, userToken
+func (_m *TokenService) Revoke(ctx context.Context, userToken []byte) error {
+	ret := _m.Called(ctx, userToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) error); ok {
+		r0 = rf(ctx, userToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenService_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type TokenService_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userToken []byte
+func (_e *TokenService_Expecter) Revoke(ctx interface{}, userToken interface{}) *TokenService_Revoke_Call {
+	return &TokenService_Revoke_Call{Call: _e.mock.On("Revoke", ctx, userToken)}
+}
+
+func (_c *TokenService_Revoke_Call) Run(run func(ctx context.Context, userToken []byte)) *TokenService_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *TokenService_Revoke_Call) Return(_a0 error) *TokenService_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenService_Revoke_Call) RunAndReturn(run func(context.Context, []byte) error) *TokenService_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTokenService creates a new instance of TokenService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTokenService(t interface {
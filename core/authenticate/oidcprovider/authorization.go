@@ -0,0 +1,335 @@
+package oidcprovider
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	ResponseTypeCode        = "code"
+	CodeChallengeMethodS256 = "S256"
+
+	PromptNone    = "none"
+	PromptConsent = "consent"
+)
+
+// Authorization is a request of a client to sign in a user, it waits for the
+// consent of the user and then holds the code the client redeems for tokens
+type Authorization struct {
+	ID          string
+	ClientID    string
+	UserID      string
+	RedirectURI string
+	Scopes      []string
+	State       string
+	Nonce       string
+	// CodeChallenge is the S256 PKCE challenge of the code verifier
+	CodeChallenge string
+	// AuthTime is when the user logged in to frontier
+	AuthTime time.Time
+
+	// CodeHash is set once the user consents
+	CodeHash   string
+	ExpiresAt  time.Time
+	ConsumedAt *time.Time
+	CreatedAt  time.Time
+}
+
+type AuthorizationRepository interface {
+	Create(ctx context.Context, authorization Authorization) (Authorization, error)
+	Get(ctx context.Context, id string) (Authorization, error)
+	// SetCode issues the code of a pending authorization
+	SetCode(ctx context.Context, id, codeHash string, expiresAt time.Time) error
+	// Consume marks the code used and returns its authorization, a code
+	// used before returns its authorization with ErrCodeReused
+	Consume(ctx context.Context, codeHash string) (Authorization, error)
+	Delete(ctx context.Context, id string) error
+	DeleteExpired(ctx context.Context) error
+}
+
+// Consent records the scopes a user granted to a client, later requests
+// for the same scopes skip the consent screen
+type Consent struct {
+	UserID    string
+	ClientID  string
+	Scopes    []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ConsentRepository interface {
+	Get(ctx context.Context, userID, clientID string) (Consent, error)
+	List(ctx context.Context, userID string) ([]Consent, error)
+	Upsert(ctx context.Context, consent Consent) (Consent, error)
+	Delete(ctx context.Context, userID, clientID string) error
+}
+
+type AuthorizeRequest struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Prompt              string
+}
+
+// Subject is the user logged in to frontier, zero when there is no session
+type Subject struct {
+	UserID   string
+	AuthTime time.Time
+}
+
+// ConsentRequest is what the consent screen shows the user
+type ConsentRequest struct {
+	AuthorizationID string   `json:"authorization_id"`
+	ClientID        string   `json:"client_id"`
+	ClientName      string   `json:"client_name"`
+	OrgID           string   `json:"org_id"`
+	Scopes          []string `json:"scopes"`
+	RedirectURI     string   `json:"redirect_uri"`
+}
+
+// Authorize handles a request of the authorization endpoint and returns the
+// url the user is sent to: the client with a code, the consent screen or the
+// client with an error. An error is returned when the client or its
+// redirect uri can't be trusted, and ErrLoginRequired when the user has to
+// log in first.
+func (s *Service) Authorize(ctx context.Context, request AuthorizeRequest, subject Subject) (string, error) {
+	if !s.config.Enabled {
+		return "", ErrDisabled
+	}
+	client, err := s.clientRepo.Get(ctx, request.ClientID)
+	if err != nil {
+		if errors.Is(err, ErrClientNotFound) {
+			return "", newError(ErrorInvalidClient, "unknown client")
+		}
+		return "", err
+	}
+	if !slices.Contains(client.RedirectURIs, request.RedirectURI) {
+		return "", newError(ErrorInvalidRequest, "redirect_uri is not registered for the client")
+	}
+
+	// from here on errors are reported to the client
+	fail := func(code, description string) (string, error) {
+		return redirectURL(request.RedirectURI, url.Values{
+			"error":             {code},
+			"error_description": {description},
+			"state":             {request.State},
+		}), nil
+	}
+	if request.ResponseType != ResponseTypeCode {
+		return fail(ErrorUnsupportedResponseType, "only the code response type is supported")
+	}
+	scopes := strings.Fields(request.Scope)
+	if !slices.Contains(scopes, ScopeOpenID) {
+		return fail(ErrorInvalidScope, "openid scope is required")
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(client.Scopes, scope) {
+			return fail(ErrorInvalidScope, "scope "+scope+" is not allowed for the client")
+		}
+	}
+	scopes = uniqueScopes(scopes)
+	if request.CodeChallenge != "" && request.CodeChallengeMethod != CodeChallengeMethodS256 {
+		return fail(ErrorInvalidRequest, "only the S256 code challenge method is supported")
+	}
+	if client.Public && request.CodeChallenge == "" {
+		return fail(ErrorInvalidRequest, "public clients must use PKCE")
+	}
+
+	if subject.UserID == "" {
+		if request.Prompt == PromptNone {
+			return fail(ErrorLoginRequired, "user is not logged in")
+		}
+		return "", ErrLoginRequired
+	}
+	if _, err := s.activeUser(ctx, subject.UserID); err != nil {
+		return fail(ErrorAccessDenied, "user is not active")
+	}
+
+	authorization, err := s.authorizationRepo.Create(ctx, Authorization{
+		ClientID:      client.ID,
+		UserID:        subject.UserID,
+		RedirectURI:   request.RedirectURI,
+		Scopes:        scopes,
+		State:         request.State,
+		Nonce:         request.Nonce,
+		CodeChallenge: request.CodeChallenge,
+		AuthTime:      subject.AuthTime,
+		ExpiresAt:     s.now().Add(s.config.CodeValidity),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	consented, err := s.consented(ctx, subject.UserID, client.ID, scopes)
+	if err != nil {
+		return "", err
+	}
+	if consented && request.Prompt != PromptConsent {
+		return s.issueCode(ctx, authorization)
+	}
+	if request.Prompt == PromptNone {
+		return fail(ErrorConsentRequired, "user has not consented to the client")
+	}
+	return redirectURL(s.config.ConsentURL, url.Values{
+		"authorization_id": {authorization.ID},
+	}), nil
+}
+
+// LoginURL returns the login page that sends the user back to the
+// authorization request once logged in
+func (s *Service) LoginURL(requestURI string) string {
+	return redirectURL(s.config.LoginURL, url.Values{
+		"return_to": {s.config.Issuer + requestURI},
+	})
+}
+
+// GetConsentRequest returns the pending authorization of the user to show
+// on the consent screen
+func (s *Service) GetConsentRequest(ctx context.Context, authorizationID, userID string) (ConsentRequest, error) {
+	authorization, err := s.pendingAuthorization(ctx, authorizationID, userID)
+	if err != nil {
+		return ConsentRequest{}, err
+	}
+	client, err := s.clientRepo.Get(ctx, authorization.ClientID)
+	if err != nil {
+		return ConsentRequest{}, err
+	}
+	return ConsentRequest{
+		AuthorizationID: authorization.ID,
+		ClientID:        client.ID,
+		ClientName:      client.Name,
+		OrgID:           client.OrgID,
+		Scopes:          authorization.Scopes,
+		RedirectURI:     authorization.RedirectURI,
+	}, nil
+}
+
+// Consent records the decision of the user on a pending authorization and
+// returns the url the user is sent back to the client with
+func (s *Service) Consent(ctx context.Context, authorizationID, userID string, approve bool) (string, error) {
+	authorization, err := s.pendingAuthorization(ctx, authorizationID, userID)
+	if err != nil {
+		return "", err
+	}
+	if !approve {
+		if err := s.authorizationRepo.Delete(ctx, authorization.ID); err != nil {
+			return "", err
+		}
+		return redirectURL(authorization.RedirectURI, url.Values{
+			"error":             {ErrorAccessDenied},
+			"error_description": {"user denied the request"},
+			"state":             {authorization.State},
+		}), nil
+	}
+
+	existing, err := s.consentRepo.Get(ctx, userID, authorization.ClientID)
+	if err != nil && !errors.Is(err, ErrConsentNotFound) {
+		return "", err
+	}
+	if _, err := s.consentRepo.Upsert(ctx, Consent{
+		UserID:   userID,
+		ClientID: authorization.ClientID,
+		Scopes:   uniqueScopes(append(existing.Scopes, authorization.Scopes...)),
+	}); err != nil {
+		return "", err
+	}
+	return s.issueCode(ctx, authorization)
+}
+
+// ListConsents returns the clients the user has consented to
+func (s *Service) ListConsents(ctx context.Context, userID string) ([]Consent, error) {
+	return s.consentRepo.List(ctx, userID)
+}
+
+// RevokeConsent removes the consent of the user to the client and revokes
+// the refresh tokens the client holds for the user
+func (s *Service) RevokeConsent(ctx context.Context, userID, clientID string) error {
+	if err := s.consentRepo.Delete(ctx, userID, clientID); err != nil {
+		return err
+	}
+	return s.refreshTokenRepo.RevokeByUser(ctx, userID, clientID)
+}
+
+func (s *Service) pendingAuthorization(ctx context.Context, id, userID string) (Authorization, error) {
+	authorization, err := s.authorizationRepo.Get(ctx, id)
+	if err != nil {
+		return Authorization{}, err
+	}
+	// a code issued already means the consent is decided
+	if authorization.UserID != userID || authorization.CodeHash != "" ||
+		!authorization.ExpiresAt.After(s.now()) {
+		return Authorization{}, ErrAuthorizationNotFound
+	}
+	return authorization, nil
+}
+
+func (s *Service) consented(ctx context.Context, userID, clientID string, scopes []string) (bool, error) {
+	consent, err := s.consentRepo.Get(ctx, userID, clientID)
+	if err != nil {
+		if errors.Is(err, ErrConsentNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(consent.Scopes, scope) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// issueCode attaches a code to the authorization and returns the redirect
+// to the client carrying it
+func (s *Service) issueCode(ctx context.Context, authorization Authorization) (string, error) {
+	code, codeHash, err := generateSecret()
+	if err != nil {
+		return "", err
+	}
+	if err := s.authorizationRepo.SetCode(ctx, authorization.ID, codeHash,
+		s.now().Add(s.config.CodeValidity)); err != nil {
+		return "", err
+	}
+	return redirectURL(authorization.RedirectURI, url.Values{
+		"code":  {code},
+		"state": {authorization.State},
+	}), nil
+}
+
+// redirectURL adds the params to the query of the url, empty params are
+// left out
+func redirectURL(base string, params url.Values) string {
+	parsed, err := url.Parse(base)
+	if err != nil {
+		return base
+	}
+	query := parsed.Query()
+	for key, values := range params {
+		for _, value := range values {
+			if value != "" {
+				query.Add(key, value)
+			}
+		}
+	}
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+func uniqueScopes(scopes []string) []string {
+	var unique []string
+	for _, scope := range scopes {
+		if !slices.Contains(unique, scope) {
+			unique = append(unique, scope)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,210 @@
+package oidcprovider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha3"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Client is an app of an organization signing in users with frontier
+type Client struct {
+	ID    string `json:"id"`
+	OrgID string `json:"org_id"`
+	Name  string `json:"name"`
+
+	// RedirectURIs are the only urls users are sent back to, matched exactly
+	RedirectURIs []string `json:"redirect_uris"`
+	// Scopes the client is allowed to request
+	Scopes []string `json:"scopes"`
+	// Public clients like single page and mobile apps can't keep a secret,
+	// they authenticate the code exchange with PKCE alone
+	Public     bool   `json:"public"`
+	SecretHash string `json:"-"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ClientRepository interface {
+	Create(ctx context.Context, client Client) (Client, error)
+	Get(ctx context.Context, id string) (Client, error)
+	List(ctx context.Context, orgID string) ([]Client, error)
+	Update(ctx context.Context, client Client) (Client, error)
+	UpdateSecret(ctx context.Context, id, secretHash string) error
+	Delete(ctx context.Context, id string) error
+}
+
+// CreateClient registers a client of an organization and returns its
+// secret, the secret is only known at creation and is empty for public
+// clients
+func (s *Service) CreateClient(ctx context.Context, client Client) (Client, string, error) {
+	if err := validateClient(&client); err != nil {
+		return Client{}, "", err
+	}
+
+	var secret string
+	if !client.Public {
+		var err error
+		if secret, client.SecretHash, err = generateSecret(); err != nil {
+			return Client{}, "", err
+		}
+	}
+	created, err := s.clientRepo.Create(ctx, client)
+	if err != nil {
+		return Client{}, "", err
+	}
+	return created, secret, nil
+}
+
+func (s *Service) GetClient(ctx context.Context, id string) (Client, error) {
+	return s.clientRepo.Get(ctx, id)
+}
+
+func (s *Service) ListClients(ctx context.Context, orgID string) ([]Client, error) {
+	return s.clientRepo.List(ctx, orgID)
+}
+
+// UpdateClient changes the name, redirect uris and scopes of a client
+func (s *Service) UpdateClient(ctx context.Context, client Client) (Client, error) {
+	existing, err := s.clientRepo.Get(ctx, client.ID)
+	if err != nil {
+		return Client{}, err
+	}
+	client.OrgID = existing.OrgID
+	client.Public = existing.Public
+	if err := validateClient(&client); err != nil {
+		return Client{}, err
+	}
+	return s.clientRepo.Update(ctx, client)
+}
+
+// RotateClientSecret replaces the secret of a confidential client, the old
+// secret stops working immediately
+func (s *Service) RotateClientSecret(ctx context.Context, id string) (string, error) {
+	client, err := s.clientRepo.Get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if client.Public {
+		return "", fmt.Errorf("%w: public clients don't have a secret", ErrInvalidClient)
+	}
+	secret, secretHash, err := generateSecret()
+	if err != nil {
+		return "", err
+	}
+	if err := s.clientRepo.UpdateSecret(ctx, id, secretHash); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// DeleteClient removes the client along with its consents and tokens
+func (s *Service) DeleteClient(ctx context.Context, id string) error {
+	return s.clientRepo.Delete(ctx, id)
+}
+
+// authenticateClient checks the credentials of the client calling the token
+// endpoint, public clients only identify themselves
+func (s *Service) authenticateClient(ctx context.Context, id, secret string) (Client, error) {
+	if id == "" {
+		return Client{}, newError(ErrorInvalidClient, "client_id is required")
+	}
+	client, err := s.clientRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrClientNotFound) {
+			return Client{}, newError(ErrorInvalidClient, "unknown client")
+		}
+		return Client{}, err
+	}
+	if client.Public {
+		if secret != "" {
+			return Client{}, newError(ErrorInvalidClient, "public clients don't have a secret")
+		}
+		return client, nil
+	}
+	if secret == "" || subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(client.SecretHash)) == 0 {
+		return Client{}, newError(ErrorInvalidClient, "invalid client credentials")
+	}
+	return client, nil
+}
+
+func validateClient(client *Client) error {
+	client.Name = strings.TrimSpace(client.Name)
+	if client.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidClient)
+	}
+	if client.OrgID == "" {
+		return fmt.Errorf("%w: org id is required", ErrInvalidClient)
+	}
+	if len(client.RedirectURIs) == 0 {
+		return fmt.Errorf("%w: at least one redirect uri is required", ErrInvalidClient)
+	}
+	for _, uri := range client.RedirectURIs {
+		if err := validateRedirectURI(uri); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidClient, err)
+		}
+	}
+	if len(client.Scopes) == 0 {
+		client.Scopes = []string{ScopeOpenID, ScopeProfile, ScopeEmail}
+	}
+	for _, scope := range client.Scopes {
+		if !slices.Contains(SupportedScopes, scope) {
+			return fmt.Errorf("%w: unsupported scope %q", ErrInvalidClient, scope)
+		}
+	}
+	if !slices.Contains(client.Scopes, ScopeOpenID) {
+		return fmt.Errorf("%w: %s scope is required", ErrInvalidClient, ScopeOpenID)
+	}
+	return nil
+}
+
+// validateRedirectURI accepts absolute urls without a fragment, plain http
+// is only allowed for apps running on the loopback interface
+func validateRedirectURI(uri string) error {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("invalid redirect uri %q: %w", uri, err)
+	}
+	if !parsed.IsAbs() || parsed.Fragment != "" {
+		return fmt.Errorf("redirect uri %q must be absolute and without a fragment", uri)
+	}
+	if parsed.Scheme == "http" && !isLoopback(parsed.Hostname()) {
+		return fmt.Errorf("redirect uri %q must use https", uri)
+	}
+	return nil
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// generateSecret returns a random secret and its SHA3-256 hash, secrets
+// carry 256 bits of entropy so a slow hash is not needed
+func generateSecret() (string, string, error) {
+	secretBytes := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, secretBytes); err != nil {
+		return "", "", err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+	return secret, hashSecret(secret), nil
+}
+
+func hashSecret(secret string) string {
+	hash := sha3.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
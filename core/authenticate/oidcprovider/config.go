@@ -0,0 +1,28 @@
+package oidcprovider
+
+import "time"
+
+// Config of frontier acting as an OpenID provider for third party apps
+type Config struct {
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"false"`
+
+	// Issuer is the public url of the connect server, the discovery document
+	// is served at <issuer>/.well-known/openid-configuration
+	Issuer string `yaml:"issuer" mapstructure:"issuer"`
+
+	// LoginURL is the page users without a session are sent to, the url of
+	// the authorization request is passed as the return_to query parameter
+	LoginURL string `yaml:"login_url" mapstructure:"login_url"`
+	// ConsentURL is the page asking users to approve a client, the id of the
+	// authorization is passed as the authorization_id query parameter
+	ConsentURL string `yaml:"consent_url" mapstructure:"consent_url"`
+
+	// CodeValidity is how long a user has to consent and a client has to
+	// redeem the authorization code
+	CodeValidity time.Duration `yaml:"code_validity" mapstructure:"code_validity" default:"10m"`
+	// AccessTokenValidity is the lifetime of access and id tokens
+	AccessTokenValidity time.Duration `yaml:"access_token_validity" mapstructure:"access_token_validity" default:"1h"`
+	// RefreshTokenValidity is how long a refresh token can be used, every
+	// refresh issues a new one
+	RefreshTokenValidity time.Duration `yaml:"refresh_token_validity" mapstructure:"refresh_token_validity" default:"720h"`
+}
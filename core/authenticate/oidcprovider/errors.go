@@ -0,0 +1,51 @@
+package oidcprovider
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrDisabled              = errors.New("openid provider is disabled")
+	ErrClientNotFound        = errors.New("oauth client doesn't exist")
+	ErrInvalidClient         = errors.New("oauth client is invalid")
+	ErrAuthorizationNotFound = errors.New("authorization doesn't exist")
+	ErrCodeReused            = errors.New("authorization code is already used")
+	ErrConsentNotFound       = errors.New("consent doesn't exist")
+	ErrRefreshTokenNotFound  = errors.New("refresh token doesn't exist")
+	ErrRefreshTokenReused    = errors.New("refresh token is already used")
+	ErrLoginRequired         = errors.New("user is not logged in")
+)
+
+// Error codes of RFC 6749 and OpenID Connect Core
+const (
+	ErrorInvalidRequest          = "invalid_request"
+	ErrorInvalidClient           = "invalid_client"
+	ErrorInvalidGrant            = "invalid_grant"
+	ErrorInvalidScope            = "invalid_scope"
+	ErrorInvalidToken            = "invalid_token"
+	ErrorUnauthorizedClient      = "unauthorized_client"
+	ErrorUnsupportedGrantType    = "unsupported_grant_type"
+	ErrorUnsupportedResponseType = "unsupported_response_type"
+	ErrorAccessDenied            = "access_denied"
+	ErrorLoginRequired           = "login_required"
+	ErrorConsentRequired         = "consent_required"
+	ErrorServerError             = "server_error"
+)
+
+// Error is an oauth error returned to the client as is
+type Error struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *Error) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
+
+func newError(code, description string) *Error {
+	return &Error{Code: code, Description: description}
+}
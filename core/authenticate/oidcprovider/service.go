@@ -0,0 +1,234 @@
+package oidcprovider
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/pkg/utils"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	ScopeOpenID        = "openid"
+	ScopeProfile       = "profile"
+	ScopeEmail         = "email"
+	ScopeOfflineAccess = "offline_access"
+
+	// paths of the endpoints relative to the issuer
+	DiscoveryPath     = "/.well-known/openid-configuration"
+	JWKSPath          = "/oauth2/jwks"
+	AuthorizationPath = "/oauth2/authorize"
+	TokenPath         = "/oauth2/token"
+	UserInfoPath      = "/oauth2/userinfo"
+
+	// ClientIDClaimKey tells access tokens apart from id tokens
+	ClientIDClaimKey = "client_id"
+	ScopeClaimKey    = "scope"
+
+	refreshTime = "0 * * * *" // every hour
+)
+
+var SupportedScopes = []string{ScopeOpenID, ScopeProfile, ScopeEmail, ScopeOfflineAccess}
+
+type TokenService interface {
+	Sign(token jwt.Token) ([]byte, error)
+	Verify(ctx context.Context, token []byte, options ...jwt.ParseOption) (jwt.Token, error)
+	GetPublicKeySet() jwk.Set
+}
+
+type UserService interface {
+	GetByID(ctx context.Context, id string) (user.User, error)
+}
+
+// Service lets third party apps sign in users with frontier using the
+// authorization code flow with PKCE of OpenID Connect
+type Service struct {
+	logger            *slog.Logger
+	config            Config
+	clientRepo        ClientRepository
+	authorizationRepo AuthorizationRepository
+	consentRepo       ConsentRepository
+	refreshTokenRepo  RefreshTokenRepository
+	tokenService      TokenService
+	userService       UserService
+	cron              *cron.Cron
+	now               func() time.Time
+}
+
+func NewService(logger *slog.Logger, config Config, clientRepo ClientRepository,
+	authorizationRepo AuthorizationRepository, consentRepo ConsentRepository,
+	refreshTokenRepo RefreshTokenRepository, tokenService TokenService, userService UserService) *Service {
+	config.Issuer = strings.TrimSuffix(config.Issuer, "/")
+	return &Service{
+		logger:            logger,
+		config:            config,
+		clientRepo:        clientRepo,
+		authorizationRepo: authorizationRepo,
+		consentRepo:       consentRepo,
+		refreshTokenRepo:  refreshTokenRepo,
+		tokenService:      tokenService,
+		userService:       userService,
+		now:               time.Now,
+	}
+}
+
+func (s *Service) Enabled() bool {
+	return s.config.Enabled
+}
+
+// Init schedules the removal of expired authorizations and refresh tokens
+func (s *Service) Init(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	s.cron = cron.New(cron.WithChain(
+		cron.SkipIfStillRunning(cron.DefaultLogger),
+		cron.Recover(cron.DefaultLogger),
+	))
+	if _, err := s.cron.AddFunc(refreshTime, func() {
+		if err := s.authorizationRepo.DeleteExpired(ctx); err != nil {
+			s.logger.WarnContext(ctx, "failed to delete expired oauth authorizations", "err", err)
+		}
+		if err := s.refreshTokenRepo.DeleteExpired(ctx); err != nil {
+			s.logger.WarnContext(ctx, "failed to delete expired oauth refresh tokens", "err", err)
+		}
+	}); err != nil {
+		return fmt.Errorf("failed to schedule oauth cleanup: %w", err)
+	}
+	s.cron.Start()
+	return nil
+}
+
+func (s *Service) Close() error {
+	if s.cron != nil {
+		<-s.cron.Stop().Done()
+	}
+	return nil
+}
+
+// Discovery is the OpenID provider metadata
+type Discovery struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	ResponseModesSupported            []string `json:"response_modes_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+func (s *Service) Discovery() Discovery {
+	return Discovery{
+		Issuer:                            s.config.Issuer,
+		AuthorizationEndpoint:             s.config.Issuer + AuthorizationPath,
+		TokenEndpoint:                     s.config.Issuer + TokenPath,
+		UserInfoEndpoint:                  s.config.Issuer + UserInfoPath,
+		JWKSURI:                           s.config.Issuer + JWKSPath,
+		ScopesSupported:                   SupportedScopes,
+		ResponseTypesSupported:            []string{ResponseTypeCode},
+		ResponseModesSupported:            []string{"query"},
+		GrantTypesSupported:               []string{GrantTypeAuthorizationCode, GrantTypeRefreshToken},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  s.signingAlgorithms(),
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{CodeChallengeMethodS256},
+		ClaimsSupported: []string{"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "azp",
+			"name", "preferred_username", "picture", "email"},
+	}
+}
+
+// signingAlgorithms lists the algorithms of the published keys, keys of
+// different algorithms are published while the signing key is rotated
+func (s *Service) signingAlgorithms() []string {
+	var algs []string
+	keySet := s.tokenService.GetPublicKeySet()
+	for i := 0; i < keySet.Len(); i++ {
+		key, _ := keySet.Key(i)
+		alg := utils.SigningAlgorithm(key).String()
+		if !slices.Contains(algs, alg) {
+			algs = append(algs, alg)
+		}
+	}
+	if len(algs) == 0 {
+		return []string{jwa.RS256.String()}
+	}
+	return algs
+}
+
+// JWKs returns the public keys clients verify id tokens with, the same keys
+// verify the access tokens frontier issues for itself
+func (s *Service) JWKs() jwk.Set {
+	return s.tokenService.GetPublicKeySet()
+}
+
+// UserInfo returns the claims of the user an access token was issued for,
+// limited to the scopes granted to the client
+func (s *Service) UserInfo(ctx context.Context, accessToken string) (map[string]any, error) {
+	tok, err := s.tokenService.Verify(ctx, []byte(accessToken),
+		jwt.WithIssuer(s.config.Issuer), jwt.WithClock(jwt.ClockFunc(s.now)))
+	if err != nil {
+		return nil, newError(ErrorInvalidToken, "access token is invalid or expired")
+	}
+	// id tokens are signed by the same keys but don't name the client
+	if _, ok := tok.Get(ClientIDClaimKey); !ok {
+		return nil, newError(ErrorInvalidToken, "not an access token")
+	}
+	var scopes []string
+	if scope, ok := tok.Get(ScopeClaimKey); ok {
+		if scope, ok := scope.(string); ok {
+			scopes = strings.Fields(scope)
+		}
+	}
+
+	usr, err := s.activeUser(ctx, tok.Subject())
+	if err != nil {
+		return nil, newError(ErrorInvalidToken, "user is not active")
+	}
+	claims := userClaims(usr, scopes)
+	claims["sub"] = usr.ID
+	return claims, nil
+}
+
+func (s *Service) activeUser(ctx context.Context, id string) (user.User, error) {
+	usr, err := s.userService.GetByID(ctx, id)
+	if err != nil {
+		return user.User{}, err
+	}
+	if usr.State == user.Disabled {
+		return user.User{}, user.ErrDisabled
+	}
+	return usr, nil
+}
+
+// userClaims returns the standard claims of the user covered by the scopes
+func userClaims(usr user.User, scopes []string) map[string]any {
+	claims := map[string]any{}
+	for _, scope := range scopes {
+		switch scope {
+		case ScopeProfile:
+			claims["name"] = usr.Title
+			claims["preferred_username"] = usr.Name
+			if usr.Avatar != "" {
+				claims["picture"] = usr.Avatar
+			}
+		case ScopeEmail:
+			claims["email"] = usr.Email
+		}
+	}
+	return claims
+}
@@ -0,0 +1,616 @@
+package oidcprovider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"log/slog"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/raystack/frontier/core/authenticate/token"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClientRepository struct {
+	clients map[string]Client
+}
+
+func (r *fakeClientRepository) Create(ctx context.Context, client Client) (Client, error) {
+	client.ID = uuid.NewString()
+	r.clients[client.ID] = client
+	return client, nil
+}
+
+func (r *fakeClientRepository) Get(ctx context.Context, id string) (Client, error) {
+	client, ok := r.clients[id]
+	if !ok {
+		return Client{}, ErrClientNotFound
+	}
+	return client, nil
+}
+
+func (r *fakeClientRepository) List(ctx context.Context, orgID string) ([]Client, error) {
+	var clients []Client
+	for _, client := range r.clients {
+		if client.OrgID == orgID {
+			clients = append(clients, client)
+		}
+	}
+	return clients, nil
+}
+
+func (r *fakeClientRepository) Update(ctx context.Context, client Client) (Client, error) {
+	r.clients[client.ID] = client
+	return client, nil
+}
+
+func (r *fakeClientRepository) UpdateSecret(ctx context.Context, id, secretHash string) error {
+	client := r.clients[id]
+	client.SecretHash = secretHash
+	r.clients[id] = client
+	return nil
+}
+
+func (r *fakeClientRepository) Delete(ctx context.Context, id string) error {
+	delete(r.clients, id)
+	return nil
+}
+
+type fakeAuthorizationRepository struct {
+	authorizations map[string]Authorization
+}
+
+func (r *fakeAuthorizationRepository) Create(ctx context.Context, authorization Authorization) (Authorization, error) {
+	authorization.ID = uuid.NewString()
+	r.authorizations[authorization.ID] = authorization
+	return authorization, nil
+}
+
+func (r *fakeAuthorizationRepository) Get(ctx context.Context, id string) (Authorization, error) {
+	authorization, ok := r.authorizations[id]
+	if !ok {
+		return Authorization{}, ErrAuthorizationNotFound
+	}
+	return authorization, nil
+}
+
+func (r *fakeAuthorizationRepository) SetCode(ctx context.Context, id, codeHash string, expiresAt time.Time) error {
+	authorization, ok := r.authorizations[id]
+	if !ok || authorization.CodeHash != "" {
+		return ErrAuthorizationNotFound
+	}
+	authorization.CodeHash = codeHash
+	authorization.ExpiresAt = expiresAt
+	r.authorizations[id] = authorization
+	return nil
+}
+
+func (r *fakeAuthorizationRepository) Consume(ctx context.Context, codeHash string) (Authorization, error) {
+	for id, authorization := range r.authorizations {
+		if authorization.CodeHash != codeHash {
+			continue
+		}
+		if authorization.ConsumedAt != nil {
+			return authorization, ErrCodeReused
+		}
+		now := time.Now()
+		authorization.ConsumedAt = &now
+		r.authorizations[id] = authorization
+		return authorization, nil
+	}
+	return Authorization{}, ErrAuthorizationNotFound
+}
+
+func (r *fakeAuthorizationRepository) Delete(ctx context.Context, id string) error {
+	delete(r.authorizations, id)
+	return nil
+}
+
+func (r *fakeAuthorizationRepository) DeleteExpired(ctx context.Context) error {
+	return nil
+}
+
+type fakeConsentRepository struct {
+	consents map[string]Consent
+}
+
+func (r *fakeConsentRepository) Get(ctx context.Context, userID, clientID string) (Consent, error) {
+	consent, ok := r.consents[userID+clientID]
+	if !ok {
+		return Consent{}, ErrConsentNotFound
+	}
+	return consent, nil
+}
+
+func (r *fakeConsentRepository) List(ctx context.Context, userID string) ([]Consent, error) {
+	var consents []Consent
+	for _, consent := range r.consents {
+		if consent.UserID == userID {
+			consents = append(consents, consent)
+		}
+	}
+	return consents, nil
+}
+
+func (r *fakeConsentRepository) Upsert(ctx context.Context, consent Consent) (Consent, error) {
+	r.consents[consent.UserID+consent.ClientID] = consent
+	return consent, nil
+}
+
+func (r *fakeConsentRepository) Delete(ctx context.Context, userID, clientID string) error {
+	if _, ok := r.consents[userID+clientID]; !ok {
+		return ErrConsentNotFound
+	}
+	delete(r.consents, userID+clientID)
+	return nil
+}
+
+type fakeRefreshTokenRepository struct {
+	tokens map[string]RefreshToken
+}
+
+func (r *fakeRefreshTokenRepository) Create(ctx context.Context, token RefreshToken) (RefreshToken, error) {
+	token.ID = uuid.NewString()
+	r.tokens[token.ID] = token
+	return token, nil
+}
+
+func (r *fakeRefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (RefreshToken, error) {
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			return token, nil
+		}
+	}
+	return RefreshToken{}, ErrRefreshTokenNotFound
+}
+
+func (r *fakeRefreshTokenRepository) Rotate(ctx context.Context, id string, next RefreshToken) (RefreshToken, error) {
+	token := r.tokens[id]
+	if token.RotatedAt != nil || token.RevokedAt != nil {
+		return RefreshToken{}, ErrRefreshTokenReused
+	}
+	now := time.Now()
+	token.RotatedAt = &now
+	r.tokens[id] = token
+	return r.Create(ctx, next)
+}
+
+func (r *fakeRefreshTokenRepository) RevokeFamily(ctx context.Context, authorizationID string) error {
+	return r.revoke(func(token RefreshToken) bool { return token.AuthorizationID == authorizationID })
+}
+
+func (r *fakeRefreshTokenRepository) RevokeByUser(ctx context.Context, userID, clientID string) error {
+	return r.revoke(func(token RefreshToken) bool {
+		return token.UserID == userID && (clientID == "" || token.ClientID == clientID)
+	})
+}
+
+func (r *fakeRefreshTokenRepository) revoke(match func(RefreshToken) bool) error {
+	now := time.Now()
+	for id, token := range r.tokens {
+		if match(token) && token.RevokedAt == nil {
+			token.RevokedAt = &now
+			r.tokens[id] = token
+		}
+	}
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) DeleteExpired(ctx context.Context) error {
+	return nil
+}
+
+type fakeUserService struct {
+	users map[string]user.User
+}
+
+func (s fakeUserService) GetByID(ctx context.Context, id string) (user.User, error) {
+	usr, ok := s.users[id]
+	if !ok {
+		return user.User{}, user.ErrNotExist
+	}
+	return usr, nil
+}
+
+const (
+	testIssuer      = "https://auth.example.com"
+	testRedirectURI = "https://wiki.example.com/callback"
+	testVerifier    = "dBjftJeZ4CVP-mJ92K9n8TRYn9lvQ2CN6X_zYb6bbsE-some-more-entropy"
+)
+
+type testProvider struct {
+	*Service
+	users         fakeUserService
+	refreshTokens *fakeRefreshTokenRepository
+	tokens        token.Service
+	user          user.User
+}
+
+func newTestProvider(t *testing.T) testProvider {
+	t.Helper()
+	keySet, err := utils.CreateJWKs(1)
+	require.NoError(t, err)
+	tokens := token.NewService(keySet, "frontier", time.Hour, nil)
+
+	usr := user.User{ID: uuid.NewString(), Name: "john", Title: "John Doe", Email: "john@example.com", State: user.Enabled}
+	users := fakeUserService{users: map[string]user.User{usr.ID: usr}}
+	refreshTokens := &fakeRefreshTokenRepository{tokens: map[string]RefreshToken{}}
+	svc := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), Config{
+		Enabled:              true,
+		Issuer:               testIssuer + "/",
+		LoginURL:             "https://app.example.com/login",
+		ConsentURL:           "https://app.example.com/consent",
+		CodeValidity:         10 * time.Minute,
+		AccessTokenValidity:  time.Hour,
+		RefreshTokenValidity: 24 * time.Hour,
+	},
+		&fakeClientRepository{clients: map[string]Client{}},
+		&fakeAuthorizationRepository{authorizations: map[string]Authorization{}},
+		&fakeConsentRepository{consents: map[string]Consent{}},
+		refreshTokens, tokens, users)
+	return testProvider{Service: svc, users: users, refreshTokens: refreshTokens, tokens: tokens, user: usr}
+}
+
+func codeChallenge(verifier string) string {
+	digest := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(digest[:])
+}
+
+func queryOf(t *testing.T, redirect string) url.Values {
+	t.Helper()
+	parsed, err := url.Parse(redirect)
+	require.NoError(t, err)
+	return parsed.Query()
+}
+
+func (p testProvider) createClient(t *testing.T, public bool) (Client, string) {
+	t.Helper()
+	client, secret, err := p.CreateClient(context.Background(), Client{
+		OrgID:        uuid.NewString(),
+		Name:         "Wiki",
+		RedirectURIs: []string{testRedirectURI},
+		Scopes:       SupportedScopes,
+		Public:       public,
+	})
+	require.NoError(t, err)
+	return client, secret
+}
+
+func (p testProvider) authorizeRequest(client Client) AuthorizeRequest {
+	return AuthorizeRequest{
+		ResponseType:        ResponseTypeCode,
+		ClientID:            client.ID,
+		RedirectURI:         testRedirectURI,
+		Scope:               "openid profile email offline_access",
+		State:               "state-1",
+		Nonce:               "nonce-1",
+		CodeChallenge:       codeChallenge(testVerifier),
+		CodeChallengeMethod: CodeChallengeMethodS256,
+	}
+}
+
+// authorize runs the authorization request through the consent screen and
+// returns the code
+func (p testProvider) authorize(t *testing.T, client Client) string {
+	t.Helper()
+	ctx := context.Background()
+	subject := Subject{UserID: p.user.ID, AuthTime: time.Now().Add(-time.Minute)}
+	redirect, err := p.Authorize(ctx, p.authorizeRequest(client), subject)
+	require.NoError(t, err)
+	if strings.HasPrefix(redirect, p.config.ConsentURL) {
+		redirect, err = p.Consent(ctx, queryOf(t, redirect).Get("authorization_id"), p.user.ID, true)
+		require.NoError(t, err)
+	}
+	query := queryOf(t, redirect)
+	require.Equal(t, "state-1", query.Get("state"))
+	require.NotEmpty(t, query.Get("code"), redirect)
+	return query.Get("code")
+}
+
+func TestService_CreateClient(t *testing.T) {
+	p := newTestProvider(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		client Client
+	}{
+		{name: "missing name", client: Client{OrgID: "org", RedirectURIs: []string{testRedirectURI}}},
+		{name: "missing redirect uri", client: Client{OrgID: "org", Name: "wiki"}},
+		{name: "relative redirect uri", client: Client{OrgID: "org", Name: "wiki", RedirectURIs: []string{"/callback"}}},
+		{name: "redirect uri with fragment", client: Client{OrgID: "org", Name: "wiki", RedirectURIs: []string{testRedirectURI + "#x"}}},
+		{name: "plain http redirect uri", client: Client{OrgID: "org", Name: "wiki", RedirectURIs: []string{"http://wiki.example.com/cb"}}},
+		{name: "unsupported scope", client: Client{OrgID: "org", Name: "wiki", RedirectURIs: []string{testRedirectURI}, Scopes: []string{"openid", "admin"}}},
+		{name: "without openid", client: Client{OrgID: "org", Name: "wiki", RedirectURIs: []string{testRedirectURI}, Scopes: []string{"email"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := p.CreateClient(ctx, tt.client)
+			assert.ErrorIs(t, err, ErrInvalidClient)
+		})
+	}
+
+	t.Run("confidential client gets a secret", func(t *testing.T) {
+		client, secret, err := p.CreateClient(ctx, Client{
+			OrgID: "org", Name: "cli", RedirectURIs: []string{"http://127.0.0.1:8080/callback"},
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, secret)
+		assert.Equal(t, hashSecret(secret), client.SecretHash)
+		assert.Equal(t, []string{ScopeOpenID, ScopeProfile, ScopeEmail}, client.Scopes)
+
+		rotated, err := p.RotateClientSecret(ctx, client.ID)
+		require.NoError(t, err)
+		_, err = p.authenticateClient(ctx, client.ID, secret)
+		assert.Error(t, err, "old secret stops working")
+		_, err = p.authenticateClient(ctx, client.ID, rotated)
+		assert.NoError(t, err)
+	})
+
+	t.Run("public client has no secret", func(t *testing.T) {
+		client, secret := p.createClient(t, true)
+		assert.Empty(t, secret)
+		_, err := p.RotateClientSecret(ctx, client.ID)
+		assert.ErrorIs(t, err, ErrInvalidClient)
+	})
+}
+
+func TestService_Authorize(t *testing.T) {
+	ctx := context.Background()
+	p := newTestProvider(t)
+	client, _ := p.createClient(t, true)
+	subject := Subject{UserID: p.user.ID, AuthTime: time.Now()}
+
+	t.Run("untrusted requests are not redirected", func(t *testing.T) {
+		request := p.authorizeRequest(client)
+		request.ClientID = uuid.NewString()
+		_, err := p.Authorize(ctx, request, subject)
+		var oauthErr *Error
+		require.True(t, errors.As(err, &oauthErr))
+		assert.Equal(t, ErrorInvalidClient, oauthErr.Code)
+
+		request = p.authorizeRequest(client)
+		request.RedirectURI = "https://evil.example.com/callback"
+		_, err = p.Authorize(ctx, request, subject)
+		require.True(t, errors.As(err, &oauthErr))
+		assert.Equal(t, ErrorInvalidRequest, oauthErr.Code)
+	})
+
+	t.Run("invalid requests are redirected with an error", func(t *testing.T) {
+		for name, modify := range map[string]func(*AuthorizeRequest){
+			ErrorUnsupportedResponseType: func(r *AuthorizeRequest) { r.ResponseType = "token" },
+			ErrorInvalidScope:            func(r *AuthorizeRequest) { r.Scope = "openid admin" },
+			ErrorInvalidRequest:          func(r *AuthorizeRequest) { r.CodeChallenge = "" },
+		} {
+			t.Run(name, func(t *testing.T) {
+				request := p.authorizeRequest(client)
+				modify(&request)
+				redirect, err := p.Authorize(ctx, request, subject)
+				require.NoError(t, err)
+				assert.True(t, strings.HasPrefix(redirect, testRedirectURI), redirect)
+				assert.Equal(t, name, queryOf(t, redirect).Get("error"))
+				assert.Equal(t, "state-1", queryOf(t, redirect).Get("state"))
+			})
+		}
+	})
+
+	t.Run("anonymous user has to log in", func(t *testing.T) {
+		_, err := p.Authorize(ctx, p.authorizeRequest(client), Subject{})
+		assert.ErrorIs(t, err, ErrLoginRequired)
+		assert.Equal(t, "https://app.example.com/login?return_to="+url.QueryEscape(testIssuer+"/oauth2/authorize?client_id=1"),
+			p.LoginURL("/oauth2/authorize?client_id=1"))
+
+		request := p.authorizeRequest(client)
+		request.Prompt = PromptNone
+		redirect, err := p.Authorize(ctx, request, Subject{})
+		require.NoError(t, err)
+		assert.Equal(t, ErrorLoginRequired, queryOf(t, redirect).Get("error"))
+	})
+
+	t.Run("consent is asked once", func(t *testing.T) {
+		request := p.authorizeRequest(client)
+		request.Prompt = PromptNone
+		redirect, err := p.Authorize(ctx, request, subject)
+		require.NoError(t, err)
+		assert.Equal(t, ErrorConsentRequired, queryOf(t, redirect).Get("error"))
+
+		redirect, err = p.Authorize(ctx, p.authorizeRequest(client), subject)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(redirect, "https://app.example.com/consent?"), redirect)
+		authorizationID := queryOf(t, redirect).Get("authorization_id")
+
+		_, err = p.GetConsentRequest(ctx, authorizationID, uuid.NewString())
+		assert.ErrorIs(t, err, ErrAuthorizationNotFound, "only the user can see the request")
+		consent, err := p.GetConsentRequest(ctx, authorizationID, p.user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Wiki", consent.ClientName)
+		assert.Equal(t, []string{ScopeOpenID, ScopeProfile, ScopeEmail, ScopeOfflineAccess}, consent.Scopes)
+
+		redirect, err = p.Consent(ctx, authorizationID, p.user.ID, true)
+		require.NoError(t, err)
+		assert.NotEmpty(t, queryOf(t, redirect).Get("code"))
+		_, err = p.Consent(ctx, authorizationID, p.user.ID, true)
+		assert.ErrorIs(t, err, ErrAuthorizationNotFound, "consent is decided once")
+
+		redirect, err = p.Authorize(ctx, request, subject)
+		require.NoError(t, err)
+		assert.NotEmpty(t, queryOf(t, redirect).Get("code"), "consented client skips the screen")
+	})
+
+	t.Run("denied consent", func(t *testing.T) {
+		other, _ := p.createClient(t, true)
+		redirect, err := p.Authorize(ctx, p.authorizeRequest(other), subject)
+		require.NoError(t, err)
+		redirect, err = p.Consent(ctx, queryOf(t, redirect).Get("authorization_id"), p.user.ID, false)
+		require.NoError(t, err)
+		assert.Equal(t, ErrorAccessDenied, queryOf(t, redirect).Get("error"))
+	})
+}
+
+func TestService_Token(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("code exchange and refresh", func(t *testing.T) {
+		p := newTestProvider(t)
+		client, secret := p.createClient(t, false)
+		code := p.authorize(t, client)
+
+		_, err := p.Token(ctx, TokenRequest{
+			GrantType: GrantTypeAuthorizationCode, ClientID: client.ID, ClientSecret: "wrong",
+			Code: code, RedirectURI: testRedirectURI, CodeVerifier: testVerifier,
+		})
+		assertOAuthError(t, err, ErrorInvalidClient)
+
+		response, err := p.Token(ctx, TokenRequest{
+			GrantType: GrantTypeAuthorizationCode, ClientID: client.ID, ClientSecret: secret,
+			Code: code, RedirectURI: testRedirectURI, CodeVerifier: testVerifier,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, TokenTypeBearer, response.TokenType)
+		assert.EqualValues(t, 3600, response.ExpiresIn)
+		assert.NotEmpty(t, response.RefreshToken)
+
+		idToken, err := p.tokens.Verify(ctx, []byte(response.IDToken), jwt.WithIssuer(testIssuer), jwt.WithAudience(client.ID))
+		require.NoError(t, err)
+		assert.Equal(t, p.user.ID, idToken.Subject())
+		nonce, _ := idToken.Get("nonce")
+		assert.Equal(t, "nonce-1", nonce)
+		email, _ := idToken.Get("email")
+		assert.Equal(t, p.user.Email, email)
+		_, err = p.UserInfo(ctx, response.IDToken)
+		assertOAuthError(t, err, ErrorInvalidToken)
+
+		claims, err := p.UserInfo(ctx, response.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"sub": p.user.ID, "name": "John Doe", "preferred_username": "john", "email": "john@example.com",
+		}, claims)
+
+		refreshed, err := p.Token(ctx, TokenRequest{
+			GrantType: GrantTypeRefreshToken, ClientID: client.ID, ClientSecret: secret,
+			RefreshToken: response.RefreshToken, Scope: "openid email",
+		})
+		require.NoError(t, err)
+		assert.NotEqual(t, response.RefreshToken, refreshed.RefreshToken)
+		assert.Equal(t, "openid email", refreshed.Scope)
+		claims, err = p.UserInfo(ctx, refreshed.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"sub": p.user.ID, "email": "john@example.com"}, claims)
+
+		// a rotated token used again revokes the whole family
+		_, err = p.Token(ctx, TokenRequest{
+			GrantType: GrantTypeRefreshToken, ClientID: client.ID, ClientSecret: secret,
+			RefreshToken: response.RefreshToken,
+		})
+		assertOAuthError(t, err, ErrorInvalidGrant)
+		_, err = p.Token(ctx, TokenRequest{
+			GrantType: GrantTypeRefreshToken, ClientID: client.ID, ClientSecret: secret,
+			RefreshToken: refreshed.RefreshToken,
+		})
+		assertOAuthError(t, err, ErrorInvalidGrant)
+	})
+
+	t.Run("code is used once", func(t *testing.T) {
+		p := newTestProvider(t)
+		client, _ := p.createClient(t, true)
+		request := TokenRequest{
+			GrantType: GrantTypeAuthorizationCode, ClientID: client.ID,
+			Code: p.authorize(t, client), RedirectURI: testRedirectURI, CodeVerifier: testVerifier,
+		}
+		response, err := p.Token(ctx, request)
+		require.NoError(t, err)
+
+		_, err = p.Token(ctx, request)
+		assertOAuthError(t, err, ErrorInvalidGrant)
+		refreshToken, err := p.refreshTokens.GetByHash(ctx, hashSecret(response.RefreshToken))
+		require.NoError(t, err)
+		assert.NotNil(t, refreshToken.RevokedAt, "tokens of a reused code are revoked")
+	})
+
+	t.Run("invalid code exchange", func(t *testing.T) {
+		for name, modify := range map[string]func(*TokenRequest){
+			"wrong verifier":     func(r *TokenRequest) { r.CodeVerifier = strings.Repeat("a", 43) },
+			"missing verifier":   func(r *TokenRequest) { r.CodeVerifier = "" },
+			"wrong redirect uri": func(r *TokenRequest) { r.RedirectURI = "https://wiki.example.com/other" },
+			"unknown code":       func(r *TokenRequest) { r.Code = "unknown" },
+		} {
+			t.Run(name, func(t *testing.T) {
+				p := newTestProvider(t)
+				client, _ := p.createClient(t, true)
+				request := TokenRequest{
+					GrantType: GrantTypeAuthorizationCode, ClientID: client.ID,
+					Code: p.authorize(t, client), RedirectURI: testRedirectURI, CodeVerifier: testVerifier,
+				}
+				modify(&request)
+				_, err := p.Token(ctx, request)
+				assertOAuthError(t, err, ErrorInvalidGrant)
+			})
+		}
+	})
+
+	t.Run("disabled user", func(t *testing.T) {
+		p := newTestProvider(t)
+		client, _ := p.createClient(t, true)
+		response, err := p.Token(ctx, TokenRequest{
+			GrantType: GrantTypeAuthorizationCode, ClientID: client.ID,
+			Code: p.authorize(t, client), RedirectURI: testRedirectURI, CodeVerifier: testVerifier,
+		})
+		require.NoError(t, err)
+
+		usr := p.user
+		usr.State = user.Disabled
+		p.users.users[usr.ID] = usr
+		_, err = p.UserInfo(ctx, response.AccessToken)
+		assertOAuthError(t, err, ErrorInvalidToken)
+		_, err = p.Token(ctx, TokenRequest{
+			GrantType: GrantTypeRefreshToken, ClientID: client.ID, RefreshToken: response.RefreshToken,
+		})
+		assertOAuthError(t, err, ErrorInvalidGrant)
+	})
+
+	t.Run("unsupported grant type", func(t *testing.T) {
+		p := newTestProvider(t)
+		client, _ := p.createClient(t, true)
+		_, err := p.Token(ctx, TokenRequest{GrantType: "password", ClientID: client.ID})
+		assertOAuthError(t, err, ErrorUnsupportedGrantType)
+	})
+}
+
+func TestService_RevokeConsent(t *testing.T) {
+	ctx := context.Background()
+	p := newTestProvider(t)
+	client, _ := p.createClient(t, true)
+	response, err := p.Token(ctx, TokenRequest{
+		GrantType: GrantTypeAuthorizationCode, ClientID: client.ID,
+		Code: p.authorize(t, client), RedirectURI: testRedirectURI, CodeVerifier: testVerifier,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, p.RevokeConsent(ctx, p.user.ID, client.ID))
+	_, err = p.Token(ctx, TokenRequest{
+		GrantType: GrantTypeRefreshToken, ClientID: client.ID, RefreshToken: response.RefreshToken,
+	})
+	assertOAuthError(t, err, ErrorInvalidGrant)
+
+	redirect, err := p.Authorize(ctx, p.authorizeRequest(client), Subject{UserID: p.user.ID})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(redirect, p.config.ConsentURL), "consent is asked again")
+}
+
+func assertOAuthError(t *testing.T, err error, code string) {
+	t.Helper()
+	var oauthErr *Error
+	if assert.True(t, errors.As(err, &oauthErr), "got %v", err) {
+		assert.Equal(t, code, oauthErr.Code)
+	}
+}
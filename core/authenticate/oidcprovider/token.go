@@ -0,0 +1,308 @@
+package oidcprovider
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/raystack/frontier/core/user"
+)
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+
+	TokenTypeBearer = "Bearer"
+)
+
+// RefreshToken is an opaque token a client trades for new tokens, every
+// refresh rotates it. Tokens descending from the same authorization form a
+// family that is revoked as a whole when a rotated token is used again.
+type RefreshToken struct {
+	ID              string
+	TokenHash       string
+	AuthorizationID string
+	ClientID        string
+	UserID          string
+	Scopes          []string
+	AuthTime        time.Time
+	ExpiresAt       time.Time
+	RotatedAt       *time.Time
+	RevokedAt       *time.Time
+	CreatedAt       time.Time
+}
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token RefreshToken) (RefreshToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (RefreshToken, error)
+	// Rotate marks the token used and creates its successor, it fails with
+	// ErrRefreshTokenReused if the token was used or revoked meanwhile
+	Rotate(ctx context.Context, id string, next RefreshToken) (RefreshToken, error)
+	// RevokeFamily revokes every token issued for the authorization
+	RevokeFamily(ctx context.Context, authorizationID string) error
+	// RevokeByUser revokes the tokens of the user, of a client if given
+	RevokeByUser(ctx context.Context, userID, clientID string) error
+	DeleteExpired(ctx context.Context) error
+}
+
+type TokenRequest struct {
+	GrantType    string
+	ClientID     string
+	ClientSecret string
+
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+
+	RefreshToken string
+	Scope        string
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// Token handles a request of the token endpoint
+func (s *Service) Token(ctx context.Context, request TokenRequest) (TokenResponse, error) {
+	if !s.config.Enabled {
+		return TokenResponse{}, ErrDisabled
+	}
+	client, err := s.authenticateClient(ctx, request.ClientID, request.ClientSecret)
+	if err != nil {
+		return TokenResponse{}, err
+	}
+	switch request.GrantType {
+	case GrantTypeAuthorizationCode:
+		return s.exchangeCode(ctx, client, request)
+	case GrantTypeRefreshToken:
+		return s.refresh(ctx, client, request)
+	}
+	return TokenResponse{}, newError(ErrorUnsupportedGrantType, "grant_type must be authorization_code or refresh_token")
+}
+
+func (s *Service) exchangeCode(ctx context.Context, client Client, request TokenRequest) (TokenResponse, error) {
+	if request.Code == "" {
+		return TokenResponse{}, newError(ErrorInvalidRequest, "code is required")
+	}
+	authorization, err := s.authorizationRepo.Consume(ctx, hashSecret(request.Code))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrCodeReused):
+			// the code leaked, tokens issued for it can't be trusted either
+			if err := s.refreshTokenRepo.RevokeFamily(ctx, authorization.ID); err != nil {
+				return TokenResponse{}, err
+			}
+			return TokenResponse{}, newError(ErrorInvalidGrant, "code is already used")
+		case errors.Is(err, ErrAuthorizationNotFound):
+			return TokenResponse{}, newError(ErrorInvalidGrant, "code is invalid")
+		}
+		return TokenResponse{}, err
+	}
+	if authorization.ClientID != client.ID {
+		return TokenResponse{}, newError(ErrorInvalidGrant, "code was issued to another client")
+	}
+	if !authorization.ExpiresAt.After(s.now()) {
+		return TokenResponse{}, newError(ErrorInvalidGrant, "code is expired")
+	}
+	if authorization.RedirectURI != request.RedirectURI {
+		return TokenResponse{}, newError(ErrorInvalidGrant, "redirect_uri doesn't match the authorization request")
+	}
+	if authorization.CodeChallenge != "" || request.CodeVerifier != "" {
+		if !verifyCodeChallenge(authorization.CodeChallenge, request.CodeVerifier) {
+			return TokenResponse{}, newError(ErrorInvalidGrant, "code_verifier doesn't match the code challenge")
+		}
+	}
+
+	usr, err := s.activeUser(ctx, authorization.UserID)
+	if err != nil {
+		return TokenResponse{}, newError(ErrorInvalidGrant, "user is not active")
+	}
+	response, err := s.issueTokens(client, usr, authorization.Scopes, authorization.AuthTime, authorization.Nonce)
+	if err != nil {
+		return TokenResponse{}, err
+	}
+	if slices.Contains(authorization.Scopes, ScopeOfflineAccess) {
+		refreshToken, refreshTokenHash, err := generateSecret()
+		if err != nil {
+			return TokenResponse{}, err
+		}
+		if _, err := s.refreshTokenRepo.Create(ctx, RefreshToken{
+			TokenHash:       refreshTokenHash,
+			AuthorizationID: authorization.ID,
+			ClientID:        client.ID,
+			UserID:          usr.ID,
+			Scopes:          authorization.Scopes,
+			AuthTime:        authorization.AuthTime,
+			ExpiresAt:       s.now().Add(s.config.RefreshTokenValidity),
+		}); err != nil {
+			return TokenResponse{}, err
+		}
+		response.RefreshToken = refreshToken
+	}
+	return response, nil
+}
+
+func (s *Service) refresh(ctx context.Context, client Client, request TokenRequest) (TokenResponse, error) {
+	if request.RefreshToken == "" {
+		return TokenResponse{}, newError(ErrorInvalidRequest, "refresh_token is required")
+	}
+	current, err := s.refreshTokenRepo.GetByHash(ctx, hashSecret(request.RefreshToken))
+	if err != nil {
+		if errors.Is(err, ErrRefreshTokenNotFound) {
+			return TokenResponse{}, newError(ErrorInvalidGrant, "refresh token is invalid")
+		}
+		return TokenResponse{}, err
+	}
+	if current.ClientID != client.ID {
+		return TokenResponse{}, newError(ErrorInvalidGrant, "refresh token was issued to another client")
+	}
+	if current.RotatedAt != nil || current.RevokedAt != nil {
+		return TokenResponse{}, s.revokeReusedFamily(ctx, current)
+	}
+	if !current.ExpiresAt.After(s.now()) {
+		return TokenResponse{}, newError(ErrorInvalidGrant, "refresh token is expired")
+	}
+
+	// the client may ask for fewer scopes than it was granted
+	scopes := current.Scopes
+	if request.Scope != "" {
+		scopes = uniqueScopes(strings.Fields(request.Scope))
+		for _, scope := range scopes {
+			if !slices.Contains(current.Scopes, scope) {
+				return TokenResponse{}, newError(ErrorInvalidScope, "scope "+scope+" was not granted")
+			}
+		}
+	}
+
+	usr, err := s.activeUser(ctx, current.UserID)
+	if err != nil {
+		if errors.Is(err, user.ErrDisabled) || errors.Is(err, user.ErrNotExist) {
+			if err := s.refreshTokenRepo.RevokeFamily(ctx, current.AuthorizationID); err != nil {
+				return TokenResponse{}, err
+			}
+		}
+		return TokenResponse{}, newError(ErrorInvalidGrant, "user is not active")
+	}
+
+	refreshToken, refreshTokenHash, err := generateSecret()
+	if err != nil {
+		return TokenResponse{}, err
+	}
+	if _, err := s.refreshTokenRepo.Rotate(ctx, current.ID, RefreshToken{
+		TokenHash:       refreshTokenHash,
+		AuthorizationID: current.AuthorizationID,
+		ClientID:        current.ClientID,
+		UserID:          current.UserID,
+		Scopes:          current.Scopes,
+		AuthTime:        current.AuthTime,
+		ExpiresAt:       s.now().Add(s.config.RefreshTokenValidity),
+	}); err != nil {
+		if errors.Is(err, ErrRefreshTokenReused) {
+			return TokenResponse{}, s.revokeReusedFamily(ctx, current)
+		}
+		return TokenResponse{}, err
+	}
+
+	response, err := s.issueTokens(client, usr, scopes, current.AuthTime, "")
+	if err != nil {
+		return TokenResponse{}, err
+	}
+	response.RefreshToken = refreshToken
+	return response, nil
+}
+
+// revokeReusedFamily revokes the tokens of a family one of whose rotated
+// tokens was presented again, either the client or an attacker holds a
+// stolen token
+func (s *Service) revokeReusedFamily(ctx context.Context, token RefreshToken) error {
+	s.logger.WarnContext(ctx, "reuse of oauth refresh token detected, revoking its family",
+		"client_id", token.ClientID, "user_id", token.UserID, "authorization_id", token.AuthorizationID)
+	if err := s.refreshTokenRepo.RevokeFamily(ctx, token.AuthorizationID); err != nil {
+		return err
+	}
+	return newError(ErrorInvalidGrant, "refresh token is already used")
+}
+
+// issueTokens signs the access token and, for openid requests, the id token
+func (s *Service) issueTokens(client Client, usr user.User, scopes []string, authTime time.Time, nonce string) (TokenResponse, error) {
+	now := s.now().UTC()
+	expiresAt := now.Add(s.config.AccessTokenValidity)
+	scope := strings.Join(scopes, " ")
+
+	accessToken, err := jwt.NewBuilder().
+		Issuer(s.config.Issuer).
+		Subject(usr.ID).
+		Audience([]string{client.ID}).
+		IssuedAt(now).
+		NotBefore(now).
+		Expiration(expiresAt).
+		JwtID(uuid.New().String()).
+		Claim(ClientIDClaimKey, client.ID).
+		Claim(ScopeClaimKey, scope).
+		Build()
+	if err != nil {
+		return TokenResponse{}, err
+	}
+	signedAccessToken, err := s.tokenService.Sign(accessToken)
+	if err != nil {
+		return TokenResponse{}, err
+	}
+	response := TokenResponse{
+		AccessToken: string(signedAccessToken),
+		TokenType:   TokenTypeBearer,
+		ExpiresIn:   int64(s.config.AccessTokenValidity.Seconds()),
+		Scope:       scope,
+	}
+
+	if slices.Contains(scopes, ScopeOpenID) {
+		builder := jwt.NewBuilder().
+			Issuer(s.config.Issuer).
+			Subject(usr.ID).
+			Audience([]string{client.ID}).
+			IssuedAt(now).
+			Expiration(expiresAt).
+			Claim("azp", client.ID)
+		if !authTime.IsZero() {
+			builder = builder.Claim("auth_time", authTime.Unix())
+		}
+		if nonce != "" {
+			builder = builder.Claim("nonce", nonce)
+		}
+		for key, value := range userClaims(usr, scopes) {
+			builder = builder.Claim(key, value)
+		}
+		idToken, err := builder.Build()
+		if err != nil {
+			return TokenResponse{}, err
+		}
+		signedIDToken, err := s.tokenService.Sign(idToken)
+		if err != nil {
+			return TokenResponse{}, err
+		}
+		response.IDToken = string(signedIDToken)
+	}
+	return response, nil
+}
+
+// verifyCodeChallenge checks the PKCE code verifier against the S256
+// challenge of the authorization request
+func verifyCodeChallenge(challenge, verifier string) bool {
+	if challenge == "" || len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	digest := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(digest[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
@@ -0,0 +1,37 @@
+package authenticate
+
+import (
+	"context"
+	"strings"
+
+	"github.com/raystack/frontier/core/authenticate/ratelimit"
+	"github.com/raystack/frontier/pkg/utils"
+)
+
+// takeRateLimit limits the login attempts of the email, of the client ip
+// and of the organizations verifying the domain of the email. Test users
+// aren't limited.
+func (s Service) takeRateLimit(ctx context.Context, email, ipAddress string) error {
+	if s.rateLimiter == nil {
+		return nil
+	}
+	email = strings.ToLower(email)
+	if s.config.TestUsers.Enabled && utils.ExtractDomainFromEmail(email) == s.config.TestUsers.Domain {
+		return nil
+	}
+
+	keys := []ratelimit.Key{
+		{Scope: ratelimit.ScopeEmail, Value: email},
+		{Scope: ratelimit.ScopeIP, Value: ipAddress},
+	}
+	if s.domainService != nil && strings.Contains(email, "@") {
+		orgIDs, err := s.domainService.ListOrgsByDomain(ctx, email)
+		if err != nil {
+			return err
+		}
+		for _, orgID := range orgIDs {
+			keys = append(keys, ratelimit.Key{Scope: ratelimit.ScopeOrg, Value: orgID})
+		}
+	}
+	return s.rateLimiter.Take(ctx, keys...)
+}
@@ -0,0 +1,32 @@
+package ratelimit
+
+import "time"
+
+const (
+	StoreMemory   = "memory"
+	StorePostgres = "postgres"
+)
+
+type Config struct {
+	// Enabled limits the login flows started and the codes tried per email,
+	// client ip and organization
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"true"`
+	// Store keeps the buckets, "memory" counts per instance and "postgres"
+	// across the instances sharing the database
+	Store string `yaml:"store" mapstructure:"store" default:"memory"`
+	// Interval is the duration in which an exhausted bucket fills up again
+	Interval time.Duration `yaml:"interval" mapstructure:"interval" default:"1h"`
+	// Lockout blocks an email or a client ip for the duration once it
+	// exhausts its bucket, organizations are only throttled
+	Lockout time.Duration `yaml:"lockout" mapstructure:"lockout" default:"15m"`
+	// Email is the number of login flows and codes an email can take per
+	// interval, 0 doesn't limit emails
+	Email int `yaml:"email" mapstructure:"email" default:"10"`
+	// IP is the number of login flows and codes a client ip can take per
+	// interval, 0 doesn't limit client ips
+	IP int `yaml:"ip" mapstructure:"ip" default:"30"`
+	// Org is the number of login flows and codes the emails of a domain
+	// verified by an organization can take per interval, 0 doesn't limit
+	// organizations
+	Org int `yaml:"org" mapstructure:"org" default:"300"`
+}
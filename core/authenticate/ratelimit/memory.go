@@ -0,0 +1,44 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryRepository keeps the buckets of a single instance
+type MemoryRepository struct {
+	mu      sync.Mutex
+	buckets map[string]Bucket
+}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		buckets: map[string]Bucket{},
+	}
+}
+
+func (r *MemoryRepository) Update(ctx context.Context, key string, fn func(Bucket) Bucket) (Bucket, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	bucket, ok := r.buckets[key]
+	if !ok {
+		bucket = Bucket{Key: key}
+	}
+	bucket = fn(bucket)
+	r.buckets[key] = bucket
+	return bucket, nil
+}
+
+func (r *MemoryRepository) DeleteStale(ctx context.Context, updatedBefore time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, bucket := range r.buckets {
+		if bucket.UpdatedAt.Before(updatedBefore) {
+			delete(r.buckets, key)
+		}
+	}
+	return nil
+}
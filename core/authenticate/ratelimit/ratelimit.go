@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrLimitExceeded = errors.New("too many attempts, try again later")
+
+// Scope is what a bucket limits
+type Scope string
+
+const (
+	ScopeEmail Scope = "email"
+	ScopeIP    Scope = "ip"
+	ScopeOrg   Scope = "org"
+)
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+// Key identifies the bucket of a value in a scope, e.g. an email
+type Key struct {
+	Scope Scope
+	Value string
+}
+
+func (k Key) String() string {
+	return k.Scope.String() + ":" + k.Value
+}
+
+// Bucket is a token bucket, each attempt takes a token and the tokens are
+// refilled at a constant rate
+type Bucket struct {
+	Key         string
+	Tokens      float64
+	LockedUntil *time.Time
+	// Limited is set once the bucket rejects an attempt and cleared by the
+	// next accepted one, the limit trips when it's set
+	Limited   bool
+	UpdatedAt time.Time
+}
+
+// Limit is the capacity of a bucket refilled in the interval, a bucket
+// exhausting it is locked for the lockout
+type Limit struct {
+	Capacity int
+	Interval time.Duration
+	Lockout  time.Duration
+}
+
+// take refills the bucket for the time passed since its last update and
+// takes a token, it tells if the attempt is accepted. A bucket exhausted
+// with a lockout rejects the attempts till the lockout ends.
+func (l Limit) take(bucket Bucket, now time.Time) (Bucket, bool) {
+	if bucket.LockedUntil != nil && now.Before(*bucket.LockedUntil) {
+		return bucket, false
+	}
+
+	capacity := float64(l.Capacity)
+	if bucket.UpdatedAt.IsZero() || bucket.LockedUntil != nil {
+		// a new bucket and a bucket which served its lockout are full
+		bucket.Tokens = capacity
+		bucket.LockedUntil = nil
+	} else if elapsed := now.Sub(bucket.UpdatedAt); elapsed > 0 && l.Interval > 0 {
+		bucket.Tokens = min(capacity, bucket.Tokens+capacity*elapsed.Seconds()/l.Interval.Seconds())
+	}
+	bucket.UpdatedAt = now
+
+	if bucket.Tokens >= 1 {
+		bucket.Tokens--
+		bucket.Limited = false
+		return bucket, true
+	}
+	if l.Lockout > 0 {
+		lockedUntil := now.Add(l.Lockout)
+		bucket.LockedUntil = &lockedUntil
+	}
+	bucket.Limited = true
+	return bucket, false
+}
+
+type Repository interface {
+	// Update applies fn to the bucket of the key and saves it, fn gets a zero
+	// bucket with the key when there is none. Concurrent updates of a key
+	// are applied one after the other.
+	Update(ctx context.Context, key string, fn func(Bucket) Bucket) (Bucket, error)
+	// DeleteStale removes the buckets not updated since the time
+	DeleteStale(ctx context.Context, updatedBefore time.Time) error
+}
@@ -0,0 +1,155 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/raystack/frontier/core/auditrecord/models"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	pkgauditrecord "github.com/raystack/frontier/pkg/auditrecord"
+	"github.com/robfig/cron/v3"
+)
+
+// cleanupSchedule removes the buckets which filled up again
+const cleanupSchedule = "*/30 * * * *"
+
+type AuditRecordRepository interface {
+	Create(ctx context.Context, auditRecord models.AuditRecord) (models.AuditRecord, error)
+}
+
+type Service struct {
+	log             *slog.Logger
+	config          Config
+	repo            Repository
+	auditRecordRepo AuditRecordRepository
+	cron            *cron.Cron
+	Now             func() time.Time
+}
+
+func NewService(logger *slog.Logger, config Config, repo Repository, auditRecordRepo AuditRecordRepository) *Service {
+	return &Service{
+		log:             logger,
+		config:          config,
+		repo:            repo,
+		auditRecordRepo: auditRecordRepo,
+		cron:            cron.New(),
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+func (s *Service) limit(scope Scope) Limit {
+	limit := Limit{Interval: s.config.Interval}
+	switch scope {
+	case ScopeEmail:
+		limit.Capacity, limit.Lockout = s.config.Email, s.config.Lockout
+	case ScopeIP:
+		limit.Capacity, limit.Lockout = s.config.IP, s.config.Lockout
+	case ScopeOrg:
+		limit.Capacity = s.config.Org
+	}
+	return limit
+}
+
+// Take takes a token from the bucket of each key, it returns
+// ErrLimitExceeded when one of them is exhausted or locked. Keys with an
+// empty value or a scope without a limit aren't limited.
+func (s *Service) Take(ctx context.Context, keys ...Key) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	for _, key := range keys {
+		limit := s.limit(key.Scope)
+		if key.Value == "" || limit.Capacity <= 0 {
+			continue
+		}
+
+		var accepted, tripped bool
+		bucket, err := s.repo.Update(ctx, key.String(), func(bucket Bucket) Bucket {
+			wasLimited := bucket.Limited
+			bucket, accepted = limit.take(bucket, s.Now())
+			tripped = !accepted && !wasLimited
+			return bucket
+		})
+		if err != nil {
+			return err
+		}
+		if accepted {
+			continue
+		}
+		if tripped {
+			s.log.WarnContext(ctx, "rate limit exceeded", "scope", key.Scope, "key", key.Value)
+			if err := s.createAuditRecord(ctx, key, bucket); err != nil {
+				s.log.ErrorContext(ctx, "failed to create audit record for rate limit", "scope", key.Scope, "err", err)
+			}
+		}
+		return ErrLimitExceeded
+	}
+	return nil
+}
+
+func (s *Service) createAuditRecord(ctx context.Context, key Key, bucket Bucket) error {
+	if s.auditRecordRepo == nil {
+		return nil
+	}
+	resource := models.Resource{
+		ID:   schema.PlatformID,
+		Type: pkgauditrecord.PlatformType,
+		Name: schema.PlatformID,
+	}
+	orgID := schema.PlatformOrgID.String()
+	if key.Scope == ScopeOrg {
+		resource = models.Resource{
+			ID:   key.Value,
+			Type: pkgauditrecord.OrganizationType,
+		}
+		orgID = key.Value
+	}
+
+	metadata := map[string]any{
+		"scope": key.Scope.String(),
+	}
+	if bucket.LockedUntil != nil {
+		metadata["locked_until"] = bucket.LockedUntil.Format(time.RFC3339)
+	}
+	if _, err := s.auditRecordRepo.Create(ctx, models.AuditRecord{
+		Event:    pkgauditrecord.RateLimitExceededEvent,
+		Resource: resource,
+		Target: &models.Target{
+			ID:       key.Value,
+			Type:     pkgauditrecord.RateLimitType,
+			Name:     key.String(),
+			Metadata: metadata,
+		},
+		OrgID:      orgID,
+		OccurredAt: s.Now(),
+	}); err != nil {
+		return fmt.Errorf("creating audit record: %w", err)
+	}
+	return nil
+}
+
+// Init starts removing the buckets not updated for long enough to fill up
+// and unlock again
+func (s *Service) Init(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	_, err := s.cron.AddFunc(cleanupSchedule, func() {
+		if err := s.repo.DeleteStale(ctx, s.Now().Add(-max(s.config.Interval, s.config.Lockout))); err != nil {
+			s.log.WarnContext(ctx, "failed to delete stale rate limit buckets", "err", err)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start rate limit cronjob: %w", err)
+	}
+	s.cron.Start()
+	return nil
+}
+
+func (s *Service) Close() error {
+	return s.cron.Stop().Err()
+}
@@ -0,0 +1,133 @@
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/core/auditrecord/models"
+	pkgauditrecord "github.com/raystack/frontier/pkg/auditrecord"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type auditRecordRepository struct {
+	records []models.AuditRecord
+}
+
+func (r *auditRecordRepository) Create(ctx context.Context, auditRecord models.AuditRecord) (models.AuditRecord, error) {
+	r.records = append(r.records, auditRecord)
+	return auditRecord, nil
+}
+
+func TestLimit_take(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	limit := Limit{Capacity: 2, Interval: time.Hour}
+
+	bucket, accepted := limit.take(Bucket{}, now)
+	assert.True(t, accepted)
+	bucket, accepted = limit.take(bucket, now)
+	assert.True(t, accepted)
+	bucket, accepted = limit.take(bucket, now)
+	assert.False(t, accepted)
+	assert.True(t, bucket.Limited)
+	assert.Nil(t, bucket.LockedUntil)
+
+	// a token is refilled every half an hour
+	bucket, accepted = limit.take(bucket, now.Add(30*time.Minute))
+	assert.True(t, accepted)
+	assert.False(t, bucket.Limited)
+	_, accepted = limit.take(bucket, now.Add(30*time.Minute))
+	assert.False(t, accepted)
+}
+
+func TestLimit_take_Lockout(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	limit := Limit{Capacity: 1, Interval: time.Minute, Lockout: time.Hour}
+
+	bucket, accepted := limit.take(Bucket{}, now)
+	assert.True(t, accepted)
+	bucket, accepted = limit.take(bucket, now)
+	assert.False(t, accepted)
+	require.NotNil(t, bucket.LockedUntil)
+	assert.Equal(t, now.Add(time.Hour), *bucket.LockedUntil)
+
+	// the bucket is full again but stays locked
+	_, accepted = limit.take(bucket, now.Add(30*time.Minute))
+	assert.False(t, accepted)
+	bucket, accepted = limit.take(bucket, now.Add(time.Hour))
+	assert.True(t, accepted)
+	assert.Nil(t, bucket.LockedUntil)
+}
+
+func TestService_Take(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	newService := func(config Config) (*Service, *auditRecordRepository) {
+		auditRecords := &auditRecordRepository{}
+		svc := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), config, NewMemoryRepository(), auditRecords)
+		svc.Now = func() time.Time { return now }
+		return svc, auditRecords
+	}
+	config := Config{
+		Enabled:  true,
+		Interval: time.Hour,
+		Lockout:  15 * time.Minute,
+		Email:    2,
+		IP:       3,
+		Org:      1,
+	}
+	email := Key{Scope: ScopeEmail, Value: "alice@acme.org"}
+
+	t.Run("should reject an exhausted key and audit it once", func(t *testing.T) {
+		svc, auditRecords := newService(config)
+
+		assert.NoError(t, svc.Take(ctx, email))
+		assert.NoError(t, svc.Take(ctx, email))
+		assert.ErrorIs(t, svc.Take(ctx, email), ErrLimitExceeded)
+		assert.ErrorIs(t, svc.Take(ctx, email), ErrLimitExceeded)
+		assert.NoError(t, svc.Take(ctx, Key{Scope: ScopeEmail, Value: "bob@acme.org"}))
+
+		require.Len(t, auditRecords.records, 1)
+		assert.Equal(t, pkgauditrecord.RateLimitExceededEvent, auditRecords.records[0].Event)
+		assert.Equal(t, "alice@acme.org", auditRecords.records[0].Target.ID)
+		assert.Equal(t, "email", auditRecords.records[0].Target.Metadata["scope"])
+
+		// the lockout holds the email past the refill of a token
+		now = now.Add(time.Minute)
+		assert.ErrorIs(t, svc.Take(ctx, email), ErrLimitExceeded)
+		now = now.Add(15 * time.Minute)
+		assert.NoError(t, svc.Take(ctx, email))
+	})
+
+	t.Run("should audit an exhausted organization in the organization", func(t *testing.T) {
+		svc, auditRecords := newService(config)
+		org := Key{Scope: ScopeOrg, Value: "9f256f86-31a3-11ec-8d3d-0242ac130003"}
+
+		assert.NoError(t, svc.Take(ctx, org))
+		assert.ErrorIs(t, svc.Take(ctx, org), ErrLimitExceeded)
+		require.Len(t, auditRecords.records, 1)
+		assert.Equal(t, org.Value, auditRecords.records[0].OrgID)
+		assert.Nil(t, auditRecords.records[0].Target.Metadata["locked_until"])
+	})
+
+	t.Run("should not limit keys without a value or a limit", func(t *testing.T) {
+		svc, _ := newService(Config{Enabled: true, Interval: time.Hour, Email: 1})
+
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, svc.Take(ctx, Key{Scope: ScopeIP, Value: "10.0.0.1"}, Key{Scope: ScopeEmail}))
+		}
+	})
+
+	t.Run("should not limit when disabled", func(t *testing.T) {
+		config := config
+		config.Enabled = false
+		svc, _ := newService(config)
+
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, svc.Take(ctx, email))
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package authenticate
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/raystack/frontier/core/authenticate/ratelimit"
+	testusers "github.com/raystack/frontier/core/authenticate/test_users"
+	"github.com/raystack/frontier/pkg/mailer"
+	"github.com/stretchr/testify/assert"
+)
+
+type rateLimiter struct {
+	keys []ratelimit.Key
+	err  error
+}
+
+func (r *rateLimiter) Take(ctx context.Context, keys ...ratelimit.Key) error {
+	r.keys = append(r.keys, keys...)
+	return r.err
+}
+
+func newRateLimitedService(config Config, limiter RateLimiter) *Service {
+	svc := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), config,
+		nil, mailer.NewMockDialer(), nil, nil, nil, nil, nil, nil)
+	svc.SetDomainService(&domainService{domains: map[string][]string{
+		"acme": {"acme.org"},
+	}})
+	svc.SetRateLimiter(limiter)
+	return svc
+}
+
+func TestService_RateLimit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should limit the email, the ip and the organizations of the domain", func(t *testing.T) {
+		limiter := &rateLimiter{}
+		svc := newRateLimitedService(Config{}, limiter)
+
+		err := svc.takeRateLimit(ctx, "John@Acme.org", "10.0.0.1")
+		assert.NoError(t, err)
+		assert.Equal(t, []ratelimit.Key{
+			{Scope: ratelimit.ScopeEmail, Value: "john@acme.org"},
+			{Scope: ratelimit.ScopeIP, Value: "10.0.0.1"},
+			{Scope: ratelimit.ScopeOrg, Value: "acme"},
+		}, limiter.keys)
+	})
+
+	t.Run("should not limit test users", func(t *testing.T) {
+		limiter := &rateLimiter{}
+		svc := newRateLimitedService(Config{
+			TestUsers: testusers.Config{Enabled: true, Domain: "test.org", OTP: "123456"},
+		}, limiter)
+
+		err := svc.takeRateLimit(ctx, "john@test.org", "10.0.0.1")
+		assert.NoError(t, err)
+		assert.Empty(t, limiter.keys)
+	})
+
+	t.Run("should reject the mail otp flow when the limit is exceeded", func(t *testing.T) {
+		limiter := &rateLimiter{err: ratelimit.ErrLimitExceeded}
+		svc := newRateLimitedService(Config{}, limiter)
+
+		_, err := svc.StartFlow(ctx, RegistrationStartRequest{
+			Method:    MailOTPAuthMethod.String(),
+			Email:     "john@example.com",
+			IPAddress: "10.0.0.1",
+		})
+		assert.ErrorIs(t, err, ratelimit.ErrLimitExceeded)
+	})
+}
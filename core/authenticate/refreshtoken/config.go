@@ -0,0 +1,12 @@
+package refreshtoken
+
+import "time"
+
+type Config struct {
+	// Enabled issues a refresh token along with the access token of a
+	// session, clients use it to get new access tokens without the cookie
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"false"`
+	// Validity is the lifetime of a refresh token, it never outlives its
+	// session and every use issues a new one
+	Validity time.Duration `yaml:"validity" mapstructure:"validity" default:"168h"`
+}
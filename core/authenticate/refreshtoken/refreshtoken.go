@@ -0,0 +1,42 @@
+package refreshtoken
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNotFound     = errors.New("refresh token doesn't exist")
+	ErrInvalidToken = errors.New("refresh token is invalid or expired")
+	ErrReused       = errors.New("refresh token is already used")
+	ErrDisabled     = errors.New("refresh tokens are disabled")
+)
+
+// RefreshToken is an opaque token bound to a session that is traded for a
+// new access token, every use rotates it. Tokens descending from the same
+// issue form a family that is revoked as a whole when a rotated token is
+// used again.
+type RefreshToken struct {
+	ID        string
+	TokenHash string
+	SessionID uuid.UUID
+	UserID    string
+	FamilyID  string
+	ExpiresAt time.Time
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+type Repository interface {
+	Create(ctx context.Context, token RefreshToken) (RefreshToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (RefreshToken, error)
+	// Rotate marks the token used and creates its successor, it fails with
+	// ErrReused if the token was used or revoked meanwhile
+	Rotate(ctx context.Context, id string, next RefreshToken) (RefreshToken, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	DeleteExpired(ctx context.Context) error
+}
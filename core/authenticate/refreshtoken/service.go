@@ -0,0 +1,211 @@
+package refreshtoken
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate/session"
+	"github.com/robfig/cron/v3"
+	"golang.org/x/crypto/sha3"
+)
+
+const refreshTime = "0 * * * *" // every hour
+
+type SessionService interface {
+	GetByID(ctx context.Context, sessionID uuid.UUID) (*session.Session, error)
+}
+
+type Service struct {
+	log            *slog.Logger
+	config         Config
+	repo           Repository
+	sessionService SessionService
+	cron           *cron.Cron
+	Now            func() time.Time
+}
+
+func NewService(logger *slog.Logger, config Config, repo Repository, sessionService SessionService) *Service {
+	return &Service{
+		log:            logger,
+		config:         config,
+		repo:           repo,
+		sessionService: sessionService,
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+func (s *Service) Enabled() bool {
+	return s.config.Enabled
+}
+
+// Init schedules the removal of expired refresh tokens
+func (s *Service) Init(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	s.cron = cron.New(cron.WithChain(
+		cron.SkipIfStillRunning(cron.DefaultLogger),
+		cron.Recover(cron.DefaultLogger),
+	))
+	if _, err := s.cron.AddFunc(refreshTime, func() {
+		if err := s.repo.DeleteExpired(ctx); err != nil {
+			s.log.WarnContext(ctx, "failed to delete expired refresh tokens", "err", err)
+		}
+	}); err != nil {
+		return fmt.Errorf("failed to schedule refresh token cleanup: %w", err)
+	}
+	s.cron.Start()
+	return nil
+}
+
+func (s *Service) Close() error {
+	if s.cron != nil {
+		<-s.cron.Stop().Done()
+	}
+	return nil
+}
+
+// Issue creates the first refresh token of a new family for the session
+func (s *Service) Issue(ctx context.Context, sess *session.Session) (string, error) {
+	if !s.config.Enabled {
+		return "", ErrDisabled
+	}
+	if !sess.IsValid(s.Now()) {
+		return "", ErrInvalidToken
+	}
+	value, tokenHash, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+	if _, err := s.repo.Create(ctx, RefreshToken{
+		TokenHash: tokenHash,
+		SessionID: sess.ID,
+		UserID:    sess.UserID,
+		FamilyID:  uuid.NewString(),
+		ExpiresAt: s.expiry(sess),
+	}); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// Validate returns the session a refresh token is bound to without using the
+// token up, so the caller can authenticate the session before the token is
+// rotated. A token used before revokes its family.
+func (s *Service) Validate(ctx context.Context, value string) (*session.Session, error) {
+	if !s.config.Enabled {
+		return nil, ErrDisabled
+	}
+	_, sess, err := s.redeemable(ctx, value)
+	return sess, err
+}
+
+// Rotate redeems a refresh token and returns the session it is bound to
+// along with the refresh token replacing it. A token of a deleted or expired
+// session is invalid, a token used before revokes its family.
+func (s *Service) Rotate(ctx context.Context, value string) (*session.Session, string, error) {
+	if !s.config.Enabled {
+		return nil, "", ErrDisabled
+	}
+	current, sess, err := s.redeemable(ctx, value)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next, nextHash, err := generateToken()
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := s.repo.Rotate(ctx, current.ID, RefreshToken{
+		TokenHash: nextHash,
+		SessionID: current.SessionID,
+		UserID:    current.UserID,
+		FamilyID:  current.FamilyID,
+		ExpiresAt: s.expiry(sess),
+	}); err != nil {
+		if errors.Is(err, ErrReused) {
+			return nil, "", s.revokeReusedFamily(ctx, current)
+		}
+		return nil, "", err
+	}
+	return sess, next, nil
+}
+
+// redeemable returns the refresh token of the value along with its session
+// if the token can be redeemed
+func (s *Service) redeemable(ctx context.Context, value string) (RefreshToken, *session.Session, error) {
+	current, err := s.repo.GetByHash(ctx, hashToken(value))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return RefreshToken{}, nil, ErrInvalidToken
+		}
+		return RefreshToken{}, nil, err
+	}
+	if current.RotatedAt != nil || current.RevokedAt != nil {
+		return RefreshToken{}, nil, s.revokeReusedFamily(ctx, current)
+	}
+	if !current.ExpiresAt.After(s.Now()) {
+		return RefreshToken{}, nil, ErrInvalidToken
+	}
+
+	sess, err := s.sessionService.GetByID(ctx, current.SessionID)
+	if err != nil {
+		if errors.Is(err, session.ErrNoSession) {
+			return RefreshToken{}, nil, ErrInvalidToken
+		}
+		return RefreshToken{}, nil, err
+	}
+	if !sess.IsValid(s.Now()) {
+		// the user logged out, nothing of the family is usable anymore
+		if err := s.repo.RevokeFamily(ctx, current.FamilyID); err != nil {
+			return RefreshToken{}, nil, err
+		}
+		return RefreshToken{}, nil, ErrInvalidToken
+	}
+	return current, sess, nil
+}
+
+// revokeReusedFamily revokes the tokens of a family one of whose rotated
+// tokens was presented again, either the client or an attacker holds a
+// stolen token
+func (s *Service) revokeReusedFamily(ctx context.Context, token RefreshToken) error {
+	s.log.WarnContext(ctx, "reuse of refresh token detected, revoking its family",
+		"user_id", token.UserID, "session_id", token.SessionID.String(), "family_id", token.FamilyID)
+	if err := s.repo.RevokeFamily(ctx, token.FamilyID); err != nil {
+		return err
+	}
+	return ErrReused
+}
+
+// expiry caps the validity of a token at the expiry of its session
+func (s *Service) expiry(sess *session.Session) time.Time {
+	expiresAt := s.Now().Add(s.config.Validity)
+	if sess.ExpiresAt.Before(expiresAt) {
+		return sess.ExpiresAt
+	}
+	return expiresAt
+}
+
+func generateToken() (string, string, error) {
+	tokenBytes := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, tokenBytes); err != nil {
+		return "", "", err
+	}
+	value := base64.RawURLEncoding.EncodeToString(tokenBytes)
+	return value, hashToken(value), nil
+}
+
+func hashToken(value string) string {
+	hash := sha3.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,230 @@
+package refreshtoken_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate/refreshtoken"
+	"github.com/raystack/frontier/core/authenticate/session"
+	"github.com/stretchr/testify/assert"
+)
+
+type repository struct {
+	tokens  map[string]refreshtoken.RefreshToken
+	revoked []string
+}
+
+func newRepository() *repository {
+	return &repository{tokens: map[string]refreshtoken.RefreshToken{}}
+}
+
+func (r *repository) Create(ctx context.Context, token refreshtoken.RefreshToken) (refreshtoken.RefreshToken, error) {
+	token.ID = uuid.NewString()
+	r.tokens[token.TokenHash] = token
+	return token, nil
+}
+
+func (r *repository) GetByHash(ctx context.Context, tokenHash string) (refreshtoken.RefreshToken, error) {
+	token, ok := r.tokens[tokenHash]
+	if !ok {
+		return refreshtoken.RefreshToken{}, refreshtoken.ErrNotFound
+	}
+	return token, nil
+}
+
+func (r *repository) Rotate(ctx context.Context, id string, next refreshtoken.RefreshToken) (refreshtoken.RefreshToken, error) {
+	for tokenHash, token := range r.tokens {
+		if token.ID != id {
+			continue
+		}
+		if token.RotatedAt != nil || token.RevokedAt != nil {
+			return refreshtoken.RefreshToken{}, refreshtoken.ErrReused
+		}
+		now := time.Now()
+		token.RotatedAt = &now
+		r.tokens[tokenHash] = token
+	}
+	return r.Create(ctx, next)
+}
+
+func (r *repository) RevokeFamily(ctx context.Context, familyID string) error {
+	now := time.Now()
+	for tokenHash, token := range r.tokens {
+		if token.FamilyID == familyID {
+			token.RevokedAt = &now
+			r.tokens[tokenHash] = token
+		}
+	}
+	r.revoked = append(r.revoked, familyID)
+	return nil
+}
+
+func (r *repository) DeleteExpired(ctx context.Context) error {
+	return nil
+}
+
+type sessionService struct {
+	sessions map[uuid.UUID]*session.Session
+}
+
+func (s sessionService) GetByID(ctx context.Context, sessionID uuid.UUID) (*session.Session, error) {
+	sess, ok := s.sessions[sessionID]
+	if !ok {
+		return nil, session.ErrNoSession
+	}
+	return sess, nil
+}
+
+func newSession() *session.Session {
+	now := time.Now().UTC()
+	return &session.Session{
+		ID:              uuid.New(),
+		UserID:          uuid.NewString(),
+		AuthenticatedAt: now,
+		ExpiresAt:       now.Add(time.Hour),
+		CreatedAt:       now,
+	}
+}
+
+func newService(repo refreshtoken.Repository, sessions ...*session.Session) *refreshtoken.Service {
+	sessionSvc := sessionService{sessions: map[uuid.UUID]*session.Session{}}
+	for _, sess := range sessions {
+		sessionSvc.sessions[sess.ID] = sess
+	}
+	return refreshtoken.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), refreshtoken.Config{
+		Enabled:  true,
+		Validity: 24 * time.Hour,
+	}, repo, sessionSvc)
+}
+
+func TestService_Issue(t *testing.T) {
+	t.Run("should not outlive the session", func(t *testing.T) {
+		repo := newRepository()
+		sess := newSession()
+		svc := newService(repo, sess)
+
+		value, err := svc.Issue(context.Background(), sess)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, value)
+		assert.Len(t, repo.tokens, 1)
+		for _, token := range repo.tokens {
+			assert.NotEqual(t, value, token.TokenHash)
+			assert.Equal(t, sess.ExpiresAt, token.ExpiresAt)
+		}
+	})
+
+	t.Run("should fail when refresh tokens are disabled", func(t *testing.T) {
+		svc := refreshtoken.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), refreshtoken.Config{},
+			newRepository(), sessionService{})
+
+		_, err := svc.Issue(context.Background(), newSession())
+
+		assert.ErrorIs(t, err, refreshtoken.ErrDisabled)
+	})
+}
+
+func TestService_Rotate(t *testing.T) {
+	t.Run("should return the session and a new token", func(t *testing.T) {
+		repo := newRepository()
+		sess := newSession()
+		svc := newService(repo, sess)
+		value, err := svc.Issue(context.Background(), sess)
+		assert.NoError(t, err)
+
+		gotSession, next, err := svc.Rotate(context.Background(), value)
+
+		assert.NoError(t, err)
+		assert.Equal(t, sess.ID, gotSession.ID)
+		assert.NotEmpty(t, next)
+		assert.NotEqual(t, value, next)
+	})
+
+	t.Run("should revoke the family when a token is used again", func(t *testing.T) {
+		repo := newRepository()
+		sess := newSession()
+		svc := newService(repo, sess)
+		value, err := svc.Issue(context.Background(), sess)
+		assert.NoError(t, err)
+		_, next, err := svc.Rotate(context.Background(), value)
+		assert.NoError(t, err)
+
+		_, _, err = svc.Rotate(context.Background(), value)
+		assert.ErrorIs(t, err, refreshtoken.ErrReused)
+		assert.Len(t, repo.revoked, 1)
+
+		// the successor is unusable as well
+		_, _, err = svc.Rotate(context.Background(), next)
+		assert.ErrorIs(t, err, refreshtoken.ErrReused)
+	})
+
+	t.Run("should fail for an unknown token", func(t *testing.T) {
+		svc := newService(newRepository())
+
+		_, _, err := svc.Rotate(context.Background(), "unknown")
+
+		assert.ErrorIs(t, err, refreshtoken.ErrInvalidToken)
+	})
+
+	t.Run("should fail when the session is deleted", func(t *testing.T) {
+		repo := newRepository()
+		sess := newSession()
+		value, err := newService(repo, sess).Issue(context.Background(), sess)
+		assert.NoError(t, err)
+
+		_, _, err = newService(repo).Rotate(context.Background(), value)
+
+		assert.ErrorIs(t, err, refreshtoken.ErrInvalidToken)
+	})
+
+	t.Run("should fail when the token is expired", func(t *testing.T) {
+		repo := newRepository()
+		sess := newSession()
+		svc := newService(repo, sess)
+		value, err := svc.Issue(context.Background(), sess)
+		assert.NoError(t, err)
+		svc.Now = func() time.Time {
+			return time.Now().UTC().Add(2 * time.Hour)
+		}
+
+		_, _, err = svc.Rotate(context.Background(), value)
+
+		assert.ErrorIs(t, err, refreshtoken.ErrInvalidToken)
+	})
+}
+
+func TestService_Validate(t *testing.T) {
+	t.Run("should return the session without using the token up", func(t *testing.T) {
+		repo := newRepository()
+		sess := newSession()
+		svc := newService(repo, sess)
+		value, err := svc.Issue(context.Background(), sess)
+		assert.NoError(t, err)
+
+		gotSession, err := svc.Validate(context.Background(), value)
+		assert.NoError(t, err)
+		assert.Equal(t, sess.ID, gotSession.ID)
+
+		// the token is still redeemable
+		_, _, err = svc.Rotate(context.Background(), value)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should revoke the family of a token used before", func(t *testing.T) {
+		repo := newRepository()
+		sess := newSession()
+		svc := newService(repo, sess)
+		value, err := svc.Issue(context.Background(), sess)
+		assert.NoError(t, err)
+		_, _, err = svc.Rotate(context.Background(), value)
+		assert.NoError(t, err)
+
+		_, err = svc.Validate(context.Background(), value)
+		assert.ErrorIs(t, err, refreshtoken.ErrReused)
+		assert.Len(t, repo.revoked, 1)
+	})
+}
@@ -0,0 +1,292 @@
+package authenticate
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate/strategy"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// SAMLMetadataPath serves the service provider metadata of a saml strategy
+	SAMLMetadataPath = "/saml/{name}/metadata"
+	// SAMLACSPath is the assertion consumer service the identity provider
+	// posts its response to
+	SAMLACSPath = "/saml/{name}/acs"
+
+	samlEmailKey    = "saml_email"
+	samlTitleKey    = "saml_title"
+	samlMetadataKey = "saml_metadata"
+)
+
+// SAMLMetadata returns the service provider metadata of the saml strategy to
+// register frontier with the identity provider
+func (s Service) SAMLMetadata(ctx context.Context, name string) ([]byte, error) {
+	samlConfig, ok := s.config.SAMLConfig[name]
+	if !ok {
+		return nil, ErrUnsupportedMethod
+	}
+	sp, err := samlServiceProvider(name, samlConfig)
+	if err != nil {
+		return nil, err
+	}
+	return sp.Metadata()
+}
+
+func (s Service) startSAMLFlow(ctx context.Context, samlConfig SAMLConfig, flow *Flow) (*RegistrationStartResponse, error) {
+	if samlConfig.OrgID != "" && s.orgService != nil {
+		enabled, err := s.orgService.IsEnabled(ctx, samlConfig.OrgID)
+		if err != nil {
+			return nil, err
+		}
+		if !enabled {
+			return nil, ErrUnsupportedMethod
+		}
+	}
+
+	sp, err := samlServiceProvider(flow.Method, samlConfig)
+	if err != nil {
+		return nil, err
+	}
+	if sp, err = sp.Init(ctx, samlConfig.IDPMetadataURL, samlConfig.IDPMetadata); err != nil {
+		return nil, err
+	}
+
+	relayState, err := strategy.EmbedFlowInOIDCState(flow.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	endpoint, requestID, err := sp.AuthURL(relayState)
+	if err != nil {
+		return nil, err
+	}
+
+	flow.StartURL = endpoint
+	flow.Nonce = requestID
+	if samlConfig.Validity != 0 {
+		flow.ExpiresAt = flow.CreatedAt.Add(samlConfig.Validity)
+	}
+	if err = s.flowRepo.Set(ctx, flow); err != nil {
+		return nil, err
+	}
+	return &RegistrationStartResponse{
+		Flow: flow,
+	}, nil
+}
+
+// ConsumeSAMLResponse verifies the signed response the identity provider
+// posted to the assertion consumer service and returns the callback url the
+// user finishes the flow at. The profile of the user is kept in the flow
+// till the callback presents the one time code of the url.
+func (s Service) ConsumeSAMLResponse(ctx context.Context, name, samlResponse, relayState string) (string, error) {
+	samlConfig, ok := s.config.SAMLConfig[name]
+	if !ok {
+		return "", ErrUnsupportedMethod
+	}
+	flowIDFromState, err := strategy.ExtractFlowFromOIDCState(relayState)
+	if err != nil {
+		return "", ErrInvalidOIDCState
+	}
+	flowID, err := uuid.Parse(flowIDFromState)
+	if err != nil {
+		return "", ErrInvalidOIDCState
+	}
+	flow, err := s.flowRepo.Get(ctx, flowID)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFlowInvalid, err)
+	}
+	if flow.Method != name || !flow.IsValid(s.Now()) {
+		return "", ErrFlowInvalid
+	}
+	callbackURL, ok := flow.Metadata["callback_url"].(string)
+	if !ok || len(callbackURL) == 0 {
+		return "", fmt.Errorf("callback url not configured")
+	}
+
+	sp, err := samlServiceProvider(name, samlConfig)
+	if err != nil {
+		return "", err
+	}
+	if sp, err = sp.Init(ctx, samlConfig.IDPMetadataURL, samlConfig.IDPMetadata); err != nil {
+		return "", err
+	}
+	// the flow nonce is the id of the authn request till the response is
+	// consumed, a replayed response doesn't match the code hash after that
+	samlProfile, err := sp.GetUser(samlResponse, flow.Nonce)
+	if err != nil {
+		return "", err
+	}
+
+	mapping := samlConfig.Attributes
+	email := samlProfile.Attribute(withDefault(mapping.Email, "email"))
+	if email == "" {
+		email = samlProfile.NameID
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	if !strings.Contains(email, "@") {
+		return "", fmt.Errorf("%w: missing email of the subject", strategy.ErrInvalidSAMLResponse)
+	}
+	if err = s.verifyIDPUser(ctx, email, samlConfig.OrgID); err != nil {
+		return "", err
+	}
+	userMetadata := map[string]any{}
+	for key, attr := range mapping.Metadata {
+		if value := samlProfile.Attribute(attr); value != "" {
+			userMetadata[key] = value
+		}
+	}
+
+	code, err := generateSAMLCode()
+	if err != nil {
+		return "", err
+	}
+	codeHash, err := hashOTP(code)
+	if err != nil {
+		return "", err
+	}
+	flow.Nonce = codeHash
+	flow.Email = email
+	flow.Metadata[samlEmailKey] = email
+	flow.Metadata[samlTitleKey] = samlProfile.Attribute(withDefault(mapping.Name, "name"))
+	flow.Metadata[samlMetadataKey] = userMetadata
+	if err = s.flowRepo.Set(ctx, flow); err != nil {
+		return "", err
+	}
+
+	redirectURL, err := url.Parse(callbackURL)
+	if err != nil {
+		return "", err
+	}
+	query := redirectURL.Query()
+	query.Set("state", relayState)
+	query.Set("code", code)
+	redirectURL.RawQuery = query.Encode()
+	return redirectURL.String(), nil
+}
+
+// applySAML finishes the flow of a saml response consumed by the assertion
+// consumer service, the user is created or updated with the mapped attributes
+func (s Service) applySAML(ctx context.Context, request RegistrationFinishRequest) (*RegistrationFinishResponse, error) {
+	if len(request.State) == 0 || len(request.Code) == 0 {
+		return nil, ErrStrategyNotApplicable
+	}
+	flowIDFromState, err := strategy.ExtractFlowFromOIDCState(request.State)
+	if err != nil {
+		return nil, ErrStrategyNotApplicable
+	}
+	flowID, err := uuid.Parse(flowIDFromState)
+	if err != nil {
+		return nil, ErrStrategyNotApplicable
+	}
+	flow, err := s.flowRepo.Get(ctx, flowID)
+	if err != nil {
+		return nil, err
+	}
+	samlConfig, ok := s.config.SAMLConfig[flow.Method]
+	if !ok {
+		return nil, ErrStrategyNotApplicable
+	}
+
+	email, ok := flow.Metadata[samlEmailKey].(string)
+	if !ok || !flow.IsValid(s.Now()) {
+		return nil, ErrFlowInvalid
+	}
+	if bcrypt.CompareHashAndPassword([]byte(flow.Nonce), []byte(request.Code)) != nil {
+		// the code is long enough to not be guessed, it isn't retried
+		if err = s.consumeFlow(ctx, flow.ID); err != nil {
+			return nil, fmt.Errorf("failed to process flow code missmatch")
+		}
+		return nil, ErrFlowInvalid
+	}
+	if err = s.consumeFlow(ctx, flow.ID); err != nil {
+		return nil, fmt.Errorf("failed to successfully register via saml: %w", err)
+	}
+
+	title, _ := flow.Metadata[samlTitleKey].(string)
+	newUser, err := s.getOrCreateUser(ctx, email, title)
+	if err != nil {
+		return nil, err
+	}
+
+	// the identity provider owns the profile, keep the user in sync with it
+	updated := false
+	if title != "" && newUser.Title != title {
+		newUser.Title = title
+		updated = true
+	}
+	if userMetadata, ok := flow.Metadata[samlMetadataKey].(map[string]any); ok {
+		for key, value := range userMetadata {
+			if newUser.Metadata == nil {
+				newUser.Metadata = map[string]any{}
+			}
+			if newUser.Metadata[key] != value {
+				newUser.Metadata[key] = value
+				updated = true
+			}
+		}
+	}
+	if updated {
+		if newUser, err = s.userService.Update(ctx, newUser); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.joinOrg(ctx, samlConfig.OrgID, newUser.ID); err != nil {
+		return nil, err
+	}
+
+	return &RegistrationFinishResponse{
+		User: newUser,
+		Flow: flow,
+	}, nil
+}
+
+func samlServiceProvider(name string, samlConfig SAMLConfig) (*strategy.SAML, error) {
+	metadataURL, err := samlEndpoint(samlConfig.ServiceProviderURL, SAMLMetadataPath, name)
+	if err != nil {
+		return nil, err
+	}
+	acsURL, err := samlEndpoint(samlConfig.ServiceProviderURL, SAMLACSPath, name)
+	if err != nil {
+		return nil, err
+	}
+	sp := strategy.NewServiceProviderSAML(samlConfig.EntityID, metadataURL, acsURL)
+	if len(samlConfig.Certificate) > 0 || len(samlConfig.PrivateKey) > 0 {
+		return sp.WithKeyPair(samlConfig.Certificate, samlConfig.PrivateKey)
+	}
+	return sp, nil
+}
+
+func samlEndpoint(baseURL, path, name string) (url.URL, error) {
+	if len(baseURL) == 0 {
+		return url.URL{}, fmt.Errorf("saml service provider url not configured")
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(baseURL, "/") +
+		strings.Replace(path, "{name}", url.PathEscape(name), 1))
+	if err != nil {
+		return url.URL{}, err
+	}
+	return *endpoint, nil
+}
+
+func generateSAMLCode() (string, error) {
+	codeBytes := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, codeBytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(codeBytes), nil
+}
+
+func withDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/raystack/frontier/core/audit"
 
+	"github.com/raystack/frontier/core/authenticate/ratelimit"
 	frontiersession "github.com/raystack/frontier/core/authenticate/session"
 	"github.com/raystack/frontier/core/serviceuser"
 	patModels "github.com/raystack/frontier/core/userpat/models"
@@ -25,6 +26,7 @@ import (
 	"github.com/raystack/frontier/pkg/errors"
 
 	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
 
 	"github.com/raystack/frontier/core/authenticate/token"
 
@@ -59,6 +61,12 @@ var (
 	ErrInvalidOIDCState      = errors.New("invalid auth state")
 	ErrFlowInvalid           = errors.New("invalid flow or expired")
 	ErrOIDCTokenExchange     = errors.New("failed to exchange oidc authorization code")
+	ErrIDPUserNotAllowed     = errors.New("user is not allowed to sign in with the identity provider")
+	ErrMissingDomainSvc      = errors.New("domain service is not configured")
+	ErrSSORequired           = errors.New("users of the email domain must sign in with the sso of their organization")
+	ErrSSONotConfigured      = errors.New("sso is not configured for the email domain")
+	ErrMFARequired           = errors.New("multi-factor authentication is required to complete the login")
+	ErrReauthRequired        = errors.New("recent authentication is required for the operation")
 )
 
 type UserService interface {
@@ -83,12 +91,14 @@ type FlowRepository interface {
 type SessionService interface {
 	ExtractFromContext(ctx context.Context) (*frontiersession.Session, error)
 	List(ctx context.Context, userID string) ([]*frontiersession.Session, error)
+	Track(ctx context.Context, session *frontiersession.Session) error
 }
 
 type TokenService interface {
 	GetPublicKeySet() jwk.Set
 	Build(subjectID string, metadata map[string]string) ([]byte, error)
 	Parse(ctx context.Context, userToken []byte) (string, map[string]any, error)
+	Revoke(ctx context.Context, userToken []byte) error
 }
 
 type UserPATService interface {
@@ -100,6 +110,30 @@ type OrgService interface {
 	IsEnabled(ctx context.Context, orgID string) (bool, error)
 }
 
+// DomainService checks the verified domains of the organizations whose
+// identity providers sign in their users
+type DomainService interface {
+	IsOrgDomain(ctx context.Context, orgID string, email string) (bool, error)
+	ListOrgsByDomain(ctx context.Context, email string) ([]string, error)
+	Join(ctx context.Context, orgID string, userID string) error
+}
+
+type PreferenceService interface {
+	LoadOrganizationPreferences(ctx context.Context, orgID string) (map[string]string, error)
+}
+
+type MFAService interface {
+	IsEnrolled(ctx context.Context, userID string) (bool, error)
+}
+
+type MembershipService interface {
+	ListOrgsByPrincipal(ctx context.Context, principal Principal) ([]string, error)
+}
+
+type RateLimiter interface {
+	Take(ctx context.Context, keys ...ratelimit.Key) error
+}
+
 type Service struct {
 	log                  *slog.Logger
 	cron                 *cron.Cron
@@ -113,6 +147,11 @@ type Service struct {
 	serviceUserService   ServiceUserService
 	userPATService       UserPATService
 	orgService           OrgService
+	domainService        DomainService
+	preferenceService    PreferenceService
+	mfaService           MFAService
+	membershipService    MembershipService
+	rateLimiter          RateLimiter
 	webAuth              *webauthn.WebAuthn
 }
 
@@ -146,18 +185,44 @@ func (s *Service) SetOrgService(orgService OrgService) {
 	s.orgService = orgService
 }
 
+func (s *Service) SetDomainService(domainService DomainService) {
+	s.domainService = domainService
+}
+
+func (s *Service) SetPreferenceService(preferenceService PreferenceService) {
+	s.preferenceService = preferenceService
+}
+
+func (s *Service) SetMFAService(mfaService MFAService) {
+	s.mfaService = mfaService
+}
+
+func (s *Service) SetMembershipService(membershipService MembershipService) {
+	s.membershipService = membershipService
+}
+
+func (s *Service) SetRateLimiter(rateLimiter RateLimiter) {
+	s.rateLimiter = rateLimiter
+}
+
 func (s Service) SupportedStrategies() []string {
 	// add here strategies like mail link once implemented
 	var strategies []string
 	for name := range s.config.OIDCConfig {
 		strategies = append(strategies, name)
 	}
+	for name := range s.config.SAMLConfig {
+		strategies = append(strategies, name)
+	}
 	if s.mailDialer != nil {
 		strategies = append(strategies, MailOTPAuthMethod.String(), MailLinkAuthMethod.String())
 	}
 	if s.webAuth != nil {
 		strategies = append(strategies, PassKeyAuthMethod.String())
 	}
+	if s.domainService != nil && s.preferenceService != nil {
+		strategies = append(strategies, SSOAuthMethod.String())
+	}
 	return strategies
 }
 
@@ -215,10 +280,31 @@ func (s Service) StartFlow(ctx context.Context, request RegistrationStartRequest
 		},
 	}
 
+	// a passkey sign in names the user by its id, the sso is looked up with
+	// the email of the user
+	email := request.Email
+	var loggedInUser user.User
+	var lookupErr error
+	if request.Method == PassKeyAuthMethod.String() {
+		loggedInUser, lookupErr = s.userService.GetByID(ctx, request.Email)
+		if lookupErr == nil {
+			email = loggedInUser.Email
+		}
+	}
+
+	// users of a domain verified by an organization enforcing its sso can't
+	// sign in with the other strategies
+	sso, err := s.findOrgSSO(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if sso != nil && sso.Enforced && s.methodOrgID(request.Method, sso) != sso.OrgID {
+		return nil, ErrSSORequired
+	}
+
 	if request.Method == PassKeyAuthMethod.String() {
 		needRegistration := false
-		loggedInUser, err := s.userService.GetByID(ctx, request.Email)
-		if err != nil {
+		if lookupErr != nil {
 			needRegistration = true
 		} else {
 			storedPasskey, passKeyExists := loggedInUser.Metadata["passkey_credentials"]
@@ -245,6 +331,12 @@ func (s Service) StartFlow(ctx context.Context, request RegistrationStartRequest
 		}
 	}
 
+	if request.Method == MailOTPAuthMethod.String() || request.Method == MailLinkAuthMethod.String() {
+		if err := s.takeRateLimit(ctx, request.Email, request.IPAddress); err != nil {
+			return nil, err
+		}
+	}
+
 	if request.Method == MailOTPAuthMethod.String() {
 		mailLinkStrat := strategy.NewMailOTP(s.mailDialer, s.config.MailOTP.Subject, s.config.MailOTP.Body)
 		nonce, err := mailLinkStrat.SendMail(request.Email, s.config.TestUsers)
@@ -300,54 +392,84 @@ func (s Service) StartFlow(ctx context.Context, request RegistrationStartRequest
 
 	// check for oidc flow
 	if oidcConfig, ok := s.config.OIDCConfig[request.Method]; ok {
-		idp, err := strategy.NewRelyingPartyOIDC(
-			oidcConfig.ClientID,
-			oidcConfig.ClientSecret,
-			request.CallbackUrl).
-			Init(ctx, oidcConfig.IssuerUrl)
-		if err != nil {
-			return nil, err
-		}
+		return s.startOIDCFlow(ctx, oidcConfig, flow, request.CallbackUrl)
+	}
 
-		oidcState, err := strategy.EmbedFlowInOIDCState(flow.ID.String())
-		if err != nil {
-			return nil, err
-		}
-		endpoint, nonce, err := idp.AuthURL(oidcState)
-		if err != nil {
-			return nil, err
+	// check for the oidc flow of the organization which verified the email domain
+	if request.Method == SSOAuthMethod.String() {
+		if sso == nil {
+			return nil, ErrSSONotConfigured
 		}
+		flow.Email = strings.ToLower(request.Email)
+		flow.Metadata[ssoOrgIDKey] = sso.OrgID
+		return s.startOIDCFlow(ctx, sso.Config, flow, request.CallbackUrl)
+	}
 
-		flow.StartURL = endpoint
-		flow.Nonce = nonce
-		if oidcConfig.Validity != 0 {
-			flow.ExpiresAt = flow.CreatedAt.Add(oidcConfig.Validity)
-		}
-		if err = s.flowRepo.Set(ctx, flow); err != nil {
-			return nil, err
-		}
-		return &RegistrationStartResponse{
-			Flow: flow,
-		}, nil
+	// check for saml flow
+	if samlConfig, ok := s.config.SAMLConfig[request.Method]; ok {
+		return s.startSAMLFlow(ctx, samlConfig, flow)
 	}
 
 	return nil, ErrUnsupportedMethod
 }
 
+func (s Service) startOIDCFlow(ctx context.Context, oidcConfig OIDCConfig, flow *Flow, callbackURL string) (*RegistrationStartResponse, error) {
+	idp, err := strategy.NewRelyingPartyOIDC(
+		oidcConfig.ClientID,
+		oidcConfig.ClientSecret,
+		callbackURL).
+		Init(ctx, oidcConfig.IssuerUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	oidcState, err := strategy.EmbedFlowInOIDCState(flow.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	endpoint, nonce, err := idp.AuthURL(oidcState)
+	if err != nil {
+		return nil, err
+	}
+
+	flow.StartURL = endpoint
+	flow.Nonce = nonce
+	if oidcConfig.Validity != 0 {
+		flow.ExpiresAt = flow.CreatedAt.Add(oidcConfig.Validity)
+	}
+	if err = s.flowRepo.Set(ctx, flow); err != nil {
+		return nil, err
+	}
+	return &RegistrationStartResponse{
+		Flow: flow,
+	}, nil
+}
+
 func (s Service) FinishFlow(ctx context.Context, request RegistrationFinishRequest) (*RegistrationFinishResponse, error) {
+	response, err := s.finishFlow(ctx, request)
+	if err != nil || response == nil {
+		return response, err
+	}
+	if response.MFARequired, err = s.mfaRequired(ctx, response.User.ID); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s Service) finishFlow(ctx context.Context, request RegistrationFinishRequest) (*RegistrationFinishResponse, error) {
 	if request.Method == MailOTPAuthMethod.String() || request.Method == MailLinkAuthMethod.String() {
 		response, err := s.applyMailOTP(ctx, request)
 		if err != nil && !errors.Is(err, ErrStrategyNotApplicable) {
 			return nil, err
 		}
-		return response, nil
+		return s.verifyOpenStrategyUser(ctx, response)
 	}
 	if request.Method == PassKeyAuthMethod.String() {
 		response, err := s.applyPasskey(ctx, request)
 		if err != nil && !errors.Is(err, ErrStrategyNotApplicable) {
 			return nil, err
 		}
-		return response, nil
+		return s.verifyOpenStrategyUser(ctx, response)
 	}
 
 	// check for oidc method config
@@ -360,6 +482,17 @@ func (s Service) FinishFlow(ctx context.Context, request RegistrationFinishReque
 			return nil, err
 		}
 	}
+
+	// check for saml method config
+	{
+		response, err := s.applySAML(ctx, request)
+		if err == nil {
+			return response, nil
+		}
+		if !errors.Is(err, ErrStrategyNotApplicable) {
+			return nil, err
+		}
+	}
 	return nil, ErrUnsupportedMethod
 }
 
@@ -395,6 +528,10 @@ func (s Service) applyMailOTP(ctx context.Context, request RegistrationFinishReq
 	if !flow.IsValid(s.Now()) {
 		return nil, ErrFlowInvalid
 	}
+	// codes tried across the flows of the email count towards its limit
+	if err := s.takeRateLimit(ctx, flow.Email, request.IPAddress); err != nil {
+		return nil, err
+	}
 
 	if bcrypt.CompareHashAndPassword([]byte(flow.Nonce), []byte(request.Code)) != nil {
 		// avoid brute forcing otp
@@ -688,6 +825,17 @@ func (s Service) applyOIDC(ctx context.Context, request RegistrationFinishReques
 
 	// can't find oidc config
 	oidcConfig, ok := s.config.OIDCConfig[flow.Method]
+	ssoOrgID, _ := flow.Metadata[ssoOrgIDKey].(string)
+	if flow.Method == SSOAuthMethod.String() && ssoOrgID != "" {
+		sso, err := s.loadOrgSSO(ctx, ssoOrgID)
+		if err != nil {
+			return nil, err
+		}
+		if sso == nil {
+			return nil, ErrSSONotConfigured
+		}
+		oidcConfig, ok = sso.Config, true
+	}
 	if !ok {
 		return nil, ErrStrategyNotApplicable
 	}
@@ -721,12 +869,18 @@ func (s Service) applyOIDC(ctx context.Context, request RegistrationFinishReques
 	if err != nil {
 		return nil, err
 	}
+	if err = s.verifyIDPUser(ctx, oauthProfile.Email, ssoOrgID); err != nil {
+		return nil, err
+	}
 
 	// register a new user
 	newUser, err := s.getOrCreateUser(ctx, oauthProfile.Email, oauthProfile.Name)
 	if err != nil {
 		return nil, err
 	}
+	if err = s.joinOrg(ctx, ssoOrgID, newUser.ID); err != nil {
+		return nil, err
+	}
 
 	return &RegistrationFinishResponse{
 		User: newUser,
@@ -759,6 +913,29 @@ func (s Service) BuildToken(ctx context.Context, principal Principal, metadata m
 	return s.internalTokenService.Build(principal.ID, metadata)
 }
 
+// RevokeToken invalidates the frontier access token of the request before
+// its expiry, requests without one are left as is
+func (s Service) RevokeToken(ctx context.Context) error {
+	userToken, ok := GetTokenFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	// personal access tokens and tokens of other issuers are not revoked here
+	insecureJWT, err := jwt.ParseInsecure([]byte(userToken))
+	if err != nil {
+		return nil
+	}
+	if genClaim, ok := insecureJWT.Get(token.GeneratedClaimKey); !ok || genClaim != token.GeneratedClaimValue {
+		return nil
+	}
+	err = s.internalTokenService.Revoke(ctx, []byte(userToken))
+	if errors.Is(err, token.ErrInvalidToken) || errors.Is(err, token.ErrMissingRSADisableToken) {
+		// nothing valid to revoke
+		return nil
+	}
+	return err
+}
+
 // JWKs returns the public keys to verify the access token
 func (s Service) JWKs(ctx context.Context) jwk.Set {
 	return s.internalTokenService.GetPublicKeySet()
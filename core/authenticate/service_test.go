@@ -49,6 +49,7 @@ func createMocks(t *testing.T) (*mocks.FlowRepository, *mocks.UserService, *mock
 
 func TestService_GetPrincipal(t *testing.T) {
 	userID := uuid.New()
+	sessionID := uuid.New()
 	testKey, err := utils.CreateJWKWithKID("test-id")
 	require.NoError(t, err)
 	tokenBytes, err := utils.BuildToken(testKey, "test", userID.String(), time.Hour, map[string]string{
@@ -93,9 +94,10 @@ func TestService_GetPrincipal(t *testing.T) {
 				assertions: []authenticate.ClientAssertion{authenticate.SessionClientAssertion},
 			},
 			want: authenticate.Principal{
-				ID:      userID.String(),
-				Type:    schema.UserPrincipal,
-				AuthVia: authenticate.SessionClientAssertion,
+				ID:        userID.String(),
+				Type:      schema.UserPrincipal,
+				AuthVia:   authenticate.SessionClientAssertion,
+				SessionID: sessionID.String(),
 				User: &user.User{
 					ID: userID.String(),
 				},
@@ -105,7 +107,7 @@ func TestService_GetPrincipal(t *testing.T) {
 				mockFlow, mockUserService, mockTokenService, mockSessionService, mockServiceUserService := createMocks(t)
 
 				mockSess := &frontiersession.Session{
-					ID:              uuid.New(),
+					ID:              sessionID,
 					UserID:          userID.String(),
 					AuthenticatedAt: time.Now().Add(-time.Hour),
 					ExpiresAt:       time.Now().Add(time.Hour),
@@ -113,6 +115,7 @@ func TestService_GetPrincipal(t *testing.T) {
 					Metadata:        frontiersession.SessionMetadata{},
 				}
 				mockSessionService.EXPECT().ExtractFromContext(mock.Anything).Return(mockSess, nil)
+				mockSessionService.EXPECT().Track(mock.Anything, mockSess).Return(nil)
 
 				mockUserService.EXPECT().GetByID(mock.Anything, mockSess.UserID).Return(user.User{
 					ID: mockSess.UserID,
@@ -122,6 +125,65 @@ func TestService_GetPrincipal(t *testing.T) {
 					mockFlow, nil, mockTokenService, mockSessionService, mockUserService, mockServiceUserService, nil, nil)
 			},
 		},
+		{
+			name: "reject principal from user session waiting for its second factor",
+			args: args{
+				ctx:        context.Background(),
+				assertions: []authenticate.ClientAssertion{authenticate.SessionClientAssertion},
+			},
+			wantErr: true,
+			setup: func() *authenticate.Service {
+				mockFlow, mockUserService, mockTokenService, mockSessionService, mockServiceUserService := createMocks(t)
+
+				mockSessionService.EXPECT().ExtractFromContext(mock.Anything).Return(&frontiersession.Session{
+					ID:              sessionID,
+					UserID:          userID.String(),
+					AuthenticatedAt: time.Now().Add(-time.Hour),
+					ExpiresAt:       time.Now().Add(time.Hour),
+					AssuranceLevel:  frontiersession.AssuranceLevelSingleFactor,
+					MFARequired:     true,
+				}, nil)
+
+				return authenticate.NewService(nil, authenticate.Config{},
+					mockFlow, nil, mockTokenService, mockSessionService, mockUserService, mockServiceUserService, nil, nil)
+			},
+		},
+		{
+			name: "fetch principal from user session waiting for its second factor when allowed",
+			args: args{
+				ctx:        context.Background(),
+				assertions: []authenticate.ClientAssertion{authenticate.MFAPendingSessionClientAssertion},
+			},
+			want: authenticate.Principal{
+				ID:        userID.String(),
+				Type:      schema.UserPrincipal,
+				AuthVia:   authenticate.MFAPendingSessionClientAssertion,
+				SessionID: sessionID.String(),
+				User: &user.User{
+					ID: userID.String(),
+				},
+			},
+			setup: func() *authenticate.Service {
+				mockFlow, mockUserService, mockTokenService, mockSessionService, mockServiceUserService := createMocks(t)
+
+				mockSess := &frontiersession.Session{
+					ID:              sessionID,
+					UserID:          userID.String(),
+					AuthenticatedAt: time.Now().Add(-time.Hour),
+					ExpiresAt:       time.Now().Add(time.Hour),
+					AssuranceLevel:  frontiersession.AssuranceLevelSingleFactor,
+					MFARequired:     true,
+				}
+				mockSessionService.EXPECT().ExtractFromContext(mock.Anything).Return(mockSess, nil)
+				mockSessionService.EXPECT().Track(mock.Anything, mockSess).Return(nil)
+				mockUserService.EXPECT().GetByID(mock.Anything, mockSess.UserID).Return(user.User{
+					ID: mockSess.UserID,
+				}, nil)
+
+				return authenticate.NewService(nil, authenticate.Config{},
+					mockFlow, nil, mockTokenService, mockSessionService, mockUserService, mockServiceUserService, nil, nil)
+			},
+		},
 		{
 			name: "reject principal from expired user session",
 			args: args{
@@ -146,6 +208,30 @@ func TestService_GetPrincipal(t *testing.T) {
 					mockFlow, nil, mockTokenService, mockSessionService, mockUserService, mockServiceUserService, nil, nil)
 			},
 		},
+		{
+			name: "reject principal from user session used from another client",
+			args: args{
+				ctx:        context.Background(),
+				assertions: []authenticate.ClientAssertion{authenticate.SessionClientAssertion},
+			},
+			wantErr: true,
+			setup: func() *authenticate.Service {
+				mockFlow, mockUserService, mockTokenService, mockSessionService, mockServiceUserService := createMocks(t)
+
+				mockSess := &frontiersession.Session{
+					ID:              uuid.New(),
+					UserID:          userID.String(),
+					AuthenticatedAt: time.Now().Add(-time.Hour),
+					ExpiresAt:       time.Now().Add(time.Hour),
+					Policy:          frontiersession.Policy{Binding: frontiersession.BindingIP, EnforceBinding: true},
+				}
+				mockSessionService.EXPECT().ExtractFromContext(mock.Anything).Return(mockSess, nil)
+				mockSessionService.EXPECT().Track(mock.Anything, mockSess).Return(frontiersession.ErrClientMismatch)
+
+				return authenticate.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), authenticate.Config{},
+					mockFlow, nil, mockTokenService, mockSessionService, mockUserService, mockServiceUserService, nil, nil)
+			},
+		},
 		{
 			name: "fetch principal from access token",
 			args: args{
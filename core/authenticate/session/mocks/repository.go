@@ -284,6 +284,151 @@ func (_c *Repository_Set_Call) RunAndReturn(run func(context.Context, *session.S
 	return _c
 }
 
+// UpdateAssuranceLevel provides a mock function with given fields: ctx, id, level, authenticatedAt
+func (_m *Repository) UpdateAssuranceLevel(ctx context.Context, id uuid.UUID, level session.AssuranceLevel, authenticatedAt time.Time) error {
+	ret := _m.Called(ctx, id, level, authenticatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAssuranceLevel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, session.AssuranceLevel, time.Time) error); ok {
+		r0 = rf(ctx, id, level, authenticatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_UpdateAssuranceLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAssuranceLevel'
+type Repository_UpdateAssuranceLevel_Call struct {
+	*mock.Call
+}
+
+// UpdateAssuranceLevel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - level session.AssuranceLevel
+//   - authenticatedAt time.Time
+func (_e *Repository_Expecter) UpdateAssuranceLevel(ctx interface{}, id interface{}, level interface{}, authenticatedAt interface{}) *Repository_UpdateAssuranceLevel_Call {
+	return &Repository_UpdateAssuranceLevel_Call{Call: _e.mock.On("UpdateAssuranceLevel", ctx, id, level, authenticatedAt)}
+}
+
+func (_c *Repository_UpdateAssuranceLevel_Call) Run(run func(ctx context.Context, id uuid.UUID, level session.AssuranceLevel, authenticatedAt time.Time)) *Repository_UpdateAssuranceLevel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(session.AssuranceLevel), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateAssuranceLevel_Call) Return(_a0 error) *Repository_UpdateAssuranceLevel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_UpdateAssuranceLevel_Call) RunAndReturn(run func(context.Context, uuid.UUID, session.AssuranceLevel, time.Time) error) *Repository_UpdateAssuranceLevel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAuthenticatedAt provides a mock function with given fields: ctx, id, authenticatedAt
+func (_m *Repository) UpdateAuthenticatedAt(ctx context.Context, id uuid.UUID, authenticatedAt time.Time) error {
+	ret := _m.Called(ctx, id, authenticatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAuthenticatedAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, authenticatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_UpdateAuthenticatedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAuthenticatedAt'
+type Repository_UpdateAuthenticatedAt_Call struct {
+	*mock.Call
+}
+
+// UpdateAuthenticatedAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - authenticatedAt time.Time
+func (_e *Repository_Expecter) UpdateAuthenticatedAt(ctx interface{}, id interface{}, authenticatedAt interface{}) *Repository_UpdateAuthenticatedAt_Call {
+	return &Repository_UpdateAuthenticatedAt_Call{Call: _e.mock.On("UpdateAuthenticatedAt", ctx, id, authenticatedAt)}
+}
+
+func (_c *Repository_UpdateAuthenticatedAt_Call) Run(run func(ctx context.Context, id uuid.UUID, authenticatedAt time.Time)) *Repository_UpdateAuthenticatedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateAuthenticatedAt_Call) Return(_a0 error) *Repository_UpdateAuthenticatedAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_UpdateAuthenticatedAt_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *Repository_UpdateAuthenticatedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateLastActiveAt provides a mock function with given fields: ctx, id, lastActiveAt
+func (_m *Repository) UpdateLastActiveAt(ctx context.Context, id uuid.UUID, lastActiveAt time.Time) error {
+	ret := _m.Called(ctx, id, lastActiveAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastActiveAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, lastActiveAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_UpdateLastActiveAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastActiveAt'
+type Repository_UpdateLastActiveAt_Call struct {
+	*mock.Call
+}
+
+// UpdateLastActiveAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - lastActiveAt time.Time
+func (_e *Repository_Expecter) UpdateLastActiveAt(ctx interface{}, id interface{}, lastActiveAt interface{}) *Repository_UpdateLastActiveAt_Call {
+	return &Repository_UpdateLastActiveAt_Call{Call: _e.mock.On("UpdateLastActiveAt", ctx, id, lastActiveAt)}
+}
+
+func (_c *Repository_UpdateLastActiveAt_Call) Run(run func(ctx context.Context, id uuid.UUID, lastActiveAt time.Time)) *Repository_UpdateLastActiveAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateLastActiveAt_Call) Return(_a0 error) *Repository_UpdateLastActiveAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_UpdateLastActiveAt_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *Repository_UpdateLastActiveAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSessionMetadata provides a mock function with given fields: ctx, id, metadata, updatedAt
 func (_m *Repository) UpdateSessionMetadata(ctx context.Context, id uuid.UUID, metadata session.SessionMetadata, updatedAt time.Time) error {
 	ret := _m.Called(ctx, id, metadata, updatedAt)
@@ -0,0 +1,84 @@
+package session
+
+import (
+	"context"
+	"time"
+)
+
+// Binding ties a session to the client it was created from
+type Binding string
+
+const (
+	// BindingNone lets a session move between clients
+	BindingNone Binding = "none"
+	// BindingDevice ties a session to the os and browser of the client
+	BindingDevice Binding = "device"
+	// BindingIP ties a session to the ip, os and browser of the client
+	BindingIP Binding = "ip"
+)
+
+// rank orders the bindings from the loosest to the strictest
+func (b Binding) rank() int {
+	switch b {
+	case BindingIP:
+		return 2
+	case BindingDevice:
+		return 1
+	}
+	return 0
+}
+
+// Policy restricts how long and from where the sessions of a user are used
+type Policy struct {
+	// IdleTimeout ends a session without activity for the duration, 0
+	// keeps it till it expires
+	IdleTimeout time.Duration `yaml:"idle_timeout" mapstructure:"idle_timeout" json:"idle_timeout" default:"0s"`
+	// MaxSessions caps the active sessions of a user, a login over the cap
+	// revokes the oldest sessions, 0 doesn't cap them
+	MaxSessions int `yaml:"max_sessions" mapstructure:"max_sessions" json:"max_sessions" default:"0"`
+	// Binding can be "none", "device" or "ip"
+	Binding Binding `yaml:"binding" mapstructure:"binding" json:"binding" default:"none"`
+	// EnforceBinding revokes a session used from another client, otherwise
+	// the anomaly is only recorded in the audit log
+	EnforceBinding bool `yaml:"enforce_binding" mapstructure:"enforce_binding" json:"enforce_binding" default:"false"`
+}
+
+// Stricter merges the policies keeping the strictest value of each
+func (p Policy) Stricter(other Policy) Policy {
+	if other.IdleTimeout > 0 && (p.IdleTimeout == 0 || other.IdleTimeout < p.IdleTimeout) {
+		p.IdleTimeout = other.IdleTimeout
+	}
+	if other.MaxSessions > 0 && (p.MaxSessions == 0 || other.MaxSessions < p.MaxSessions) {
+		p.MaxSessions = other.MaxSessions
+	}
+	if other.Binding.rank() > p.Binding.rank() {
+		p.Binding = other.Binding
+	}
+	p.EnforceBinding = p.EnforceBinding || other.EnforceBinding
+	return p
+}
+
+// anomalies lists the attributes of the client the session is bound to
+// that differ in the metadata of a request, attributes missing in the
+// request aren't compared
+func (p Policy) anomalies(bound, current SessionMetadata) []string {
+	var changed []string
+	if p.Binding.rank() >= BindingIP.rank() && current.IpAddress != "" && current.IpAddress != bound.IpAddress {
+		changed = append(changed, "ip_address")
+	}
+	if p.Binding.rank() >= BindingDevice.rank() {
+		if current.OperatingSystem != "" && current.OperatingSystem != bound.OperatingSystem {
+			changed = append(changed, "operating_system")
+		}
+		if current.Browser != "" && current.Browser != bound.Browser {
+			changed = append(changed, "browser")
+		}
+	}
+	return changed
+}
+
+// PolicyProvider resolves the policy the organizations of a user demand
+// for the sessions of the user
+type PolicyProvider interface {
+	SessionPolicy(ctx context.Context, userID string) (Policy, error)
+}
@@ -3,9 +3,15 @@ package session
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/raystack/frontier/core/auditrecord/models"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	pkgauditrecord "github.com/raystack/frontier/pkg/auditrecord"
 	"github.com/raystack/frontier/pkg/server/consts"
 
 	"github.com/google/uuid"
@@ -16,7 +22,12 @@ import (
 var (
 	ErrNoSession       = errors.New("no session")
 	ErrDeletingSession = errors.New("error deleting session")
+	ErrClientMismatch  = errors.New("session is bound to another client")
 	refreshTime        = "0 0 * * *" // Once a day at midnight (UTC)
+
+	// activityPrecision limits how often the activity of a session is
+	// written to the database
+	activityPrecision = time.Minute
 )
 
 type Repository interface {
@@ -27,30 +38,83 @@ type Repository interface {
 	UpdateValidity(ctx context.Context, id uuid.UUID, validity time.Duration) error
 	List(ctx context.Context, userID string) ([]*Session, error)
 	UpdateSessionMetadata(ctx context.Context, id uuid.UUID, metadata SessionMetadata, updatedAt time.Time) error
+	UpdateAssuranceLevel(ctx context.Context, id uuid.UUID, level AssuranceLevel, authenticatedAt time.Time) error
+	UpdateAuthenticatedAt(ctx context.Context, id uuid.UUID, authenticatedAt time.Time) error
+	UpdateLastActiveAt(ctx context.Context, id uuid.UUID, lastActiveAt time.Time) error
+}
+
+// LoginNotifier is told about the sessions created on login
+type LoginNotifier interface {
+	NotifyLogin(ctx context.Context, session *Session) error
+}
+
+type AuditRecordRepository interface {
+	Create(ctx context.Context, auditRecord models.AuditRecord) (models.AuditRecord, error)
+}
+
+// TokenRevoker revokes the access tokens issued for sessions and users
+type TokenRevoker interface {
+	RevokeSession(ctx context.Context, sessionID string) error
+	RevokeSubject(ctx context.Context, subjectID string) error
 }
 
 type Service struct {
-	repo     Repository
-	validity time.Duration
-	log      *slog.Logger
-	cron     *cron.Cron
-	Now      func() time.Time
+	repo            Repository
+	validity        time.Duration
+	policy          Policy
+	log             *slog.Logger
+	cron            *cron.Cron
+	revoker         TokenRevoker
+	policyProvider  PolicyProvider
+	loginNotifier   LoginNotifier
+	auditRecordRepo AuditRecordRepository
+	Now             func() time.Time
 }
 
-func NewService(logger *slog.Logger, repo Repository, validity time.Duration) *Service {
+func NewService(logger *slog.Logger, repo Repository, validity time.Duration, policy Policy) *Service {
 	return &Service{
 		log:      logger,
 		repo:     repo,
 		cron:     cron.New(),
 		validity: validity,
+		policy:   policy,
 		Now: func() time.Time {
 			return time.Now().UTC()
 		},
 	}
 }
 
-func (s Service) Create(ctx context.Context, userID string, metadata SessionMetadata) (*Session, error) {
+// SetTokenRevoker makes deleting sessions revoke the access tokens issued
+// for them
+func (s *Service) SetTokenRevoker(revoker TokenRevoker) {
+	s.revoker = revoker
+}
+
+// SetPolicyProvider lets the organizations of a user demand a stricter
+// policy for the sessions of the user
+func (s *Service) SetPolicyProvider(provider PolicyProvider) {
+	s.policyProvider = provider
+}
+
+// SetLoginNotifier tells the notifier about every session created on login
+func (s *Service) SetLoginNotifier(notifier LoginNotifier) {
+	s.loginNotifier = notifier
+}
+
+// SetAuditRecordRepository records the sessions used from another client
+// than the one they are bound to
+func (s *Service) SetAuditRecordRepository(repo AuditRecordRepository) {
+	s.auditRecordRepo = repo
+}
+
+// Create starts a session of the user, a session requiring mfa doesn't
+// authenticate requests till the second factor is verified
+func (s Service) Create(ctx context.Context, userID string, metadata SessionMetadata, mfaRequired bool) (*Session, error) {
 	now := s.Now()
+	policy, err := s.resolvePolicy(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 
 	sess := &Session{
 		ID:              uuid.New(),
@@ -61,27 +125,91 @@ func (s Service) Create(ctx context.Context, userID string, metadata SessionMeta
 		UpdatedAt:       now,
 		DeletedAt:       nil,
 		Metadata:        metadata,
+		AssuranceLevel:  AssuranceLevelSingleFactor,
+		MFARequired:     mfaRequired,
+		LastActiveAt:    now,
+		Policy:          policy,
 	}
-	err := s.repo.Set(ctx, sess)
+	err = s.repo.Set(ctx, sess)
 	if err != nil {
 		s.log.WarnContext(ctx, "failed to create session", "err", err)
 		return nil, err
 	}
+	if err := s.evictSessions(ctx, sess); err != nil {
+		s.log.WarnContext(ctx, "failed to revoke sessions over the cap", "user_id", userID, "err", err)
+	}
+	if s.loginNotifier != nil {
+		if err := s.loginNotifier.NotifyLogin(ctx, sess); err != nil {
+			s.log.WarnContext(ctx, "failed to notify the login", "user_id", userID, "err", err)
+		}
+	}
 	return sess, nil
 }
 
+// resolvePolicy merges the configured policy with the stricter ones the
+// organizations of the user demand
+func (s Service) resolvePolicy(ctx context.Context, userID string) (Policy, error) {
+	if s.policyProvider == nil {
+		return s.policy, nil
+	}
+	orgPolicy, err := s.policyProvider.SessionPolicy(ctx, userID)
+	if err != nil {
+		return Policy{}, err
+	}
+	return s.policy.Stricter(orgPolicy), nil
+}
+
+// evictSessions revokes the oldest sessions of the user over the cap of the
+// policy of the new session
+func (s Service) evictSessions(ctx context.Context, sess *Session) error {
+	if sess.Policy.MaxSessions <= 0 {
+		return nil
+	}
+	sessions, err := s.List(ctx, sess.UserID)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.After(sessions[j].CreatedAt)
+	})
+	// the new session is always kept
+	kept := 1
+	for _, other := range sessions {
+		if other.ID == sess.ID {
+			continue
+		}
+		if kept < sess.Policy.MaxSessions {
+			kept++
+			continue
+		}
+		if err := s.Delete(ctx, other.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Refresh extends validity of session
 func (s Service) Refresh(ctx context.Context, sessionID uuid.UUID) error {
 	return s.repo.UpdateValidity(ctx, sessionID, s.validity)
 }
 
 // Delete marks a session as deleted without removing it from the database
+// and revokes the access tokens issued for it
 func (s Service) Delete(ctx context.Context, sessionID uuid.UUID) error {
-	return s.repo.Delete(ctx, sessionID)
+	if err := s.repo.Delete(ctx, sessionID); err != nil {
+		return err
+	}
+	if s.revoker != nil {
+		return s.revoker.RevokeSession(ctx, sessionID.String())
+	}
+	return nil
 }
 
 // DeleteByUserID soft-deletes all active sessions belonging to a user.
-// Iterates over the user's active sessions and revokes each via Delete.
+// Iterates over the user's active sessions and revokes each via Delete,
+// then revokes every access token of the user, including the ones not
+// issued for a session.
 func (s Service) DeleteByUserID(ctx context.Context, userID string) error {
 	sessions, err := s.repo.List(ctx, userID)
 	if err != nil {
@@ -92,6 +220,9 @@ func (s Service) DeleteByUserID(ctx context.Context, userID string) error {
 			return err
 		}
 	}
+	if s.revoker != nil {
+		return s.revoker.RevokeSubject(ctx, userID)
+	}
 	return nil
 }
 
@@ -158,6 +289,78 @@ func (s Service) Ping(ctx context.Context, sessionID uuid.UUID, metadata Session
 	return s.repo.UpdateSessionMetadata(ctx, sessionID, metadata, s.Now())
 }
 
+// Track records the activity of a session authenticating a request and
+// checks the request comes from the client the session is bound to. A
+// session used from another client is revoked if its policy enforces the
+// binding, otherwise the anomaly is recorded and the session follows the
+// client.
+func (s Service) Track(ctx context.Context, sess *Session) error {
+	now := s.Now()
+	if metadata, ok := GetSessionMetadataFromContext(ctx); ok {
+		if changed := sess.Policy.anomalies(sess.Metadata, metadata); len(changed) > 0 {
+			s.log.WarnContext(ctx, "session used from another client", "session_id", sess.ID, "user_id", sess.UserID, "changed", changed)
+			if err := s.createAnomalyAuditRecord(ctx, sess, metadata, changed); err != nil {
+				s.log.ErrorContext(ctx, "failed to create audit record for session anomaly", "session_id", sess.ID, "err", err)
+			}
+			if sess.Policy.EnforceBinding {
+				if err := s.Delete(ctx, sess.ID); err != nil {
+					return err
+				}
+				return ErrClientMismatch
+			}
+			if err := s.repo.UpdateSessionMetadata(ctx, sess.ID, metadata, now); err != nil {
+				return err
+			}
+		}
+	}
+
+	if sess.Policy.IdleTimeout > 0 && now.Sub(sess.LastActiveAt) >= activityPrecision {
+		return s.repo.UpdateLastActiveAt(ctx, sess.ID, now)
+	}
+	return nil
+}
+
+func (s Service) createAnomalyAuditRecord(ctx context.Context, sess *Session, metadata SessionMetadata, changed []string) error {
+	if s.auditRecordRepo == nil {
+		return nil
+	}
+	if _, err := s.auditRecordRepo.Create(ctx, models.AuditRecord{
+		Event: pkgauditrecord.SessionAnomalyEvent,
+		Resource: models.Resource{
+			ID:   sess.UserID,
+			Type: pkgauditrecord.UserType,
+		},
+		Target: &models.Target{
+			ID:   sess.ID.String(),
+			Type: pkgauditrecord.SessionType,
+			Metadata: map[string]any{
+				"changed":          strings.Join(changed, ","),
+				"enforced":         sess.Policy.EnforceBinding,
+				"ip_address":       metadata.IpAddress,
+				"operating_system": metadata.OperatingSystem,
+				"browser":          metadata.Browser,
+			},
+		},
+		OrgID:      schema.PlatformOrgID.String(),
+		OccurredAt: s.Now(),
+	}); err != nil {
+		return fmt.Errorf("creating audit record: %w", err)
+	}
+	return nil
+}
+
+// SetAssuranceLevel records the assurance level the user proved in the
+// session, e.g. after verifying a second factor
+func (s Service) SetAssuranceLevel(ctx context.Context, sessionID uuid.UUID, level AssuranceLevel) error {
+	return s.repo.UpdateAssuranceLevel(ctx, sessionID, level, s.Now())
+}
+
+// Reauthenticate records the user proved the login strategy again in the
+// session
+func (s Service) Reauthenticate(ctx context.Context, sessionID uuid.UUID) error {
+	return s.repo.UpdateAuthenticatedAt(ctx, sessionID, s.Now())
+}
+
 // GetSession retrieves a session by its ID
 func (s Service) GetByID(ctx context.Context, sessionID uuid.UUID) (*Session, error) {
 	return s.repo.Get(ctx, sessionID)
@@ -21,7 +21,7 @@ import (
 func TestService_Create(t *testing.T) {
 	t.Run("should create a session when parameters are passed correctly", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		mockRepository.On("Set", mock.Anything, mock.AnythingOfType("*session.Session")).Run(func(args mock.Arguments) {
 			arg := args.Get(1)
@@ -31,15 +31,32 @@ func TestService_Create(t *testing.T) {
 
 		userID := "1"
 		metadata := session.SessionMetadata{}
-		sess, err := svc.Create(context.Background(), userID, metadata)
+		sess, err := svc.Create(context.Background(), userID, metadata, false)
 
 		assert.Nil(t, err)
 		assert.Equal(t, sess.UserID, "1")
+		assert.Equal(t, session.AssuranceLevelSingleFactor, sess.AssuranceLevel)
+		assert.False(t, sess.MFAPending())
+	})
+
+	t.Run("should hold a session requiring mfa till the second factor is verified", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
+
+		mockRepository.On("Set", mock.Anything, mock.AnythingOfType("*session.Session")).Return(nil)
+
+		sess, err := svc.Create(context.Background(), "1", session.SessionMetadata{}, true)
+		assert.Nil(t, err)
+		assert.True(t, sess.MFARequired)
+		assert.True(t, sess.MFAPending())
+
+		sess.AssuranceLevel = session.AssuranceLevelMultiFactor
+		assert.False(t, sess.MFAPending())
 	})
 
 	t.Run("should return an error when session is not successfully set", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		mockRepository.On("Set", mock.Anything, mock.AnythingOfType("*session.Session")).Run(func(args mock.Arguments) {
 			arg := args.Get(1)
@@ -49,7 +66,7 @@ func TestService_Create(t *testing.T) {
 
 		userID := "1"
 		metadata := session.SessionMetadata{}
-		_, err := svc.Create(context.Background(), userID, metadata)
+		_, err := svc.Create(context.Background(), userID, metadata, false)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, err.Error(), "internal-error")
@@ -60,7 +77,7 @@ func TestService_Refresh(t *testing.T) {
 	t.Run("should refresh a session successfully", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
 		mockSessionID := uuid.New()
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		mockRepository.On("UpdateValidity", mock.Anything, mockSessionID, 24*time.Hour).Return(nil)
 
@@ -72,7 +89,7 @@ func TestService_Refresh(t *testing.T) {
 	t.Run("should return an error if refresh fails", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
 		mockSessionID := uuid.New()
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		mockRepository.On("UpdateValidity", mock.Anything, mockSessionID, 24*time.Hour).Return(errors.New("internal-error"))
 
@@ -87,7 +104,7 @@ func TestService_Delete(t *testing.T) {
 	t.Run("should delete a session successfully", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
 		mockSessionID := uuid.New()
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		mockRepository.On("Delete", mock.Anything, mockSessionID).Return(nil)
 
@@ -99,7 +116,7 @@ func TestService_Delete(t *testing.T) {
 	t.Run("should return an error if deletion fails", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
 		mockSessionID := uuid.New()
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		mockRepository.On("Delete", mock.Anything, mockSessionID).Return(errors.New("internal-error"))
 
@@ -115,7 +132,7 @@ func TestService_DeleteByUserID(t *testing.T) {
 
 	t.Run("revokes each active session for the user", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 		sess1 := &session.Session{ID: uuid.New(), UserID: userID}
 		sess2 := &session.Session{ID: uuid.New(), UserID: userID}
 
@@ -130,7 +147,7 @@ func TestService_DeleteByUserID(t *testing.T) {
 
 	t.Run("returns nil when user has no active sessions", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		mockRepository.On("List", mock.Anything, userID).Return([]*session.Session{}, nil)
 
@@ -141,7 +158,7 @@ func TestService_DeleteByUserID(t *testing.T) {
 
 	t.Run("propagates list errors", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		mockRepository.On("List", mock.Anything, userID).Return(nil, errors.New("db down"))
 
@@ -152,7 +169,7 @@ func TestService_DeleteByUserID(t *testing.T) {
 
 	t.Run("stops and returns error when an individual delete fails", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 		sess1 := &session.Session{ID: uuid.New(), UserID: userID}
 		sess2 := &session.Session{ID: uuid.New(), UserID: userID}
 
@@ -164,13 +181,45 @@ func TestService_DeleteByUserID(t *testing.T) {
 		assert.ErrorContains(t, err, "revoke failed")
 		mockRepository.AssertNotCalled(t, "Delete", mock.Anything, sess2.ID)
 	})
+
+	t.Run("revokes the access tokens of the sessions and the user", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
+		revoker := &tokenRevoker{}
+		svc.SetTokenRevoker(revoker)
+		sess1 := &session.Session{ID: uuid.New(), UserID: userID}
+
+		mockRepository.On("List", mock.Anything, userID).Return([]*session.Session{sess1}, nil)
+		mockRepository.On("Delete", mock.Anything, sess1.ID).Return(nil)
+
+		err := svc.DeleteByUserID(context.Background(), userID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{sess1.ID.String()}, revoker.sessions)
+		assert.Equal(t, []string{userID}, revoker.subjects)
+	})
+}
+
+type tokenRevoker struct {
+	sessions []string
+	subjects []string
+}
+
+func (r *tokenRevoker) RevokeSession(ctx context.Context, sessionID string) error {
+	r.sessions = append(r.sessions, sessionID)
+	return nil
+}
+
+func (r *tokenRevoker) RevokeSubject(ctx context.Context, subjectID string) error {
+	r.subjects = append(r.subjects, subjectID)
+	return nil
 }
 
 func TestService_ExtractFromContext(t *testing.T) {
 	t.Run("should be able to extract session from context if it is present", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
 		mockSessionID := uuid.New()
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		md := metadata.New(map[string]string{consts.SessionIDGatewayKey: mockSessionID.String(), "key2": "val2"})
 		ctx := metadata.NewIncomingContext(context.Background(), md)
@@ -186,7 +235,7 @@ func TestService_ExtractFromContext(t *testing.T) {
 
 	t.Run("should return an error if session is not present in context metadata", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		_, err := svc.ExtractFromContext(context.Background())
 		assert.NotNil(t, err)
@@ -197,7 +246,7 @@ func TestService_ExtractFromContext(t *testing.T) {
 func TestService_List(t *testing.T) {
 	t.Run("should return active sessions only", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		userID := "user-123"
 		now := time.Now().UTC()
@@ -251,7 +300,7 @@ func TestService_List(t *testing.T) {
 
 	t.Run("should return empty list when no active sessions", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		userID := "user-123"
 		now := time.Now().UTC()
@@ -280,7 +329,7 @@ func TestService_List(t *testing.T) {
 
 	t.Run("should return error when repository fails", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		userID := "user-123"
 		expectedError := errors.New("database error")
@@ -296,7 +345,7 @@ func TestService_List(t *testing.T) {
 
 	t.Run("should return empty list when no sessions exist", func(t *testing.T) {
 		mockRepository := mocks.NewRepository(t)
-		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
 
 		userID := "user-123"
 
@@ -308,3 +357,203 @@ func TestService_List(t *testing.T) {
 		assert.Len(t, activeSessions, 0)
 	})
 }
+
+func TestService_Reauthenticate(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	sessionID := uuid.New()
+
+	t.Run("should record the time the login strategy was proved again", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
+		svc.Now = func() time.Time { return now }
+
+		mockRepository.On("UpdateAuthenticatedAt", mock.Anything, sessionID, now).Return(nil)
+		assert.Nil(t, svc.Reauthenticate(context.Background(), sessionID))
+	})
+
+	t.Run("should record the time the second factor was verified", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
+		svc.Now = func() time.Time { return now }
+
+		mockRepository.On("UpdateAssuranceLevel", mock.Anything, sessionID, session.AssuranceLevelMultiFactor, now).Return(nil)
+		assert.Nil(t, svc.SetAssuranceLevel(context.Background(), sessionID, session.AssuranceLevelMultiFactor))
+	})
+}
+
+func TestSession_AuthenticatedWithin(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	mfaAuthenticatedAt := now.Add(-time.Minute)
+
+	t.Run("should check the login strategy of a single factor session", func(t *testing.T) {
+		sess := session.Session{
+			AuthenticatedAt: now.Add(-10 * time.Minute),
+			AssuranceLevel:  session.AssuranceLevelSingleFactor,
+		}
+		assert.True(t, sess.AuthenticatedWithin(now, 15*time.Minute))
+		assert.False(t, sess.AuthenticatedWithin(now, 5*time.Minute))
+	})
+
+	t.Run("should check the second factor of a multi factor session", func(t *testing.T) {
+		sess := session.Session{
+			AuthenticatedAt: now,
+			AssuranceLevel:  session.AssuranceLevelMultiFactor,
+		}
+		assert.False(t, sess.AuthenticatedWithin(now, 15*time.Minute))
+
+		sess.MFAAuthenticatedAt = &mfaAuthenticatedAt
+		assert.True(t, sess.AuthenticatedWithin(now, 15*time.Minute))
+		assert.False(t, sess.AuthenticatedWithin(now, 30*time.Second))
+	})
+}
+
+type policyProvider session.Policy
+
+func (p policyProvider) SessionPolicy(ctx context.Context, userID string) (session.Policy, error) {
+	return session.Policy(p), nil
+}
+
+func TestService_Create_Policy(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	userID := uuid.NewString()
+
+	t.Run("should apply the stricter policy of the organizations of the user", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{
+			IdleTimeout: time.Hour,
+			Binding:     session.BindingNone,
+		})
+		svc.Now = func() time.Time { return now }
+		svc.SetPolicyProvider(policyProvider{IdleTimeout: 30 * time.Minute, Binding: session.BindingDevice, EnforceBinding: true})
+
+		mockRepository.On("Set", mock.Anything, mock.AnythingOfType("*session.Session")).Return(nil)
+
+		sess, err := svc.Create(context.Background(), userID, session.SessionMetadata{}, false)
+		assert.Nil(t, err)
+		assert.Equal(t, session.Policy{IdleTimeout: 30 * time.Minute, Binding: session.BindingDevice, EnforceBinding: true}, sess.Policy)
+		assert.Equal(t, now, sess.LastActiveAt)
+	})
+
+	t.Run("should revoke the oldest sessions over the cap", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{MaxSessions: 2})
+		svc.Now = func() time.Time { return now }
+
+		var created *session.Session
+		mockRepository.On("Set", mock.Anything, mock.AnythingOfType("*session.Session")).Run(func(args mock.Arguments) {
+			created = args.Get(1).(*session.Session)
+		}).Return(nil)
+		older := &session.Session{ID: uuid.New(), UserID: userID, AuthenticatedAt: now, ExpiresAt: now.Add(time.Hour), CreatedAt: now.Add(-2 * time.Hour)}
+		newer := &session.Session{ID: uuid.New(), UserID: userID, AuthenticatedAt: now, ExpiresAt: now.Add(time.Hour), CreatedAt: now.Add(-time.Hour)}
+		mockRepository.On("List", mock.Anything, userID).Return(func(ctx context.Context, userID string) ([]*session.Session, error) {
+			return []*session.Session{created, newer, older}, nil
+		})
+		mockRepository.On("Delete", mock.Anything, older.ID).Return(nil)
+
+		_, err := svc.Create(context.Background(), userID, session.SessionMetadata{}, false)
+		assert.Nil(t, err)
+		mockRepository.AssertNotCalled(t, "Delete", mock.Anything, newer.ID)
+	})
+}
+
+type loginNotifier struct {
+	notified []*session.Session
+	err      error
+}
+
+func (n *loginNotifier) NotifyLogin(ctx context.Context, sess *session.Session) error {
+	n.notified = append(n.notified, sess)
+	return n.err
+}
+
+func TestService_Create_NotifyLogin(t *testing.T) {
+	t.Run("should notify the login without failing it", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
+		notifier := &loginNotifier{err: errors.New("smtp unavailable")}
+		svc.SetLoginNotifier(notifier)
+
+		mockRepository.On("Set", mock.Anything, mock.AnythingOfType("*session.Session")).Return(nil)
+
+		sess, err := svc.Create(context.Background(), uuid.NewString(), session.SessionMetadata{}, false)
+		assert.Nil(t, err)
+		assert.Equal(t, []*session.Session{sess}, notifier.notified)
+	})
+}
+
+func TestService_Track(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	bound := session.SessionMetadata{IpAddress: "10.0.0.1", OperatingSystem: "Mac OS X", Browser: "Chrome"}
+	moved := session.SetSessionMetadataInContext(context.Background(), session.SessionMetadata{
+		IpAddress: "10.0.0.2", OperatingSystem: "Mac OS X", Browser: "Chrome",
+	})
+
+	t.Run("should record the activity of a session with an idle timeout", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
+		svc.Now = func() time.Time { return now }
+		sess := &session.Session{ID: uuid.New(), LastActiveAt: now.Add(-5 * time.Minute), Policy: session.Policy{IdleTimeout: 30 * time.Minute}}
+
+		mockRepository.On("UpdateLastActiveAt", mock.Anything, sess.ID, now).Return(nil)
+		assert.Nil(t, svc.Track(context.Background(), sess))
+
+		sess.LastActiveAt = now.Add(-10 * time.Second)
+		assert.Nil(t, svc.Track(context.Background(), sess))
+		mockRepository.AssertNumberOfCalls(t, "UpdateLastActiveAt", 1)
+	})
+
+	t.Run("should let a session follow the client when the binding isn't enforced", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
+		svc.Now = func() time.Time { return now }
+		sess := &session.Session{ID: uuid.New(), Metadata: bound, Policy: session.Policy{Binding: session.BindingIP}}
+
+		mockRepository.On("UpdateSessionMetadata", mock.Anything, sess.ID, mock.Anything, now).Return(nil)
+		assert.Nil(t, svc.Track(moved, sess))
+	})
+
+	t.Run("should revoke a session used from another client when the binding is enforced", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
+		svc.Now = func() time.Time { return now }
+		sess := &session.Session{ID: uuid.New(), Metadata: bound, Policy: session.Policy{Binding: session.BindingIP, EnforceBinding: true}}
+
+		mockRepository.On("Delete", mock.Anything, sess.ID).Return(nil)
+		assert.ErrorIs(t, svc.Track(moved, sess), session.ErrClientMismatch)
+	})
+
+	t.Run("should ignore the ip of a session bound to the device", func(t *testing.T) {
+		mockRepository := mocks.NewRepository(t)
+		svc := session.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), mockRepository, 24*time.Hour, session.Policy{})
+		svc.Now = func() time.Time { return now }
+		sess := &session.Session{ID: uuid.New(), Metadata: bound, Policy: session.Policy{Binding: session.BindingDevice, EnforceBinding: true}}
+
+		assert.Nil(t, svc.Track(moved, sess))
+	})
+}
+
+func TestSession_IsIdle(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	sess := session.Session{
+		AuthenticatedAt: now.Add(-time.Hour),
+		ExpiresAt:       now.Add(time.Hour),
+		LastActiveAt:    now.Add(-time.Hour),
+	}
+	assert.True(t, sess.IsValid(now))
+
+	sess.Policy.IdleTimeout = 30 * time.Minute
+	assert.True(t, sess.IsIdle(now))
+	assert.False(t, sess.IsValid(now))
+
+	sess.LastActiveAt = now.Add(-10 * time.Minute)
+	assert.True(t, sess.IsValid(now))
+}
+
+func TestPolicy_Stricter(t *testing.T) {
+	policy := session.Policy{IdleTimeout: time.Hour, Binding: session.BindingIP}.Stricter(session.Policy{
+		IdleTimeout: 2 * time.Hour,
+		MaxSessions: 3,
+		Binding:     session.BindingDevice,
+	})
+	assert.Equal(t, session.Policy{IdleTimeout: time.Hour, MaxSessions: 3, Binding: session.BindingIP}, policy)
+}
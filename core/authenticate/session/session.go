@@ -20,6 +20,18 @@ type SessionMetadata struct {
 	Browser         string
 }
 
+// AssuranceLevel is the authenticator assurance level a user proved in a
+// session
+type AssuranceLevel string
+
+const (
+	// AssuranceLevelSingleFactor is a session of a login strategy alone
+	AssuranceLevelSingleFactor AssuranceLevel = "aal1"
+	// AssuranceLevelMultiFactor is a session whose user verified a second
+	// factor after the login
+	AssuranceLevelMultiFactor AssuranceLevel = "aal2"
+)
+
 // Session is created on successful authentication of users
 type Session struct {
 	ID uuid.UUID
@@ -27,8 +39,12 @@ type Session struct {
 	// UserID is a unique identifier for logged in users
 	UserID string
 
-	// AuthenticatedAt is set when a user is successfully authn
+	// AuthenticatedAt is set when a user is successfully authn, and again
+	// when the user re-authenticates in the session
 	AuthenticatedAt time.Time
+	// MFAAuthenticatedAt is the last time the user verified the second
+	// factor in the session
+	MFAAuthenticatedAt *time.Time
 
 	// ExpiresAt is ideally now() + lifespan of session, e.g. 7 days
 	ExpiresAt time.Time
@@ -37,15 +53,52 @@ type Session struct {
 	DeletedAt *time.Time // Soft delete timestamp (nil = not deleted)
 
 	Metadata SessionMetadata
+
+	AssuranceLevel AssuranceLevel
+	// MFARequired holds the session back till the user verifies a second
+	// factor, set on login for users with a totp or members of orgs
+	// requiring mfa
+	MFARequired bool
+
+	// LastActiveAt is the last time the session authenticated a request,
+	// tracked with a minute of precision
+	LastActiveAt time.Time
+	// Policy is the session policy in effect for the user on login
+	Policy Policy
 }
 
 func (s Session) IsValid(now time.Time) bool {
-	if s.ExpiresAt.After(now) && !s.AuthenticatedAt.IsZero() && s.DeletedAt == nil {
+	if s.ExpiresAt.After(now) && !s.AuthenticatedAt.IsZero() && s.DeletedAt == nil && !s.IsIdle(now) {
 		return true
 	}
 	return false
 }
 
+// IsIdle tells if the session wasn't used for longer than the idle timeout
+// of its policy
+func (s Session) IsIdle(now time.Time) bool {
+	return s.Policy.IdleTimeout > 0 && !s.LastActiveAt.IsZero() && now.Sub(s.LastActiveAt) > s.Policy.IdleTimeout
+}
+
+// MFAPending tells if the user has to verify a second factor before the
+// session authenticates requests
+func (s Session) MFAPending() bool {
+	return s.MFARequired && s.AssuranceLevel != AssuranceLevelMultiFactor
+}
+
+// AuthenticatedWithin tells if the user proved the session's factors within
+// the max age, a session at aal2 has to re-verify the second factor
+func (s Session) AuthenticatedWithin(now time.Time, maxAge time.Duration) bool {
+	authenticatedAt := s.AuthenticatedAt
+	if s.AssuranceLevel == AssuranceLevelMultiFactor {
+		if s.MFAAuthenticatedAt == nil {
+			return false
+		}
+		authenticatedAt = *s.MFAAuthenticatedAt
+	}
+	return now.Sub(authenticatedAt) <= maxAge
+}
+
 // SetSessionMetadataInContext sets session metadata in context
 // It accepts a SessionMetadata struct but stores it as a map with the same structure to avoid layer violations in repositories
 func SetSessionMetadataInContext(ctx context.Context, metadata SessionMetadata) context.Context {
@@ -62,3 +115,23 @@ func SetSessionMetadataInContext(ctx context.Context, metadata SessionMetadata)
 	}
 	return context.WithValue(ctx, consts.SessionContextKey, metadataMap)
 }
+
+// GetSessionMetadataFromContext reads the session metadata set in context
+// by SetSessionMetadataInContext
+func GetSessionMetadataFromContext(ctx context.Context) (SessionMetadata, bool) {
+	metadataMap, ok := ctx.Value(consts.SessionContextKey).(map[string]any)
+	if !ok {
+		return SessionMetadata{}, false
+	}
+	metadata := SessionMetadata{}
+	metadata.IpAddress, _ = metadataMap["IpAddress"].(string)
+	metadata.OperatingSystem, _ = metadataMap["OperatingSystem"].(string)
+	metadata.Browser, _ = metadataMap["Browser"].(string)
+	if location, ok := metadataMap["Location"].(map[string]any); ok {
+		metadata.Location.Country, _ = location["Country"].(string)
+		metadata.Location.City, _ = location["City"].(string)
+		metadata.Location.Latitude, _ = location["Latitude"].(string)
+		metadata.Location.Longitude, _ = location["Longitude"].(string)
+	}
+	return metadata, true
+}
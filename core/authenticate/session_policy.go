@@ -0,0 +1,51 @@
+package authenticate
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	frontiersession "github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/core/preference"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+)
+
+// SessionPolicy merges the session policies the organizations of the user
+// demand, a binding demanded by an organization is always enforced
+func (s Service) SessionPolicy(ctx context.Context, userID string) (frontiersession.Policy, error) {
+	policy := frontiersession.Policy{}
+	if s.membershipService == nil || s.preferenceService == nil {
+		return policy, nil
+	}
+	orgIDs, err := s.membershipService.ListOrgsByPrincipal(ctx, Principal{
+		ID:   userID,
+		Type: schema.UserPrincipal,
+	})
+	if err != nil {
+		return policy, err
+	}
+	for _, orgID := range orgIDs {
+		prefs, err := s.preferenceService.LoadOrganizationPreferences(ctx, orgID)
+		if err != nil {
+			return policy, err
+		}
+		policy = policy.Stricter(orgSessionPolicy(prefs))
+	}
+	return policy, nil
+}
+
+func orgSessionPolicy(prefs map[string]string) frontiersession.Policy {
+	policy := frontiersession.Policy{}
+	if minutes, err := strconv.Atoi(prefs[preference.OrganizationSessionIdleTimeout]); err == nil && minutes > 0 {
+		policy.IdleTimeout = time.Duration(minutes) * time.Minute
+	}
+	if maxSessions, err := strconv.Atoi(prefs[preference.OrganizationSessionMaxSessions]); err == nil && maxSessions > 0 {
+		policy.MaxSessions = maxSessions
+	}
+	switch binding := frontiersession.Binding(prefs[preference.OrganizationSessionBinding]); binding {
+	case frontiersession.BindingDevice, frontiersession.BindingIP:
+		policy.Binding = binding
+		policy.EnforceBinding = true
+	}
+	return policy
+}
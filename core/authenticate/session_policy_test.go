@@ -0,0 +1,49 @@
+package authenticate
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	frontiersession "github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/core/preference"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_SessionPolicy(t *testing.T) {
+	ctx := context.Background()
+	svc := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), Config{}, nil, nil, nil, nil, nil, nil, nil, nil)
+	svc.SetMembershipService(membershipService{
+		"alice": {"acme", "globex"},
+		"bob":   {"initech"},
+	})
+	svc.SetPreferenceService(preferenceService{
+		"acme": {
+			preference.OrganizationSessionIdleTimeout: "30",
+			preference.OrganizationSessionMaxSessions: "5",
+		},
+		"globex": {
+			preference.OrganizationSessionIdleTimeout: "60",
+			preference.OrganizationSessionBinding:     "device",
+		},
+		"initech": {
+			preference.OrganizationSessionIdleTimeout: "not-a-number",
+			preference.OrganizationSessionBinding:     "none",
+		},
+	})
+
+	policy, err := svc.SessionPolicy(ctx, "alice")
+	assert.NoError(t, err)
+	assert.Equal(t, frontiersession.Policy{
+		IdleTimeout:    30 * time.Minute,
+		MaxSessions:    5,
+		Binding:        frontiersession.BindingDevice,
+		EnforceBinding: true,
+	}, policy)
+
+	policy, err = svc.SessionPolicy(ctx, "bob")
+	assert.NoError(t, err)
+	assert.Equal(t, frontiersession.Policy{}, policy)
+}
@@ -0,0 +1,141 @@
+package authenticate
+
+import (
+	"context"
+	"strings"
+
+	"github.com/raystack/frontier/core/preference"
+)
+
+// ssoOrgIDKey keeps the organization whose identity provider a flow uses
+const ssoOrgIDKey = "sso_org_id"
+
+// orgSSO is the OpenID Connect identity provider an organization registered
+// for the users of its verified domains in its preferences
+type orgSSO struct {
+	OrgID string
+	// Enforced rejects the other strategies for the users of the domains
+	Enforced bool
+	Config   OIDCConfig
+}
+
+// findOrgSSO returns the sso of the enabled organization which verified the
+// domain of the email, nil when there is none
+func (s Service) findOrgSSO(ctx context.Context, email string) (*orgSSO, error) {
+	if s.domainService == nil || s.preferenceService == nil || !strings.Contains(email, "@") {
+		return nil, nil
+	}
+	orgIDs, err := s.domainService.ListOrgsByDomain(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	for _, orgID := range orgIDs {
+		sso, err := s.loadOrgSSO(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if sso != nil {
+			return sso, nil
+		}
+	}
+	return nil, nil
+}
+
+// loadOrgSSO returns the sso of the organization, nil when it isn't
+// configured or the organization is disabled
+func (s Service) loadOrgSSO(ctx context.Context, orgID string) (*orgSSO, error) {
+	if s.preferenceService == nil {
+		return nil, nil
+	}
+	if s.orgService != nil {
+		enabled, err := s.orgService.IsEnabled(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if !enabled {
+			return nil, nil
+		}
+	}
+	prefs, err := s.preferenceService.LoadOrganizationPreferences(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if prefs[preference.OrganizationSSOIssuerURL] == "" || prefs[preference.OrganizationSSOClientID] == "" {
+		return nil, nil
+	}
+	return &orgSSO{
+		OrgID:    orgID,
+		Enforced: prefs[preference.OrganizationSSOEnforced] == "true",
+		Config: OIDCConfig{
+			ClientID:     prefs[preference.OrganizationSSOClientID],
+			ClientSecret: prefs[preference.OrganizationSSOClientSecret],
+			IssuerUrl:    prefs[preference.OrganizationSSOIssuerURL],
+		},
+	}, nil
+}
+
+// methodOrgID returns the organization whose identity provider the strategy
+// signs in with, empty for the strategies open to every user
+func (s Service) methodOrgID(method string, sso *orgSSO) string {
+	if method == SSOAuthMethod.String() && sso != nil {
+		return sso.OrgID
+	}
+	if samlConfig, ok := s.config.SAMLConfig[method]; ok {
+		return samlConfig.OrgID
+	}
+	return ""
+}
+
+// verifyIDPUser checks the identity provider of the organization vouches
+// only for the users of its verified domains, and the users of a domain with
+// enforced sso only sign in with the identity provider of their organization.
+// An empty orgID is an identity provider open to every user.
+func (s Service) verifyIDPUser(ctx context.Context, email, orgID string) error {
+	if orgID != "" {
+		if s.domainService == nil {
+			return ErrMissingDomainSvc
+		}
+		isOrgDomain, err := s.domainService.IsOrgDomain(ctx, orgID, email)
+		if err != nil {
+			return err
+		}
+		if !isOrgDomain {
+			return ErrIDPUserNotAllowed
+		}
+	}
+
+	sso, err := s.findOrgSSO(ctx, email)
+	if err != nil {
+		return err
+	}
+	if sso != nil && sso.Enforced && sso.OrgID != orgID {
+		return ErrSSORequired
+	}
+	return nil
+}
+
+// verifyOpenStrategyUser checks the user signed in by a strategy open to
+// every user isn't required to sign in with the sso of its organization. The
+// flow was checked when it started but the sso may have been enforced since,
+// and a passkey flow doesn't always start with the email of the user.
+func (s Service) verifyOpenStrategyUser(ctx context.Context, response *RegistrationFinishResponse) (*RegistrationFinishResponse, error) {
+	if response == nil {
+		return nil, nil
+	}
+	if err := s.verifyIDPUser(ctx, response.User.Email, ""); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// joinOrg adds the user signed in by the identity provider of the
+// organization as its member
+func (s Service) joinOrg(ctx context.Context, orgID, userID string) error {
+	if orgID == "" {
+		return nil
+	}
+	if s.domainService == nil {
+		return ErrMissingDomainSvc
+	}
+	return s.domainService.Join(ctx, orgID, userID)
+}
@@ -0,0 +1,188 @@
+package authenticate
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/raystack/frontier/core/preference"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/pkg/mailer"
+	"github.com/raystack/frontier/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type domainService struct {
+	// domains of the organizations
+	domains map[string][]string
+}
+
+func (d *domainService) IsOrgDomain(ctx context.Context, orgID string, email string) (bool, error) {
+	for _, domain := range d.domains[orgID] {
+		if domain == utils.ExtractDomainFromEmail(email) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (d *domainService) ListOrgsByDomain(ctx context.Context, email string) ([]string, error) {
+	var orgIDs []string
+	for orgID := range d.domains {
+		if ok, _ := d.IsOrgDomain(ctx, orgID, email); ok {
+			orgIDs = append(orgIDs, orgID)
+		}
+	}
+	return orgIDs, nil
+}
+
+func (d *domainService) Join(ctx context.Context, orgID string, userID string) error {
+	return nil
+}
+
+type preferenceService map[string]map[string]string
+
+func (p preferenceService) LoadOrganizationPreferences(ctx context.Context, orgID string) (map[string]string, error) {
+	return p[orgID], nil
+}
+
+type orgService map[string]bool
+
+func (o orgService) IsEnabled(ctx context.Context, orgID string) (bool, error) {
+	return o[orgID], nil
+}
+
+// userService keeps the users by id
+type userService map[string]user.User
+
+func (u userService) GetByID(ctx context.Context, id string) (user.User, error) {
+	if usr, ok := u[id]; ok {
+		return usr, nil
+	}
+	return user.User{}, user.ErrNotExist
+}
+
+func (u userService) Create(ctx context.Context, usr user.User) (user.User, error) {
+	u[usr.Email] = usr
+	return usr, nil
+}
+
+func (u userService) Update(ctx context.Context, usr user.User) (user.User, error) {
+	u[usr.ID] = usr
+	return usr, nil
+}
+
+func newSSOService(enforced string, enabled bool) *Service {
+	svc := NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), Config{
+		SAMLConfig: map[string]SAMLConfig{
+			"acme-saml":  {OrgID: "acme"},
+			"other-saml": {},
+		},
+	}, nil, mailer.NewMockDialer(), nil, nil, nil, nil, nil, nil)
+	svc.SetDomainService(&domainService{domains: map[string][]string{
+		"acme": {"acme.org"},
+	}})
+	svc.SetPreferenceService(preferenceService{
+		"acme": {
+			preference.OrganizationSSOIssuerURL:    "https://acme.okta.com",
+			preference.OrganizationSSOClientID:     "client",
+			preference.OrganizationSSOClientSecret: "secret",
+			preference.OrganizationSSOEnforced:     enforced,
+		},
+	})
+	svc.SetOrgService(orgService{"acme": enabled})
+	return svc
+}
+
+func TestService_SSOEnforcement(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should reject other strategies for the domain of an enforced sso", func(t *testing.T) {
+		svc := newSSOService("true", true)
+
+		_, err := svc.StartFlow(ctx, RegistrationStartRequest{
+			Method:      MailOTPAuthMethod.String(),
+			Email:       "alice@acme.org",
+			CallbackUrl: "http://localhost/callback",
+		})
+		assert.ErrorIs(t, err, ErrSSORequired)
+
+		assert.ErrorIs(t, svc.verifyIDPUser(ctx, "alice@acme.org", ""), ErrSSORequired)
+		assert.ErrorIs(t, svc.verifyIDPUser(ctx, "alice@acme.org", svc.methodOrgID("other-saml", nil)), ErrSSORequired)
+		assert.NoError(t, svc.verifyIDPUser(ctx, "alice@acme.org", svc.methodOrgID("acme-saml", nil)))
+		assert.NoError(t, svc.verifyIDPUser(ctx, "bob@example.com", ""))
+	})
+
+	t.Run("should allow other strategies when the sso isn't enforced", func(t *testing.T) {
+		svc := newSSOService("false", true)
+
+		assert.NoError(t, svc.verifyIDPUser(ctx, "alice@acme.org", ""))
+		sso, err := svc.findOrgSSO(ctx, "alice@acme.org")
+		assert.NoError(t, err)
+		assert.Equal(t, "acme", sso.OrgID)
+		assert.Equal(t, "https://acme.okta.com", sso.Config.IssuerUrl)
+		assert.Equal(t, "secret", sso.Config.ClientSecret)
+	})
+
+	t.Run("should ignore the sso of a disabled organization", func(t *testing.T) {
+		svc := newSSOService("true", false)
+
+		assert.NoError(t, svc.verifyIDPUser(ctx, "alice@acme.org", ""))
+		_, err := svc.StartFlow(ctx, RegistrationStartRequest{
+			Method:      SSOAuthMethod.String(),
+			Email:       "alice@acme.org",
+			CallbackUrl: "http://localhost/callback",
+		})
+		assert.ErrorIs(t, err, ErrSSONotConfigured)
+	})
+
+	t.Run("should only let the identity provider of an organization sign in its users", func(t *testing.T) {
+		svc := newSSOService("false", true)
+
+		assert.ErrorIs(t, svc.verifyIDPUser(ctx, "bob@example.com", "acme"), ErrIDPUserNotAllowed)
+		assert.NoError(t, svc.verifyIDPUser(ctx, "alice@acme.org", "acme"))
+	})
+
+	t.Run("should reject a passkey sign in by a user of the domain of an enforced sso", func(t *testing.T) {
+		svc := newSSOService("true", true)
+		webAuth, err := webauthn.New(&webauthn.Config{
+			RPDisplayName: "Frontier",
+			RPID:          "localhost",
+			RPOrigins:     []string{"http://localhost"},
+		})
+		assert.NoError(t, err)
+		svc.webAuth = webAuth
+		svc.userService = userService{
+			"9f256f86-31a3-11ec-8d3d-0242ac130003": {
+				ID:    "9f256f86-31a3-11ec-8d3d-0242ac130003",
+				Email: "alice@acme.org",
+			},
+		}
+
+		// the passkey flow names the user by its id
+		_, err = svc.StartFlow(ctx, RegistrationStartRequest{
+			Method:      PassKeyAuthMethod.String(),
+			Email:       "9f256f86-31a3-11ec-8d3d-0242ac130003",
+			CallbackUrl: "http://localhost/callback",
+		})
+		assert.ErrorIs(t, err, ErrSSORequired)
+
+		_, err = svc.verifyOpenStrategyUser(ctx, &RegistrationFinishResponse{
+			User: user.User{ID: "9f256f86-31a3-11ec-8d3d-0242ac130003", Email: "alice@acme.org"},
+		})
+		assert.ErrorIs(t, err, ErrSSORequired)
+		response, err := svc.verifyOpenStrategyUser(ctx, &RegistrationFinishResponse{
+			User: user.User{Email: "bob@example.com"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "bob@example.com", response.User.Email)
+	})
+
+	t.Run("should list sso as a strategy", func(t *testing.T) {
+		svc := newSSOService("true", true)
+
+		assert.Contains(t, svc.SupportedStrategies(), SSOAuthMethod.String())
+	})
+}
@@ -0,0 +1,165 @@
+package strategy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/crewjam/saml"
+	"github.com/crewjam/saml/samlsp"
+	dsig "github.com/russellhaering/goxmldsig"
+)
+
+var ErrInvalidSAMLResponse = errors.New("invalid saml response")
+
+// SAML is a service provider signing in users of a SAML 2.0 identity provider
+type SAML struct {
+	Client *http.Client
+	sp     *saml.ServiceProvider
+}
+
+// SAMLUserInfo is the subject of a verified assertion
+type SAMLUserInfo struct {
+	NameID     string
+	Attributes map[string][]string
+}
+
+// Attribute returns the first value of the attribute matched by its name or
+// friendly name
+func (u SAMLUserInfo) Attribute(name string) string {
+	if values := u.Attributes[name]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// NewServiceProviderSAML creates a service provider whose metadata is served at
+// metadataURL and assertions are posted to acsURL, entityID defaults to the
+// metadata url
+func NewServiceProviderSAML(entityID string, metadataURL, acsURL url.URL) *SAML {
+	return &SAML{
+		Client: http.DefaultClient,
+		sp: &saml.ServiceProvider{
+			EntityID:          entityID,
+			MetadataURL:       metadataURL,
+			AcsURL:            acsURL,
+			AuthnNameIDFormat: saml.EmailAddressNameIDFormat,
+		},
+	}
+}
+
+// WithKeyPair signs the authn requests and decrypts the assertions with the
+// pem encoded certificate and its private key
+func (s *SAML) WithKeyPair(certificate, privateKey string) (*SAML, error) {
+	pair, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid saml key pair: %w", err)
+	}
+	if s.sp.Certificate, err = x509.ParseCertificate(pair.Certificate[0]); err != nil {
+		return nil, fmt.Errorf("invalid saml certificate: %w", err)
+	}
+	switch key := pair.PrivateKey.(type) {
+	case *rsa.PrivateKey:
+		s.sp.SignatureMethod = dsig.RSASHA256SignatureMethod
+		s.sp.Key = key
+	case *ecdsa.PrivateKey:
+		s.sp.SignatureMethod = dsig.ECDSASHA256SignatureMethod
+		s.sp.Key = key
+	default:
+		return nil, errors.New("saml private key must be rsa or ecdsa")
+	}
+	return s, nil
+}
+
+// Init reads the metadata of the identity provider, inline metadata is used
+// when set, it's fetched from metadataURL otherwise
+func (s *SAML) Init(ctx context.Context, metadataURL, metadata string) (*SAML, error) {
+	var err error
+	if len(metadata) > 0 {
+		s.sp.IDPMetadata, err = samlsp.ParseMetadata([]byte(metadata))
+	} else {
+		var idpURL *url.URL
+		if idpURL, err = url.Parse(metadataURL); err != nil {
+			return nil, err
+		}
+		s.sp.IDPMetadata, err = samlsp.FetchMetadata(ctx, s.Client, *idpURL)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read saml idp metadata: %w", err)
+	}
+	s.sp.HTTPClient = s.Client
+	return s, nil
+}
+
+// AuthURL returns the url redirecting the user to the identity provider with
+// an authn request, the request id has to match the response of the provider
+func (s *SAML) AuthURL(relayState string) (authURL string, requestID string, err error) {
+	ssoURL := s.sp.GetSSOBindingLocation(saml.HTTPRedirectBinding)
+	if ssoURL == "" {
+		return "", "", errors.New("saml idp doesn't support the redirect binding")
+	}
+	request, err := s.sp.MakeAuthenticationRequest(ssoURL, saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return "", "", err
+	}
+	redirectURL, err := request.Redirect(relayState, s.sp)
+	if err != nil {
+		return "", "", err
+	}
+	return redirectURL.String(), request.ID, nil
+}
+
+// Metadata returns the xml metadata of the service provider to register it
+// with the identity provider
+func (s *SAML) Metadata() ([]byte, error) {
+	buf, err := xml.MarshalIndent(s.sp.Metadata(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), buf...), nil
+}
+
+// GetUser verifies the signed base64 encoded response of the identity provider
+// is issued for requestID and returns the subject of its assertion
+func (s *SAML) GetUser(samlResponse string, requestID string) (*SAMLUserInfo, error) {
+	rawResponse, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSAMLResponse, err)
+	}
+	assertion, err := s.sp.ParseXMLResponse(rawResponse, []string{requestID}, s.sp.AcsURL)
+	if err != nil {
+		// the error of the library is static, the reason is kept private
+		var invalidErr *saml.InvalidResponseError
+		if errors.As(err, &invalidErr) {
+			err = invalidErr.PrivateErr
+		}
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSAMLResponse, err)
+	}
+
+	user := &SAMLUserInfo{
+		Attributes: map[string][]string{},
+	}
+	if assertion.Subject != nil && assertion.Subject.NameID != nil {
+		user.NameID = strings.TrimSpace(assertion.Subject.NameID.Value)
+	}
+	for _, statement := range assertion.AttributeStatements {
+		for _, attr := range statement.Attributes {
+			for _, value := range attr.Values {
+				user.Attributes[attr.Name] = append(user.Attributes[attr.Name], value.Value)
+				if attr.FriendlyName != "" && attr.FriendlyName != attr.Name {
+					user.Attributes[attr.FriendlyName] = append(user.Attributes[attr.FriendlyName], value.Value)
+				}
+			}
+		}
+	}
+	return user, nil
+}
@@ -0,0 +1,153 @@
+package strategy
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/xml"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testServiceProviders struct {
+	metadata *saml.EntityDescriptor
+}
+
+func (p testServiceProviders) GetServiceProvider(r *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error) {
+	return p.metadata, nil
+}
+
+func newTestKeyPair(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return key, cert
+}
+
+func newTestIDP(t *testing.T) *saml.IdentityProvider {
+	t.Helper()
+	key, cert := newTestKeyPair(t)
+	metadataURL, _ := url.Parse("https://idp.example.com/metadata")
+	ssoURL, _ := url.Parse("https://idp.example.com/sso")
+	return &saml.IdentityProvider{
+		Key:            key,
+		Certificate:    cert,
+		MetadataURL:    *metadataURL,
+		SSOURL:         *ssoURL,
+		AssertionMaker: saml.DefaultAssertionMaker{},
+	}
+}
+
+func newTestSP(t *testing.T, idp *saml.IdentityProvider) *SAML {
+	t.Helper()
+	metadataURL, _ := url.Parse("https://frontier.example.com/saml/acme/metadata")
+	acsURL, _ := url.Parse("https://frontier.example.com/saml/acme/acs")
+	idpMetadata, err := xml.Marshal(idp.Metadata())
+	require.NoError(t, err)
+
+	sp, err := NewServiceProviderSAML("", *metadataURL, *acsURL).
+		Init(context.Background(), "", string(idpMetadata))
+	require.NoError(t, err)
+	idp.ServiceProviderProvider = testServiceProviders{metadata: sp.sp.Metadata()}
+	return sp
+}
+
+// respond signs in the user at the identity provider and returns the form
+// value it posts to the assertion consumer service
+func respond(t *testing.T, idp *saml.IdentityProvider, authURL string, session *saml.Session) string {
+	t.Helper()
+	httpReq := httptest.NewRequest(http.MethodGet, authURL, nil)
+	req, err := saml.NewIdpAuthnRequest(idp, httpReq)
+	require.NoError(t, err)
+	require.NoError(t, req.Validate())
+	require.NoError(t, idp.AssertionMaker.MakeAssertion(req, session))
+	form, err := req.PostBinding()
+	require.NoError(t, err)
+	return form.SAMLResponse
+}
+
+func TestSAML(t *testing.T) {
+	session := &saml.Session{
+		ID:           "session",
+		NameID:       "alice@example.com",
+		NameIDFormat: string(saml.EmailAddressNameIDFormat),
+		CustomAttributes: []saml.Attribute{
+			{Name: "email", Values: []saml.AttributeValue{{Type: "xs:string", Value: "alice@example.com"}}},
+			{Name: "urn:oid:2.5.4.3", FriendlyName: "cn", Values: []saml.AttributeValue{{Type: "xs:string", Value: "Alice"}}},
+		},
+	}
+
+	t.Run("should verify the signed response of the authn request", func(t *testing.T) {
+		idp := newTestIDP(t)
+		sp := newTestSP(t, idp)
+
+		authURL, requestID, err := sp.AuthURL("relay")
+		require.NoError(t, err)
+		assert.Contains(t, authURL, "https://idp.example.com/sso?SAMLRequest=")
+		assert.Contains(t, authURL, "RelayState=relay")
+
+		user, err := sp.GetUser(respond(t, idp, authURL, session), requestID)
+		require.NoError(t, err)
+		assert.Equal(t, "alice@example.com", user.NameID)
+		assert.Equal(t, "alice@example.com", user.Attribute("email"))
+		assert.Equal(t, "Alice", user.Attribute("cn"))
+		assert.Equal(t, "Alice", user.Attribute("urn:oid:2.5.4.3"))
+		assert.Empty(t, user.Attribute("missing"))
+	})
+
+	t.Run("should reject a response of another request", func(t *testing.T) {
+		idp := newTestIDP(t)
+		sp := newTestSP(t, idp)
+
+		authURL, _, err := sp.AuthURL("relay")
+		require.NoError(t, err)
+		_, otherRequestID, err := sp.AuthURL("relay")
+		require.NoError(t, err)
+
+		_, err = sp.GetUser(respond(t, idp, authURL, session), otherRequestID)
+		assert.True(t, errors.Is(err, ErrInvalidSAMLResponse))
+	})
+
+	t.Run("should reject a response signed by another identity provider", func(t *testing.T) {
+		idp := newTestIDP(t)
+		sp := newTestSP(t, idp)
+
+		authURL, requestID, err := sp.AuthURL("relay")
+		require.NoError(t, err)
+		idp.Key, idp.Certificate = newTestKeyPair(t)
+
+		_, err = sp.GetUser(respond(t, idp, authURL, session), requestID)
+		assert.True(t, errors.Is(err, ErrInvalidSAMLResponse))
+	})
+
+	t.Run("should serve the service provider metadata", func(t *testing.T) {
+		idp := newTestIDP(t)
+		sp := newTestSP(t, idp)
+
+		metadata, err := sp.Metadata()
+		require.NoError(t, err)
+		assert.Contains(t, string(metadata), `entityID="https://frontier.example.com/saml/acme/metadata"`)
+		assert.Contains(t, string(metadata), `Location="https://frontier.example.com/saml/acme/acs"`)
+	})
+}
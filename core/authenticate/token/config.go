@@ -0,0 +1,23 @@
+package token
+
+import "time"
+
+// KeyRotationConfig keeps the signing keys in the database and rotates them
+// on a schedule, the keys of rsa_path and rsa_base64 are not used then
+type KeyRotationConfig struct {
+	Enabled bool `yaml:"enabled" mapstructure:"enabled" default:"false"`
+	// Algorithm of the keys generated from now on, one of RS256, ES256 or
+	// EdDSA. Keys of other algorithms are published till they retire.
+	Algorithm string `yaml:"algorithm" mapstructure:"algorithm" default:"RS256"`
+	// EncryptionKey encrypts the private keys stored in the database, it
+	// must be 32 bytes long
+	EncryptionKey string `yaml:"encryption_key" mapstructure:"encryption_key" default:"hash-secret-should-be-32-chars--"`
+	// Interval is how long a key signs tokens before the next one replaces it
+	Interval time.Duration `yaml:"interval" mapstructure:"interval" default:"720h"`
+	// PublishAhead is how long a new key is in the jwks before it signs
+	// tokens, so verifiers caching the jwks know it by then
+	PublishAhead time.Duration `yaml:"publish_ahead" mapstructure:"publish_ahead" default:"24h"`
+	// SyncInterval is how often an instance reloads the keys and rotates
+	// them when due
+	SyncInterval time.Duration `yaml:"sync_interval" mapstructure:"sync_interval" default:"1m"`
+}
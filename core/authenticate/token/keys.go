@@ -0,0 +1,366 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/raystack/frontier/pkg/utils"
+	"github.com/robfig/cron/v3"
+)
+
+var (
+	ErrKeyNotFound      = errors.New("signing key doesn't exist")
+	ErrRotationDisabled = errors.New("signing key rotation is not enabled")
+)
+
+const keyRotationLockKey = "token-signing-key-rotation"
+
+type KeyState string
+
+const (
+	// KeyStatePending keys are published in the jwks but don't sign tokens yet
+	KeyStatePending KeyState = "pending"
+	// KeyStateActive is the key signing new tokens
+	KeyStateActive KeyState = "active"
+	// KeyStateRetiring keys don't sign tokens anymore, they stay published
+	// till the tokens they signed expire
+	KeyStateRetiring KeyState = "retiring"
+)
+
+// SigningKey is a private key of the jwks, ID is its kid
+type SigningKey struct {
+	ID        string
+	Key       jwk.Key
+	State     KeyState
+	CreatedAt time.Time
+	// ActivateAt is when a pending key replaces the active key, PublishAhead
+	// after it was created when not set
+	ActivateAt  *time.Time
+	ActivatedAt *time.Time
+	RetiredAt   *time.Time
+}
+
+type KeyRepository interface {
+	Create(ctx context.Context, key SigningKey) (SigningKey, error)
+	List(ctx context.Context) ([]SigningKey, error)
+	// Promote makes the key active and the active key retiring
+	Promote(ctx context.Context, id string) error
+	Delete(ctx context.Context, id string) error
+}
+
+// Locker acquires distributed locks via Postgres advisory locks.
+type Locker interface {
+	TryLock(ctx context.Context, id string) (*db.Lock, error)
+}
+
+// KeySource provides the keys tokens are signed and verified with
+type KeySource interface {
+	// SigningKey returns the key signing new tokens
+	SigningKey() (jwk.Key, bool)
+	// PublicKeySet returns the public keys tokens are verified with
+	PublicKeySet() jwk.Set
+}
+
+// staticKeys signs with the first key of a key set read from the config
+type staticKeys struct {
+	keySet       jwk.Set
+	publicKeySet jwk.Set
+}
+
+func (k staticKeys) SigningKey() (jwk.Key, bool) {
+	return k.keySet.Key(0)
+}
+
+func (k staticKeys) PublicKeySet() jwk.Set {
+	return k.publicKeySet
+}
+
+// KeyStore keeps the signing keys in the database and rotates them. A new
+// key is published PublishAhead before it replaces the active key, a
+// replaced key is published till the tokens it signed expire.
+type KeyStore struct {
+	log       *slog.Logger
+	config    KeyRotationConfig
+	repo      KeyRepository
+	locker    Locker
+	retention time.Duration
+	cron      *cron.Cron
+
+	mu           sync.RWMutex
+	signingKey   jwk.Key
+	publicKeySet jwk.Set
+	Now          func() time.Time
+}
+
+// NewKeyStore creates a store whose replaced keys are published for
+// retention, the longest validity of the tokens they sign
+func NewKeyStore(logger *slog.Logger, config KeyRotationConfig, repo KeyRepository, locker Locker, retention time.Duration) *KeyStore {
+	return &KeyStore{
+		log:          logger,
+		config:       config,
+		repo:         repo,
+		locker:       locker,
+		retention:    retention,
+		publicKeySet: jwk.NewSet(),
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+// Init creates the first key if there is none, loads the keys and
+// schedules their rotation
+func (s *KeyStore) Init(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+	if err := s.Rotate(ctx); err != nil {
+		return fmt.Errorf("failed to load signing keys: %w", err)
+	}
+	s.cron = cron.New(cron.WithChain(
+		cron.SkipIfStillRunning(cron.DefaultLogger),
+		cron.Recover(cron.DefaultLogger),
+	))
+	if _, err := s.cron.AddFunc(fmt.Sprintf("@every %s", s.config.SyncInterval), func() {
+		if err := s.Rotate(ctx); err != nil {
+			s.log.WarnContext(ctx, "failed to rotate signing keys", "err", err)
+		}
+	}); err != nil {
+		return err
+	}
+	s.cron.Start()
+	return nil
+}
+
+func (s *KeyStore) Close() error {
+	if s.cron != nil {
+		<-s.cron.Stop().Done()
+	}
+	return nil
+}
+
+func (s *KeyStore) SigningKey() (jwk.Key, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.signingKey, s.signingKey != nil
+}
+
+func (s *KeyStore) PublicKeySet() jwk.Set {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.publicKeySet
+}
+
+// Rotate advances the keys that are due and reloads them. Only one instance
+// rotates at a time, the rest only reload the keys.
+func (s *KeyStore) Rotate(ctx context.Context) error {
+	lock, err := s.locker.TryLock(ctx, keyRotationLockKey)
+	if err != nil && !errors.Is(err, db.ErrLockBusy) {
+		return err
+	}
+	if err == nil {
+		defer s.unlock(ctx, lock)
+		if err := s.advance(ctx); err != nil {
+			return err
+		}
+	}
+	return s.load(ctx)
+}
+
+// Replace publishes a key replacing the active key once every instance
+// loaded it, SyncInterval from now, and returns it. A pending key published
+// for that long replaces the active key at once.
+func (s *KeyStore) Replace(ctx context.Context) (SigningKey, error) {
+	if !s.config.Enabled {
+		return SigningKey{}, ErrRotationDisabled
+	}
+	lock, err := s.locker.TryLock(ctx, keyRotationLockKey)
+	if err != nil {
+		return SigningKey{}, err
+	}
+	defer s.unlock(ctx, lock)
+	key, err := s.replace(ctx)
+	if err != nil {
+		return SigningKey{}, err
+	}
+	return key, s.load(ctx)
+}
+
+func (s *KeyStore) unlock(ctx context.Context, lock *db.Lock) {
+	if err := lock.Unlock(ctx); err != nil {
+		s.log.WarnContext(ctx, "failed to release signing key rotation lock", "err", err)
+	}
+}
+
+// replace schedules the replacement of the active key, callers hold the
+// rotation lock
+func (s *KeyStore) replace(ctx context.Context) (SigningKey, error) {
+	keys, err := s.repo.List(ctx)
+	if err != nil {
+		return SigningKey{}, err
+	}
+	now := s.Now()
+	var active, pending *SigningKey
+	for i, key := range keys {
+		switch key.State {
+		case KeyStateActive:
+			active = &keys[i]
+		case KeyStatePending:
+			pending = &keys[i]
+		}
+	}
+
+	if active == nil {
+		// nothing signs tokens yet, the first key is activated at once
+		if err := s.advance(ctx); err != nil {
+			return SigningKey{}, err
+		}
+		return s.activeKey(ctx)
+	}
+	if pending != nil {
+		// every instance loaded the key by now
+		if !pending.CreatedAt.Add(s.config.SyncInterval).After(now) {
+			if err := s.promote(ctx, *pending); err != nil {
+				return SigningKey{}, err
+			}
+			return s.activeKey(ctx)
+		}
+		// nothing was signed with it, a key activated sooner takes its place
+		if err := s.repo.Delete(ctx, pending.ID); err != nil {
+			return SigningKey{}, err
+		}
+	}
+	activateAt := now.Add(s.config.SyncInterval)
+	created, err := s.create(ctx, &activateAt)
+	if err != nil {
+		return SigningKey{}, err
+	}
+	return *created, nil
+}
+
+func (s *KeyStore) activeKey(ctx context.Context) (SigningKey, error) {
+	keys, err := s.repo.List(ctx)
+	if err != nil {
+		return SigningKey{}, err
+	}
+	for _, key := range keys {
+		if key.State == KeyStateActive {
+			return key, nil
+		}
+	}
+	return SigningKey{}, ErrKeyNotFound
+}
+
+// advance moves the keys that are due to their next state, callers hold the
+// rotation lock
+func (s *KeyStore) advance(ctx context.Context) error {
+	keys, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	now := s.Now()
+	var active, pending *SigningKey
+	for i, key := range keys {
+		switch key.State {
+		case KeyStateActive:
+			active = &keys[i]
+		case KeyStatePending:
+			pending = &keys[i]
+		case KeyStateRetiring:
+			if key.RetiredAt != nil && !key.RetiredAt.Add(s.retention).After(now) {
+				if err := s.repo.Delete(ctx, key.ID); err != nil {
+					return err
+				}
+				s.log.InfoContext(ctx, "signing key retired", "kid", key.ID)
+			}
+		}
+	}
+
+	switch {
+	case active == nil:
+		// nothing signs tokens yet, no verifier can miss the new key
+		if pending == nil {
+			if pending, err = s.create(ctx, nil); err != nil {
+				return err
+			}
+		}
+		return s.promote(ctx, *pending)
+	case pending != nil:
+		activateAt := pending.CreatedAt.Add(s.config.PublishAhead)
+		if pending.ActivateAt != nil {
+			activateAt = *pending.ActivateAt
+		}
+		if !activateAt.After(now) {
+			return s.promote(ctx, *pending)
+		}
+	case active.ActivatedAt == nil || !active.ActivatedAt.Add(s.config.Interval-s.config.PublishAhead).After(now):
+		_, err := s.create(ctx, nil)
+		return err
+	}
+	return nil
+}
+
+func (s *KeyStore) create(ctx context.Context, activateAt *time.Time) (*SigningKey, error) {
+	var alg jwa.SignatureAlgorithm
+	if err := alg.Accept(s.config.Algorithm); err != nil {
+		return nil, err
+	}
+	key, err := utils.CreateJWK(alg)
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.repo.Create(ctx, SigningKey{
+		ID:         key.KeyID(),
+		Key:        key,
+		State:      KeyStatePending,
+		ActivateAt: activateAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "signing key published", "kid", created.ID, "alg", alg.String())
+	return &created, nil
+}
+
+func (s *KeyStore) promote(ctx context.Context, key SigningKey) error {
+	if err := s.repo.Promote(ctx, key.ID); err != nil {
+		return err
+	}
+	s.log.InfoContext(ctx, "signing key activated", "kid", key.ID)
+	return nil
+}
+
+// load replaces the keys in memory with the ones of the database
+func (s *KeyStore) load(ctx context.Context) error {
+	keys, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	var signingKey jwk.Key
+	publicKeySet := jwk.NewSet()
+	for _, key := range keys {
+		pubKey, err := key.Key.PublicKey()
+		if err != nil {
+			return fmt.Errorf("failed to generate public key of %s: %w", key.ID, err)
+		}
+		if err := publicKeySet.AddKey(pubKey); err != nil {
+			return err
+		}
+		if key.State == KeyStateActive {
+			signingKey = key.Key
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.signingKey = signingKey
+	s.publicKeySet = publicKeySet
+	return nil
+}
@@ -0,0 +1,255 @@
+package token
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/raystack/frontier/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type keyRepository struct {
+	keys []SigningKey
+	now  time.Time
+}
+
+func (r *keyRepository) Create(ctx context.Context, key SigningKey) (SigningKey, error) {
+	key.CreatedAt = r.now
+	r.keys = append(r.keys, key)
+	return key, nil
+}
+
+func (r *keyRepository) List(ctx context.Context) ([]SigningKey, error) {
+	return append([]SigningKey{}, r.keys...), nil
+}
+
+func (r *keyRepository) Promote(ctx context.Context, id string) error {
+	now := r.now
+	for i, key := range r.keys {
+		switch {
+		case key.ID == id:
+			r.keys[i].State = KeyStateActive
+			r.keys[i].ActivatedAt = &now
+		case key.State == KeyStateActive:
+			r.keys[i].State = KeyStateRetiring
+			r.keys[i].RetiredAt = &now
+		}
+	}
+	return nil
+}
+
+func (r *keyRepository) Delete(ctx context.Context, id string) error {
+	for i, key := range r.keys {
+		if key.ID == id {
+			r.keys = append(r.keys[:i], r.keys[i+1:]...)
+			return nil
+		}
+	}
+	return ErrKeyNotFound
+}
+
+func (r *keyRepository) states() []KeyState {
+	var states []KeyState
+	for _, key := range r.keys {
+		states = append(states, key.State)
+	}
+	return states
+}
+
+func newKeyStore(repo *keyRepository, algorithm string) *KeyStore {
+	store := NewKeyStore(slog.New(slog.NewTextHandler(io.Discard, nil)), KeyRotationConfig{
+		Enabled:      true,
+		Algorithm:    algorithm,
+		Interval:     30 * 24 * time.Hour,
+		PublishAhead: 24 * time.Hour,
+		SyncInterval: time.Minute,
+	}, repo, nil, time.Hour)
+	store.Now = func() time.Time {
+		return repo.now
+	}
+	return store
+}
+
+func TestKeyStore_advance(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should activate a key at once when there is none", func(t *testing.T) {
+		repo := &keyRepository{now: time.Now().UTC()}
+		store := newKeyStore(repo, "ES256")
+
+		assert.NoError(t, store.advance(ctx))
+		assert.NoError(t, store.load(ctx))
+
+		assert.Equal(t, []KeyState{KeyStateActive}, repo.states())
+		signingKey, ok := store.SigningKey()
+		assert.True(t, ok)
+		assert.Equal(t, jwa.ES256, utils.SigningAlgorithm(signingKey))
+		assert.Equal(t, 1, store.PublicKeySet().Len())
+	})
+
+	t.Run("should publish the next key ahead and promote it later", func(t *testing.T) {
+		repo := &keyRepository{now: time.Now().UTC()}
+		store := newKeyStore(repo, "RS256")
+		assert.NoError(t, store.advance(ctx))
+		first := repo.keys[0].ID
+
+		// nothing is due before the active key nears the end of its interval
+		repo.now = repo.now.Add(28 * 24 * time.Hour)
+		assert.NoError(t, store.advance(ctx))
+		assert.Equal(t, []KeyState{KeyStateActive}, repo.states())
+
+		repo.now = repo.now.Add(24 * time.Hour)
+		assert.NoError(t, store.advance(ctx))
+		assert.NoError(t, store.load(ctx))
+		assert.Equal(t, []KeyState{KeyStateActive, KeyStatePending}, repo.states())
+		signingKey, _ := store.SigningKey()
+		assert.Equal(t, first, signingKey.KeyID())
+		assert.Equal(t, 2, store.PublicKeySet().Len())
+
+		repo.now = repo.now.Add(24 * time.Hour)
+		assert.NoError(t, store.advance(ctx))
+		assert.NoError(t, store.load(ctx))
+		assert.Equal(t, []KeyState{KeyStateRetiring, KeyStateActive}, repo.states())
+		signingKey, _ = store.SigningKey()
+		assert.NotEqual(t, first, signingKey.KeyID())
+		assert.Equal(t, 2, store.PublicKeySet().Len())
+
+		// the replaced key is dropped once the tokens it signed expired
+		repo.now = repo.now.Add(time.Hour)
+		assert.NoError(t, store.advance(ctx))
+		assert.Equal(t, []KeyState{KeyStateActive}, repo.states())
+	})
+
+	t.Run("should replace the active key once every instance loaded the new one", func(t *testing.T) {
+		repo := &keyRepository{now: time.Now().UTC()}
+		store := newKeyStore(repo, "EdDSA")
+		assert.NoError(t, store.advance(ctx))
+		first := repo.keys[0].ID
+
+		key, err := store.replace(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, store.load(ctx))
+		assert.Equal(t, KeyStatePending, key.State)
+		assert.Equal(t, repo.now.Add(time.Minute), *key.ActivateAt)
+		assert.Equal(t, []KeyState{KeyStateActive, KeyStatePending}, repo.states())
+		signingKey, _ := store.SigningKey()
+		assert.Equal(t, first, signingKey.KeyID())
+		assert.Equal(t, 2, store.PublicKeySet().Len())
+
+		// instances syncing before the key is due keep the active key
+		repo.now = repo.now.Add(30 * time.Second)
+		assert.NoError(t, store.advance(ctx))
+		assert.Equal(t, []KeyState{KeyStateActive, KeyStatePending}, repo.states())
+
+		repo.now = repo.now.Add(30 * time.Second)
+		assert.NoError(t, store.advance(ctx))
+		assert.NoError(t, store.load(ctx))
+		assert.Equal(t, []KeyState{KeyStateRetiring, KeyStateActive}, repo.states())
+		signingKey, _ = store.SigningKey()
+		assert.Equal(t, key.ID, signingKey.KeyID())
+	})
+
+	t.Run("should replace the active key at once with a key every instance loaded", func(t *testing.T) {
+		repo := &keyRepository{now: time.Now().UTC()}
+		store := newKeyStore(repo, "EdDSA")
+		assert.NoError(t, store.advance(ctx))
+		repo.now = repo.now.Add(29 * 24 * time.Hour)
+		assert.NoError(t, store.advance(ctx))
+		pending := repo.keys[1].ID
+
+		repo.now = repo.now.Add(time.Minute)
+		key, err := store.replace(ctx)
+		assert.NoError(t, err)
+
+		assert.Equal(t, pending, key.ID)
+		assert.Equal(t, KeyStateActive, key.State)
+		assert.Equal(t, []KeyState{KeyStateRetiring, KeyStateActive}, repo.states())
+	})
+
+	t.Run("should replace a pending key not every instance loaded", func(t *testing.T) {
+		repo := &keyRepository{now: time.Now().UTC()}
+		store := newKeyStore(repo, "EdDSA")
+		assert.NoError(t, store.advance(ctx))
+		repo.now = repo.now.Add(29 * 24 * time.Hour)
+		assert.NoError(t, store.advance(ctx))
+		pending := repo.keys[1].ID
+
+		key, err := store.replace(ctx)
+		assert.NoError(t, err)
+
+		assert.NotEqual(t, pending, key.ID)
+		assert.Equal(t, []KeyState{KeyStateActive, KeyStatePending}, repo.states())
+		assert.Equal(t, key.ID, repo.keys[1].ID)
+	})
+
+	t.Run("should activate the first key at once when replaced", func(t *testing.T) {
+		repo := &keyRepository{now: time.Now().UTC()}
+		store := newKeyStore(repo, "EdDSA")
+
+		key, err := store.replace(ctx)
+		assert.NoError(t, err)
+
+		assert.Equal(t, KeyStateActive, key.State)
+		assert.Equal(t, []KeyState{KeyStateActive}, repo.states())
+	})
+
+	t.Run("should fail for an unsupported algorithm", func(t *testing.T) {
+		repo := &keyRepository{now: time.Now().UTC()}
+		store := newKeyStore(repo, "HS256")
+
+		assert.Error(t, store.advance(ctx))
+		assert.Empty(t, repo.keys)
+	})
+}
+
+func TestService_withKeyStore(t *testing.T) {
+	ctx := context.Background()
+	repo := &keyRepository{now: time.Now().UTC()}
+	store := newKeyStore(repo, "EdDSA")
+	assert.NoError(t, store.advance(ctx))
+	assert.NoError(t, store.load(ctx))
+	svc := NewServiceWithKeys(store, "frontier", time.Hour, nil)
+
+	signed, err := svc.Build("subject", map[string]string{})
+	assert.NoError(t, err)
+
+	// tokens signed by a replaced key stay valid
+	_, err = store.replace(ctx)
+	assert.NoError(t, err)
+	repo.now = repo.now.Add(time.Minute)
+	assert.NoError(t, store.advance(ctx))
+	assert.NoError(t, store.load(ctx))
+	newKey, _ := store.SigningKey()
+	assert.NotEqual(t, repo.keys[0].ID, newKey.KeyID())
+	subject, _, err := svc.Parse(ctx, signed)
+	assert.NoError(t, err)
+	assert.Equal(t, "subject", subject)
+}
+
+func TestService_Parse_withoutJwtID(t *testing.T) {
+	ctx := context.Background()
+	repo := &keyRepository{now: time.Now().UTC()}
+	store := newKeyStore(repo, "EdDSA")
+	assert.NoError(t, store.advance(ctx))
+	assert.NoError(t, store.load(ctx))
+	svc := NewServiceWithKeys(store, "frontier", time.Hour, nil)
+
+	// tokens built before the jti claim was added stay valid
+	tok, err := jwt.NewBuilder().
+		Subject("subject").
+		IssuedAt(time.Now().UTC()).
+		Expiration(time.Now().UTC().Add(time.Hour)).
+		Build()
+	assert.NoError(t, err)
+	signed, err := svc.Sign(tok)
+	assert.NoError(t, err)
+
+	subject, _, err := svc.Parse(ctx, signed)
+	assert.NoError(t, err)
+	assert.Equal(t, "subject", subject)
+}
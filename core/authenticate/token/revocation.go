@@ -0,0 +1,239 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/robfig/cron/v3"
+)
+
+var ErrTokenRevoked = errors.New("token is revoked")
+
+const (
+	// RevokedTokenKind matches a single token by its jti claim
+	RevokedTokenKind = "token"
+	// RevokedSessionKind matches the tokens carrying the session in the sid claim
+	RevokedSessionKind = "session"
+	// RevokedSubjectKind matches the tokens of a principal, by the sub or the
+	// user_id claim
+	RevokedSubjectKind = "subject"
+
+	cleanupTime = "0 * * * *" // every hour
+	// syncOverlap is how far back a sync looks past the previous one, it
+	// covers revocations committed late or by instances with a skewed clock
+	syncOverlap = time.Minute
+)
+
+// Revocation invalidates the access tokens it matches that were issued
+// before RevokedAt
+type Revocation struct {
+	Kind      string
+	Value     string
+	RevokedAt time.Time
+	// ExpiresAt is when the last token the revocation matches expires, the
+	// revocation can be forgotten after it
+	ExpiresAt time.Time
+}
+
+type RevocationRepository interface {
+	// Upsert records the revocation, a later revocation of the same value
+	// replaces the earlier one
+	Upsert(ctx context.Context, revocation Revocation) error
+	// List returns the revocations not expired yet that were made after since
+	List(ctx context.Context, since time.Time) ([]Revocation, error)
+	DeleteExpired(ctx context.Context) error
+}
+
+// RevocationStore keeps the revocations of access tokens in memory, so
+// parsing a token doesn't hit the database. Revocations made by this
+// instance apply at once, the ones of other instances once synced.
+type RevocationStore struct {
+	log          *slog.Logger
+	repo         RevocationRepository
+	validity     time.Duration
+	syncInterval time.Duration
+	cron         *cron.Cron
+
+	mu          sync.RWMutex
+	revocations map[string]Revocation
+	syncedAt    time.Time
+	Now         func() time.Time
+}
+
+// NewRevocationStore creates a store for tokens valid for validity, the
+// revocations of other instances are synced every syncInterval
+func NewRevocationStore(logger *slog.Logger, repo RevocationRepository, validity, syncInterval time.Duration) *RevocationStore {
+	return &RevocationStore{
+		log:          logger,
+		repo:         repo,
+		validity:     validity,
+		syncInterval: syncInterval,
+		revocations:  map[string]Revocation{},
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
+	}
+}
+
+// Init loads the revocations and schedules syncing them
+func (s *RevocationStore) Init(ctx context.Context) error {
+	if err := s.sync(ctx); err != nil {
+		return fmt.Errorf("failed to load token revocations: %w", err)
+	}
+	s.cron = cron.New(cron.WithChain(
+		cron.SkipIfStillRunning(cron.DefaultLogger),
+		cron.Recover(cron.DefaultLogger),
+	))
+	if _, err := s.cron.AddFunc(fmt.Sprintf("@every %s", s.syncInterval), func() {
+		if err := s.sync(ctx); err != nil {
+			s.log.WarnContext(ctx, "failed to sync token revocations", "err", err)
+		}
+	}); err != nil {
+		return err
+	}
+	if _, err := s.cron.AddFunc(cleanupTime, func() {
+		if err := s.repo.DeleteExpired(ctx); err != nil {
+			s.log.WarnContext(ctx, "failed to delete expired token revocations", "err", err)
+		}
+	}); err != nil {
+		return err
+	}
+	s.cron.Start()
+	return nil
+}
+
+func (s *RevocationStore) Close() error {
+	if s.cron != nil {
+		<-s.cron.Stop().Done()
+	}
+	return nil
+}
+
+// RevokeToken revokes a single token until it expires
+func (s *RevocationStore) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	return s.revoke(ctx, Revocation{
+		Kind:      RevokedTokenKind,
+		Value:     jti,
+		RevokedAt: s.Now(),
+		ExpiresAt: expiresAt,
+	})
+}
+
+// RevokeSession revokes the tokens issued for the session so far
+func (s *RevocationStore) RevokeSession(ctx context.Context, sessionID string) error {
+	now := s.Now()
+	return s.revoke(ctx, Revocation{
+		Kind:      RevokedSessionKind,
+		Value:     sessionID,
+		RevokedAt: now,
+		ExpiresAt: now.Add(s.validity),
+	})
+}
+
+// RevokeSubject revokes the tokens issued for the principal so far, tokens
+// issued later are valid
+func (s *RevocationStore) RevokeSubject(ctx context.Context, subjectID string) error {
+	now := s.Now()
+	return s.revoke(ctx, Revocation{
+		Kind:      RevokedSubjectKind,
+		Value:     subjectID,
+		RevokedAt: now,
+		ExpiresAt: now.Add(s.validity),
+	})
+}
+
+// IsRevoked reports whether a revocation matches the token
+func (s *RevocationStore) IsRevoked(tok jwt.Token) bool {
+	issuedAt := tok.IssuedAt()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	matches := func(kind, value string) bool {
+		if value == "" {
+			return false
+		}
+		revocation, ok := s.revocations[revocationKey(kind, value)]
+		// iat has a precision of seconds, a token of the same second as the
+		// revocation is taken as issued after it so the session logged in
+		// right after a revocation keeps its token
+		return ok && issuedAt.Before(revocation.RevokedAt.Truncate(time.Second))
+	}
+
+	// a token revoked by its id is revoked whenever it was issued
+	if jwtID := tok.JwtID(); jwtID != "" {
+		if _, ok := s.revocations[revocationKey(RevokedTokenKind, jwtID)]; ok {
+			return true
+		}
+	}
+	if matches(RevokedSubjectKind, tok.Subject()) {
+		return true
+	}
+	if sessionID, ok := tok.Get(SessionIDClaimKey); ok {
+		if value, _ := sessionID.(string); matches(RevokedSessionKind, value) {
+			return true
+		}
+	}
+	if userID, ok := tok.Get(UserIDClaimKey); ok {
+		if value, _ := userID.(string); matches(RevokedSubjectKind, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *RevocationStore) revoke(ctx context.Context, revocation Revocation) error {
+	if err := s.repo.Upsert(ctx, revocation); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.add(revocation)
+	return nil
+}
+
+// sync fetches the revocations made since the last sync and forgets the
+// expired ones
+func (s *RevocationStore) sync(ctx context.Context) error {
+	now := s.Now()
+	s.mu.RLock()
+	since := s.syncedAt
+	s.mu.RUnlock()
+	if !since.IsZero() {
+		since = since.Add(-syncOverlap)
+	}
+
+	revocations, err := s.repo.List(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, revocation := range revocations {
+		s.add(revocation)
+	}
+	for key, revocation := range s.revocations {
+		if !revocation.ExpiresAt.After(now) {
+			delete(s.revocations, key)
+		}
+	}
+	s.syncedAt = now
+	return nil
+}
+
+// add keeps the latest revocation of a value, callers hold the lock
+func (s *RevocationStore) add(revocation Revocation) {
+	key := revocationKey(revocation.Kind, revocation.Value)
+	if existing, ok := s.revocations[key]; ok && existing.RevokedAt.After(revocation.RevokedAt) {
+		return
+	}
+	s.revocations[key] = revocation
+}
+
+func revocationKey(kind, value string) string {
+	return kind + ":" + value
+}
@@ -0,0 +1,151 @@
+package token_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/raystack/frontier/core/authenticate/token"
+	"github.com/stretchr/testify/assert"
+)
+
+type revocationRepository struct {
+	revocations []token.Revocation
+}
+
+func (r *revocationRepository) Upsert(ctx context.Context, revocation token.Revocation) error {
+	r.revocations = append(r.revocations, revocation)
+	return nil
+}
+
+func (r *revocationRepository) List(ctx context.Context, since time.Time) ([]token.Revocation, error) {
+	var revocations []token.Revocation
+	for _, revocation := range r.revocations {
+		if !revocation.RevokedAt.Before(since) {
+			revocations = append(revocations, revocation)
+		}
+	}
+	return revocations, nil
+}
+
+func (r *revocationRepository) DeleteExpired(ctx context.Context) error {
+	return nil
+}
+
+func buildToken(t *testing.T, issuedAt time.Time, claims map[string]string) jwt.Token {
+	t.Helper()
+	builder := jwt.NewBuilder().
+		JwtID("token-id").
+		Subject("subject-id").
+		IssuedAt(issuedAt).
+		Expiration(issuedAt.Add(time.Hour))
+	for key, value := range claims {
+		builder = builder.Claim(key, value)
+	}
+	tok, err := builder.Build()
+	assert.NoError(t, err)
+	return tok
+}
+
+func TestRevocationStore_IsRevoked(t *testing.T) {
+	ctx := context.Background()
+	issuedAt := time.Now().UTC().Add(-time.Minute).Truncate(time.Second)
+
+	tests := []struct {
+		name   string
+		revoke func(store *token.RevocationStore) error
+		token  jwt.Token
+		want   bool
+	}{
+		{
+			name:   "should not match a token without revocations",
+			revoke: func(store *token.RevocationStore) error { return nil },
+			token:  buildToken(t, issuedAt, nil),
+			want:   false,
+		},
+		{
+			name: "should match a token revoked by its id",
+			revoke: func(store *token.RevocationStore) error {
+				return store.RevokeToken(ctx, "token-id", issuedAt.Add(time.Hour))
+			},
+			token: buildToken(t, issuedAt, nil),
+			want:  true,
+		},
+		{
+			name: "should match a token of a revoked session",
+			revoke: func(store *token.RevocationStore) error {
+				return store.RevokeSession(ctx, "session-id")
+			},
+			token: buildToken(t, issuedAt, map[string]string{token.SessionIDClaimKey: "session-id"}),
+			want:  true,
+		},
+		{
+			name: "should match a token of a revoked user",
+			revoke: func(store *token.RevocationStore) error {
+				return store.RevokeSubject(ctx, "user-id")
+			},
+			token: buildToken(t, issuedAt, map[string]string{token.UserIDClaimKey: "user-id"}),
+			want:  true,
+		},
+		{
+			name: "should not match a token issued after the revocation",
+			revoke: func(store *token.RevocationStore) error {
+				return store.RevokeSubject(ctx, "subject-id")
+			},
+			token: buildToken(t, time.Now().UTC().Add(time.Minute), nil),
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := token.NewRevocationStore(slog.New(slog.NewTextHandler(io.Discard, nil)),
+				&revocationRepository{}, time.Hour, time.Minute)
+			assert.NoError(t, tt.revoke(store))
+			assert.Equal(t, tt.want, store.IsRevoked(tt.token))
+		})
+	}
+}
+
+func TestRevocationStore_IsRevoked_SameSecond(t *testing.T) {
+	store := token.NewRevocationStore(slog.New(slog.NewTextHandler(io.Discard, nil)),
+		&revocationRepository{}, time.Hour, time.Minute)
+	assert.NoError(t, store.RevokeSession(context.Background(), "session-id"))
+
+	// the token of a login right after the revocation has the same iat second
+	issuedAt := time.Now().UTC().Truncate(time.Second)
+	assert.False(t, store.IsRevoked(buildToken(t, issuedAt, map[string]string{token.SessionIDClaimKey: "session-id"})))
+	assert.True(t, store.IsRevoked(buildToken(t, issuedAt.Add(-time.Second), map[string]string{token.SessionIDClaimKey: "session-id"})))
+}
+
+func TestRevocationStore_Init(t *testing.T) {
+	t.Run("should load the revocations made by other instances", func(t *testing.T) {
+		now := time.Now().UTC()
+		repo := &revocationRepository{
+			revocations: []token.Revocation{
+				{
+					Kind:      token.RevokedSessionKind,
+					Value:     "session-id",
+					RevokedAt: now,
+					ExpiresAt: now.Add(time.Hour),
+				},
+				{
+					Kind:      token.RevokedTokenKind,
+					Value:     "token-id",
+					RevokedAt: now,
+					ExpiresAt: now.Add(-time.Minute),
+				},
+			},
+		}
+		store := token.NewRevocationStore(slog.New(slog.NewTextHandler(io.Discard, nil)), repo, time.Hour, time.Minute)
+		assert.NoError(t, store.Init(context.Background()))
+		defer store.Close()
+
+		issuedAt := now.Add(-time.Minute)
+		assert.True(t, store.IsRevoked(buildToken(t, issuedAt, map[string]string{token.SessionIDClaimKey: "session-id"})))
+		// expired revocations are dropped
+		assert.False(t, store.IsRevoked(buildToken(t, issuedAt, nil)))
+	})
+}
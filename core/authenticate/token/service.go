@@ -15,6 +15,7 @@ import (
 var (
 	ErrMissingRSADisableToken = errors.New("rsa key missing in config, generate and pass file path")
 	ErrInvalidToken           = errors.New("failed to verify a valid token")
+	ErrRevocationDisabled     = errors.New("token revocation is not configured")
 )
 
 const (
@@ -28,67 +29,130 @@ const (
 	AuthViaClaimKey     = "auth_via"
 )
 
+// Revoker tracks the access tokens revoked before their expiry
+type Revoker interface {
+	IsRevoked(tok jwt.Token) bool
+	RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error
+}
+
 type Service struct {
-	keySet       jwk.Set
-	publicKeySet jwk.Set
-	issuer       string
-	validity     time.Duration
+	keys        KeySource
+	issuer      string
+	validity    time.Duration
+	revocations Revoker
 }
 
 // NewService creates a new token service
 // generate keys used for rsa via frontier cli "frontier server keygen"
-func NewService(keySet jwk.Set, issuer string, validity time.Duration) Service {
-	publicKeySet := jwk.NewSet()
+// revocations is consulted when parsing tokens, it can be nil
+func NewService(keySet jwk.Set, issuer string, validity time.Duration, revocations Revoker) Service {
+	var keys KeySource
 	if keySet != nil {
 		pub, err := utils.GetPublicKeySet(context.Background(), keySet)
 		if err != nil {
 			panic(err)
 		}
-		publicKeySet = pub
+		keys = staticKeys{
+			keySet:       keySet,
+			publicKeySet: pub,
+		}
 	}
+	return NewServiceWithKeys(keys, issuer, validity, revocations)
+}
 
+// NewServiceWithKeys creates a token service with keys that can change
+// over time, like the ones of a KeyStore
+func NewServiceWithKeys(keys KeySource, issuer string, validity time.Duration, revocations Revoker) Service {
 	return Service{
-		keySet:       keySet,
-		issuer:       issuer,
-		publicKeySet: publicKeySet,
-		validity:     validity,
+		keys:        keys,
+		issuer:      issuer,
+		validity:    validity,
+		revocations: revocations,
 	}
 }
 
 // GetPublicKeySet returns the public keys to verify the access token
 func (s Service) GetPublicKeySet() jwk.Set {
-	return s.publicKeySet
+	if s.keys == nil {
+		return jwk.NewSet()
+	}
+	return s.keys.PublicKeySet()
 }
 
 // Build creates an access token for the given subjectID
 func (s Service) Build(subjectID string, metadata map[string]string) ([]byte, error) {
-	if s.keySet == nil {
+	if s.keys == nil {
 		return nil, ErrMissingRSADisableToken
 	}
-	// use first key to sign token
-	rsaKey, ok := s.keySet.Key(0)
+	signingKey, ok := s.keys.SigningKey()
 	if !ok {
-		return nil, errors.New("missing rsa key to generate token")
+		return nil, errors.New("missing key to generate token")
 	}
 
 	// frontier generated token has an extra custom claim
 	// used to identify which public key to use to verify the token
 	metadata[GeneratedClaimKey] = GeneratedClaimValue
-	return utils.BuildToken(rsaKey, s.issuer, subjectID, s.validity, metadata)
+	return utils.BuildToken(signingKey, s.issuer, subjectID, s.validity, metadata)
+}
+
+// Sign signs a token built by the caller with the current signing key, the
+// caller owns every claim of the token
+func (s Service) Sign(tok jwt.Token) ([]byte, error) {
+	if s.keys == nil {
+		return nil, ErrMissingRSADisableToken
+	}
+	signingKey, ok := s.keys.SigningKey()
+	if !ok {
+		return nil, errors.New("missing key to generate token")
+	}
+	return jwt.Sign(tok, jwt.WithKey(utils.SigningAlgorithm(signingKey), signingKey))
+}
+
+// Verify checks the signature and validity of a token signed by Sign or
+// Build, options add checks like the expected issuer
+func (s Service) Verify(ctx context.Context, userToken []byte, options ...jwt.ParseOption) (jwt.Token, error) {
+	if s.keys == nil {
+		return nil, ErrMissingRSADisableToken
+	}
+	verifiedToken, err := jwt.Parse(userToken, append([]jwt.ParseOption{jwt.WithKeySet(s.keys.PublicKeySet())}, options...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidToken)
+	}
+	return verifiedToken, nil
 }
 
 func (s Service) Parse(ctx context.Context, userToken []byte) (string, map[string]any, error) {
-	if s.keySet == nil {
+	if s.keys == nil {
 		return "", nil, ErrMissingRSADisableToken
 	}
-	// verify token with jwks
-	verifiedToken, err := jwt.Parse(userToken, jwt.WithKeySet(s.publicKeySet))
+	// verify token with jwks, tokens built before the jti was added have none
+	// and are only revoked along with their session or subject
+	verifiedToken, err := jwt.Parse(userToken, jwt.WithKeySet(s.keys.PublicKeySet()))
 	if err != nil {
 		return "", nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidToken)
 	}
+	if s.revocations != nil && s.revocations.IsRevoked(verifiedToken) {
+		return "", nil, fmt.Errorf("%w: %w", ErrTokenRevoked, ErrInvalidToken)
+	}
 	tokenClaims, err := verifiedToken.AsMap(ctx)
 	if err != nil {
 		return "", nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidToken)
 	}
 	return verifiedToken.Subject(), tokenClaims, nil
 }
+
+// Revoke invalidates a token built by Build before its expiry
+func (s Service) Revoke(ctx context.Context, userToken []byte) error {
+	if s.revocations == nil {
+		return ErrRevocationDisabled
+	}
+	if s.keys == nil {
+		return ErrMissingRSADisableToken
+	}
+	verifiedToken, err := jwt.Parse(userToken, jwt.WithKeySet(s.keys.PublicKeySet()),
+		jwt.WithRequiredClaim(jwt.JwtIDKey))
+	if err != nil {
+		return fmt.Errorf("%s: %w", err.Error(), ErrInvalidToken)
+	}
+	return s.revocations.RevokeToken(ctx, verifiedToken.JwtID(), verifiedToken.Expiration())
+}
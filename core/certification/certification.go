@@ -0,0 +1,103 @@
+package certification
+
+import (
+	"context"
+	"time"
+)
+
+// CSVContentType is the content type of the exported evidence
+const CSVContentType = "text/csv"
+
+type State string
+
+const (
+	Active    State = "active"
+	Completed State = "completed"
+	Cancelled State = "cancelled"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+type Decision string
+
+const (
+	Pending   Decision = "pending"
+	Certified Decision = "certified"
+	Revoked   Decision = "revoked"
+	// Uncertified bindings weren't decided by the deadline and were kept
+	Uncertified Decision = "uncertified"
+	// AutoRevoked bindings weren't decided by the deadline and were revoked
+	AutoRevoked Decision = "auto_revoked"
+)
+
+func (d Decision) String() string {
+	return string(d)
+}
+
+type Repository interface {
+	// CreateCampaign stores the campaign with the items to review
+	CreateCampaign(ctx context.Context, campaign Campaign, items []Item) (Campaign, error)
+	GetCampaign(ctx context.Context, id string) (Campaign, error)
+	ListCampaigns(ctx context.Context, flt Filter) ([]Campaign, error)
+	// UpdateCampaign updates the state and the reminder and completion times
+	// of an active campaign, it returns ErrNotActive if the campaign ended
+	UpdateCampaign(ctx context.Context, campaign Campaign) (Campaign, error)
+	GetItem(ctx context.Context, id string) (Item, error)
+	ListItems(ctx context.Context, flt ItemFilter) ([]Item, error)
+	// DecideItem records the decision of a pending item, it returns
+	// ErrAlreadyDecided if the item was decided
+	DecideItem(ctx context.Context, item Item) (Item, error)
+}
+
+// Campaign is a periodic review of the policies of an organization, the
+// users managing the policies of a resource certify or revoke its bindings
+// till the deadline
+type Campaign struct {
+	ID    string
+	OrgID string
+	Name  string
+	// ProjectIDs and RoleIDs narrow the policies under review, the policies
+	// of the organization, its projects and groups are reviewed when empty
+	ProjectIDs []string
+	RoleIDs    []string
+	Deadline   time.Time
+	// AutoRevoke deletes the policies not certified by the deadline
+	AutoRevoke  bool
+	State       State
+	CreatedBy   string
+	RemindedAt  time.Time
+	CompletedAt time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Item is a policy under review in a campaign, the policy is copied when
+// the campaign starts so the evidence outlives revoked policies
+type Item struct {
+	ID            string
+	CampaignID    string
+	PolicyID      string
+	ResourceID    string
+	ResourceType  string
+	RoleID        string
+	PrincipalID   string
+	PrincipalType string
+	Decision      Decision
+	ReviewerID    string
+	Comment       string
+	DecidedAt     time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type Filter struct {
+	OrgID string
+	State State
+}
+
+type ItemFilter struct {
+	CampaignID string
+	Decision   Decision
+}
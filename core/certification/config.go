@@ -0,0 +1,18 @@
+package certification
+
+import "time"
+
+type Config struct {
+	// Enabled runs the job mailing reminders to reviewers and closing the
+	// campaigns past their deadline
+	Enabled  bool   `yaml:"enabled" mapstructure:"enabled" default:"true"`
+	Schedule string `yaml:"schedule" mapstructure:"schedule" default:"@every 1h"`
+	// ReminderInterval is how often reviewers with pending policies are
+	// reminded of an active campaign
+	ReminderInterval time.Duration `yaml:"reminder_interval" mapstructure:"reminder_interval" default:"72h"`
+	// ReviewURL is linked in the reminders, e.g. the review page of the
+	// admin console
+	ReviewURL string `yaml:"review_url" mapstructure:"review_url"`
+	Subject   string `yaml:"subject" mapstructure:"subject"`
+	Body      string `yaml:"body" mapstructure:"body"`
+}
@@ -0,0 +1,16 @@
+package certification
+
+import "errors"
+
+var (
+	ErrNotExist       = errors.New("certification campaign or item doesn't exist")
+	ErrInvalidID      = errors.New("certification campaign or item id is invalid")
+	ErrInvalidDetail  = errors.New("certification campaign needs an organization, a name and a deadline in the future")
+	ErrInvalidScope   = errors.New("certification campaign projects must belong to its organization")
+	ErrNoBindings     = errors.New("certification campaign has no policies to review")
+	ErrNotActive      = errors.New("certification campaign has ended")
+	ErrAlreadyDecided = errors.New("policy is already certified or revoked in the campaign")
+	ErrSelfReview     = errors.New("policy can't be certified by its own principal")
+	ErrNotManager     = errors.New("user can't manage certification campaigns of the organization")
+	ErrNotReviewer    = errors.New("user can't review the policies of the resource")
+)
@@ -0,0 +1,61 @@
+package certification
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+const csvTimeLayout = "2006-01-02 15:04:05.000 MST"
+
+// Export returns the decisions of every policy in the campaign as csv
+// evidence of the review, it is exported to the managers of the organization
+func (s *Service) Export(ctx context.Context, id, userID string) (io.Reader, string, error) {
+	campaign, err := s.repository.GetCampaign(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.authorizeManager(ctx, campaign.OrgID, userID); err != nil {
+		return nil, "", err
+	}
+	items, err := s.repository.ListItems(ctx, ItemFilter{CampaignID: campaign.ID})
+	if err != nil {
+		return nil, "", err
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write([]string{
+		"Campaign ID", "Campaign Name", "Organization ID", "Deadline", "Auto Revoke", "Item ID", "Policy ID",
+		"Resource Type", "Resource ID", "Role ID", "Principal Type", "Principal ID", "Decision", "Reviewer ID",
+		"Comment", "Decided At",
+	}); err != nil {
+		return nil, "", fmt.Errorf("error writing CSV headers: %w", err)
+	}
+	for _, item := range items {
+		if err := writer.Write([]string{
+			campaign.ID, campaign.Name, campaign.OrgID, formatTime(campaign.Deadline),
+			strconv.FormatBool(campaign.AutoRevoke), item.ID, item.PolicyID, item.ResourceType, item.ResourceID,
+			item.RoleID, item.PrincipalType, item.PrincipalID, item.Decision.String(), item.ReviewerID,
+			item.Comment, formatTime(item.DecidedAt),
+		}); err != nil {
+			return nil, "", fmt.Errorf("error writing CSV row: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, "", fmt.Errorf("error flushing CSV writer: %w", err)
+	}
+	return &buf, CSVContentType, nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(csvTimeLayout)
+}
@@ -0,0 +1,267 @@
+package certification
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"sort"
+	texttemplate "text/template"
+
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	pkgauditrecord "github.com/raystack/frontier/pkg/auditrecord"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/robfig/cron/v3"
+	mail "gopkg.in/mail.v2"
+)
+
+const (
+	defaultSubject = `Access review "{{.Campaign.Name}}" needs your decision`
+	defaultBody    = `{{if .User.Title}}Hi {{.User.Title}},{{else}}Hi,{{end}}<br><br><b>{{.Pending}}</b> {{if eq .Pending 1}}policy{{else}}policies{{end}} of organization <b>{{.Org.Title}}</b> you manage {{if eq .Pending 1}}waits{{else}}wait{{end}} for your review in the access review <b>{{.Campaign.Name}}</b>.<br><br>Please certify or revoke them by <b>{{.Deadline}}</b>.{{if .Campaign.AutoRevoke}} Policies not certified by then are revoked.{{end}}{{if .ReviewURL}}<br><br><a href="{{.ReviewURL}}">Review the policies</a>{{end}}`
+)
+
+// Init schedules the job reminding reviewers and closing the campaigns
+// past their deadline
+func (s *Service) Init(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	s.cron = cron.New(cron.WithChain(
+		cron.SkipIfStillRunning(cron.DefaultLogger),
+		cron.Recover(cron.DefaultLogger),
+	))
+	if _, err := s.cron.AddFunc(s.config.Schedule, func() {
+		if err := s.Run(ctx); err != nil {
+			s.log.ErrorContext(ctx, "certification campaign run failed", "err", err)
+		}
+	}); err != nil {
+		return fmt.Errorf("failed to schedule certification campaigns: %w", err)
+	}
+	s.cron.Start()
+	return nil
+}
+
+func (s *Service) Close() error {
+	if s.cron != nil {
+		<-s.cron.Stop().Done()
+	}
+	return nil
+}
+
+// Run completes the active campaigns past their deadline and reminds the
+// reviewers of the others, one instance runs it at a time
+func (s *Service) Run(ctx context.Context) error {
+	lock, err := s.locker.TryLock(ctx, lockKey)
+	if err != nil {
+		if errors.Is(err, db.ErrLockBusy) {
+			return nil
+		}
+		return err
+	}
+	defer func() {
+		if err := lock.Unlock(ctx); err != nil {
+			s.log.ErrorContext(ctx, "failed to unlock certification campaigns", "err", err)
+		}
+	}()
+	return s.process(ctx)
+}
+
+// process completes or reminds each of the active campaigns
+func (s *Service) process(ctx context.Context) error {
+	campaigns, err := s.repository.ListCampaigns(ctx, Filter{State: Active})
+	if err != nil {
+		return fmt.Errorf("list active campaigns: %w", err)
+	}
+	var errs []error
+	now := s.Now()
+	for _, campaign := range campaigns {
+		switch {
+		case !now.Before(campaign.Deadline):
+			if err := s.complete(ctx, campaign); err != nil {
+				errs = append(errs, fmt.Errorf("complete campaign %s: %w", campaign.ID, err))
+			}
+		case campaign.RemindedAt.IsZero() || now.Sub(campaign.RemindedAt) >= s.config.ReminderInterval:
+			if err := s.remind(ctx, campaign); err != nil {
+				errs = append(errs, fmt.Errorf("remind campaign %s: %w", campaign.ID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// complete closes the pending items of the campaign, revoking their
+// policies if the campaign auto revokes, and completes it
+func (s *Service) complete(ctx context.Context, campaign Campaign) error {
+	items, err := s.repository.ListItems(ctx, ItemFilter{CampaignID: campaign.ID, Decision: Pending})
+	if err != nil {
+		return err
+	}
+	decision := Uncertified
+	if campaign.AutoRevoke {
+		decision = AutoRevoked
+	}
+	for _, item := range items {
+		if campaign.AutoRevoke {
+			if err := s.revokePolicy(ctx, item.PolicyID); err != nil {
+				return err
+			}
+		}
+		item.Decision = decision
+		item.DecidedAt = s.Now()
+		if _, err := s.repository.DecideItem(ctx, item); err != nil && !errors.Is(err, ErrAlreadyDecided) {
+			return err
+		}
+	}
+
+	campaign.State = Completed
+	campaign.CompletedAt = s.Now()
+	completed, err := s.repository.UpdateCampaign(ctx, campaign)
+	if err != nil {
+		return err
+	}
+	s.audit(ctx, pkgauditrecord.CertificationCompletedEvent, completed, map[string]any{
+		decision.String(): len(items),
+	})
+	s.log.InfoContext(ctx, "completed certification campaign", "campaign_id", campaign.ID,
+		"org_id", campaign.OrgID, decision.String(), len(items))
+	return nil
+}
+
+// remind mails the reviewers of the resources with pending items
+func (s *Service) remind(ctx context.Context, campaign Campaign) error {
+	items, err := s.repository.ListItems(ctx, ItemFilter{CampaignID: campaign.ID, Decision: Pending})
+	if err != nil {
+		return err
+	}
+	pending := map[string]int{}
+	resources := map[relation.Object]int{}
+	for _, item := range items {
+		resources[relation.Object{ID: item.ResourceID, Namespace: item.ResourceType}]++
+	}
+	for object, count := range resources {
+		permission := schema.PolicyManagePermission
+		if object.Namespace == schema.GroupNamespace {
+			permission = group.AdminPermission
+		}
+		reviewers, err := s.relationService.LookupSubjects(ctx, relation.Relation{
+			Object:       object,
+			Subject:      relation.Subject{Namespace: schema.UserPrincipal},
+			RelationName: permission,
+		})
+		if err != nil {
+			return err
+		}
+		for _, reviewerID := range reviewers {
+			pending[reviewerID] += count
+		}
+	}
+
+	if len(pending) > 0 {
+		org, err := s.orgService.Get(ctx, campaign.OrgID)
+		if err != nil {
+			return err
+		}
+		reviewerIDs := make([]string, 0, len(pending))
+		for id := range pending {
+			reviewerIDs = append(reviewerIDs, id)
+		}
+		sort.Strings(reviewerIDs)
+		for _, reviewerID := range reviewerIDs {
+			if err := s.sendReminder(ctx, campaign, org, reviewerID, pending[reviewerID]); err != nil {
+				s.log.ErrorContext(ctx, "failed to send certification reminder", "campaign_id", campaign.ID,
+					"user_id", reviewerID, "err", err)
+			}
+		}
+	}
+
+	campaign.RemindedAt = s.Now()
+	_, err = s.repository.UpdateCampaign(ctx, campaign)
+	return err
+}
+
+type mailTemplateData struct {
+	Campaign  Campaign
+	Org       organization.Organization
+	User      user.User
+	Pending   int
+	Deadline  string
+	ReviewURL string
+}
+
+func (s *Service) sendReminder(ctx context.Context, campaign Campaign, org organization.Organization,
+	reviewerID string, pending int) error {
+	usr, err := s.userService.GetByID(ctx, reviewerID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	data := mailTemplateData{
+		Campaign:  campaign,
+		Org:       org,
+		User:      usr,
+		Pending:   pending,
+		Deadline:  campaign.Deadline.UTC().Format("January 2, 2006 at 3:04 PM UTC"),
+		ReviewURL: s.config.ReviewURL,
+	}
+
+	subjectTpl := s.config.Subject
+	if subjectTpl == "" {
+		subjectTpl = defaultSubject
+	}
+	bodyTpl := s.config.Body
+	if bodyTpl == "" {
+		bodyTpl = defaultBody
+	}
+	subject, err := renderTextTemplate(subjectTpl, data)
+	if err != nil {
+		return fmt.Errorf("failed to render subject: %w", err)
+	}
+	body, err := renderHTMLTemplate(bodyTpl, data)
+	if err != nil {
+		return fmt.Errorf("failed to render body: %w", err)
+	}
+
+	msg := mail.NewMessage()
+	msg.SetHeader("From", s.dialer.FromHeader())
+	msg.SetHeader("To", usr.Email)
+	msg.SetHeader("Subject", subject)
+	msg.SetBody("text/html", body)
+	if err := s.dialer.DialAndSend(msg); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	s.audit(ctx, pkgauditrecord.CertificationReminderEvent, campaign, map[string]any{
+		"user_id":    usr.ID,
+		"user_email": usr.Email,
+		"pending":    pending,
+	})
+	return nil
+}
+
+func renderTextTemplate(tpl string, data mailTemplateData) (string, error) {
+	t, err := texttemplate.New("subject").Parse(tpl)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func renderHTMLTemplate(tpl string, data mailTemplateData) (string, error) {
+	t, err := htmltemplate.New("body").Parse(tpl)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,404 @@
+package certification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	auditmodels "github.com/raystack/frontier/core/auditrecord/models"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	pkgauditrecord "github.com/raystack/frontier/pkg/auditrecord"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/raystack/frontier/pkg/mailer"
+	"github.com/robfig/cron/v3"
+)
+
+const lockKey = "certification-campaigns"
+
+type PolicyService interface {
+	List(ctx context.Context, flt policy.Filter) ([]policy.Policy, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type RelationService interface {
+	CheckPermission(ctx context.Context, rel relation.Relation) (bool, error)
+	LookupSubjects(ctx context.Context, rel relation.Relation) ([]string, error)
+}
+
+type OrgService interface {
+	Get(ctx context.Context, idOrName string) (organization.Organization, error)
+}
+
+type ProjectService interface {
+	Get(ctx context.Context, idOrName string) (project.Project, error)
+	List(ctx context.Context, flt project.Filter) ([]project.Project, error)
+}
+
+type GroupService interface {
+	ListByOrganization(ctx context.Context, id string) ([]group.Group, error)
+}
+
+type UserService interface {
+	GetByID(ctx context.Context, id string) (user.User, error)
+}
+
+type AuditRecordRepository interface {
+	Create(ctx context.Context, auditRecord auditmodels.AuditRecord) (auditmodels.AuditRecord, error)
+}
+
+// Locker acquires distributed locks via Postgres advisory locks
+type Locker interface {
+	TryLock(ctx context.Context, id string) (*db.Lock, error)
+}
+
+type Service struct {
+	log                   *slog.Logger
+	config                Config
+	repository            Repository
+	policyService         PolicyService
+	relationService       RelationService
+	orgService            OrgService
+	projectService        ProjectService
+	groupService          GroupService
+	userService           UserService
+	dialer                mailer.Dialer
+	locker                Locker
+	auditRecordRepository AuditRecordRepository
+	cron                  *cron.Cron
+
+	Now func() time.Time
+}
+
+func NewService(logger *slog.Logger, config Config, repository Repository, policyService PolicyService,
+	relationService RelationService, orgService OrgService, projectService ProjectService,
+	groupService GroupService, userService UserService, dialer mailer.Dialer, locker Locker,
+	auditRecordRepository AuditRecordRepository) *Service {
+	return &Service{
+		log:                   logger,
+		config:                config,
+		repository:            repository,
+		policyService:         policyService,
+		relationService:       relationService,
+		orgService:            orgService,
+		projectService:        projectService,
+		groupService:          groupService,
+		userService:           userService,
+		dialer:                dialer,
+		locker:                locker,
+		auditRecordRepository: auditRecordRepository,
+		Now:                   func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Create starts a campaign reviewing the policies in its scope as they are
+// now, it can be started by the users managing the policies of the
+// organization
+func (s *Service) Create(ctx context.Context, campaign Campaign) (Campaign, error) {
+	campaign.Name = strings.TrimSpace(campaign.Name)
+	if campaign.OrgID == "" || campaign.Name == "" || !campaign.Deadline.After(s.Now()) {
+		return Campaign{}, ErrInvalidDetail
+	}
+	org, err := s.orgService.Get(ctx, campaign.OrgID)
+	if err != nil {
+		return Campaign{}, err
+	}
+	campaign.OrgID = org.ID
+	if err := s.authorizeManager(ctx, campaign.OrgID, campaign.CreatedBy); err != nil {
+		return Campaign{}, err
+	}
+
+	policies, err := s.policies(ctx, campaign)
+	if err != nil {
+		return Campaign{}, err
+	}
+	if len(policies) == 0 {
+		return Campaign{}, ErrNoBindings
+	}
+	items := make([]Item, 0, len(policies))
+	for _, pol := range policies {
+		items = append(items, Item{
+			PolicyID:      pol.ID,
+			ResourceID:    pol.ResourceID,
+			ResourceType:  pol.ResourceType,
+			RoleID:        pol.RoleID,
+			PrincipalID:   pol.PrincipalID,
+			PrincipalType: pol.PrincipalType,
+			Decision:      Pending,
+		})
+	}
+
+	campaign.State = Active
+	created, err := s.repository.CreateCampaign(ctx, campaign, items)
+	if err != nil {
+		return Campaign{}, err
+	}
+	s.audit(ctx, pkgauditrecord.CertificationStartedEvent, created, map[string]any{
+		"bindings": len(items),
+	})
+	return created, nil
+}
+
+// policies lists the policies in the scope of the campaign
+func (s *Service) policies(ctx context.Context, campaign Campaign) ([]policy.Policy, error) {
+	var filters []policy.Filter
+	if len(campaign.ProjectIDs) > 0 {
+		for _, id := range campaign.ProjectIDs {
+			proj, err := s.projectService.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if proj.Organization.ID != campaign.OrgID {
+				return nil, ErrInvalidScope
+			}
+			filters = append(filters, policy.Filter{ProjectID: proj.ID})
+		}
+	} else {
+		filters = append(filters, policy.Filter{OrgID: campaign.OrgID})
+		projects, err := s.projectService.List(ctx, project.Filter{OrgID: campaign.OrgID})
+		if err != nil {
+			return nil, err
+		}
+		for _, proj := range projects {
+			filters = append(filters, policy.Filter{ProjectID: proj.ID})
+		}
+		groups, err := s.groupService.ListByOrganization(ctx, campaign.OrgID)
+		if err != nil {
+			return nil, err
+		}
+		for _, grp := range groups {
+			filters = append(filters, policy.Filter{GroupID: grp.ID})
+		}
+	}
+
+	var policies []policy.Policy
+	for _, flt := range filters {
+		flt.RoleIDs = campaign.RoleIDs
+		found, err := s.policyService.List(ctx, flt)
+		if err != nil {
+			return nil, err
+		}
+		for _, pol := range found {
+			if len(campaign.RoleIDs) > 0 && !slices.Contains(campaign.RoleIDs, pol.RoleID) {
+				continue
+			}
+			policies = append(policies, pol)
+		}
+	}
+	return policies, nil
+}
+
+func (s *Service) Get(ctx context.Context, id string) (Campaign, error) {
+	return s.repository.GetCampaign(ctx, id)
+}
+
+// List returns the campaigns of the organization to its managers
+func (s *Service) List(ctx context.Context, orgID, userID string, state State) ([]Campaign, error) {
+	if err := s.authorizeManager(ctx, orgID, userID); err != nil {
+		return nil, err
+	}
+	return s.repository.ListCampaigns(ctx, Filter{OrgID: orgID, State: state})
+}
+
+// Cancel ends an active campaign, the pending policies are left as they are
+func (s *Service) Cancel(ctx context.Context, id, userID string) (Campaign, error) {
+	campaign, err := s.repository.GetCampaign(ctx, id)
+	if err != nil {
+		return Campaign{}, err
+	}
+	if err := s.authorizeManager(ctx, campaign.OrgID, userID); err != nil {
+		return Campaign{}, err
+	}
+	campaign.State = Cancelled
+	campaign.CompletedAt = s.Now()
+	cancelled, err := s.repository.UpdateCampaign(ctx, campaign)
+	if err != nil {
+		return Campaign{}, err
+	}
+	s.audit(ctx, pkgauditrecord.CertificationCancelledEvent, cancelled, nil)
+	return cancelled, nil
+}
+
+// Items returns the items of the campaign the user can review, the items of
+// the resources the user manages the policies of
+func (s *Service) Items(ctx context.Context, campaignID, userID string, decision Decision) ([]Item, error) {
+	campaign, err := s.repository.GetCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.repository.ListItems(ctx, ItemFilter{CampaignID: campaign.ID, Decision: decision})
+	if err != nil {
+		return nil, err
+	}
+	reviewable := map[string]bool{}
+	result := make([]Item, 0, len(items))
+	for _, item := range items {
+		key := schema.JoinNamespaceAndResourceID(item.ResourceType, item.ResourceID)
+		ok, checked := reviewable[key]
+		if !checked {
+			if ok, err = s.IsReviewer(ctx, item.ResourceType, item.ResourceID, userID); err != nil {
+				return nil, err
+			}
+			reviewable[key] = ok
+		}
+		if ok {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+// IsReviewer reports if the user manages the policies of the resource, the
+// holders of policymanage on an organization or project and the owners of
+// a group
+func (s *Service) IsReviewer(ctx context.Context, namespace, id, userID string) (bool, error) {
+	permission := schema.PolicyManagePermission
+	if namespace == schema.GroupNamespace {
+		permission = group.AdminPermission
+	}
+	return s.relationService.CheckPermission(ctx, relation.Relation{
+		Subject: relation.Subject{
+			ID:        userID,
+			Namespace: schema.UserPrincipal,
+		},
+		Object: relation.Object{
+			ID:        id,
+			Namespace: namespace,
+		},
+		RelationName: permission,
+	})
+}
+
+// Certify keeps the policy of the item
+func (s *Service) Certify(ctx context.Context, id, reviewerID, comment string) (Item, error) {
+	return s.decide(ctx, id, reviewerID, comment, Certified)
+}
+
+// Revoke deletes the policy of the item
+func (s *Service) Revoke(ctx context.Context, id, reviewerID, comment string) (Item, error) {
+	return s.decide(ctx, id, reviewerID, comment, Revoked)
+}
+
+func (s *Service) decide(ctx context.Context, id, reviewerID, comment string, decision Decision) (Item, error) {
+	item, err := s.repository.GetItem(ctx, id)
+	if err != nil {
+		return Item{}, err
+	}
+	campaign, err := s.repository.GetCampaign(ctx, item.CampaignID)
+	if err != nil {
+		return Item{}, err
+	}
+	if campaign.State != Active {
+		return Item{}, ErrNotActive
+	}
+	if item.Decision != Pending {
+		return Item{}, ErrAlreadyDecided
+	}
+	if item.PrincipalType == schema.UserPrincipal && item.PrincipalID == reviewerID {
+		return Item{}, ErrSelfReview
+	}
+	ok, err := s.IsReviewer(ctx, item.ResourceType, item.ResourceID, reviewerID)
+	if err != nil {
+		return Item{}, err
+	}
+	if !ok {
+		return Item{}, ErrNotReviewer
+	}
+
+	// the policy is deleted before the decision is recorded, a failed
+	// deletion leaves the item pending to be revoked again
+	if decision == Revoked {
+		if err := s.revokePolicy(ctx, item.PolicyID); err != nil {
+			return Item{}, err
+		}
+	}
+	item.Decision = decision
+	item.ReviewerID = reviewerID
+	item.Comment = strings.TrimSpace(comment)
+	item.DecidedAt = s.Now()
+	decided, err := s.repository.DecideItem(ctx, item)
+	if err != nil {
+		return Item{}, err
+	}
+
+	event := pkgauditrecord.CertificationCertifiedEvent
+	if decision == Revoked {
+		event = pkgauditrecord.CertificationRevokedEvent
+	}
+	s.audit(ctx, event, campaign, itemMetadata(decided))
+	return decided, nil
+}
+
+func (s *Service) revokePolicy(ctx context.Context, id string) error {
+	if err := s.policyService.Delete(ctx, id); err != nil && !errors.Is(err, policy.ErrNotExist) {
+		return fmt.Errorf("revoke policy %s: %w", id, err)
+	}
+	return nil
+}
+
+func (s *Service) authorizeManager(ctx context.Context, orgID, userID string) error {
+	ok, err := s.IsReviewer(ctx, schema.OrganizationNamespace, orgID, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotManager
+	}
+	return nil
+}
+
+func (s *Service) audit(ctx context.Context, event pkgauditrecord.Event, campaign Campaign,
+	targetMetadata map[string]any) {
+	var orgName string
+	if org, err := s.orgService.Get(ctx, campaign.OrgID); err == nil {
+		orgName = org.Title
+	}
+	if targetMetadata == nil {
+		targetMetadata = map[string]any{}
+	}
+	targetMetadata["state"] = campaign.State.String()
+	targetMetadata["deadline"] = campaign.Deadline.Format(time.RFC3339)
+	targetMetadata["auto_revoke"] = campaign.AutoRevoke
+	if _, err := s.auditRecordRepository.Create(ctx, auditmodels.AuditRecord{
+		Event: event,
+		Resource: auditmodels.Resource{
+			ID:   campaign.OrgID,
+			Type: pkgauditrecord.OrganizationType,
+			Name: orgName,
+		},
+		Target: &auditmodels.Target{
+			ID:       campaign.ID,
+			Type:     pkgauditrecord.CertificationType,
+			Name:     campaign.Name,
+			Metadata: targetMetadata,
+		},
+		OrgID:      campaign.OrgID,
+		OccurredAt: s.Now(),
+	}); err != nil {
+		s.log.WarnContext(ctx, "failed to create audit record", "error", err, "event", event,
+			"campaign_id", campaign.ID)
+	}
+}
+
+func itemMetadata(item Item) map[string]any {
+	return map[string]any{
+		"item_id":        item.ID,
+		"policy_id":      item.PolicyID,
+		"resource_id":    item.ResourceID,
+		"resource_type":  item.ResourceType,
+		"role_id":        item.RoleID,
+		"principal_id":   item.PrincipalID,
+		"principal_type": item.PrincipalType,
+		"decision":       item.Decision.String(),
+		"comment":        item.Comment,
+	}
+}
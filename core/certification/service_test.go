@@ -0,0 +1,384 @@
+package certification
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	auditmodels "github.com/raystack/frontier/core/auditrecord/models"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	pkgauditrecord "github.com/raystack/frontier/pkg/auditrecord"
+	"github.com/raystack/frontier/pkg/db"
+	mailerMock "github.com/raystack/frontier/pkg/mailer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// repository keeps the campaigns and their items in memory
+type repository struct {
+	campaigns map[string]Campaign
+	items     map[string]Item
+}
+
+func newRepository() *repository {
+	return &repository{campaigns: map[string]Campaign{}, items: map[string]Item{}}
+}
+
+func (r *repository) CreateCampaign(ctx context.Context, campaign Campaign, items []Item) (Campaign, error) {
+	campaign.ID = uuid.NewString()
+	r.campaigns[campaign.ID] = campaign
+	for _, item := range items {
+		item.ID = uuid.NewString()
+		item.CampaignID = campaign.ID
+		r.items[item.ID] = item
+	}
+	return campaign, nil
+}
+
+func (r *repository) GetCampaign(ctx context.Context, id string) (Campaign, error) {
+	campaign, ok := r.campaigns[id]
+	if !ok {
+		return Campaign{}, ErrNotExist
+	}
+	return campaign, nil
+}
+
+func (r *repository) ListCampaigns(ctx context.Context, flt Filter) ([]Campaign, error) {
+	var campaigns []Campaign
+	for _, campaign := range r.campaigns {
+		if (flt.OrgID == "" || campaign.OrgID == flt.OrgID) && (flt.State == "" || campaign.State == flt.State) {
+			campaigns = append(campaigns, campaign)
+		}
+	}
+	return campaigns, nil
+}
+
+func (r *repository) UpdateCampaign(ctx context.Context, campaign Campaign) (Campaign, error) {
+	if r.campaigns[campaign.ID].State != Active {
+		return Campaign{}, ErrNotActive
+	}
+	r.campaigns[campaign.ID] = campaign
+	return campaign, nil
+}
+
+func (r *repository) GetItem(ctx context.Context, id string) (Item, error) {
+	item, ok := r.items[id]
+	if !ok {
+		return Item{}, ErrNotExist
+	}
+	return item, nil
+}
+
+func (r *repository) ListItems(ctx context.Context, flt ItemFilter) ([]Item, error) {
+	var items []Item
+	for _, item := range r.items {
+		if item.CampaignID == flt.CampaignID && (flt.Decision == "" || item.Decision == flt.Decision) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (r *repository) DecideItem(ctx context.Context, item Item) (Item, error) {
+	if r.items[item.ID].Decision != Pending {
+		return Item{}, ErrAlreadyDecided
+	}
+	r.items[item.ID] = item
+	return item, nil
+}
+
+type policyService struct {
+	policies []policy.Policy
+	deleted  []string
+}
+
+func (p *policyService) List(ctx context.Context, flt policy.Filter) ([]policy.Policy, error) {
+	var policies []policy.Policy
+	for _, pol := range p.policies {
+		if (flt.OrgID != "" && pol.ResourceType == schema.OrganizationNamespace && pol.ResourceID == flt.OrgID) ||
+			(flt.ProjectID != "" && pol.ResourceType == schema.ProjectNamespace && pol.ResourceID == flt.ProjectID) ||
+			(flt.GroupID != "" && pol.ResourceType == schema.GroupNamespace && pol.ResourceID == flt.GroupID) {
+			policies = append(policies, pol)
+		}
+	}
+	return policies, nil
+}
+
+func (p *policyService) Delete(ctx context.Context, id string) error {
+	p.deleted = append(p.deleted, id)
+	return nil
+}
+
+// relationService lets the listed users manage the policies of a resource
+type relationService map[string][]string
+
+func (r relationService) CheckPermission(ctx context.Context, rel relation.Relation) (bool, error) {
+	for _, id := range r[rel.Object.ID] {
+		if id == rel.Subject.ID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r relationService) LookupSubjects(ctx context.Context, rel relation.Relation) ([]string, error) {
+	return r[rel.Object.ID], nil
+}
+
+type orgService struct{}
+
+func (orgService) Get(ctx context.Context, id string) (organization.Organization, error) {
+	return organization.Organization{ID: id, Title: "Acme"}, nil
+}
+
+type projectService struct {
+	projects []project.Project
+}
+
+func (p projectService) Get(ctx context.Context, id string) (project.Project, error) {
+	for _, proj := range p.projects {
+		if proj.ID == id {
+			return proj, nil
+		}
+	}
+	return project.Project{}, project.ErrNotExist
+}
+
+func (p projectService) List(ctx context.Context, flt project.Filter) ([]project.Project, error) {
+	return p.projects, nil
+}
+
+type groupService struct {
+	groups []group.Group
+}
+
+func (g groupService) ListByOrganization(ctx context.Context, id string) ([]group.Group, error) {
+	return g.groups, nil
+}
+
+type userService struct{}
+
+func (userService) GetByID(ctx context.Context, id string) (user.User, error) {
+	return user.User{ID: id, Email: id + "@acme.dev"}, nil
+}
+
+type auditRecordRepository struct {
+	events []pkgauditrecord.Event
+}
+
+func (a *auditRecordRepository) Create(ctx context.Context, record auditmodels.AuditRecord) (auditmodels.AuditRecord, error) {
+	a.events = append(a.events, record.Event)
+	return record, nil
+}
+
+type locker struct {
+	err error
+}
+
+func (l locker) TryLock(ctx context.Context, id string) (*db.Lock, error) {
+	return nil, l.err
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	orgID := uuid.NewString()
+	projectID := uuid.NewString()
+	otherProjectID := uuid.NewString()
+	groupID := uuid.NewString()
+	managerID := uuid.NewString()
+	ownerID := uuid.NewString()
+	userID := uuid.NewString()
+
+	orgPolicy := policy.Policy{ID: uuid.NewString(), RoleID: "viewer", ResourceID: orgID,
+		ResourceType: schema.OrganizationNamespace, PrincipalID: userID, PrincipalType: schema.UserPrincipal}
+	projectPolicy := policy.Policy{ID: uuid.NewString(), RoleID: "manager", ResourceID: projectID,
+		ResourceType: schema.ProjectNamespace, PrincipalID: userID, PrincipalType: schema.UserPrincipal}
+	groupPolicy := policy.Policy{ID: uuid.NewString(), RoleID: "member", ResourceID: groupID,
+		ResourceType: schema.GroupNamespace, PrincipalID: managerID, PrincipalType: schema.UserPrincipal}
+
+	type fixture struct {
+		svc      *Service
+		repo     *repository
+		policies *policyService
+		audits   *auditRecordRepository
+		dialer   *mailerMock.Dialer
+	}
+	newFixture := func(t *testing.T, lockErr error) fixture {
+		f := fixture{
+			repo:     newRepository(),
+			policies: &policyService{policies: []policy.Policy{orgPolicy, projectPolicy, groupPolicy}},
+			audits:   &auditRecordRepository{},
+			dialer:   mailerMock.NewDialer(t),
+		}
+		f.svc = NewService(slog.New(slog.NewTextHandler(io.Discard, nil)),
+			Config{Enabled: true, Schedule: "@every 1h", ReminderInterval: 72 * time.Hour},
+			f.repo, f.policies, relationService{
+				orgID:     {managerID},
+				projectID: {managerID},
+				groupID:   {ownerID},
+			}, orgService{}, projectService{projects: []project.Project{
+				{ID: projectID, Organization: organization.Organization{ID: orgID}},
+				{ID: otherProjectID, Organization: organization.Organization{ID: uuid.NewString()}},
+			}}, groupService{groups: []group.Group{{ID: groupID}}}, userService{}, f.dialer,
+			locker{err: lockErr}, f.audits)
+		f.svc.Now = func() time.Time { return now }
+		return f
+	}
+	newCampaign := func(f fixture, autoRevoke bool) Campaign {
+		campaign, err := f.svc.Create(ctx, Campaign{OrgID: orgID, Name: "Q4 review", CreatedBy: managerID,
+			Deadline: now.Add(14 * 24 * time.Hour), AutoRevoke: autoRevoke})
+		require.NoError(t, err)
+		return campaign
+	}
+
+	t.Run("should snapshot the policies in the scope of a campaign", func(t *testing.T) {
+		f := newFixture(t, nil)
+
+		_, err := f.svc.Create(ctx, Campaign{OrgID: orgID, Name: "Q4 review", CreatedBy: userID,
+			Deadline: now.Add(time.Hour)})
+		assert.ErrorIs(t, err, ErrNotManager)
+		_, err = f.svc.Create(ctx, Campaign{OrgID: orgID, Name: "Q4 review", CreatedBy: managerID,
+			Deadline: now.Add(-time.Hour)})
+		assert.ErrorIs(t, err, ErrInvalidDetail)
+		_, err = f.svc.Create(ctx, Campaign{OrgID: orgID, Name: "Q4 review", CreatedBy: managerID,
+			Deadline: now.Add(time.Hour), ProjectIDs: []string{otherProjectID}})
+		assert.ErrorIs(t, err, ErrInvalidScope)
+		_, err = f.svc.Create(ctx, Campaign{OrgID: orgID, Name: "Q4 review", CreatedBy: managerID,
+			Deadline: now.Add(time.Hour), RoleIDs: []string{"owner"}})
+		assert.ErrorIs(t, err, ErrNoBindings)
+
+		campaign := newCampaign(f, false)
+		assert.Equal(t, Active, campaign.State)
+		assert.Len(t, f.repo.items, 3)
+		assert.Equal(t, []pkgauditrecord.Event{pkgauditrecord.CertificationStartedEvent}, f.audits.events)
+
+		scoped, err := f.svc.Create(ctx, Campaign{OrgID: orgID, Name: "Production", CreatedBy: managerID,
+			Deadline: now.Add(time.Hour), ProjectIDs: []string{projectID}})
+		require.NoError(t, err)
+		items, err := f.repo.ListItems(ctx, ItemFilter{CampaignID: scoped.ID})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, projectPolicy.ID, items[0].PolicyID)
+	})
+
+	t.Run("should let the reviewers of a resource decide its policies", func(t *testing.T) {
+		f := newFixture(t, nil)
+		campaign := newCampaign(f, false)
+
+		items, err := f.svc.Items(ctx, campaign.ID, ownerID, "")
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, groupPolicy.ID, items[0].PolicyID)
+		groupItem := items[0]
+
+		items, err = f.svc.Items(ctx, campaign.ID, managerID, Pending)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+
+		_, err = f.svc.Certify(ctx, groupItem.ID, managerID, "")
+		assert.ErrorIs(t, err, ErrSelfReview)
+		_, err = f.svc.Certify(ctx, items[0].ID, ownerID, "")
+		assert.ErrorIs(t, err, ErrNotReviewer)
+
+		certified, err := f.svc.Certify(ctx, groupItem.ID, ownerID, " still on call ")
+		require.NoError(t, err)
+		assert.Equal(t, Certified, certified.Decision)
+		assert.Equal(t, ownerID, certified.ReviewerID)
+		assert.Equal(t, "still on call", certified.Comment)
+		assert.Equal(t, now, certified.DecidedAt)
+		_, err = f.svc.Revoke(ctx, groupItem.ID, ownerID, "")
+		assert.ErrorIs(t, err, ErrAlreadyDecided)
+
+		revoked, err := f.svc.Revoke(ctx, items[0].ID, managerID, "left the team")
+		require.NoError(t, err)
+		assert.Equal(t, Revoked, revoked.Decision)
+		assert.Equal(t, []string{items[0].PolicyID}, f.policies.deleted)
+		assert.Equal(t, []pkgauditrecord.Event{
+			pkgauditrecord.CertificationStartedEvent,
+			pkgauditrecord.CertificationCertifiedEvent,
+			pkgauditrecord.CertificationRevokedEvent,
+		}, f.audits.events)
+
+		_, err = f.svc.Cancel(ctx, campaign.ID, ownerID)
+		assert.ErrorIs(t, err, ErrNotManager)
+		cancelled, err := f.svc.Cancel(ctx, campaign.ID, managerID)
+		require.NoError(t, err)
+		assert.Equal(t, Cancelled, cancelled.State)
+		_, err = f.svc.Certify(ctx, items[1].ID, managerID, "")
+		assert.ErrorIs(t, err, ErrNotActive)
+	})
+
+	t.Run("should remind the reviewers with pending policies", func(t *testing.T) {
+		f := newFixture(t, nil)
+		campaign := newCampaign(f, false)
+		f.dialer.EXPECT().FromHeader().Return("frontier@acme.dev")
+		f.dialer.EXPECT().DialAndSend(mock.AnythingOfType("*mail.Message")).Return(nil).Times(2)
+
+		require.NoError(t, f.svc.process(ctx))
+		assert.Equal(t, now, f.repo.campaigns[campaign.ID].RemindedAt)
+
+		// reminders are not sent again before the interval
+		f.svc.Now = func() time.Time { return now.Add(time.Hour) }
+		require.NoError(t, f.svc.process(ctx))
+	})
+
+	t.Run("should complete a campaign past its deadline", func(t *testing.T) {
+		f := newFixture(t, nil)
+		campaign := newCampaign(f, true)
+		items, err := f.svc.Items(ctx, campaign.ID, ownerID, "")
+		require.NoError(t, err)
+		_, err = f.svc.Certify(ctx, items[0].ID, ownerID, "")
+		require.NoError(t, err)
+
+		f.svc.Now = func() time.Time { return campaign.Deadline }
+		require.NoError(t, f.svc.process(ctx))
+
+		completed := f.repo.campaigns[campaign.ID]
+		assert.Equal(t, Completed, completed.State)
+		assert.Equal(t, campaign.Deadline, completed.CompletedAt)
+		assert.ElementsMatch(t, []string{orgPolicy.ID, projectPolicy.ID}, f.policies.deleted)
+		revoked, err := f.repo.ListItems(ctx, ItemFilter{CampaignID: campaign.ID, Decision: AutoRevoked})
+		require.NoError(t, err)
+		assert.Len(t, revoked, 2)
+
+		reader, contentType, err := f.svc.Export(ctx, campaign.ID, managerID)
+		require.NoError(t, err)
+		assert.Equal(t, CSVContentType, contentType)
+		rows, err := csv.NewReader(reader).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 4)
+		assert.Equal(t, "Campaign ID", rows[0][0])
+		_, _, err = f.svc.Export(ctx, campaign.ID, ownerID)
+		assert.ErrorIs(t, err, ErrNotManager)
+	})
+
+	t.Run("should skip a run while another instance holds the lock", func(t *testing.T) {
+		f := newFixture(t, db.ErrLockBusy)
+		assert.NoError(t, f.svc.Run(ctx))
+
+		f = newFixture(t, errors.New("connection refused"))
+		assert.ErrorContains(t, f.svc.Run(ctx), "connection refused")
+	})
+
+	t.Run("should schedule the job when enabled", func(t *testing.T) {
+		f := newFixture(t, nil)
+		assert.NoError(t, f.svc.Init(ctx))
+		assert.NoError(t, f.svc.Close())
+
+		f.svc.config.Schedule = "bad-schedule"
+		assert.ErrorContains(t, f.svc.Init(ctx), "failed to schedule")
+	})
+}
@@ -170,6 +170,51 @@ func (s Service) Join(ctx context.Context, orgID string, userId string) error {
 	return ErrDomainsMisMatch
 }
 
+// IsOrgDomain checks if the email belongs to a verified domain of the organization
+func (s Service) IsOrgDomain(ctx context.Context, orgID string, email string) (bool, error) {
+	userDomain := utils.ExtractDomainFromEmail(email)
+	if userDomain == "" {
+		return false, user.ErrInvalidEmail
+	}
+
+	orgTrustedDomains, err := s.List(ctx, Filter{
+		OrgID: orgID,
+		State: Verified,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, dmn := range orgTrustedDomains {
+		if userDomain == dmn.Name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ListOrgsByDomain lists the organizations which verified the domain of the email
+func (s Service) ListOrgsByDomain(ctx context.Context, email string) ([]string, error) {
+	userDomain := utils.ExtractDomainFromEmail(email)
+	if userDomain == "" {
+		return nil, user.ErrInvalidEmail
+	}
+	domains, err := s.repository.List(ctx, Filter{
+		Name:  userDomain,
+		State: Verified,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var orgIDs []string
+	for _, dmn := range domains {
+		if !slices.Contains(orgIDs, dmn.OrgID) {
+			orgIDs = append(orgIDs, dmn.OrgID)
+		}
+	}
+	return orgIDs, nil
+}
+
 func (s Service) ListJoinableOrgsByDomain(ctx context.Context, email string) ([]string, error) {
 	domain := utils.ExtractDomainFromEmail(email)
 	domains, err := s.repository.List(ctx, Filter{
@@ -85,3 +85,56 @@ func TestService_ListJoinableOrgsByDomain(t *testing.T) {
 		assert.Equal(t, []string{"org-1", "org-2"}, got)
 	})
 }
+
+func TestService_IsOrgDomain(t *testing.T) {
+	ctx := context.Background()
+
+	newService := func(t *testing.T) (*domain.Service, *mocks.Repository) {
+		t.Helper()
+		repo := mocks.NewRepository(t)
+		svc := domain.NewService(slog.Default(), repo, mocks.NewUserService(t), mocks.NewOrgService(t), mocks.NewMembershipService(t))
+		return svc, repo
+	}
+
+	t.Run("matches a verified domain of the org", func(t *testing.T) {
+		svc, repo := newService(t)
+		repo.EXPECT().List(ctx, domain.Filter{OrgID: "org-1", State: domain.Verified}).
+			Return([]domain.Domain{{Name: "acme.org"}, {Name: "example.com"}}, nil)
+
+		got, err := svc.IsOrgDomain(ctx, "org-1", "alice@example.com")
+		assert.NoError(t, err)
+		assert.True(t, got)
+	})
+
+	t.Run("doesn't match domains of other orgs", func(t *testing.T) {
+		svc, repo := newService(t)
+		repo.EXPECT().List(ctx, domain.Filter{OrgID: "org-1", State: domain.Verified}).
+			Return([]domain.Domain{{Name: "acme.org"}}, nil)
+
+		got, err := svc.IsOrgDomain(ctx, "org-1", "alice@example.com")
+		assert.NoError(t, err)
+		assert.False(t, got)
+	})
+
+	t.Run("rejects an invalid email", func(t *testing.T) {
+		svc, _ := newService(t)
+
+		_, err := svc.IsOrgDomain(ctx, "org-1", "alice")
+		assert.ErrorIs(t, err, user.ErrInvalidEmail)
+	})
+}
+
+func TestService_ListOrgsByDomain(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lists the orgs which verified the email domain", func(t *testing.T) {
+		repo := mocks.NewRepository(t)
+		svc := domain.NewService(slog.Default(), repo, mocks.NewUserService(t), mocks.NewOrgService(t), mocks.NewMembershipService(t))
+		repo.EXPECT().List(ctx, domain.Filter{Name: "example.com", State: domain.Verified}).
+			Return([]domain.Domain{{OrgID: "org-1"}, {OrgID: "org-2"}, {OrgID: "org-1"}}, nil)
+
+		got, err := svc.ListOrgsByDomain(ctx, "alice@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"org-1", "org-2"}, got)
+	})
+}
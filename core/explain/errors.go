@@ -0,0 +1,8 @@
+package explain
+
+import "errors"
+
+var (
+	ErrInvalidDetail = errors.New("a resource and a permission are required to explain a check")
+	ErrNotAllowed    = errors.New("user can't explain the permissions of the principal on the resource")
+)
@@ -0,0 +1,52 @@
+package explain
+
+import (
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+)
+
+type StepType string
+
+const (
+	// ResourceStep is an organization, project or resource the permission
+	// was inherited through
+	ResourceStep StepType = "resource"
+	// PolicyStep is a policy binding a role to the principal or its group
+	PolicyStep StepType = "policy"
+	// GroupStep is a group the principal is a member of
+	GroupStep StepType = "group"
+	// SuperuserStep is the platform superuser access of the principal
+	SuperuserStep StepType = "superuser"
+)
+
+func (s StepType) String() string {
+	return string(s)
+}
+
+// Step is a frontier entity a permission is resolved through
+type Step struct {
+	Type      StepType
+	Namespace string
+	ID        string
+	// Relation is the permission or relation checked on the entity
+	Relation string
+	// Title is the title of the organization, project or group, and the
+	// title of the role of a policy
+	Title string
+
+	// Policy and Role are only set on policy steps
+	Policy policy.Policy
+	Role   role.Role
+}
+
+// Explanation tells why a principal has a permission on a resource
+type Explanation struct {
+	Object     relation.Object
+	Subject    relation.Subject
+	Permission string
+	Allowed    bool
+	// Path leads from the principal to the permission on the resource, it
+	// is empty when the permission isn't allowed
+	Path []Step
+}
@@ -0,0 +1,247 @@
+package explain
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+)
+
+type RelationService interface {
+	CheckPermission(ctx context.Context, rel relation.Relation) (bool, error)
+	ExplainPermission(ctx context.Context, rel relation.Relation) (relation.Trace, error)
+}
+
+type PolicyService interface {
+	Get(ctx context.Context, id string) (policy.Policy, error)
+}
+
+type RoleService interface {
+	Get(ctx context.Context, idOrName string) (role.Role, error)
+}
+
+type OrgService interface {
+	GetRaw(ctx context.Context, idOrName string) (organization.Organization, error)
+}
+
+type ProjectService interface {
+	Get(ctx context.Context, idOrName string) (project.Project, error)
+}
+
+type GroupService interface {
+	Get(ctx context.Context, id string) (group.Group, error)
+}
+
+type Service struct {
+	relationService RelationService
+	policyService   PolicyService
+	roleService     RoleService
+	orgService      OrgService
+	projectService  ProjectService
+	groupService    GroupService
+}
+
+func NewService(relationService RelationService, policyService PolicyService, roleService RoleService,
+	orgService OrgService, projectService ProjectService, groupService GroupService) *Service {
+	return &Service{
+		relationService: relationService,
+		policyService:   policyService,
+		roleService:     roleService,
+		orgService:      orgService,
+		projectService:  projectService,
+		groupService:    groupService,
+	}
+}
+
+// Explain checks the permission of the subject on the object with tracing
+// and maps the trace to the groups, policies and resources it was granted
+// through. The subject defaults to the requesting user, the access of
+// anyone else can only be explained by a platform superuser or by the users
+// managing the policies of the resource.
+func (s Service) Explain(ctx context.Context, requesterID string, object relation.Object,
+	subject relation.Subject, permission string) (Explanation, error) {
+	permission = strings.TrimSpace(permission)
+	if object.Namespace == "" || object.ID == "" || permission == "" {
+		return Explanation{}, ErrInvalidDetail
+	}
+	object, err := s.resolveObject(ctx, object)
+	if err != nil {
+		return Explanation{}, err
+	}
+	if subject.ID == "" {
+		subject = relation.Subject{ID: requesterID, Namespace: schema.UserPrincipal}
+	}
+	if subject.ID != requesterID || subject.Namespace != schema.UserPrincipal {
+		ok, err := s.CanExplain(ctx, requesterID, object)
+		if err != nil {
+			return Explanation{}, err
+		}
+		if !ok {
+			return Explanation{}, ErrNotAllowed
+		}
+	}
+	return s.Trace(ctx, object, subject, permission)
+}
+
+// Trace explains the permission of the subject on the object by its id
+// without authorizing the request, callers authorize it with CanExplain
+func (s Service) Trace(ctx context.Context, object relation.Object, subject relation.Subject,
+	permission string) (Explanation, error) {
+	trace, err := s.relationService.ExplainPermission(ctx, relation.Relation{
+		Object:       object,
+		Subject:      subject,
+		RelationName: permission,
+	})
+	if err != nil {
+		return Explanation{}, err
+	}
+	explanation := Explanation{
+		Object:     object,
+		Subject:    subject,
+		Permission: permission,
+		Allowed:    trace.Allowed,
+	}
+	if !trace.Allowed {
+		return explanation, nil
+	}
+
+	var path []Step
+	seen := map[string]bool{}
+	if err := s.walk(ctx, trace, seen, &path); err != nil {
+		return Explanation{}, err
+	}
+	// the trace starts at the resource, the path starts at the principal
+	slices.Reverse(path)
+	explanation.Path = path
+	return explanation, nil
+}
+
+// resolveObject turns the name of an organization or a project into its id
+func (s Service) resolveObject(ctx context.Context, object relation.Object) (relation.Object, error) {
+	switch object.Namespace {
+	case schema.OrganizationNamespace:
+		org, err := s.orgService.GetRaw(ctx, object.ID)
+		if err != nil {
+			return relation.Object{}, err
+		}
+		object.ID = org.ID
+	case schema.ProjectNamespace:
+		proj, err := s.projectService.Get(ctx, object.ID)
+		if err != nil {
+			return relation.Object{}, err
+		}
+		object.ID = proj.ID
+	}
+	return object, nil
+}
+
+// CanExplain reports if the user can explain the access of anyone on the
+// object, platform superusers can on every object and the users managing the
+// policies of an organization, project or group on it
+func (s Service) CanExplain(ctx context.Context, userID string, object relation.Object) (bool, error) {
+	subject := relation.Subject{ID: userID, Namespace: schema.UserPrincipal}
+	sudo, err := s.relationService.CheckPermission(ctx, relation.Relation{
+		Subject:      subject,
+		Object:       relation.Object{ID: schema.PlatformID, Namespace: schema.PlatformNamespace},
+		RelationName: schema.PlatformSudoPermission,
+	})
+	if err != nil || sudo {
+		return sudo, err
+	}
+
+	permission := schema.PolicyManagePermission
+	switch object.Namespace {
+	case schema.OrganizationNamespace, schema.ProjectNamespace:
+	case schema.GroupNamespace:
+		permission = group.AdminPermission
+	default:
+		return false, nil
+	}
+	return s.relationService.CheckPermission(ctx, relation.Relation{
+		Subject:      subject,
+		Object:       object,
+		RelationName: permission,
+	})
+}
+
+// walk collects the entities of the allowed branches of the trace, each
+// entity is only added the first time it is reached
+func (s Service) walk(ctx context.Context, trace relation.Trace, seen map[string]bool, path *[]Step) error {
+	if !trace.Allowed {
+		return nil
+	}
+	key := schema.JoinNamespaceAndResourceID(trace.Object.Namespace, trace.Object.ID)
+	if !seen[key] {
+		seen[key] = true
+		step, ok, err := s.toStep(ctx, trace)
+		if err != nil {
+			return err
+		}
+		if ok {
+			*path = append(*path, step)
+		}
+	}
+	for _, child := range trace.Children {
+		if err := s.walk(ctx, child, seen, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s Service) toStep(ctx context.Context, trace relation.Trace) (Step, bool, error) {
+	step := Step{
+		Type:      ResourceStep,
+		Namespace: trace.Object.Namespace,
+		ID:        trace.Object.ID,
+		Relation:  trace.Name,
+	}
+	switch trace.Object.Namespace {
+	case schema.RoleNamespace:
+		// the role is part of the policy step binding it
+		return Step{}, false, nil
+	case schema.RoleBindingNamespace:
+		// role bindings are created with the id of their policy
+		pol, err := s.policyService.Get(ctx, trace.Object.ID)
+		if err != nil {
+			return Step{}, false, err
+		}
+		rl, err := s.roleService.Get(ctx, pol.RoleID)
+		if err != nil {
+			return Step{}, false, err
+		}
+		step.Type = PolicyStep
+		step.Title = rl.Title
+		step.Policy = pol
+		step.Role = rl
+	case schema.GroupNamespace:
+		grp, err := s.groupService.Get(ctx, trace.Object.ID)
+		if err != nil {
+			return Step{}, false, err
+		}
+		step.Type = GroupStep
+		step.Title = grp.Title
+	case schema.PlatformNamespace:
+		step.Type = SuperuserStep
+	case schema.OrganizationNamespace:
+		org, err := s.orgService.GetRaw(ctx, trace.Object.ID)
+		if err != nil {
+			return Step{}, false, err
+		}
+		step.Title = org.Title
+	case schema.ProjectNamespace:
+		proj, err := s.projectService.Get(ctx, trace.Object.ID)
+		if err != nil {
+			return Step{}, false, err
+		}
+		step.Title = proj.Title
+	}
+	return step, true, nil
+}
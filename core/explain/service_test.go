@@ -0,0 +1,189 @@
+package explain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/explain"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// relationService grants the listed permissions of users and returns the
+// same trace for every explained check
+type relationService struct {
+	granted map[string]bool
+	trace   relation.Trace
+}
+
+func (r relationService) CheckPermission(ctx context.Context, rel relation.Relation) (bool, error) {
+	return r.granted[rel.Subject.ID+"#"+rel.RelationName], nil
+}
+
+func (r relationService) ExplainPermission(ctx context.Context, rel relation.Relation) (relation.Trace, error) {
+	return r.trace, nil
+}
+
+type policyService map[string]policy.Policy
+
+func (p policyService) Get(ctx context.Context, id string) (policy.Policy, error) {
+	pol, ok := p[id]
+	if !ok {
+		return policy.Policy{}, policy.ErrNotExist
+	}
+	return pol, nil
+}
+
+type roleService map[string]role.Role
+
+func (r roleService) Get(ctx context.Context, id string) (role.Role, error) {
+	rl, ok := r[id]
+	if !ok {
+		return role.Role{}, role.ErrNotExist
+	}
+	return rl, nil
+}
+
+type orgService struct{}
+
+func (orgService) GetRaw(ctx context.Context, id string) (organization.Organization, error) {
+	return organization.Organization{ID: id, Title: "Acme"}, nil
+}
+
+type projectService struct {
+	projectID string
+}
+
+func (p projectService) Get(ctx context.Context, idOrName string) (project.Project, error) {
+	return project.Project{ID: p.projectID, Name: "production", Title: "Production"}, nil
+}
+
+type groupService struct{}
+
+func (groupService) Get(ctx context.Context, id string) (group.Group, error) {
+	return group.Group{ID: id, Title: "SRE"}, nil
+}
+
+func TestService_Explain(t *testing.T) {
+	ctx := context.Background()
+	orgID := uuid.NewString()
+	projectID := uuid.NewString()
+	groupID := uuid.NewString()
+	userID := uuid.NewString()
+	adminID := uuid.NewString()
+	viewer := role.Role{ID: uuid.NewString(), Name: "app_project_viewer", Title: "Project Viewer"}
+	pol := policy.Policy{ID: uuid.NewString(), RoleID: viewer.ID, ResourceID: orgID,
+		ResourceType: schema.OrganizationNamespace, PrincipalID: groupID, PrincipalType: schema.GroupPrincipal}
+
+	// project get → organization → rolebinding of the group → role
+	groupTrace := relation.Trace{
+		Object: relation.Object{ID: projectID, Namespace: schema.ProjectNamespace}, Name: schema.GetPermission,
+		Permission: true, Allowed: true,
+		Children: []relation.Trace{
+			{Object: relation.Object{ID: projectID, Namespace: schema.ProjectNamespace}, Name: "granted"},
+			{
+				Object: relation.Object{ID: orgID, Namespace: schema.OrganizationNamespace}, Name: "app_project_get",
+				Permission: true, Allowed: true,
+				Children: []relation.Trace{{
+					Object: relation.Object{ID: pol.ID, Namespace: schema.RoleBindingNamespace}, Name: "app_project_get",
+					Permission: true, Allowed: true,
+					Children: []relation.Trace{
+						{
+							Object: relation.Object{ID: pol.ID, Namespace: schema.RoleBindingNamespace}, Name: "bearer", Allowed: true,
+							Children: []relation.Trace{{
+								Object: relation.Object{ID: groupID, Namespace: schema.GroupNamespace}, Name: schema.MemberRelationName,
+								Allowed: true,
+							}},
+						},
+						{Object: relation.Object{ID: viewer.ID, Namespace: schema.RoleNamespace}, Name: "app_project_get", Allowed: true},
+					},
+				}},
+			},
+		},
+	}
+	newService := func(relations relationService) *explain.Service {
+		return explain.NewService(relations, policyService{pol.ID: pol}, roleService{viewer.ID: viewer},
+			orgService{}, projectService{projectID: projectID}, groupService{})
+	}
+
+	t.Run("should map the trace to the path of the grant", func(t *testing.T) {
+		svc := newService(relationService{trace: groupTrace})
+
+		explanation, err := svc.Explain(ctx, userID, relation.Object{ID: "production", Namespace: schema.ProjectNamespace},
+			relation.Subject{}, schema.GetPermission)
+		require.NoError(t, err)
+		assert.True(t, explanation.Allowed)
+		assert.Equal(t, projectID, explanation.Object.ID)
+		assert.Equal(t, relation.Subject{ID: userID, Namespace: schema.UserPrincipal}, explanation.Subject)
+
+		require.Len(t, explanation.Path, 4)
+		assert.Equal(t, explain.GroupStep, explanation.Path[0].Type)
+		assert.Equal(t, "SRE", explanation.Path[0].Title)
+		assert.Equal(t, explain.PolicyStep, explanation.Path[1].Type)
+		assert.Equal(t, pol.ID, explanation.Path[1].Policy.ID)
+		assert.Equal(t, "app_project_viewer", explanation.Path[1].Role.Name)
+		assert.Equal(t, explain.ResourceStep, explanation.Path[2].Type)
+		assert.Equal(t, orgID, explanation.Path[2].ID)
+		assert.Equal(t, "app_project_get", explanation.Path[2].Relation)
+		assert.Equal(t, projectID, explanation.Path[3].ID)
+		assert.Equal(t, schema.GetPermission, explanation.Path[3].Relation)
+	})
+
+	t.Run("should explain superuser access", func(t *testing.T) {
+		svc := newService(relationService{trace: relation.Trace{
+			Object: relation.Object{ID: projectID, Namespace: schema.ProjectNamespace}, Name: schema.GetPermission,
+			Permission: true, Allowed: true,
+			Children: []relation.Trace{{
+				Object: relation.Object{ID: schema.PlatformID, Namespace: schema.PlatformNamespace},
+				Name:   schema.PlatformSudoPermission, Permission: true, Allowed: true,
+			}},
+		}})
+
+		explanation, err := svc.Explain(ctx, userID, relation.Object{ID: projectID, Namespace: schema.ProjectNamespace},
+			relation.Subject{}, schema.GetPermission)
+		require.NoError(t, err)
+		require.Len(t, explanation.Path, 2)
+		assert.Equal(t, explain.SuperuserStep, explanation.Path[0].Type)
+	})
+
+	t.Run("should return an empty path when the permission isn't allowed", func(t *testing.T) {
+		svc := newService(relationService{trace: relation.Trace{
+			Object: relation.Object{ID: projectID, Namespace: schema.ProjectNamespace}, Name: schema.GetPermission,
+			Permission: true,
+		}})
+
+		explanation, err := svc.Explain(ctx, userID, relation.Object{ID: projectID, Namespace: schema.ProjectNamespace},
+			relation.Subject{}, schema.GetPermission)
+		require.NoError(t, err)
+		assert.False(t, explanation.Allowed)
+		assert.Empty(t, explanation.Path)
+	})
+
+	t.Run("should only explain the access of others to managers", func(t *testing.T) {
+		svc := newService(relationService{
+			granted: map[string]bool{adminID + "#" + schema.PolicyManagePermission: true},
+			trace:   groupTrace,
+		})
+		other := relation.Subject{ID: userID, Namespace: schema.UserPrincipal}
+		object := relation.Object{ID: projectID, Namespace: schema.ProjectNamespace}
+
+		_, err := svc.Explain(ctx, uuid.NewString(), object, other, schema.GetPermission)
+		assert.ErrorIs(t, err, explain.ErrNotAllowed)
+
+		explanation, err := svc.Explain(ctx, adminID, object, other, schema.GetPermission)
+		require.NoError(t, err)
+		assert.Equal(t, other, explanation.Subject)
+
+		_, err = svc.Explain(ctx, adminID, relation.Object{ID: projectID, Namespace: schema.ProjectNamespace},
+			other, " ")
+		assert.ErrorIs(t, err, explain.ErrInvalidDetail)
+	})
+}
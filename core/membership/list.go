@@ -3,6 +3,7 @@ package membership
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/raystack/frontier/core/authenticate"
 	"github.com/raystack/frontier/core/group"
@@ -37,6 +38,9 @@ type Member struct {
 	PrincipalID   string
 	PrincipalType string
 	Roles         []role.Role
+	// ExpiresAt is when the last time bound policy of the principal on the
+	// resource expires, zero when one of its policies doesn't expire
+	ExpiresAt time.Time
 }
 
 // principalKey identifies a principal across policy rows.
@@ -70,17 +74,28 @@ func (s *Service) ListPrincipalsByResource(ctx context.Context, resourceID, reso
 	// deduplicate by (principalID, principalType) preserving order
 	memberIndex := make(map[principalKey]int, len(policies))
 	members := make([]Member, 0, len(policies))
+	permanent := make(map[principalKey]bool, len(policies))
 	for _, pol := range policies {
 		key := policyPrincipalKey(pol)
-		if _, ok := memberIndex[key]; ok {
+		permanent[key] = permanent[key] || pol.ExpiresAt.IsZero()
+		if idx, ok := memberIndex[key]; ok {
+			if pol.ExpiresAt.After(members[idx].ExpiresAt) {
+				members[idx].ExpiresAt = pol.ExpiresAt
+			}
 			continue
 		}
 		memberIndex[key] = len(members)
 		members = append(members, Member{
 			PrincipalID:   pol.PrincipalID,
 			PrincipalType: pol.PrincipalType,
+			ExpiresAt:     pol.ExpiresAt,
 		})
 	}
+	for key, idx := range memberIndex {
+		if permanent[key] {
+			members[idx].ExpiresAt = time.Time{}
+		}
+	}
 
 	// role enrichment needs every policy on the resource, not just the ones
 	// matching the role filter. Without a role filter the first query already
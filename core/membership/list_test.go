@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"io"
 	"log/slog"
@@ -59,6 +60,7 @@ func TestService_ListPrincipalsByResource(t *testing.T) {
 
 	viewerRole := role.Role{ID: roleViewerID, Name: "viewer"}
 	ownerRole := role.Role{ID: roleOwnerID, Name: schema.RoleOrganizationOwner}
+	expiresAt := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name         string
@@ -115,6 +117,29 @@ func TestService_ListPrincipalsByResource(t *testing.T) {
 				{PrincipalID: user2, PrincipalType: schema.UserPrincipal, Roles: []role.Role{viewerRole}},
 			},
 		},
+		{
+			name:         "reports when the time bound policies of a member expire",
+			resourceID:   orgID,
+			resourceType: schema.OrganizationNamespace,
+			filter:       membership.MemberFilter{PrincipalType: schema.UserPrincipal},
+			setup: func(ps *mocks.PolicyService, rs *mocks.RoleService) {
+				ps.EXPECT().List(ctx, policy.Filter{
+					OrgID:         orgID,
+					PrincipalType: schema.UserPrincipal,
+					ResourceType:  schema.OrganizationNamespace,
+				}).Return([]policy.Policy{
+					{PrincipalID: user1, PrincipalType: schema.UserPrincipal, RoleID: roleViewerID, ExpiresAt: expiresAt},
+					{PrincipalID: user1, PrincipalType: schema.UserPrincipal, RoleID: roleOwnerID, ExpiresAt: expiresAt.Add(time.Hour)},
+					{PrincipalID: user2, PrincipalType: schema.UserPrincipal, RoleID: roleViewerID, ExpiresAt: expiresAt},
+					{PrincipalID: user2, PrincipalType: schema.UserPrincipal, RoleID: roleOwnerID},
+				}, nil).Once()
+				rs.EXPECT().List(ctx, mock.Anything).Return([]role.Role{viewerRole, ownerRole}, nil)
+			},
+			want: []membership.Member{
+				{PrincipalID: user1, PrincipalType: schema.UserPrincipal, Roles: []role.Role{viewerRole, ownerRole}, ExpiresAt: expiresAt.Add(time.Hour)},
+				{PrincipalID: user2, PrincipalType: schema.UserPrincipal, Roles: []role.Role{viewerRole, ownerRole}},
+			},
+		},
 		{
 			name:         "filters by roles when RoleIDs provided",
 			resourceID:   orgID,
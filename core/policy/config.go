@@ -0,0 +1,15 @@
+package policy
+
+type Config struct {
+	// Caveat writes the validity window of time bound policies to the authz
+	// engine, checks honour the window before the reaper removes the policy
+	Caveat bool         `yaml:"caveat" mapstructure:"caveat" default:"false"`
+	Reaper ReaperConfig `yaml:"reaper" mapstructure:"reaper"`
+}
+
+type ReaperConfig struct {
+	// Enabled deletes expired policies and writes the relations of policies
+	// whose validity window started
+	Enabled  bool   `yaml:"enabled" mapstructure:"enabled" default:"true"`
+	Schedule string `yaml:"schedule" mapstructure:"schedule" default:"@every 1m"`
+}
@@ -9,4 +9,5 @@ var (
 	ErrConflict      = errors.New("policy already exist")
 	ErrInvalidDetail = errors.New("invalid policy detail")
 	ErrLastRoleGuard = errors.New("cannot delete: this is the last policy with the guarded role for this resource")
+	ErrInvalidWindow = errors.New("policy must expire in the future and after it starts")
 )
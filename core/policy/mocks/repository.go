@@ -7,6 +7,8 @@ import (
 
 	policy "github.com/raystack/frontier/core/policy"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -349,6 +351,171 @@ func (_c *Repository_List_Call) RunAndReturn(run func(context.Context, policy.Fi
 	return _c
 }
 
+// ListDue provides a mock function with given fields: ctx, now
+func (_m *Repository) ListDue(ctx context.Context, now time.Time) ([]policy.Policy, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDue")
+	}
+
+	var r0 []policy.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]policy.Policy, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []policy.Policy); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]policy.Policy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_ListDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDue'
+type Repository_ListDue_Call struct {
+	*mock.Call
+}
+
+// ListDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *Repository_Expecter) ListDue(ctx interface{}, now interface{}) *Repository_ListDue_Call {
+	return &Repository_ListDue_Call{Call: _e.mock.On("ListDue", ctx, now)}
+}
+
+func (_c *Repository_ListDue_Call) Run(run func(ctx context.Context, now time.Time)) *Repository_ListDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repository_ListDue_Call) Return(_a0 []policy.Policy, _a1 error) *Repository_ListDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_ListDue_Call) RunAndReturn(run func(context.Context, time.Time) ([]policy.Policy, error)) *Repository_ListDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpired provides a mock function with given fields: ctx, now
+func (_m *Repository) ListExpired(ctx context.Context, now time.Time) ([]policy.Policy, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpired")
+	}
+
+	var r0 []policy.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]policy.Policy, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []policy.Policy); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]policy.Policy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_ListExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpired'
+type Repository_ListExpired_Call struct {
+	*mock.Call
+}
+
+// ListExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *Repository_Expecter) ListExpired(ctx interface{}, now interface{}) *Repository_ListExpired_Call {
+	return &Repository_ListExpired_Call{Call: _e.mock.On("ListExpired", ctx, now)}
+}
+
+func (_c *Repository_ListExpired_Call) Run(run func(ctx context.Context, now time.Time)) *Repository_ListExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Repository_ListExpired_Call) Return(_a0 []policy.Policy, _a1 error) *Repository_ListExpired_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_ListExpired_Call) RunAndReturn(run func(context.Context, time.Time) ([]policy.Policy, error)) *Repository_ListExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkActivated provides a mock function with given fields: ctx, id
+func (_m *Repository) MarkActivated(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkActivated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_MarkActivated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkActivated'
+type Repository_MarkActivated_Call struct {
+	*mock.Call
+}
+
+// MarkActivated is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *Repository_Expecter) MarkActivated(ctx interface{}, id interface{}) *Repository_MarkActivated_Call {
+	return &Repository_MarkActivated_Call{Call: _e.mock.On("MarkActivated", ctx, id)}
+}
+
+func (_c *Repository_MarkActivated_Call) Run(run func(ctx context.Context, id string)) *Repository_MarkActivated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Repository_MarkActivated_Call) Return(_a0 error) *Repository_MarkActivated_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_MarkActivated_Call) RunAndReturn(run func(context.Context, string) error) *Repository_MarkActivated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OrgMemberCount provides a mock function with given fields: ctx, ID
 func (_m *Repository) OrgMemberCount(ctx context.Context, ID string) (policy.MemberCount, error) {
 	ret := _m.Called(ctx, ID)
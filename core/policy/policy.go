@@ -17,6 +17,12 @@ type Repository interface {
 	GroupMemberCount(ctx context.Context, IDs []string) ([]MemberCount, error)
 	ProjectMemberCount(ctx context.Context, IDs []string) ([]MemberCount, error)
 	OrgMemberCount(ctx context.Context, ID string) (MemberCount, error)
+	// ListExpired lists the policies whose validity window ended by now
+	ListExpired(ctx context.Context, now time.Time) ([]Policy, error)
+	// ListDue lists the policies whose validity window started by now but
+	// whose relations aren't written yet
+	ListDue(ctx context.Context, now time.Time) ([]Policy, error)
+	MarkActivated(ctx context.Context, id string) error
 }
 
 type Policy struct {
@@ -29,10 +35,30 @@ type Policy struct {
 	GrantRelation string `json:"grant_relation"`
 	Metadata      metadata.Metadata
 
+	// NotBefore and ExpiresAt bound the validity of a time bound policy,
+	// zero values leave the window open on that side
+	NotBefore time.Time `json:"not_before"`
+	ExpiresAt time.Time `json:"expires_at"`
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// TimeBound reports if the policy is only valid within a window
+func (p Policy) TimeBound() bool {
+	return !p.NotBefore.IsZero() || !p.ExpiresAt.IsZero()
+}
+
+// Pending reports if the validity window of the policy hasn't started
+func (p Policy) Pending(now time.Time) bool {
+	return p.NotBefore.After(now)
+}
+
+// Expired reports if the validity window of the policy has ended
+func (p Policy) Expired(now time.Time) bool {
+	return !p.ExpiresAt.IsZero() && !p.ExpiresAt.After(now)
+}
+
 type Filters struct {
 	UserID  string
 	GroupID string
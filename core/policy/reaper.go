@@ -0,0 +1,76 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Init schedules the reaper of time bound policies
+func (s *Service) Init(ctx context.Context) error {
+	if !s.config.Reaper.Enabled {
+		return nil
+	}
+
+	s.cron = cron.New(cron.WithChain(
+		cron.SkipIfStillRunning(cron.DefaultLogger),
+		cron.Recover(cron.DefaultLogger),
+	))
+	if _, err := s.cron.AddFunc(s.config.Reaper.Schedule, func() {
+		if err := s.Reap(ctx); err != nil {
+			s.log.ErrorContext(ctx, "failed to reap time bound policies", "err", err)
+		}
+	}); err != nil {
+		return fmt.Errorf("failed to schedule policy reaper: %w", err)
+	}
+	s.cron.Start()
+	return nil
+}
+
+func (s *Service) Close() error {
+	if s.cron != nil {
+		<-s.cron.Stop().Done()
+	}
+	return nil
+}
+
+// Reap writes the relations of the policies whose validity window started
+// and deletes the policies whose window ended, deleting a policy records
+// a policy.deleted audit record
+func (s *Service) Reap(ctx context.Context) error {
+	now := s.Now()
+	var errs []error
+
+	due, err := s.repository.ListDue(ctx, now)
+	if err != nil {
+		return fmt.Errorf("list due policies: %w", err)
+	}
+	for _, pol := range due {
+		if pol.Expired(now) {
+			continue
+		}
+		if err := s.AssignRole(ctx, pol); err != nil {
+			errs = append(errs, fmt.Errorf("activate policy %s: %w", pol.ID, err))
+			continue
+		}
+		if err := s.repository.MarkActivated(ctx, pol.ID); err != nil {
+			errs = append(errs, fmt.Errorf("activate policy %s: %w", pol.ID, err))
+		}
+	}
+
+	expired, err := s.repository.ListExpired(ctx, now)
+	if err != nil {
+		return errors.Join(append(errs, fmt.Errorf("list expired policies: %w", err))...)
+	}
+	for _, pol := range expired {
+		if err := s.Delete(ctx, pol.ID); err != nil && !errors.Is(err, ErrNotExist) {
+			errs = append(errs, fmt.Errorf("delete expired policy %s: %w", pol.ID, err))
+			continue
+		}
+		s.log.InfoContext(ctx, "deleted expired policy", "policy_id", pol.ID,
+			"principal_id", pol.PrincipalID, "resource_id", pol.ResourceID)
+	}
+	return errors.Join(errs...)
+}
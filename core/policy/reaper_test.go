@@ -0,0 +1,65 @@
+package policy_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestService_Reap(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should activate due policies and delete expired ones", func(t *testing.T) {
+		repo, roleService, relationService := mockService(t)
+		svc := policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
+		svc.Now = func() time.Time { return now }
+
+		due := policy.Policy{ID: "due-id", RoleID: "role-id", ResourceID: "org-id", ResourceType: schema.OrganizationNamespace,
+			PrincipalID: "user-id", PrincipalType: schema.UserPrincipal, GrantRelation: schema.RoleGrantRelationName,
+			NotBefore: now.Add(-time.Minute), ExpiresAt: now.Add(time.Hour)}
+		lapsed := policy.Policy{ID: "lapsed-id", NotBefore: now.Add(-2 * time.Hour), ExpiresAt: now.Add(-time.Hour)}
+		expired := policy.Policy{ID: "expired-id", ExpiresAt: now.Add(-time.Minute)}
+
+		repo.EXPECT().ListDue(ctx, now).Return([]policy.Policy{due, lapsed}, nil)
+		relationService.On("Create", ctx, mock.MatchedBy(func(rel relation.Relation) bool {
+			return rel.Object.ID == "due-id" || rel.Subject.ID == "due-id"
+		})).Return(relation.Relation{}, nil).Times(3)
+		repo.EXPECT().MarkActivated(ctx, "due-id").Return(nil)
+
+		repo.EXPECT().ListExpired(ctx, now).Return([]policy.Policy{lapsed, expired}, nil)
+		for _, id := range []string{"lapsed-id", "expired-id"} {
+			relationService.On("Delete", ctx, relation.Relation{Object: relation.Object{ID: id, Namespace: schema.RoleBindingNamespace}}).Return(nil)
+			relationService.On("Delete", ctx, relation.Relation{Subject: relation.Subject{ID: id, Namespace: schema.RoleBindingNamespace}}).Return(nil)
+		}
+		repo.EXPECT().Delete(ctx, "lapsed-id").Return(policy.ErrNotExist)
+		repo.EXPECT().Delete(ctx, "expired-id").Return(nil)
+
+		assert.NoError(t, svc.Reap(ctx))
+	})
+
+	t.Run("should keep reaping when a policy fails", func(t *testing.T) {
+		repo, roleService, relationService := mockService(t)
+		svc := policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
+		svc.Now = func() time.Time { return now }
+
+		repo.EXPECT().ListDue(ctx, now).Return(nil, nil)
+		repo.EXPECT().ListExpired(ctx, now).Return([]policy.Policy{{ID: "first-id"}, {ID: "second-id"}}, nil)
+		relationService.On("Delete", ctx, relation.Relation{Object: relation.Object{ID: "first-id", Namespace: schema.RoleBindingNamespace}}).Return(errors.New("spicedb unavailable"))
+		relationService.On("Delete", ctx, mock.MatchedBy(func(rel relation.Relation) bool {
+			return rel.Object.ID == "second-id" || rel.Subject.ID == "second-id"
+		})).Return(nil).Twice()
+		repo.EXPECT().Delete(ctx, "second-id").Return(nil)
+
+		assert.ErrorContains(t, svc.Reap(ctx), "first-id")
+	})
+}
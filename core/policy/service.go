@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/raystack/frontier/pkg/utils"
+	"github.com/robfig/cron/v3"
 
 	"github.com/raystack/frontier/core/role"
 
@@ -24,16 +27,25 @@ type RoleService interface {
 }
 
 type Service struct {
+	log             *slog.Logger
+	config          Config
 	repository      Repository
 	relationService RelationService
 	roleService     RoleService
+	cron            *cron.Cron
+	Now             func() time.Time
 }
 
-func NewService(repository Repository, relationService RelationService, roleService RoleService) *Service {
+func NewService(logger *slog.Logger, config Config, repository Repository, relationService RelationService, roleService RoleService) *Service {
 	return &Service{
+		log:             logger,
+		config:          config,
 		repository:      repository,
 		relationService: relationService,
 		roleService:     roleService,
+		Now: func() time.Time {
+			return time.Now().UTC()
+		},
 	}
 }
 
@@ -67,11 +79,21 @@ func (s Service) Create(ctx context.Context, policy Policy) (Policy, error) {
 			schema.PATGrantRelationName, schema.PATPrincipal, policy.PrincipalType)
 	}
 
+	now := s.Now()
+	if !policy.ExpiresAt.IsZero() && (!policy.ExpiresAt.After(now) || !policy.ExpiresAt.After(policy.NotBefore)) {
+		return Policy{}, ErrInvalidWindow
+	}
+
 	createdPolicy, err := s.repository.Upsert(ctx, policy)
 	if err != nil {
 		return Policy{}, err
 	}
 
+	// without the caveat the relations of a pending policy are written by
+	// the reaper once its window starts
+	if createdPolicy.Pending(now) && !s.config.Caveat {
+		return createdPolicy, s.deleteRoleBindingRelations(ctx, createdPolicy.ID)
+	}
 	if err = s.AssignRole(ctx, createdPolicy); err != nil {
 		return createdPolicy, err
 	}
@@ -135,6 +157,7 @@ func (s Service) AssignRole(ctx context.Context, pol Policy) error {
 			SubRelationName: subjectSubRelation,
 		},
 		RelationName: schema.RoleBearerRelationName,
+		Caveat:       s.windowCaveat(pol),
 	})
 	if err != nil {
 		return err
@@ -172,6 +195,28 @@ func (s Service) AssignRole(ctx context.Context, pol Policy) error {
 	return nil
 }
 
+// windowCaveat limits the bearer of a time bound policy to its validity
+// window when the caveat is enabled
+func (s Service) windowCaveat(pol Policy) *relation.Caveat {
+	if !s.config.Caveat || !pol.TimeBound() {
+		return nil
+	}
+	notBefore, expiresAt := pol.NotBefore, pol.ExpiresAt
+	if notBefore.IsZero() {
+		notBefore = time.Unix(0, 0)
+	}
+	if expiresAt.IsZero() {
+		expiresAt = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
+	}
+	return &relation.Caveat{
+		Name: schema.RoleBindingWindowCaveat,
+		Context: map[string]any{
+			"not_before": notBefore.UTC().Format(time.RFC3339),
+			"expires_at": expiresAt.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
 // ListRoles lists roles assigned via policies to a user
 func (s Service) ListRoles(ctx context.Context, principalType, principalID, objectNamespace, objectID string) ([]role.Role, error) {
 	flt := Filter{
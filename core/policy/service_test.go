@@ -3,7 +3,10 @@ package policy_test
 import (
 	"context"
 	"errors"
+	"io"
+	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/raystack/frontier/core/policy"
@@ -11,6 +14,7 @@ import (
 	"github.com/raystack/frontier/core/relation"
 	"github.com/raystack/frontier/core/role"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/stretchr/testify/mock"
 )
 
 func mockService(t *testing.T) (*mocks.Repository, *mocks.RoleService, *mocks.RelationService) {
@@ -48,7 +52,7 @@ func TestService_Delete(t *testing.T) {
 					},
 				}).Return(nil)
 				repo.On("Delete", ctx, "test-id").Return(nil)
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 		{
@@ -70,7 +74,7 @@ func TestService_Delete(t *testing.T) {
 					},
 				}).Return(relation.ErrNotExist)
 				repo.On("Delete", ctx, "test-id").Return(nil)
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 		{
@@ -85,7 +89,7 @@ func TestService_Delete(t *testing.T) {
 						Namespace: schema.RoleBindingNamespace,
 					},
 				}).Return(errors.New("relation delete failed"))
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 		{
@@ -106,7 +110,7 @@ func TestService_Delete(t *testing.T) {
 						Namespace: schema.RoleBindingNamespace,
 					},
 				}).Return(errors.New("spicedb unavailable"))
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 	}
@@ -149,7 +153,7 @@ func TestService_DeleteWithMinRoleGuard(t *testing.T) {
 						Namespace: schema.RoleBindingNamespace,
 					},
 				}).Return(nil)
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 		{
@@ -172,7 +176,7 @@ func TestService_DeleteWithMinRoleGuard(t *testing.T) {
 						Namespace: schema.RoleBindingNamespace,
 					},
 				}).Return(relation.ErrNotExist)
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 		{
@@ -183,7 +187,7 @@ func TestService_DeleteWithMinRoleGuard(t *testing.T) {
 			setup: func() *policy.Service {
 				repo, roleService, relationService := mockService(t)
 				repo.On("DeleteWithMinRoleGuard", ctx, "test-id", "guard-role-id").Return(errors.New("guard violated"))
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 	}
@@ -215,7 +219,7 @@ func TestService_Create(t *testing.T) {
 			setup: func() *policy.Service {
 				repo, roleService, relationService := mockService(t)
 				roleService.On("Get", ctx, "role-id").Return(role.Role{}, errors.New("role not found"))
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 		{
@@ -228,7 +232,7 @@ func TestService_Create(t *testing.T) {
 			setup: func() *policy.Service {
 				repo, roleService, relationService := mockService(t)
 				roleService.On("Get", ctx, "role-id").Return(role.Role{ID: "role-id"}, nil)
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 		{
@@ -242,7 +246,7 @@ func TestService_Create(t *testing.T) {
 			setup: func() *policy.Service {
 				repo, roleService, relationService := mockService(t)
 				roleService.On("Get", ctx, "role-id").Return(role.Role{ID: "role-id"}, nil)
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 		{
@@ -320,7 +324,7 @@ func TestService_Create(t *testing.T) {
 					},
 					RelationName: schema.RoleGrantRelationName,
 				}).Return(relation.Relation{}, nil)
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 		{
@@ -398,7 +402,7 @@ func TestService_Create(t *testing.T) {
 					},
 					RelationName: schema.RoleGrantRelationName,
 				}).Return(relation.Relation{}, nil)
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 	}
@@ -471,7 +475,7 @@ func TestService_ListRoles(t *testing.T) {
 						Name: "role-name",
 					},
 				}, nil)
-				return policy.NewService(repo, relationService, roleService)
+				return policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), policy.Config{}, repo, relationService, roleService)
 			},
 		},
 	}
@@ -489,3 +493,86 @@ func TestService_ListRoles(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Create_TimeBound(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	newService := func(config policy.Config, repo *mocks.Repository, roleService *mocks.RoleService, relationService *mocks.RelationService) *policy.Service {
+		svc := policy.NewService(slog.New(slog.NewTextHandler(io.Discard, nil)), config, repo, relationService, roleService)
+		svc.Now = func() time.Time { return now }
+		return svc
+	}
+	timeBound := func(notBefore, expiresAt time.Time) policy.Policy {
+		return policy.Policy{
+			ID:            "policy-id",
+			RoleID:        "role-id",
+			ResourceID:    "resource-id",
+			ResourceType:  schema.ProjectNamespace,
+			PrincipalID:   "user-id",
+			PrincipalType: schema.UserPrincipal,
+			GrantRelation: schema.RoleGrantRelationName,
+			NotBefore:     notBefore,
+			ExpiresAt:     expiresAt,
+		}
+	}
+
+	t.Run("should reject a policy expiring in the past or before it starts", func(t *testing.T) {
+		for _, pol := range []policy.Policy{
+			timeBound(time.Time{}, now.Add(-time.Minute)),
+			timeBound(now.Add(2*time.Hour), now.Add(time.Hour)),
+		} {
+			repo, roleService, relationService := mockService(t)
+			roleService.On("Get", ctx, "role-id").Return(role.Role{ID: "role-id"}, nil)
+
+			_, err := newService(policy.Config{}, repo, roleService, relationService).Create(ctx, pol)
+			if !errors.Is(err, policy.ErrInvalidWindow) {
+				t.Errorf("Create() error = %v, want %v", err, policy.ErrInvalidWindow)
+			}
+		}
+	})
+
+	t.Run("should leave the relations of a pending policy to the reaper", func(t *testing.T) {
+		pol := timeBound(now.Add(time.Hour), now.Add(2*time.Hour))
+		repo, roleService, relationService := mockService(t)
+		roleService.On("Get", ctx, "role-id").Return(role.Role{ID: "role-id"}, nil)
+		repo.On("Upsert", ctx, pol).Return(pol, nil)
+		relationService.On("Delete", ctx, mock.AnythingOfType("relation.Relation")).Return(nil).Twice()
+
+		got, err := newService(policy.Config{}, repo, roleService, relationService).Create(ctx, pol)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if diff := cmp.Diff(pol, got); diff != "" {
+			t.Errorf("Create() mismatch (-want +got):\n%s", diff)
+		}
+		relationService.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should write the window of the policy as a caveat of the bearer", func(t *testing.T) {
+		pol := timeBound(now.Add(time.Hour), time.Time{})
+		repo, roleService, relationService := mockService(t)
+		roleService.On("Get", ctx, "role-id").Return(role.Role{ID: "role-id"}, nil)
+		repo.On("Upsert", ctx, pol).Return(pol, nil)
+		var bearer relation.Relation
+		relationService.On("Create", ctx, mock.AnythingOfType("relation.Relation")).Run(func(args mock.Arguments) {
+			if rel := args.Get(1).(relation.Relation); rel.RelationName == schema.RoleBearerRelationName {
+				bearer = rel
+			}
+		}).Return(relation.Relation{}, nil).Times(3)
+
+		_, err := newService(policy.Config{Caveat: true}, repo, roleService, relationService).Create(ctx, pol)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		want := &relation.Caveat{
+			Name: schema.RoleBindingWindowCaveat,
+			Context: map[string]any{
+				"not_before": "2026-10-17T13:00:00Z",
+				"expires_at": "9999-12-31T23:59:59Z",
+			},
+		}
+		if diff := cmp.Diff(want, bearer.Caveat); diff != "" {
+			t.Errorf("bearer caveat mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
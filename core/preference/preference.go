@@ -15,6 +15,8 @@ var (
 	ErrTraitNotFound = fmt.Errorf("preference trait not found, preferences can only be created with valid trait")
 	ErrInvalidValue  = fmt.Errorf("invalid value for preference")
 	ErrInvalidScope  = fmt.Errorf("invalid scope: trait does not support scoping or scope_type/scope_id mismatch")
+
+	ErrMissingEncryptionKey = fmt.Errorf("encryption key of sensitive preferences is not configured")
 )
 
 type TraitInput string
@@ -42,6 +44,20 @@ const (
 	OrganizationMailLink    = "mail_link"
 	OrganizationMailOTP     = "mail_otp"
 	OrganizationSocialLogin = "social_login"
+	OrganizationMFARequired = "mfa_required"
+
+	// organization session traits, the sessions of members follow the
+	// strictest policy of their organizations
+	OrganizationSessionIdleTimeout = "session_idle_timeout"
+	OrganizationSessionMaxSessions = "session_max_sessions"
+	OrganizationSessionBinding     = "session_binding"
+
+	// organization sso traits, members of the verified domains of an
+	// organization sign in with its own OIDC identity provider
+	OrganizationSSOEnforced     = "sso_enforced"
+	OrganizationSSOIssuerURL    = "sso_oidc_issuer_url"
+	OrganizationSSOClientID     = "sso_oidc_client_id"
+	OrganizationSSOClientSecret = "sso_oidc_client_secret"
 
 	// user default traits
 	UserFirstName  = "first_name"
@@ -84,6 +100,8 @@ type Trait struct {
 	InputOptions []InputHintOption `json:"input_options" yaml:"input_options"`
 	// Default value to be used for the trait if the preference is not set (say "true" for a TraitInput of type Checkbox)
 	Default string `json:"default" yaml:"default"`
+	// Sensitive values are stored encrypted and masked when read back
+	Sensitive bool `json:"sensitive" yaml:"sensitive"`
 	// AllowedScopes specifies which scope types are valid for this trait
 	// e.g., ["app/organization"] allows org-scoped preferences
 	// Empty means the trait is global only (no scoping allowed)
@@ -246,4 +264,92 @@ var DefaultTraits = []Trait{
 		SubHeading:   "Manage organization security and how it's members authenticate.",
 		Input:        TraitInputCheckbox,
 	},
+	{
+		ResourceType: schema.OrganizationNamespace,
+		Name:         OrganizationMFARequired,
+		Title:        "Require two-factor authentication",
+		Description:  "Members verify a code of their authenticator app after the login. Default is false.",
+		Heading:      "Security",
+		SubHeading:   "Manage organization security and how it's members authenticate.",
+		Input:        TraitInputCheckbox,
+		InputHints:   "true,false",
+		Default:      "false",
+	},
+	{
+		ResourceType: schema.OrganizationNamespace,
+		Name:         OrganizationSessionIdleTimeout,
+		Title:        "Session idle timeout",
+		Description:  "Sign members out after the minutes without activity, applies to the sessions of the next login. Default is 0, no timeout.",
+		Heading:      "Security",
+		SubHeading:   "Manage organization security and how it's members authenticate.",
+		Breadcrumb:   "Organization.Security.Sessions",
+		Input:        TraitInputNumber,
+		Default:      "0",
+	},
+	{
+		ResourceType: schema.OrganizationNamespace,
+		Name:         OrganizationSessionMaxSessions,
+		Title:        "Maximum sessions",
+		Description:  "Number of sessions a member can have at once, a login over it signs the oldest session out. Default is 0, no limit.",
+		Heading:      "Security",
+		SubHeading:   "Manage organization security and how it's members authenticate.",
+		Breadcrumb:   "Organization.Security.Sessions",
+		Input:        TraitInputNumber,
+		Default:      "0",
+	},
+	{
+		ResourceType: schema.OrganizationNamespace,
+		Name:         OrganizationSessionBinding,
+		Title:        "Session binding",
+		Description:  "Sign members out when a session is used from another device, or another device or ip. Default is none.",
+		Heading:      "Security",
+		SubHeading:   "Manage organization security and how it's members authenticate.",
+		Breadcrumb:   "Organization.Security.Sessions",
+		Input:        TraitInputSelect,
+		InputHints:   "none,device,ip",
+		Default:      "none",
+	},
+	{
+		ResourceType: schema.OrganizationNamespace,
+		Name:         OrganizationSSOIssuerURL,
+		Title:        "SSO issuer URL",
+		Description:  "Issuer URL of the OpenID Connect identity provider of the organization.",
+		Heading:      "Security",
+		SubHeading:   "Manage organization security and how it's members authenticate.",
+		Breadcrumb:   "Organization.Security.SSO",
+		Input:        TraitInputText,
+	},
+	{
+		ResourceType: schema.OrganizationNamespace,
+		Name:         OrganizationSSOClientID,
+		Title:        "SSO client ID",
+		Description:  "Client ID of frontier registered with the identity provider of the organization.",
+		Heading:      "Security",
+		SubHeading:   "Manage organization security and how it's members authenticate.",
+		Breadcrumb:   "Organization.Security.SSO",
+		Input:        TraitInputText,
+	},
+	{
+		ResourceType: schema.OrganizationNamespace,
+		Name:         OrganizationSSOClientSecret,
+		Title:        "SSO client secret",
+		Description:  "Client secret of frontier registered with the identity provider of the organization.",
+		Heading:      "Security",
+		SubHeading:   "Manage organization security and how it's members authenticate.",
+		Breadcrumb:   "Organization.Security.SSO",
+		Input:        TraitInputText,
+		Sensitive:    true,
+	},
+	{
+		ResourceType: schema.OrganizationNamespace,
+		Name:         OrganizationSSOEnforced,
+		Title:        "Enforce SSO",
+		Description:  "Users of the verified domains of the organization can only sign in with its identity provider. Default is false.",
+		Heading:      "Security",
+		SubHeading:   "Manage organization security and how it's members authenticate.",
+		Breadcrumb:   "Organization.Security.SSO",
+		Input:        TraitInputCheckbox,
+		InputHints:   "true,false",
+		Default:      "false",
+	},
 }
@@ -2,9 +2,11 @@ package preference
 
 import (
 	"context"
+	"encoding/base64"
 
 	"github.com/google/uuid"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/crypt"
 )
 
 var (
@@ -12,6 +14,9 @@ var (
 	PlatformID = uuid.Nil.String()
 )
 
+// SensitiveValueMask replaces the value of sensitive preferences when read
+const SensitiveValueMask = "********"
+
 type Repository interface {
 	Set(ctx context.Context, preference Preference) (Preference, error)
 	Get(ctx context.Context, id uuid.UUID) (Preference, error)
@@ -19,8 +24,9 @@ type Repository interface {
 }
 
 type Service struct {
-	repo   Repository
-	traits []Trait
+	repo          Repository
+	traits        []Trait
+	encryptionKey []byte
 }
 
 func NewService(repo Repository, traits []Trait) *Service {
@@ -33,6 +39,12 @@ func NewService(repo Repository, traits []Trait) *Service {
 	}
 }
 
+// SetEncryptionKey sets the 32 bytes long key sensitive preferences are
+// encrypted with
+func (s *Service) SetEncryptionKey(key []byte) {
+	s.encryptionKey = key
+}
+
 func (s *Service) Create(ctx context.Context, preference Preference) (Preference, error) {
 	// only allow creating preferences for which a trait exists
 	var matchedTrait *Trait
@@ -68,10 +80,18 @@ func (s *Service) Create(ctx context.Context, preference Preference) (Preference
 	if !validator.Validate(preference.Value) {
 		return Preference{}, ErrInvalidValue
 	}
+	if matchedTrait.Sensitive {
+		encrypted, err := s.encrypt(preference.Value)
+		if err != nil {
+			return Preference{}, err
+		}
+		preference.Value = encrypted
+	}
 	created, err := s.repo.Set(ctx, preference)
 	if err != nil {
 		return Preference{}, err
 	}
+	created = s.mask(created)
 	// Populate ValueDescription from trait's InputOptions
 	created.ValueDescription = matchedTrait.GetValueDescription(created.Value)
 	return created, nil
@@ -82,11 +102,22 @@ func (s *Service) Get(ctx context.Context, id string) (Preference, error) {
 	if err != nil {
 		return Preference{}, ErrInvalidID
 	}
-	return s.repo.Get(ctx, prefID)
+	pref, err := s.repo.Get(ctx, prefID)
+	if err != nil {
+		return Preference{}, err
+	}
+	return s.mask(pref), nil
 }
 
 func (s *Service) List(ctx context.Context, filter Filter) ([]Preference, error) {
-	return s.repo.List(ctx, filter)
+	prefs, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for i := range prefs {
+		prefs[i] = s.mask(prefs[i])
+	}
+	return prefs, nil
 }
 
 func (s *Service) Describe(ctx context.Context) []Trait {
@@ -134,6 +165,7 @@ func (s *Service) LoadUserPreferences(ctx context.Context, filter Filter) ([]Pre
 			continue
 		}
 		if pref, exists := prefMap[trait.Name]; exists {
+			pref = s.mask(pref)
 			// Populate ValueDescription from trait's InputOptions
 			pref.ValueDescription = trait.GetValueDescription(pref.Value)
 			result = append(result, pref)
@@ -189,3 +221,80 @@ func (s *Service) LoadPlatformPreferences(ctx context.Context) (map[string]strin
 	}
 	return prefs, nil
 }
+
+// LoadOrganizationPreferences loads the preferences of an organization with
+// sensitive values decrypted and returns a map of preference name to value,
+// the default value of the trait is used for the ones not set
+func (s *Service) LoadOrganizationPreferences(ctx context.Context, orgID string) (map[string]string, error) {
+	preferences, err := s.repo.List(ctx, Filter{
+		OrgID: orgID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prefs := make(map[string]string)
+	for _, pref := range preferences {
+		value := pref.Value
+		if trait := s.trait(pref.ResourceType, pref.Name); trait != nil && trait.Sensitive && value != "" {
+			if value, err = s.decrypt(value); err != nil {
+				return nil, err
+			}
+		}
+		prefs[pref.Name] = value
+	}
+
+	for _, t := range s.traits {
+		if t.ResourceType == schema.OrganizationNamespace && prefs[t.Name] == "" {
+			prefs[t.Name] = t.Default
+		}
+	}
+	return prefs, nil
+}
+
+func (s *Service) trait(resourceType, name string) *Trait {
+	for i, trait := range s.traits {
+		if trait.ResourceType == resourceType && trait.Name == name {
+			return &s.traits[i]
+		}
+	}
+	return nil
+}
+
+// mask hides the value of a sensitive preference, an empty value shows it
+// isn't set
+func (s *Service) mask(pref Preference) Preference {
+	if trait := s.trait(pref.ResourceType, pref.Name); trait != nil && trait.Sensitive && pref.Value != "" {
+		pref.Value = SensitiveValueMask
+	}
+	return pref
+}
+
+func (s *Service) encrypt(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if len(s.encryptionKey) == 0 {
+		return "", ErrMissingEncryptionKey
+	}
+	encrypted, err := crypt.Encrypt([]byte(value), s.encryptionKey)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawStdEncoding.EncodeToString(encrypted), nil
+}
+
+func (s *Service) decrypt(value string) (string, error) {
+	if len(s.encryptionKey) == 0 {
+		return "", ErrMissingEncryptionKey
+	}
+	encrypted, err := base64.RawStdEncoding.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+	decrypted, err := crypt.Decrypt(encrypted, s.encryptionKey)
+	if err != nil {
+		return "", err
+	}
+	return string(decrypted), nil
+}
@@ -354,3 +354,85 @@ func TestCreate(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+// memoryRepository keeps preferences as stored, to check what is persisted
+type memoryRepository struct {
+	prefs []Preference
+}
+
+func (r *memoryRepository) Set(ctx context.Context, preference Preference) (Preference, error) {
+	r.prefs = append(r.prefs, preference)
+	return preference, nil
+}
+
+func (r *memoryRepository) Get(ctx context.Context, id uuid.UUID) (Preference, error) {
+	return Preference{}, ErrNotFound
+}
+
+func (r *memoryRepository) List(ctx context.Context, filter Filter) ([]Preference, error) {
+	return append([]Preference{}, r.prefs...), nil
+}
+
+func TestSensitivePreferences(t *testing.T) {
+	ctx := context.Background()
+	orgID := "org-123"
+	encryptionKey := []byte("hash-secret-should-be-32-chars--")
+
+	testTraits := []Trait{
+		{
+			ResourceType: schema.OrganizationNamespace,
+			Name:         "client_secret",
+			Input:        TraitInputText,
+			Sensitive:    true,
+		},
+		{
+			ResourceType: schema.OrganizationNamespace,
+			Name:         "enforced",
+			Input:        TraitInputCheckbox,
+			Default:      "false",
+		},
+	}
+
+	t.Run("Create stores sensitive values encrypted and masks them", func(t *testing.T) {
+		repo := &memoryRepository{}
+		svc := NewService(repo, testTraits)
+		svc.SetEncryptionKey(encryptionKey)
+
+		result, err := svc.Create(ctx, Preference{
+			Name:         "client_secret",
+			Value:        "s3cret",
+			ResourceID:   orgID,
+			ResourceType: schema.OrganizationNamespace,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, SensitiveValueMask, result.Value)
+		require.Len(t, repo.prefs, 1)
+		assert.NotEqual(t, "s3cret", repo.prefs[0].Value)
+		assert.NotEmpty(t, repo.prefs[0].Value)
+
+		listed, err := svc.List(ctx, Filter{OrgID: orgID})
+		require.NoError(t, err)
+		assert.Equal(t, SensitiveValueMask, listed[0].Value)
+
+		loaded, err := svc.LoadOrganizationPreferences(ctx, orgID)
+		require.NoError(t, err)
+		assert.Equal(t, "s3cret", loaded["client_secret"])
+		assert.Equal(t, "false", loaded["enforced"])
+	})
+
+	t.Run("Create fails for a sensitive value without an encryption key", func(t *testing.T) {
+		repo := &memoryRepository{}
+		svc := NewService(repo, testTraits)
+
+		_, err := svc.Create(ctx, Preference{
+			Name:         "client_secret",
+			Value:        "s3cret",
+			ResourceID:   orgID,
+			ResourceType: schema.OrganizationNamespace,
+		})
+
+		assert.ErrorIs(t, err, ErrMissingEncryptionKey)
+		assert.Empty(t, repo.prefs)
+	})
+}
@@ -15,6 +15,7 @@ type Repository interface {
 
 type AuthzRepository interface {
 	Check(ctx context.Context, rel Relation) (bool, error)
+	Explain(ctx context.Context, rel Relation) (Trace, error)
 	BatchCheck(ctx context.Context, relations []Relation) ([]CheckPair, error)
 	Delete(ctx context.Context, rel Relation) error
 	Add(ctx context.Context, rel Relation) error
@@ -39,11 +40,20 @@ type Subject struct {
 	SubRelationName string `json:"subject_sub_relation"`
 }
 
+// Caveat conditions a relation on the context of a check, the context of
+// the caveat holds the parameters known when the relation is written
+type Caveat struct {
+	Name    string
+	Context map[string]any
+}
+
 type Relation struct {
 	ID           string
 	Object       Object
 	Subject      Subject
 	RelationName string `json:"relation_name"`
+	// Caveat is only written to the authz engine
+	Caveat *Caveat `json:"-"`
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -53,3 +63,14 @@ type Filter struct {
 	Subject Subject
 	Object  Object
 }
+
+// Trace is a step of a permission check as evaluated by the authz engine,
+// Name is the permission or relation checked on the object and Children the
+// steps it was resolved with
+type Trace struct {
+	Object     Object
+	Name       string
+	Permission bool
+	Allowed    bool
+	Children   []Trace
+}
@@ -37,6 +37,7 @@ func (s Service) Create(ctx context.Context, rel Relation) (Relation, error) {
 		return Relation{}, fmt.Errorf("%w: %s", ErrCreatingRelationInStore, err.Error())
 	}
 
+	createdRelation.Caveat = rel.Caveat
 	err = s.authzRepository.Add(ctx, createdRelation)
 	if err != nil {
 		// PAT subjects may be rejected by the authz schema for relations they are not allowed on
@@ -80,6 +81,12 @@ func (s Service) CheckPermission(ctx context.Context, rel Relation) (bool, error
 	return s.authzRepository.Check(ctx, rel)
 }
 
+// ExplainPermission runs the check with tracing and returns how the authz
+// engine resolved it
+func (s Service) ExplainPermission(ctx context.Context, rel Relation) (Trace, error) {
+	return s.authzRepository.Explain(ctx, rel)
+}
+
 func (s Service) BatchCheckPermission(ctx context.Context, relations []Relation) ([]CheckPair, error) {
 	return s.authzRepository.BatchCheck(ctx, relations)
 }
@@ -1,5 +1,45 @@
 package webhook
 
+import "time"
+
 type Config struct {
-	EncryptionKey string `yaml:"encryption_key" mapstructure:"encryption_key" default:"hash-secret-should-be-32-chars--"`
+	EncryptionKey string         `yaml:"encryption_key" mapstructure:"encryption_key" default:"hash-secret-should-be-32-chars--"`
+	Delivery      DeliveryConfig `yaml:"delivery" mapstructure:"delivery"`
+}
+
+type DeliveryConfig struct {
+	// Interval at which queued deliveries are picked up. Newly published
+	// events are also attempted right away without waiting for the interval.
+	Interval time.Duration `yaml:"interval" mapstructure:"interval" default:"10s"`
+	// BatchSize is the maximum number of deliveries attempted per run
+	BatchSize int `yaml:"batch_size" mapstructure:"batch_size" default:"100"`
+	// MaxAttempts after which a delivery is marked failed
+	MaxAttempts int `yaml:"max_attempts" mapstructure:"max_attempts" default:"10"`
+	// InitialBackoff is the wait after the first failed attempt, doubled on
+	// every following failure up to MaxBackoff
+	InitialBackoff time.Duration `yaml:"initial_backoff" mapstructure:"initial_backoff" default:"30s"`
+	MaxBackoff     time.Duration `yaml:"max_backoff" mapstructure:"max_backoff" default:"6h"`
+	// Timeout of a single request to an endpoint
+	Timeout time.Duration `yaml:"timeout" mapstructure:"timeout" default:"5s"`
+	// DisableAfterFailures disables an endpoint once this many consecutive
+	// attempts to it have failed. 0 never disables an endpoint.
+	DisableAfterFailures int `yaml:"disable_after_failures" mapstructure:"disable_after_failures" default:"0"`
+	// AllowPrivateNetworks lets endpoints be on loopback, private and
+	// link-local addresses, for development setups where the receiver runs
+	// next to the server. Organizations could reach internal services
+	// through their webhooks with it.
+	AllowPrivateNetworks bool `yaml:"allow_private_networks" mapstructure:"allow_private_networks" default:"false"`
+}
+
+// Backoff returns how long to wait before retrying a delivery that has
+// failed attempts times so far
+func (c DeliveryConfig) Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+	wait := c.InitialBackoff
+	for i := 1; i < attempts && wait < c.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, c.MaxBackoff)
 }
@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/raystack/frontier/pkg/pagination"
+)
+
+type DeliveryStatus string
+
+const (
+	// DeliveryPending is waiting for its first or next attempt
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliverySucceeded got a 2xx response from the endpoint
+	DeliverySucceeded DeliveryStatus = "succeeded"
+	// DeliveryFailed exhausted every attempt without a 2xx response
+	DeliveryFailed DeliveryStatus = "failed"
+)
+
+// Delivery is one event queued for one endpoint. It is persisted before any
+// attempt is made so an event survives restarts and endpoint downtime.
+type Delivery struct {
+	ID         string
+	EndpointID string
+	EventID    string
+	Action     string
+	// PayloadFormat is the format the payload was rendered in
+	PayloadFormat PayloadFormat
+	// Payload is the serialized event. It is posted as is, except in the
+	// CloudEvents binary mode where it holds the structured event.
+	Payload []byte
+	// RequestID of the API call that produced the event, forwarded as a header
+	RequestID string
+	Status    DeliveryStatus
+
+	// AttemptCount is the number of attempts made since the delivery was
+	// last queued
+	AttemptCount   int
+	NextAttemptAt  time.Time
+	LastAttemptAt  *time.Time
+	LastStatusCode int
+	LastError      string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Attempt is the outcome of a single POST of a delivery
+type Attempt struct {
+	ID         string
+	DeliveryID string
+	// StatusCode is 0 when no response was received
+	StatusCode int
+	Latency    time.Duration
+	// Response is the leading part of the response body
+	Response  string
+	Error     string
+	CreatedAt time.Time
+}
+
+// Succeeded reports if the endpoint accepted the payload
+func (a Attempt) Succeeded() bool {
+	return a.Error == "" && a.StatusCode >= 200 && a.StatusCode < 300
+}
+
+type DeliveryFilter struct {
+	ID         string
+	EndpointID string
+	EventID    string
+	Status     DeliveryStatus
+	// LastError limits to the deliveries whose last attempt failed with it
+	LastError string
+	// Since and Until bound the creation time of the delivery
+	Since      time.Time
+	Until      time.Time
+	Pagination *pagination.Pagination
+}
@@ -0,0 +1,462 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/webhook"
+	"github.com/raystack/frontier/pkg/crypt"
+	pkgwebhook "github.com/raystack/frontier/pkg/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDeliveryRepo is an in-memory webhook.DeliveryRepository for service tests.
+type fakeDeliveryRepo struct {
+	mu         sync.Mutex
+	deliveries []webhook.Delivery
+	attempts   []webhook.Attempt
+}
+
+func (f *fakeDeliveryRepo) Create(_ context.Context, deliveries []webhook.Delivery) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deliveries = append(f.deliveries, deliveries...)
+	return nil
+}
+
+func (f *fakeDeliveryRepo) GetByID(_ context.Context, id string) (webhook.Delivery, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, d := range f.deliveries {
+		if d.ID == id {
+			return d, nil
+		}
+	}
+	return webhook.Delivery{}, webhook.ErrDeliveryNotFound
+}
+
+func (f *fakeDeliveryRepo) List(_ context.Context, flt webhook.DeliveryFilter) ([]webhook.Delivery, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []webhook.Delivery
+	for _, d := range f.deliveries {
+		if flt.EndpointID != "" && d.EndpointID != flt.EndpointID {
+			continue
+		}
+		out = append(out, d)
+	}
+	return out, nil
+}
+
+func (f *fakeDeliveryRepo) ListAttempts(_ context.Context, deliveryID string) ([]webhook.Attempt, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []webhook.Attempt
+	for _, a := range f.attempts {
+		if a.DeliveryID == deliveryID {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeDeliveryRepo) ClaimDue(_ context.Context, limit int, lease time.Duration) ([]webhook.Delivery, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []webhook.Delivery
+	now := time.Now()
+	for i, d := range f.deliveries {
+		if len(out) == limit {
+			break
+		}
+		if d.Status == webhook.DeliveryPending && !d.NextAttemptAt.After(now) {
+			f.deliveries[i].NextAttemptAt = now.Add(lease)
+			out = append(out, f.deliveries[i])
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeDeliveryRepo) RecordAttempt(_ context.Context, delivery webhook.Delivery, attempt webhook.Attempt) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.attempts = append(f.attempts, attempt)
+	for i := range f.deliveries {
+		if f.deliveries[i].ID == delivery.ID {
+			f.deliveries[i] = delivery
+		}
+	}
+	return nil
+}
+
+func (f *fakeDeliveryRepo) Requeue(_ context.Context, flt webhook.DeliveryFilter) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	count := 0
+	for i, d := range f.deliveries {
+		if flt.ID != "" && d.ID != flt.ID {
+			continue
+		}
+		if flt.EndpointID != "" && d.EndpointID != flt.EndpointID {
+			continue
+		}
+		if flt.Status != "" && d.Status != flt.Status {
+			continue
+		}
+		if flt.LastError != "" && d.LastError != flt.LastError {
+			continue
+		}
+		if !flt.Since.IsZero() && d.CreatedAt.Before(flt.Since) {
+			continue
+		}
+		if !flt.Until.IsZero() && !d.CreatedAt.Before(flt.Until) {
+			continue
+		}
+		f.deliveries[i].Status = webhook.DeliveryPending
+		f.deliveries[i].AttemptCount = 0
+		f.deliveries[i].NextAttemptAt = time.Now()
+		count++
+	}
+	if flt.ID != "" && count == 0 {
+		return 0, webhook.ErrDeliveryNotFound
+	}
+	return count, nil
+}
+
+var testDeliveryConfig = webhook.DeliveryConfig{
+	Interval:       time.Minute,
+	BatchSize:      10,
+	MaxAttempts:    2,
+	InitialBackoff: time.Minute,
+	MaxBackoff:     time.Hour,
+	Timeout:        time.Second,
+	// the test endpoints listen on loopback
+	AllowPrivateNetworks: true,
+}
+
+func testEndpoint(t *testing.T, id, url string, events ...string) webhook.Endpoint {
+	t.Helper()
+	secret, err := crypt.NewEncryptionKeyInHex()
+	require.NoError(t, err)
+	return webhook.Endpoint{
+		ID:               id,
+		URL:              url,
+		State:            webhook.Enabled,
+		SubscribedEvents: events,
+		Secrets:          []webhook.Secret{{ID: webhook.DefaultSecretID, Value: secret}},
+	}
+}
+
+func TestServicePublishQueuesDeliveries(t *testing.T) {
+	endpoints := &fakeEndpointRepo{items: []webhook.Endpoint{
+		testEndpoint(t, "e1", "https://a.example/hook", "organization.create"),
+		testEndpoint(t, "e2", "https://b.example/hook"),
+		testEndpoint(t, "e3", "https://c.example/hook", "user.create"),
+	}}
+	deliveries := &fakeDeliveryRepo{}
+	s := webhook.NewService(endpoints, deliveries, &fakeAuditRecordService{}, testDeliveryConfig)
+
+	err := s.Publish(context.Background(), webhook.Event{
+		ID:        "evt-1",
+		Action:    "organization.create",
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	// e3 isn't subscribed to the action
+	require.Len(t, deliveries.deliveries, 2)
+	assert.Equal(t, "e1", deliveries.deliveries[0].EndpointID)
+	assert.Equal(t, "e2", deliveries.deliveries[1].EndpointID)
+	for _, d := range deliveries.deliveries {
+		assert.Equal(t, webhook.DeliveryPending, d.Status)
+		assert.Equal(t, "evt-1", d.EventID)
+		assert.NotEmpty(t, d.Payload)
+	}
+}
+
+func TestServiceDeliverPending(t *testing.T) {
+	t.Run("marks a delivery succeeded on a 2xx response", func(t *testing.T) {
+		var gotSignature, gotTimestampedSignature, gotDeliveryID string
+		var gotBody []byte
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotSignature = r.Header.Get(webhook.SignatureHeader)
+			gotTimestampedSignature = r.Header.Get(pkgwebhook.TimestampedSignatureHeader)
+			gotDeliveryID = r.Header.Get(pkgwebhook.DeliveryIDHeader)
+			gotBody, _ = io.ReadAll(r.Body)
+			_, _ = w.Write([]byte("ok"))
+		}))
+		defer srv.Close()
+
+		endpoint := testEndpoint(t, "e1", srv.URL)
+		deliveries := &fakeDeliveryRepo{}
+		s := webhook.NewService(&fakeEndpointRepo{items: []webhook.Endpoint{endpoint}}, deliveries, &fakeAuditRecordService{}, testDeliveryConfig)
+		require.NoError(t, s.Publish(context.Background(), webhook.Event{ID: "evt-1", Action: "user.create", CreatedAt: time.Now()}))
+
+		require.NoError(t, s.DeliverPending(context.Background()))
+
+		delivery := deliveries.deliveries[0]
+		assert.Equal(t, webhook.DeliverySucceeded, delivery.Status)
+		assert.Equal(t, 1, delivery.AttemptCount)
+		assert.Equal(t, http.StatusOK, delivery.LastStatusCode)
+		require.Len(t, deliveries.attempts, 1)
+		assert.Equal(t, "ok", deliveries.attempts[0].Response)
+
+		event, err := pkgwebhook.ParseAndValidateEventWithKeys(gotBody, map[string]string{
+			endpoint.Secrets[0].ID: endpoint.Secrets[0].Value,
+		}, gotSignature)
+		require.NoError(t, err)
+		assert.Equal(t, "evt-1", event.GetId())
+
+		_, err = pkgwebhook.NewVerifier([]string{endpoint.Secrets[0].Value}).Verify(gotBody, gotTimestampedSignature)
+		assert.NoError(t, err)
+		assert.Equal(t, delivery.ID, gotDeliveryID)
+	})
+
+	t.Run("backs off and finally fails a delivery on errors", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		deliveries := &fakeDeliveryRepo{}
+		s := webhook.NewService(&fakeEndpointRepo{items: []webhook.Endpoint{testEndpoint(t, "e1", srv.URL)}}, deliveries, &fakeAuditRecordService{}, testDeliveryConfig)
+		require.NoError(t, s.Publish(context.Background(), webhook.Event{ID: "evt-1", Action: "user.create", CreatedAt: time.Now()}))
+
+		require.NoError(t, s.DeliverPending(context.Background()))
+		delivery := deliveries.deliveries[0]
+		assert.Equal(t, webhook.DeliveryPending, delivery.Status)
+		assert.Equal(t, http.StatusServiceUnavailable, delivery.LastStatusCode)
+		assert.True(t, delivery.NextAttemptAt.After(time.Now().Add(30*time.Second)))
+
+		// the retry is not due yet
+		require.NoError(t, s.DeliverPending(context.Background()))
+		assert.Len(t, deliveries.attempts, 1)
+
+		deliveries.deliveries[0].NextAttemptAt = time.Now()
+		require.NoError(t, s.DeliverPending(context.Background()))
+		assert.Equal(t, webhook.DeliveryFailed, deliveries.deliveries[0].Status)
+		assert.Len(t, deliveries.attempts, 2)
+
+		// a replayed delivery is attempted again
+		require.NoError(t, s.Redeliver(context.Background(), delivery.ID))
+		assert.Equal(t, webhook.DeliveryPending, deliveries.deliveries[0].Status)
+		require.NoError(t, s.DeliverPending(context.Background()))
+		assert.Len(t, deliveries.attempts, 3)
+	})
+}
+
+func TestServiceDeliverPendingOfDeletedEndpoint(t *testing.T) {
+	deliveries := &fakeDeliveryRepo{deliveries: []webhook.Delivery{{
+		ID:            "d1",
+		EndpointID:    "deleted",
+		Status:        webhook.DeliveryPending,
+		NextAttemptAt: time.Now(),
+	}}}
+	s := webhook.NewService(&fakeEndpointRepo{}, deliveries, &fakeAuditRecordService{}, testDeliveryConfig)
+
+	require.NoError(t, s.DeliverPending(context.Background()))
+	assert.Equal(t, webhook.DeliveryFailed, deliveries.deliveries[0].Status)
+	assert.Equal(t, webhook.ErrNotFound.Error(), deliveries.deliveries[0].LastError)
+	require.Len(t, deliveries.attempts, 1)
+
+	// it isn't claimed again
+	require.NoError(t, s.DeliverPending(context.Background()))
+	assert.Len(t, deliveries.attempts, 1)
+}
+
+func TestServiceSignsWithEverySecret(t *testing.T) {
+	var gotSignature string
+	var gotBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get(webhook.SignatureHeader)
+		gotBody, _ = io.ReadAll(r.Body)
+	}))
+	defer srv.Close()
+
+	endpoints := &fakeEndpointRepo{items: []webhook.Endpoint{testEndpoint(t, "e1", srv.URL)}}
+	s := webhook.NewService(endpoints, &fakeDeliveryRepo{}, &fakeAuditRecordService{}, testDeliveryConfig)
+	oldSecret := endpoints.items[0].Secrets[0]
+	newSecret, err := s.RotateSecret(context.Background(), "e1")
+	require.NoError(t, err)
+
+	require.NoError(t, s.Publish(context.Background(), webhook.Event{ID: "evt-1", Action: "user.create", CreatedAt: time.Now()}))
+	require.NoError(t, s.DeliverPending(context.Background()))
+	assert.Len(t, pkgwebhook.ParseSignatureHeader(gotSignature), 2)
+
+	// a consumer knowing either secret accepts the event
+	for _, secret := range []webhook.Secret{oldSecret, newSecret} {
+		_, err := pkgwebhook.ParseAndValidateEventWithKeys(gotBody, map[string]string{secret.ID: secret.Value}, gotSignature)
+		assert.NoError(t, err)
+	}
+	_, err = pkgwebhook.ParseAndValidateEventWithKeys(gotBody, map[string]string{"3": oldSecret.Value}, gotSignature)
+	assert.ErrorIs(t, err, pkgwebhook.ErrInvalidSignature)
+}
+
+func TestServiceDisablesFailingEndpoint(t *testing.T) {
+	status := http.StatusInternalServerError
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	cfg := testDeliveryConfig
+	cfg.MaxAttempts = 5
+	cfg.DisableAfterFailures = 3
+	endpoints := &fakeEndpointRepo{items: []webhook.Endpoint{testEndpoint(t, "e1", srv.URL)}}
+	deliveries := &fakeDeliveryRepo{}
+	audits := &fakeAuditRecordService{}
+	s := webhook.NewService(endpoints, deliveries, audits, cfg)
+
+	publishAndDeliver := func() {
+		require.NoError(t, s.Publish(context.Background(), webhook.Event{ID: "evt", Action: "user.create", CreatedAt: time.Now()}))
+		require.NoError(t, s.DeliverPending(context.Background()))
+	}
+
+	// a success in between resets the count
+	publishAndDeliver()
+	publishAndDeliver()
+	status = http.StatusOK
+	publishAndDeliver()
+	assert.Equal(t, 0, endpoints.items[0].ConsecutiveFailures)
+
+	status = http.StatusInternalServerError
+	publishAndDeliver()
+	publishAndDeliver()
+	assert.Equal(t, webhook.Enabled, endpoints.items[0].State)
+	assert.Empty(t, audits.records)
+
+	publishAndDeliver()
+	assert.Equal(t, webhook.Disabled, endpoints.items[0].State)
+	require.Len(t, audits.records, 1)
+	assert.Equal(t, "webhook.disabled", audits.records[0].Event.String())
+	assert.Equal(t, "e1", audits.records[0].Target.ID)
+	assert.Equal(t, uuid.Nil.String(), audits.records[0].Actor.ID)
+
+	// pending deliveries of a disabled endpoint fail without a request
+	for i := range deliveries.deliveries {
+		deliveries.deliveries[i].NextAttemptAt = time.Now()
+	}
+	attempts := len(deliveries.attempts)
+	require.NoError(t, s.DeliverPending(context.Background()))
+	for _, d := range deliveries.deliveries {
+		assert.NotEqual(t, webhook.DeliveryPending, d.Status)
+	}
+	assert.Greater(t, len(deliveries.attempts), attempts)
+	assert.Len(t, audits.records, 1)
+
+	// enabling the endpoint again queues the deliveries failed while it was
+	// disabled, but not the ones that ran out of attempts
+	status = http.StatusOK
+	var autoFailed int
+	for _, d := range deliveries.deliveries {
+		if d.LastError == webhook.ErrDisabled.Error() {
+			autoFailed++
+		}
+	}
+	require.NotZero(t, autoFailed)
+	enabled := endpoints.items[0]
+	enabled.State = webhook.Enabled
+	_, err := s.UpdateEndpoint(context.Background(), enabled)
+	require.NoError(t, err)
+	var requeued int
+	for _, d := range deliveries.deliveries {
+		if d.Status == webhook.DeliveryPending {
+			assert.Equal(t, webhook.ErrDisabled.Error(), d.LastError)
+			requeued++
+		}
+	}
+	assert.Equal(t, autoFailed, requeued)
+}
+
+func TestServiceSendTestEvent(t *testing.T) {
+	var gotBody []byte
+	var gotSignature string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotSignature = r.Header.Get(pkgwebhook.TimestampedSignatureHeader)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("received"))
+	}))
+	defer srv.Close()
+
+	endpoint := testEndpoint(t, "e1", srv.URL)
+	endpoint.State = webhook.Disabled
+	endpoints := &fakeEndpointRepo{items: []webhook.Endpoint{endpoint}}
+	deliveries := &fakeDeliveryRepo{}
+	s := webhook.NewService(endpoints, deliveries, &fakeAuditRecordService{}, testDeliveryConfig)
+
+	attempt, err := s.SendTestEvent(context.Background(), "e1")
+	require.NoError(t, err)
+	assert.True(t, attempt.Succeeded())
+	assert.Equal(t, http.StatusAccepted, attempt.StatusCode)
+	assert.Equal(t, "received", attempt.Response)
+	assert.Empty(t, deliveries.deliveries)
+
+	event, err := pkgwebhook.NewVerifier([]string{endpoint.Secrets[0].Value}).Verify(gotBody, gotSignature)
+	require.NoError(t, err)
+	assert.Equal(t, webhook.TestEventAction, event.GetAction())
+
+	_, err = s.SendTestEvent(context.Background(), "missing")
+	assert.ErrorIs(t, err, webhook.ErrNotFound)
+}
+
+func TestServiceRedeliverRange(t *testing.T) {
+	now := time.Now()
+	delivery := func(id, endpointID string, status webhook.DeliveryStatus, createdAt time.Time) webhook.Delivery {
+		return webhook.Delivery{
+			ID:           id,
+			EndpointID:   endpointID,
+			Status:       status,
+			AttemptCount: testDeliveryConfig.MaxAttempts,
+			CreatedAt:    createdAt,
+		}
+	}
+	deliveries := &fakeDeliveryRepo{deliveries: []webhook.Delivery{
+		delivery("before", "e1", webhook.DeliveryFailed, now.Add(-3*time.Hour)),
+		delivery("failed", "e1", webhook.DeliveryFailed, now.Add(-90*time.Minute)),
+		delivery("succeeded", "e1", webhook.DeliverySucceeded, now.Add(-time.Hour)),
+		delivery("other-endpoint", "e2", webhook.DeliveryFailed, now.Add(-time.Hour)),
+		delivery("at-until", "e1", webhook.DeliveryFailed, now),
+	}}
+	s := webhook.NewService(&fakeEndpointRepo{}, deliveries, &fakeAuditRecordService{}, testDeliveryConfig)
+
+	_, err := s.RedeliverRange(context.Background(), webhook.DeliveryFilter{EndpointID: "e1"})
+	assert.ErrorIs(t, err, webhook.ErrInvalidDetail)
+
+	_, err = s.RedeliverRange(context.Background(), webhook.DeliveryFilter{Since: now, Until: now.Add(-time.Hour)})
+	assert.ErrorIs(t, err, webhook.ErrInvalidDetail)
+
+	count, err := s.RedeliverRange(context.Background(), webhook.DeliveryFilter{
+		EndpointID: "e1",
+		Since:      now.Add(-2 * time.Hour),
+		Until:      now,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	requeued := map[string]bool{}
+	for _, d := range deliveries.deliveries {
+		if d.Status == webhook.DeliveryPending {
+			assert.Zero(t, d.AttemptCount, d.ID)
+			requeued[d.ID] = true
+		}
+	}
+	assert.Equal(t, map[string]bool{"failed": true, "succeeded": true}, requeued)
+}
+
+func TestDeliveryConfigBackoff(t *testing.T) {
+	cfg := webhook.DeliveryConfig{InitialBackoff: 30 * time.Second, MaxBackoff: 5 * time.Minute}
+	assert.Equal(t, time.Duration(0), cfg.Backoff(0))
+	assert.Equal(t, 30*time.Second, cfg.Backoff(1))
+	assert.Equal(t, 60*time.Second, cfg.Backoff(2))
+	assert.Equal(t, 4*time.Minute, cfg.Backoff(4))
+	assert.Equal(t, 5*time.Minute, cfg.Backoff(5))
+	assert.Equal(t, 5*time.Minute, cfg.Backoff(50))
+}
@@ -8,4 +8,10 @@ var (
 	ErrConflict      = errors.New("webhook already exist")
 	ErrInvalidUUID   = errors.New("invalid syntax of uuid")
 	ErrDisabled      = errors.New("webhook is disabled")
+	// ErrInternalAddress is the error of endpoints on loopback, private or
+	// link-local addresses, events aren't sent into the network of the server
+	ErrInternalAddress = errors.New("webhook url is an internal address")
+
+	ErrDeliveryNotFound = errors.New("webhook delivery doesn't exist")
+	ErrSecretNotFound   = errors.New("webhook secret doesn't exist")
 )
@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// orgIDKey is the event data key holding the organization of the event
+const orgIDKey = "org_id"
+
+// Accepts reports whether the event should be delivered to the endpoint
+func (e Endpoint) Accepts(evt Event) bool {
+	if e.OrgID != "" {
+		if orgID, ok := lookup(evt.Data, orgIDKey); !ok || orgID != e.OrgID {
+			return false
+		}
+	}
+	return matchesAction(e.SubscribedEvents, evt.Action) && matchesFilters(e.Filters, evt.Data)
+}
+
+// matchesAction reports whether the action matches any of the subscribed
+// actions or patterns, no subscriptions match every action
+func matchesAction(subscribed []string, action string) bool {
+	if len(subscribed) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(subscribed, func(pattern string) bool {
+		return matchPattern(pattern, action)
+	})
+}
+
+// matchPattern reports whether the action matches the pattern, where a "*"
+// matches any run of characters including dots, so "app.organization.*"
+// matches "app.organization.member.created" too. Every other character
+// matches itself.
+func matchPattern(pattern, action string) bool {
+	p, a := 0, 0
+	// the position of the last star and of the action when it was reached,
+	// a mismatch retries with the star covering one more character
+	star, starAction := -1, 0
+	for a < len(action) {
+		switch {
+		case p < len(pattern) && pattern[p] == '*':
+			star, starAction = p, a
+			p++
+		case p < len(pattern) && pattern[p] == action[a]:
+			p++
+			a++
+		case star >= 0:
+			starAction++
+			p, a = star+1, starAction
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// validPattern reports whether the pattern is made of the characters actions
+// are made of and stars
+func validPattern(pattern string) bool {
+	if pattern == "" {
+		return false
+	}
+	for _, r := range pattern {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '.', r == '_', r == '-', r == '*':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// matchesFilters reports whether the data holds one of the accepted values at
+// every filtered path
+func matchesFilters(filters map[string][]string, data map[string]any) bool {
+	for key, values := range filters {
+		value, ok := lookup(data, key)
+		if !ok || !slices.Contains(values, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// lookup returns the value at the dot separated path of the data as a string,
+// only scalar values can be filtered on
+func lookup(data map[string]any, key string) (string, bool) {
+	var current any = data
+	for _, part := range strings.Split(key, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		if current, ok = m[part]; !ok {
+			return "", false
+		}
+	}
+	switch v := current.(type) {
+	case string:
+		return v, true
+	case bool, float64, float32, int, int32, int64:
+		return fmt.Sprint(v), true
+	default:
+		return "", false
+	}
+}
+
+func validateSubscriptions(endpoint Endpoint) error {
+	for _, pattern := range endpoint.SubscribedEvents {
+		if !validPattern(pattern) {
+			return fmt.Errorf("%w: invalid subscribed event pattern %q", ErrInvalidDetail, pattern)
+		}
+	}
+	for key, values := range endpoint.Filters {
+		if strings.TrimSpace(key) == "" || slices.Contains(strings.Split(key, "."), "") {
+			return fmt.Errorf("%w: invalid filter key %q", ErrInvalidDetail, key)
+		}
+		if len(values) == 0 {
+			return fmt.Errorf("%w: filter %q needs at least one value", ErrInvalidDetail, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/raystack/frontier/core/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointAccepts(t *testing.T) {
+	evt := webhook.Event{
+		Action: "app.organization.member.created",
+		Data: map[string]any{
+			"org_id": "org-1",
+			"target": map[string]any{"id": "u1", "type": "app/user"},
+			"count":  float64(2),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		endpoint webhook.Endpoint
+		want     bool
+	}{
+		{"no subscriptions accept every event", webhook.Endpoint{}, true},
+		{"exact action", webhook.Endpoint{SubscribedEvents: []string{"app.organization.member.created"}}, true},
+		{"wildcard action", webhook.Endpoint{SubscribedEvents: []string{"app.organization.*"}}, true},
+		{"wildcard in a segment", webhook.Endpoint{SubscribedEvents: []string{"app.*.member.created"}}, true},
+		{"prefix wildcard", webhook.Endpoint{SubscribedEvents: []string{"app.org*"}}, true},
+		{"wildcard across dots", webhook.Endpoint{SubscribedEvents: []string{"app.*.created"}}, true},
+		{"wildcard needs the rest to match", webhook.Endpoint{SubscribedEvents: []string{"app.*.deleted"}}, false},
+		{"question mark is literal", webhook.Endpoint{SubscribedEvents: []string{"app.organization.member.create?"}}, false},
+		{"other action", webhook.Endpoint{SubscribedEvents: []string{"app.user.*"}}, false},
+		{"top level filter", webhook.Endpoint{Filters: map[string][]string{"org_id": {"org-2", "org-1"}}}, true},
+		{"nested filter", webhook.Endpoint{Filters: map[string][]string{"target.type": {"app/user"}}}, true},
+		{"non string filter", webhook.Endpoint{Filters: map[string][]string{"count": {"2"}}}, true},
+		{"filter on another value", webhook.Endpoint{Filters: map[string][]string{"org_id": {"org-2"}}}, false},
+		{"filter on a missing field", webhook.Endpoint{Filters: map[string][]string{"target.name": {"x"}}}, false},
+		{"filter on an object", webhook.Endpoint{Filters: map[string][]string{"target": {"x"}}}, false},
+		{"every filter must match", webhook.Endpoint{
+			SubscribedEvents: []string{"app.organization.*"},
+			Filters:          map[string][]string{"org_id": {"org-1"}, "target.type": {"app/group"}},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.endpoint.Accepts(evt))
+		})
+	}
+}
+
+func TestServiceCreateEndpointFilterValidation(t *testing.T) {
+	for name, endpoint := range map[string]webhook.Endpoint{
+		"malformed pattern": {SubscribedEvents: []string{"app.[user"}},
+		"empty pattern":     {SubscribedEvents: []string{""}},
+		"empty filter key":  {Filters: map[string][]string{"": {"x"}}},
+		"empty path part":   {Filters: map[string][]string{"target..type": {"x"}}},
+		"no filter values":  {Filters: map[string][]string{"org_id": {}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			endpoint.URL = "https://a.example/hook"
+			_, err := newService(&fakeEndpointRepo{}).CreateEndpoint(context.Background(), endpoint)
+			assert.ErrorIs(t, err, webhook.ErrInvalidDetail)
+		})
+	}
+}
+
+func TestOrganizationEndpointAccepts(t *testing.T) {
+	endpoint := webhook.Endpoint{OrgID: "org-1", SubscribedEvents: []string{"app.organization.*"}}
+
+	assert.True(t, endpoint.Accepts(webhook.Event{Action: "app.organization.member.created", Data: map[string]any{"org_id": "org-1"}}))
+	assert.False(t, endpoint.Accepts(webhook.Event{Action: "app.organization.member.created", Data: map[string]any{"org_id": "org-2"}}))
+	assert.False(t, endpoint.Accepts(webhook.Event{Action: "app.organization.member.created"}))
+	// platform webhooks receive events of every organization
+	assert.True(t, webhook.Endpoint{}.Accepts(webhook.Event{Action: "app.user.created", Data: map[string]any{"org_id": "org-2"}}))
+}
+
+func TestServiceUpdateFilters(t *testing.T) {
+	repo := &fakeEndpointRepo{items: []webhook.Endpoint{{
+		ID:      "e1",
+		URL:     "https://a.example/hook",
+		Filters: map[string][]string{"org_id": {"org-1"}},
+	}}}
+	s := newService(repo)
+
+	updated, err := s.UpdateFilters(context.Background(), "e1", map[string][]string{"target.type": {"app/user"}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"target.type": {"app/user"}}, updated.Filters)
+
+	updated, err = s.UpdateFilters(context.Background(), "e1", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, updated.Filters)
+
+	_, err = s.UpdateFilters(context.Background(), "e1", map[string][]string{"org_id": {}})
+	assert.ErrorIs(t, err, webhook.ErrInvalidDetail)
+	_, err = s.UpdateFilters(context.Background(), "missing", nil)
+	assert.ErrorIs(t, err, webhook.ErrNotFound)
+}
@@ -0,0 +1,71 @@
+package webhook
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// newHTTPClient returns the client delivering events. Organizations register
+// their own endpoints, so unless private networks are allowed the client
+// refuses to connect to loopback, private, link-local and unspecified
+// addresses. The check runs on the address the host resolved to when it is
+// dialed, a host resolving to an internal address is refused however the URL
+// was written.
+func newHTTPClient(config DeliveryConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: config.Timeout}
+	if !config.AllowPrivateNetworks {
+		dialer.Control = refuseInternalAddress
+	}
+	return &http.Client{
+		Timeout: config.Timeout,
+		Transport: &http.Transport{
+			// no proxy, it would dial the endpoint in place of the dialer
+			// checking it
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: config.Timeout,
+		},
+		// redirects aren't followed, a public endpoint could redirect the
+		// delivery to an internal one. The redirect is the response of the
+		// attempt and fails it.
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// refuseInternalAddress is the dialer control rejecting connections to
+// internal addresses, it is given the resolved address being connected to
+func refuseInternalAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return ErrInternalAddress
+	}
+	if ip := net.ParseIP(host); ip == nil || isInternalIP(ip) {
+		return ErrInternalAddress
+	}
+	return nil
+}
+
+func isInternalIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast()
+}
+
+// isInternalHost reports whether the host of an endpoint URL is an internal
+// address or localhost, to refuse such endpoints when they are registered.
+// Other hosts are checked when they are dialed.
+func isInternalHost(host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && isInternalIP(ip)
+}
@@ -0,0 +1,95 @@
+package webhook_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/raystack/frontier/core/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceRefusesInternalEndpoints(t *testing.T) {
+	t.Run("doesn't register endpoints on internal addresses", func(t *testing.T) {
+		s := newService(&fakeEndpointRepo{})
+		for _, endpointURL := range []string{
+			"http://169.254.169.254/latest/meta-data",
+			"http://localhost:8080/hook",
+			"http://127.0.0.1/hook",
+			"http://10.0.0.1/hook",
+			"http://192.168.1.1/hook",
+			"http://[::1]/hook",
+			"http://0.0.0.0/hook",
+		} {
+			_, err := s.CreateEndpoint(context.Background(), webhook.Endpoint{URL: endpointURL})
+			assert.ErrorIs(t, err, webhook.ErrInternalAddress, endpointURL)
+			assert.ErrorIs(t, err, webhook.ErrInvalidDetail, endpointURL)
+		}
+	})
+
+	t.Run("doesn't connect to hosts resolving to internal addresses", func(t *testing.T) {
+		var called bool
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer srv.Close()
+		u, err := url.Parse(srv.URL)
+		require.NoError(t, err)
+
+		// the endpoint was stored before the check, its host resolves to
+		// loopback when it is dialed
+		cfg := testDeliveryConfig
+		cfg.AllowPrivateNetworks = false
+		endpoints := &fakeEndpointRepo{items: []webhook.Endpoint{
+			testEndpoint(t, "e1", "http://localhost:"+u.Port()+"/hook"),
+		}}
+		s := webhook.NewService(endpoints, &fakeDeliveryRepo{}, &fakeAuditRecordService{}, cfg)
+
+		attempt, err := s.SendTestEvent(context.Background(), "e1")
+		require.NoError(t, err)
+		assert.False(t, attempt.Succeeded())
+		assert.Contains(t, attempt.Error, webhook.ErrInternalAddress.Error())
+		assert.False(t, called)
+	})
+
+	t.Run("doesn't follow redirects", func(t *testing.T) {
+		var redirected bool
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			redirected = true
+		}))
+		defer target.Close()
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+		}))
+		defer srv.Close()
+
+		endpoints := &fakeEndpointRepo{items: []webhook.Endpoint{testEndpoint(t, "e1", srv.URL)}}
+		s := webhook.NewService(endpoints, &fakeDeliveryRepo{}, &fakeAuditRecordService{}, testDeliveryConfig)
+
+		attempt, err := s.SendTestEvent(context.Background(), "e1")
+		require.NoError(t, err)
+		assert.False(t, attempt.Succeeded())
+		assert.Equal(t, http.StatusTemporaryRedirect, attempt.StatusCode)
+		assert.False(t, redirected)
+	})
+
+	t.Run("doesn't keep the response of an organization's endpoint", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("internal details"))
+		}))
+		defer srv.Close()
+
+		endpoint := testEndpoint(t, "e1", srv.URL)
+		endpoint.OrgID = "org-1"
+		endpoints := &fakeEndpointRepo{items: []webhook.Endpoint{endpoint}}
+		s := webhook.NewService(endpoints, &fakeDeliveryRepo{}, &fakeAuditRecordService{}, testDeliveryConfig)
+
+		attempt, err := s.SendTestEvent(context.Background(), "e1")
+		require.NoError(t, err)
+		assert.True(t, attempt.Succeeded())
+		assert.Empty(t, attempt.Response)
+	})
+}
@@ -0,0 +1,149 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PayloadFormat is the format of the request sent to an endpoint
+type PayloadFormat string
+
+const (
+	// PayloadFormatFrontier sends the protojson encoded WebhookEvent
+	PayloadFormatFrontier PayloadFormat = "frontier"
+	// PayloadFormatCloudEvents sends a CloudEvents 1.0 event in structured
+	// content mode, the whole event is the JSON body
+	PayloadFormatCloudEvents PayloadFormat = "cloudevents"
+	// PayloadFormatCloudEventsBinary sends a CloudEvents 1.0 event in binary
+	// content mode, the attributes are ce-* headers and the data is the body
+	PayloadFormatCloudEventsBinary PayloadFormat = "cloudevents_binary"
+
+	cloudEventsSpecVersion   = "1.0"
+	cloudEventsContentType   = "application/cloudevents+json"
+	cloudEventsDataType      = "application/json"
+	cloudEventsDefaultSource = "frontier"
+	cloudEventsHeaderPrefix  = "ce-"
+)
+
+func (f PayloadFormat) valid() bool {
+	switch f {
+	case "", PayloadFormatFrontier, PayloadFormatCloudEvents, PayloadFormatCloudEventsBinary:
+		return true
+	}
+	return false
+}
+
+func (f PayloadFormat) cloudEvents() bool {
+	return f == PayloadFormatCloudEvents || f == PayloadFormatCloudEventsBinary
+}
+
+// cloudEvent is a CloudEvents 1.0 event in the JSON event format
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	OrgID           string          `json:"orgid,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// encodeEvent renders the event in the format. Both CloudEvents modes are
+// stored as the structured event, the binary mode is derived from it when the
+// request is sent.
+func encodeEvent(evt Event, format PayloadFormat) ([]byte, error) {
+	if format.cloudEvents() {
+		return encodeCloudEvent(evt)
+	}
+	data, err := structpb.NewStruct(evt.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert data to structpb: %w", err)
+	}
+	return protojson.Marshal(&frontierv1beta1.WebhookEvent{
+		Id:        evt.ID,
+		Action:    evt.Action,
+		Data:      data,
+		CreatedAt: timestamppb.New(evt.CreatedAt),
+	})
+}
+
+// encodeCloudEvent maps the event to a CloudEvent. The type is the action,
+// and the source, subject and organization come from the fields audit logs
+// put in the event data.
+func encodeCloudEvent(evt Event) ([]byte, error) {
+	data := evt.Data
+	if data == nil {
+		data = map[string]any{}
+	}
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	source, _ := lookup(data, "source")
+	if source == "" {
+		source = cloudEventsDefaultSource
+	}
+	orgID, _ := lookup(data, orgIDKey)
+	return json.Marshal(cloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              evt.ID,
+		Source:          source,
+		Type:            evt.Action,
+		Subject:         cloudEventSubject(data),
+		Time:            evt.CreatedAt.UTC().Format(time.RFC3339Nano),
+		DataContentType: cloudEventsDataType,
+		OrgID:           orgID,
+		Data:            rawData,
+	})
+}
+
+// cloudEventSubject identifies the target of the event, e.g. "app/user/<id>"
+func cloudEventSubject(data map[string]any) string {
+	targetID, _ := lookup(data, "target.id")
+	targetType, _ := lookup(data, "target.type")
+	switch {
+	case targetID == "":
+		return ""
+	case targetType == "":
+		return targetID
+	}
+	return targetType + "/" + targetID
+}
+
+// requestBody returns the body and the format specific headers of the request
+// delivering the stored payload
+func requestBody(payload []byte, format PayloadFormat) (map[string]string, []byte, error) {
+	switch format {
+	case PayloadFormatCloudEvents:
+		return map[string]string{"Content-Type": cloudEventsContentType}, payload, nil
+	case PayloadFormatCloudEventsBinary:
+		var evt cloudEvent
+		if err := json.Unmarshal(payload, &evt); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode cloud event: %w", err)
+		}
+		headers := map[string]string{
+			"Content-Type":                          evt.DataContentType,
+			cloudEventsHeaderPrefix + "specversion": evt.SpecVersion,
+			cloudEventsHeaderPrefix + "id":          evt.ID,
+			cloudEventsHeaderPrefix + "source":      evt.Source,
+			cloudEventsHeaderPrefix + "type":        evt.Type,
+			cloudEventsHeaderPrefix + "time":        evt.Time,
+		}
+		if evt.Subject != "" {
+			headers[cloudEventsHeaderPrefix+"subject"] = evt.Subject
+		}
+		if evt.OrgID != "" {
+			headers[cloudEventsHeaderPrefix+"orgid"] = evt.OrgID
+		}
+		return headers, evt.Data, nil
+	}
+	return nil, payload, nil
+}
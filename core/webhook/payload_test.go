@@ -0,0 +1,115 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/core/webhook"
+	pkgwebhook "github.com/raystack/frontier/pkg/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceCloudEventsPayload(t *testing.T) {
+	createdAt := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	evt := webhook.Event{
+		ID:     "evt-1",
+		Action: "app.user.created",
+		Data: map[string]any{
+			"source": "frontier",
+			"org_id": "org-1",
+			"target": map[string]any{"id": "u1", "type": "app/user"},
+		},
+		CreatedAt: createdAt,
+	}
+
+	deliver := func(t *testing.T, format webhook.PayloadFormat) (http.Header, []byte, string) {
+		t.Helper()
+		var header http.Header
+		var body []byte
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header.Clone()
+			body, _ = io.ReadAll(r.Body)
+		}))
+		defer srv.Close()
+
+		endpoint := testEndpoint(t, "e1", srv.URL)
+		endpoint.PayloadFormat = format
+		s := webhook.NewService(&fakeEndpointRepo{items: []webhook.Endpoint{endpoint}}, &fakeDeliveryRepo{}, &fakeAuditRecordService{}, testDeliveryConfig)
+		require.NoError(t, s.Publish(context.Background(), evt))
+		require.NoError(t, s.DeliverPending(context.Background()))
+		return header, body, endpoint.Secrets[0].Value
+	}
+
+	t.Run("structured mode", func(t *testing.T) {
+		header, body, secret := deliver(t, webhook.PayloadFormatCloudEvents)
+		assert.Equal(t, "application/cloudevents+json", header.Get("Content-Type"))
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(body, &got))
+		assert.Equal(t, "1.0", got["specversion"])
+		assert.Equal(t, "evt-1", got["id"])
+		assert.Equal(t, "frontier", got["source"])
+		assert.Equal(t, "app.user.created", got["type"])
+		assert.Equal(t, "app/user/u1", got["subject"])
+		assert.Equal(t, "2026-10-17T10:00:00Z", got["time"])
+		assert.Equal(t, "org-1", got["orgid"])
+		assert.Equal(t, "org-1", got["data"].(map[string]any)["org_id"])
+
+		verifier := pkgwebhook.NewVerifier([]string{secret})
+		assert.NoError(t, verifier.VerifySignature(body, header.Get(pkgwebhook.TimestampedSignatureHeader)))
+	})
+
+	t.Run("binary mode", func(t *testing.T) {
+		header, body, secret := deliver(t, webhook.PayloadFormatCloudEventsBinary)
+		assert.Equal(t, "application/json", header.Get("Content-Type"))
+		assert.Equal(t, "1.0", header.Get("ce-specversion"))
+		assert.Equal(t, "evt-1", header.Get("ce-id"))
+		assert.Equal(t, "frontier", header.Get("ce-source"))
+		assert.Equal(t, "app.user.created", header.Get("ce-type"))
+		assert.Equal(t, "app/user/u1", header.Get("ce-subject"))
+		assert.Equal(t, "org-1", header.Get("ce-orgid"))
+
+		var data map[string]any
+		require.NoError(t, json.Unmarshal(body, &data))
+		assert.Equal(t, "org-1", data["org_id"])
+
+		// the signature covers the attributes along with the data
+		verifier := pkgwebhook.NewVerifier([]string{secret})
+		assert.NoError(t, verifier.VerifyCloudEventsBinary(header, body))
+		assert.ErrorIs(t, verifier.VerifySignature(body, header.Get(pkgwebhook.TimestampedSignatureHeader)),
+			pkgwebhook.ErrInvalidSignature)
+
+		header.Set("ce-type", "app.user.deleted")
+		assert.ErrorIs(t, verifier.VerifyCloudEventsBinary(header, body), pkgwebhook.ErrInvalidSignature)
+	})
+
+	t.Run("rejects an unknown format", func(t *testing.T) {
+		_, err := newService(&fakeEndpointRepo{}).CreateEndpoint(context.Background(),
+			webhook.Endpoint{URL: "https://a.example/hook", PayloadFormat: "xml"})
+		assert.ErrorIs(t, err, webhook.ErrInvalidDetail)
+	})
+}
+
+func TestServiceUpdatePayloadFormat(t *testing.T) {
+	repo := &fakeEndpointRepo{items: []webhook.Endpoint{{
+		ID:            "e1",
+		URL:           "https://a.example/hook",
+		PayloadFormat: webhook.PayloadFormatFrontier,
+	}}}
+	s := newService(repo)
+
+	updated, err := s.UpdatePayloadFormat(context.Background(), "e1", webhook.PayloadFormatCloudEvents)
+	assert.NoError(t, err)
+	assert.Equal(t, webhook.PayloadFormatCloudEvents, updated.PayloadFormat)
+
+	_, err = s.UpdatePayloadFormat(context.Background(), "e1", "")
+	assert.ErrorIs(t, err, webhook.ErrInvalidDetail)
+	_, err = s.UpdatePayloadFormat(context.Background(), "missing", webhook.PayloadFormatFrontier)
+	assert.ErrorIs(t, err, webhook.ErrNotFound)
+}
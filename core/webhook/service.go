@@ -1,47 +1,112 @@
 package webhook
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"maps"
+	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/raystack/frontier/core/auditrecord/models"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	pkgauditrecord "github.com/raystack/frontier/pkg/auditrecord"
 	"github.com/raystack/frontier/pkg/server/consts"
+	"github.com/robfig/cron/v3"
+	"golang.org/x/sync/errgroup"
 
 	"slices"
 
-	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
-	"google.golang.org/protobuf/encoding/protojson"
-	"google.golang.org/protobuf/types/known/structpb"
-	"google.golang.org/protobuf/types/known/timestamppb"
-
-	"github.com/go-resty/resty/v2"
 	"github.com/google/uuid"
 	"github.com/raystack/frontier/pkg/crypt"
+	pkgwebhook "github.com/raystack/frontier/pkg/webhook"
 )
 
 const (
-	DefaultSecretID    = "1"
-	SignatureHeader    = "X-Signature"
-	EndpointRetryCount = 3
+	DefaultSecretID = "1"
+	SignatureHeader = "X-Signature"
+	// TestEventAction is the action of events sent to check an endpoint
+	TestEventAction = "app.webhook.test"
+
+	// deliveryConcurrency is the number of deliveries attempted in parallel
+	deliveryConcurrency = 10
+	// responseSnippetSize is how much of an endpoint's response body is kept
+	// in the attempt history
+	responseSnippetSize = 1024
 )
 
 type EndpointRepository interface {
 	Create(ctx context.Context, endpoint Endpoint) (Endpoint, error)
+	GetByID(ctx context.Context, id string) (Endpoint, error)
 	UpdateByID(ctx context.Context, endpoint Endpoint) (Endpoint, error)
+	// UpdateSecrets replaces the secrets of the endpoint with the result of
+	// update, which is given the current secrets. The endpoint is locked while
+	// update runs, so concurrent rotations and revocations don't lose secrets.
+	UpdateSecrets(ctx context.Context, id string, update func([]Secret) ([]Secret, error)) (Endpoint, error)
+	// UpdateHealth resets the consecutive failures of the endpoint on success,
+	// otherwise increments them and disables the endpoint once they reach
+	// disableAfter, if it is above 0
+	UpdateHealth(ctx context.Context, id string, succeeded bool, disableAfter int) (Endpoint, error)
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, filter EndpointFilter) ([]Endpoint, error)
 }
 
+type DeliveryRepository interface {
+	Create(ctx context.Context, deliveries []Delivery) error
+	GetByID(ctx context.Context, id string) (Delivery, error)
+	List(ctx context.Context, filter DeliveryFilter) ([]Delivery, error)
+	ListAttempts(ctx context.Context, deliveryID string) ([]Attempt, error)
+	// ClaimDue returns up to limit pending deliveries whose next attempt is due
+	// and pushes their next attempt lease into the future, so concurrent
+	// workers on other replicas don't pick the same deliveries.
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]Delivery, error)
+	// RecordAttempt stores the attempt and the delivery state it resulted in
+	RecordAttempt(ctx context.Context, delivery Delivery, attempt Attempt) error
+	// Requeue marks the deliveries matching the filter pending again with a
+	// fresh attempt budget, returning how many were queued
+	Requeue(ctx context.Context, filter DeliveryFilter) (int, error)
+}
+
+type AuditRecordService interface {
+	Create(ctx context.Context, auditRecord models.AuditRecord) (models.AuditRecord, bool, error)
+}
+
 type Service struct {
-	eRepo EndpointRepository
+	eRepo              EndpointRepository
+	dRepo              DeliveryRepository
+	auditRecordService AuditRecordService
+	config             DeliveryConfig
+	client             *http.Client
+
+	cron     *cron.Cron
+	wake     chan struct{}
+	stop     chan struct{}
+	stopOnce *sync.Once
 }
 
-func NewService(eRepo EndpointRepository) *Service {
-	return &Service{eRepo: eRepo}
+func NewService(eRepo EndpointRepository, dRepo DeliveryRepository, auditRecordService AuditRecordService,
+	config DeliveryConfig) *Service {
+	return &Service{
+		eRepo:              eRepo,
+		dRepo:              dRepo,
+		auditRecordService: auditRecordService,
+		config:             config,
+		client:             newHTTPClient(config),
+		cron: cron.New(cron.WithChain(
+			cron.SkipIfStillRunning(cron.DefaultLogger),
+			cron.Recover(cron.DefaultLogger),
+		)),
+		wake:     make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		stopOnce: &sync.Once{},
+	}
 }
 
 func (s Service) CreateEndpoint(ctx context.Context, endpoint Endpoint) (Endpoint, error) {
@@ -51,11 +116,14 @@ func (s Service) CreateEndpoint(ctx context.Context, endpoint Endpoint) (Endpoin
 	if endpoint.State == "" {
 		endpoint.State = Enabled
 	}
+	if endpoint.PayloadFormat == "" {
+		endpoint.PayloadFormat = PayloadFormatFrontier
+	}
 	endpoint.URL = strings.TrimSpace(endpoint.URL)
-	if err := validateEndpoint(endpoint); err != nil {
+	if err := s.validateEndpoint(endpoint); err != nil {
 		return Endpoint{}, err
 	}
-	if err := s.ensureURLIsFree(ctx, endpoint.URL, endpoint.ID); err != nil {
+	if err := s.ensureURLIsFree(ctx, endpoint.OrgID, endpoint.URL, endpoint.ID); err != nil {
 		return Endpoint{}, err
 	}
 
@@ -76,16 +144,82 @@ func (s Service) UpdateEndpoint(ctx context.Context, endpoint Endpoint) (Endpoin
 		return Endpoint{}, ErrInvalidUUID
 	}
 	endpoint.URL = strings.TrimSpace(endpoint.URL)
-	if err := validateEndpoint(endpoint); err != nil {
+	if err := s.validateEndpoint(endpoint); err != nil {
+		return Endpoint{}, err
+	}
+	existing, err := s.eRepo.GetByID(ctx, endpoint.ID)
+	if err != nil {
 		return Endpoint{}, err
 	}
-	if err := s.ensureURLIsFree(ctx, endpoint.URL, endpoint.ID); err != nil {
+	// an organization can only update its own webhooks
+	if endpoint.OrgID != "" && existing.OrgID != endpoint.OrgID {
+		return Endpoint{}, ErrNotFound
+	}
+	if err := s.ensureURLIsFree(ctx, existing.OrgID, endpoint.URL, endpoint.ID); err != nil {
 		return Endpoint{}, err
 	}
 	updated, err := s.eRepo.UpdateByID(ctx, endpoint)
 	if err != nil {
 		return Endpoint{}, err
 	}
+	if existing.State == Disabled && updated.State == Enabled {
+		// deliveries failed while the endpoint was disabled never reached
+		// it, queue them again now that it is back
+		if _, err := s.dRepo.Requeue(ctx, DeliveryFilter{
+			EndpointID: updated.ID,
+			Status:     DeliveryFailed,
+			LastError:  ErrDisabled.Error(),
+		}); err != nil {
+			return Endpoint{}, fmt.Errorf("failed to requeue deliveries of the webhook: %w", err)
+		}
+		s.Notify()
+	}
+	updated.Secrets = nil
+	return updated, nil
+}
+
+// UpdateFilters replaces the filters of the endpoint, an empty map removes
+// them. The rest of the endpoint is left as is.
+func (s Service) UpdateFilters(ctx context.Context, endpointID string, filters map[string][]string) (Endpoint, error) {
+	endpoint, err := s.eRepo.GetByID(ctx, endpointID)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	endpoint.Filters = filters
+	if endpoint.Filters == nil {
+		// nil leaves the stored filters untouched
+		endpoint.Filters = map[string][]string{}
+	}
+	if err := validateSubscriptions(endpoint); err != nil {
+		return Endpoint{}, err
+	}
+	// an unchanged state isn't written, enabling would reset the failures
+	endpoint.State = ""
+	updated, err := s.eRepo.UpdateByID(ctx, endpoint)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	updated.Secrets = nil
+	return updated, nil
+}
+
+// UpdatePayloadFormat changes the format events are sent to the endpoint in.
+// Deliveries already queued keep the format they were rendered in.
+func (s Service) UpdatePayloadFormat(ctx context.Context, endpointID string, format PayloadFormat) (Endpoint, error) {
+	if format == "" || !format.valid() {
+		return Endpoint{}, fmt.Errorf("%w: unknown payload format %q", ErrInvalidDetail, format)
+	}
+	endpoint, err := s.eRepo.GetByID(ctx, endpointID)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	endpoint.PayloadFormat = format
+	// an unchanged state isn't written, enabling would reset the failures
+	endpoint.State = ""
+	updated, err := s.eRepo.UpdateByID(ctx, endpoint)
+	if err != nil {
+		return Endpoint{}, err
+	}
 	updated.Secrets = nil
 	return updated, nil
 }
@@ -94,7 +228,7 @@ func (s Service) UpdateEndpoint(ctx context.Context, endpoint Endpoint) (Endpoin
 // on. The URL is that flow's identity for an endpoint and the state is managed
 // as enabled/disabled, so the server only stores values that reconcile can
 // represent and round-trip: a valid absolute URL, and a known state.
-func validateEndpoint(endpoint Endpoint) error {
+func (s Service) validateEndpoint(endpoint Endpoint) error {
 	u, err := url.Parse(endpoint.URL)
 	if err != nil || !u.IsAbs() {
 		return fmt.Errorf("%w: url must be a valid absolute URL", ErrInvalidDetail)
@@ -109,20 +243,33 @@ func validateEndpoint(endpoint Endpoint) error {
 	if u.Host == "" {
 		return fmt.Errorf("%w: url must include a host", ErrInvalidDetail)
 	}
+	// hosts resolving to internal addresses are refused when they are dialed,
+	// literal ones are refused right away
+	if !s.config.AllowPrivateNetworks && isInternalHost(u.Hostname()) {
+		return fmt.Errorf("%w: %w", ErrInvalidDetail, ErrInternalAddress)
+	}
 	switch endpoint.State {
 	case "", Enabled, Disabled:
 	default:
 		return fmt.Errorf("%w: state must be %q or %q", ErrInvalidDetail, Enabled, Disabled)
 	}
-	return nil
+	if !endpoint.PayloadFormat.valid() {
+		return fmt.Errorf("%w: unknown payload format %q", ErrInvalidDetail, endpoint.PayloadFormat)
+	}
+	return validateSubscriptions(endpoint)
 }
 
-// ensureURLIsFree rejects a URL that another endpoint already uses. The reconcile
-// flow uses the URL as the endpoint's identity, so two endpoints sharing a URL
-// would be ambiguous. excludeID is the endpoint being updated, so it does not
-// conflict with itself.
-func (s Service) ensureURLIsFree(ctx context.Context, rawURL, excludeID string) error {
-	existing, err := s.eRepo.List(ctx, EndpointFilter{})
+// ensureURLIsFree rejects a URL that another endpoint of the same owner already
+// uses. The reconcile flow uses the URL as the endpoint's identity, so two
+// endpoints sharing a URL would be ambiguous. Endpoints of other organizations
+// don't conflict, an organization must not learn which URLs others use.
+// excludeID is the endpoint being updated, so it does not conflict with itself.
+func (s Service) ensureURLIsFree(ctx context.Context, orgID, rawURL, excludeID string) error {
+	filter := EndpointFilter{OrgID: orgID}
+	if orgID == "" {
+		filter.Platform = true
+	}
+	existing, err := s.eRepo.List(ctx, filter)
 	if err != nil {
 		return err
 	}
@@ -134,6 +281,65 @@ func (s Service) ensureURLIsFree(ctx context.Context, rawURL, excludeID string)
 	return nil
 }
 
+// RotateSecret adds a new signing secret to the endpoint. Payloads are signed
+// with every secret of the endpoint, so consumers can switch over to the new
+// secret before the old one is revoked. The returned secret holds the value,
+// which is not exposed by any other call.
+func (s Service) RotateSecret(ctx context.Context, endpointID string) (Secret, error) {
+	secretHex, err := crypt.NewEncryptionKeyInHex()
+	if err != nil {
+		return Secret{}, err
+	}
+	secret := Secret{Value: secretHex}
+	if _, err := s.eRepo.UpdateSecrets(ctx, endpointID, func(secrets []Secret) ([]Secret, error) {
+		secret.ID = nextSecretID(secrets)
+		return append(secrets, secret), nil
+	}); err != nil {
+		return Secret{}, err
+	}
+	return secret, nil
+}
+
+// RevokeSecret removes a signing secret from the endpoint. The last secret of
+// an endpoint can't be revoked, rotate in a new one first.
+func (s Service) RevokeSecret(ctx context.Context, endpointID, secretID string) error {
+	_, err := s.eRepo.UpdateSecrets(ctx, endpointID, func(secrets []Secret) ([]Secret, error) {
+		remaining := slices.DeleteFunc(slices.Clone(secrets), func(secret Secret) bool {
+			return secret.ID == secretID
+		})
+		if len(remaining) == len(secrets) {
+			return nil, ErrSecretNotFound
+		}
+		if len(remaining) == 0 {
+			return nil, fmt.Errorf("%w: the only secret of a webhook can't be revoked", ErrInvalidDetail)
+		}
+		return remaining, nil
+	})
+	return err
+}
+
+// nextSecretID returns an id greater than every numeric id in use, ids are
+// never reused so a revoked secret can't be confused with a new one
+func nextSecretID(secrets []Secret) string {
+	last := 0
+	for _, secret := range secrets {
+		if id, err := strconv.Atoi(secret.ID); err == nil && id > last {
+			last = id
+		}
+	}
+	return strconv.Itoa(last + 1)
+}
+
+// GetEndpoint returns the endpoint without its secrets
+func (s Service) GetEndpoint(ctx context.Context, id string) (Endpoint, error) {
+	endpoint, err := s.eRepo.GetByID(ctx, id)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	endpoint.Secrets = nil
+	return endpoint, nil
+}
+
 func (s Service) DeleteEndpoint(ctx context.Context, id string) error {
 	return s.eRepo.Delete(ctx, id)
 }
@@ -149,84 +355,442 @@ func (s Service) ListEndpoints(ctx context.Context, filter EndpointFilter) ([]En
 	return endpoints, nil
 }
 
+// Publish queues the event for every enabled endpoint subscribed to it. The
+// deliveries are persisted before returning, and attempted in the background.
 func (s Service) Publish(ctx context.Context, evt Event) error {
-	data, err := structpb.NewStruct(evt.Data)
+	deliveries, err := s.Deliveries(ctx, evt)
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to convert data to structpb", "error", err)
-		return fmt.Errorf("failed to convert data to structpb: %w", err)
+		return err
 	}
-	event := &frontierv1beta1.WebhookEvent{
-		Id:        evt.ID,
-		Action:    evt.Action,
-		Data:      data,
-		CreatedAt: timestamppb.New(evt.CreatedAt),
+	if len(deliveries) == 0 {
+		return nil
 	}
+	if err := s.dRepo.Create(ctx, deliveries); err != nil {
+		return fmt.Errorf("failed to queue deliveries: %w", err)
+	}
+	s.Notify()
+	return nil
+}
 
-	payload, err := protojson.Marshal(event)
+// Deliveries returns the deliveries of the event to every enabled endpoint
+// subscribed to it without queuing them. Callers recording the event in the
+// database insert them in the same transaction, so an event is never stored
+// without its deliveries, and call Notify once it commits.
+func (s Service) Deliveries(ctx context.Context, evt Event) ([]Delivery, error) {
+	// endpoints sharing a format share the payload
+	payloads := make(map[PayloadFormat][]byte)
+	payloadFor := func(format PayloadFormat) ([]byte, error) {
+		if payload, ok := payloads[format]; ok {
+			return payload, nil
+		}
+		payload, err := encodeEvent(evt, format)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to marshal event", "error", err, "format", format)
+			return nil, fmt.Errorf("failed to marshal event: %w", err)
+		}
+		payloads[format] = payload
+		return payload, nil
+	}
+
+	endpoints, err := s.eRepo.List(ctx, EndpointFilter{
+		State: Enabled,
+	})
 	if err != nil {
-		slog.ErrorContext(ctx, "failed to marshal event", "error", err)
-		return fmt.Errorf("failed to marshal event: %w", err)
+		return nil, fmt.Errorf("failed to list endpoints: %w", err)
 	}
+	requestID, _ := consts.GetRequestIDFromCtx(ctx)
+	var deliveries []Delivery
+	for _, endpoint := range endpoints {
+		if !endpoint.Accepts(evt) {
+			continue
+		}
+		format := endpoint.payloadFormat()
+		payload, err := payloadFor(format)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, Delivery{
+			ID:            uuid.NewString(),
+			EndpointID:    endpoint.ID,
+			EventID:       evt.ID,
+			Action:        evt.Action,
+			PayloadFormat: format,
+			Payload:       payload,
+			RequestID:     requestID,
+			Status:        DeliveryPending,
+			NextAttemptAt: time.Now().UTC(),
+		})
+	}
+	return deliveries, nil
+}
 
-	// send event to endpoints
+// Init starts the background worker that attempts queued deliveries
+func (s Service) Init(ctx context.Context) error {
+	run := func() {
+		if err := s.DeliverPending(ctx); err != nil {
+			slog.WarnContext(ctx, "failed to deliver webhook events", "err", err)
+		}
+	}
+	if _, err := s.cron.AddFunc(fmt.Sprintf("@every %s", s.config.Interval.String()), run); err != nil {
+		return fmt.Errorf("failed to schedule webhook delivery job: %w", err)
+	}
+	s.cron.Start()
+
+	// pick up freshly published events without waiting for the next tick
 	go func() {
-		detachedRepoContext, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-		endpoints, err := s.eRepo.List(detachedRepoContext, EndpointFilter{
-			State: Enabled,
-		})
+		for {
+			select {
+			case <-s.wake:
+				run()
+			case <-s.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Close stops the background delivery worker
+func (s Service) Close() error {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+	<-s.cron.Stop().Done()
+	return nil
+}
+
+// Notify wakes the background worker up to attempt freshly queued deliveries
+// without waiting for the next interval
+func (s Service) Notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// DeliverPending attempts a batch of deliveries that are due
+func (s Service) DeliverPending(ctx context.Context) error {
+	// a claimed batch is not offered to other workers until the lease ends,
+	// which is long enough to attempt every delivery in it
+	lease := time.Duration(s.config.BatchSize) * s.config.Timeout
+	deliveries, err := s.dRepo.ClaimDue(ctx, s.config.BatchSize, lease)
+	if err != nil {
+		return fmt.Errorf("failed to claim deliveries: %w", err)
+	}
+
+	endpoints := make(map[string]Endpoint)
+	var mu sync.Mutex
+	getEndpoint := func(ctx context.Context, id string) (Endpoint, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if endpoint, ok := endpoints[id]; ok {
+			return endpoint, nil
+		}
+		endpoint, err := s.eRepo.GetByID(ctx, id)
 		if err != nil {
-			slog.ErrorContext(ctx, "failed to list endpoints", "error", err)
-			return
+			return Endpoint{}, err
 		}
-		var errs []error
-		for _, endpoint := range endpoints {
-			if len(endpoint.SubscribedEvents) > 0 && !slices.Contains(endpoint.SubscribedEvents, event.GetAction()) {
-				continue
-			}
-			if len(endpoint.Secrets) == 0 {
-				errs = append(errs, fmt.Errorf("no secret found for endpoint: %s", endpoint.ID))
-				continue
-			}
+		endpoints[id] = endpoint
+		return endpoint, nil
+	}
 
-			// just use the first secret to sign the payload for now
-			secret := endpoint.Secrets[0]
-			signature, err := crypt.GenerateHMACFromHex(payload, secret.Value)
-			if err != nil {
-				errs = append(errs, fmt.Errorf("failed to generate HMAC: %w", err))
-				continue
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(deliveryConcurrency)
+	for _, delivery := range deliveries {
+		g.Go(func() error {
+			endpoint, err := getEndpoint(gctx, delivery.EndpointID)
+			if errors.Is(err, ErrNotFound) {
+				// the endpoint was deleted, nothing can deliver it anymore
+				if err := s.discard(gctx, delivery, ErrNotFound); err != nil {
+					slog.WarnContext(gctx, "failed to record webhook delivery attempt",
+						"delivery_id", delivery.ID, "err", err)
+				}
+				return nil
 			}
-
-			requestHeaders := make(map[string]string)
-			maps.Copy(requestHeaders, endpoint.Headers)
-			if id, ok := consts.GetRequestIDFromCtx(ctx); ok {
-				requestHeaders[consts.RequestIDHeader] = id
+			if err != nil {
+				// left pending, the delivery is claimed again once its lease ends
+				slog.WarnContext(gctx, "failed to get webhook endpoint",
+					"delivery_id", delivery.ID, "endpoint_id", delivery.EndpointID, "err", err)
+				return nil
 			}
-			requestHeaders[SignatureHeader] = signatureHeader(signature, secret.ID)
-			if err := post(endpoint.URL, requestHeaders, payload); err != nil {
-				errs = append(errs, err)
+			if err := s.deliver(gctx, endpoint, delivery); err != nil {
+				slog.WarnContext(gctx, "failed to record webhook delivery attempt",
+					"delivery_id", delivery.ID, "err", err)
 			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// deliver makes one attempt of the delivery and records its outcome
+func (s Service) deliver(ctx context.Context, endpoint Endpoint, delivery Delivery) error {
+	if endpoint.State == Disabled {
+		return s.discard(ctx, delivery, ErrDisabled)
+	}
+	attempt := s.send(ctx, endpoint, delivery)
+	attempt.ID = uuid.NewString()
+	attempt.DeliveryID = delivery.ID
+	attempt.CreatedAt = time.Now().UTC()
+
+	delivery.AttemptCount++
+	delivery.LastAttemptAt = &attempt.CreatedAt
+	delivery.LastStatusCode = attempt.StatusCode
+	delivery.LastError = attempt.Error
+	switch {
+	case attempt.Succeeded():
+		delivery.Status = DeliverySucceeded
+	case delivery.AttemptCount >= s.config.MaxAttempts:
+		delivery.Status = DeliveryFailed
+	default:
+		delivery.Status = DeliveryPending
+		delivery.NextAttemptAt = attempt.CreatedAt.Add(s.config.Backoff(delivery.AttemptCount))
+	}
+	if delivery.LastError == "" && !attempt.Succeeded() {
+		delivery.LastError = fmt.Sprintf("unexpected status code: %d", attempt.StatusCode)
+	}
+	if err := s.dRepo.RecordAttempt(ctx, delivery, attempt); err != nil {
+		return err
+	}
+	return s.trackHealth(ctx, endpoint, attempt)
+}
+
+// discard records the delivery failed without sending it, for endpoints
+// that are disabled or deleted
+func (s Service) discard(ctx context.Context, delivery Delivery, reason error) error {
+	attempt := Attempt{
+		ID:         uuid.NewString(),
+		DeliveryID: delivery.ID,
+		Error:      reason.Error(),
+		CreatedAt:  time.Now().UTC(),
+	}
+	delivery.AttemptCount++
+	delivery.LastAttemptAt = &attempt.CreatedAt
+	delivery.LastStatusCode = attempt.StatusCode
+	delivery.LastError = attempt.Error
+	delivery.Status = DeliveryFailed
+	return s.dRepo.RecordAttempt(ctx, delivery, attempt)
+}
+
+// trackHealth counts consecutive failed attempts of the endpoint and records
+// it being disabled once they reach the configured threshold
+func (s Service) trackHealth(ctx context.Context, endpoint Endpoint, attempt Attempt) error {
+	updated, err := s.eRepo.UpdateHealth(ctx, endpoint.ID, attempt.Succeeded(), s.config.DisableAfterFailures)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook health: %w", err)
+	}
+	// only the attempt that crossed the threshold reports it
+	if updated.State != Disabled || updated.ConsecutiveFailures != s.config.DisableAfterFailures {
+		return nil
+	}
+	slog.WarnContext(ctx, "webhook disabled after consecutive failed deliveries",
+		"webhook_id", endpoint.ID, "url", endpoint.URL, "failures", updated.ConsecutiveFailures)
+	resource := models.Resource{
+		ID:   schema.PlatformID,
+		Type: pkgauditrecord.PlatformType,
+		Name: schema.PlatformID,
+	}
+	orgID := schema.PlatformOrgID.String()
+	if endpoint.OrgID != "" {
+		resource = models.Resource{
+			ID:   endpoint.OrgID,
+			Type: pkgauditrecord.OrganizationType,
+		}
+		orgID = endpoint.OrgID
+	}
+	// disabled by the delivery worker, not on behalf of a principal
+	_, _, err = s.auditRecordService.Create(ctx, models.AuditRecord{
+		Event: pkgauditrecord.WebhookDisabledEvent,
+		Actor: models.Actor{
+			ID: uuid.Nil.String(),
+		},
+		Resource: resource,
+		Target: &models.Target{
+			ID:   endpoint.ID,
+			Type: pkgauditrecord.WebhookType,
+			Name: endpoint.URL,
+		},
+		OrgID:      orgID,
+		OccurredAt: time.Now().UTC(),
+		Metadata: map[string]any{
+			"consecutive_failures": updated.ConsecutiveFailures,
+			"last_status_code":     attempt.StatusCode,
+			"last_error":           attempt.Error,
+		},
+	})
+	return err
+}
+
+// SendTestEvent synchronously sends a signed test event to the endpoint and
+// returns the outcome. The attempt is not queued, retried or counted towards
+// the health of the endpoint, so a disabled endpoint can be checked before
+// it is enabled again.
+func (s Service) SendTestEvent(ctx context.Context, endpointID string) (Attempt, error) {
+	endpoint, err := s.eRepo.GetByID(ctx, endpointID)
+	if err != nil {
+		return Attempt{}, err
+	}
+	evt := Event{
+		ID:        uuid.NewString(),
+		Action:    TestEventAction,
+		Data:      map[string]any{},
+		CreatedAt: time.Now().UTC(),
+	}
+	format := endpoint.payloadFormat()
+	payload, err := encodeEvent(evt, format)
+	if err != nil {
+		return Attempt{}, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	requestID, _ := consts.GetRequestIDFromCtx(ctx)
+	attempt := s.send(ctx, endpoint, Delivery{
+		ID:            uuid.NewString(),
+		EndpointID:    endpoint.ID,
+		EventID:       evt.ID,
+		Action:        evt.Action,
+		PayloadFormat: format,
+		Payload:       payload,
+		RequestID:     requestID,
+	})
+	attempt.CreatedAt = time.Now().UTC()
+	return attempt, nil
+}
+
+// send signs and posts the delivery payload to the endpoint
+func (s Service) send(ctx context.Context, endpoint Endpoint, delivery Delivery) Attempt {
+	if len(endpoint.Secrets) == 0 {
+		return Attempt{Error: fmt.Sprintf("no secret found for endpoint: %s", endpoint.ID)}
+	}
+	formatHeaders, body, err := requestBody(delivery.Payload, delivery.PayloadFormat)
+	if err != nil {
+		return Attempt{Error: err.Error()}
+	}
+	// in binary content mode the attributes are headers, they are signed
+	// along with the body so they can't be swapped
+	signed := body
+	if delivery.PayloadFormat == PayloadFormatCloudEventsBinary {
+		header := make(http.Header, len(formatHeaders))
+		for k, v := range formatHeaders {
+			header.Set(k, v)
 		}
-		if len(errs) > 0 {
-			slog.ErrorContext(ctx, "failed to send events", "errs", errs)
+		signed = pkgwebhook.CloudEventsBinaryContent(header, body)
+	}
+	signature, err := signatureHeader(signed, endpoint.Secrets)
+	if err != nil {
+		return Attempt{Error: err.Error()}
+	}
+	hexKeys := make([]string, 0, len(endpoint.Secrets))
+	for _, secret := range endpoint.Secrets {
+		hexKeys = append(hexKeys, secret.Value)
+	}
+	// signed with the time of this attempt, so a retry is fresh even when the
+	// event itself is old
+	timestampedSignature, err := pkgwebhook.SignTimestamped(signed, time.Now(), hexKeys)
+	if err != nil {
+		return Attempt{Error: fmt.Sprintf("failed to generate HMAC: %s", err)}
+	}
+
+	requestHeaders := make(map[string]string)
+	maps.Copy(requestHeaders, endpoint.Headers)
+	maps.Copy(requestHeaders, formatHeaders)
+	if delivery.RequestID != "" {
+		requestHeaders[consts.RequestIDHeader] = delivery.RequestID
+	}
+	requestHeaders[SignatureHeader] = signature
+	requestHeaders[pkgwebhook.TimestampedSignatureHeader] = timestampedSignature
+	requestHeaders[pkgwebhook.DeliveryIDHeader] = delivery.ID
+	attempt := s.post(ctx, endpoint.URL, requestHeaders, body)
+	// the response of an organization's endpoint isn't kept, it would be
+	// returned to the organization whatever the endpoint answered with
+	if endpoint.OrgID != "" {
+		attempt.Response = ""
+	}
+	return attempt
+}
+
+// signatureHeader signs the payload with each secret and joins the signatures
+// as id=signature pairs, e.g. "1=ab12..,2=cd34.."
+func signatureHeader(payload []byte, secrets []Secret) (string, error) {
+	signatures := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		signature, err := crypt.GenerateHMACFromHex(payload, secret.Value)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate HMAC with secret %s: %w", secret.ID, err)
 		}
-	}()
-	return nil
+		signatures = append(signatures, fmt.Sprintf("%s=%s", secret.ID, signature))
+	}
+	return strings.Join(signatures, ","), nil
 }
 
-func signatureHeader(val string, id string) string {
-	return fmt.Sprintf("%s=%s", id, val)
+func (s Service) post(ctx context.Context, url string, headers map[string]string, payload []byte) Attempt {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return Attempt{Error: err.Error()}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return Attempt{Latency: time.Since(start), Error: err.Error()}
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, responseSnippetSize))
+	attempt := Attempt{
+		StatusCode: resp.StatusCode,
+		Latency:    time.Since(start),
+		Response:   strings.ToValidUTF8(string(body), ""),
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		attempt.Error = fmt.Sprintf("failed to read response: %s", err)
+	}
+	return attempt
 }
 
-func post(url string, headers map[string]string, payload []byte) error {
-	// post event
-	client := resty.New().
-		SetRetryCount(EndpointRetryCount).
-		SetRetryWaitTime(3 * time.Second).
-		SetRetryMaxWaitTime(20 * time.Second).
-		SetTimeout(3 * time.Second).
-		SetHeaders(headers)
+// ListDeliveries returns the delivery log, most recent first
+func (s Service) ListDeliveries(ctx context.Context, filter DeliveryFilter) ([]Delivery, error) {
+	return s.dRepo.List(ctx, filter)
+}
 
-	_, err := client.R().SetBody(payload).Post(url)
-	return err
+func (s Service) GetDelivery(ctx context.Context, id string) (Delivery, error) {
+	return s.dRepo.GetByID(ctx, id)
+}
+
+// ListDeliveryAttempts returns every attempt made for a delivery, oldest first
+func (s Service) ListDeliveryAttempts(ctx context.Context, deliveryID string) ([]Attempt, error) {
+	return s.dRepo.ListAttempts(ctx, deliveryID)
+}
+
+// Redeliver queues an already sent or failed delivery to be sent again
+func (s Service) Redeliver(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return ErrInvalidUUID
+	}
+	if _, err := s.dRepo.Requeue(ctx, DeliveryFilter{ID: id}); err != nil {
+		return err
+	}
+	s.Notify()
+	return nil
+}
+
+// RedeliverRange queues every delivery matching the filter to be sent again,
+// typically all deliveries of an endpoint within a time range. It returns the
+// number of deliveries queued.
+func (s Service) RedeliverRange(ctx context.Context, filter DeliveryFilter) (int, error) {
+	if filter.Since.IsZero() || filter.Until.IsZero() || !filter.Since.Before(filter.Until) {
+		return 0, fmt.Errorf("%w: a valid time range is required", ErrInvalidDetail)
+	}
+	filter.Pagination = nil
+	count, err := s.dRepo.Requeue(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	if count > 0 {
+		s.Notify()
+	}
+	return count, nil
 }
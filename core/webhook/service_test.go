@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/raystack/frontier/core/auditrecord/models"
 	"github.com/raystack/frontier/core/webhook"
 	"github.com/stretchr/testify/assert"
 )
@@ -18,6 +19,15 @@ func (f *fakeEndpointRepo) Create(_ context.Context, e webhook.Endpoint) (webhoo
 	return e, nil
 }
 
+func (f *fakeEndpointRepo) GetByID(_ context.Context, id string) (webhook.Endpoint, error) {
+	for _, e := range f.items {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return webhook.Endpoint{}, webhook.ErrNotFound
+}
+
 func (f *fakeEndpointRepo) UpdateByID(_ context.Context, e webhook.Endpoint) (webhook.Endpoint, error) {
 	for i := range f.items {
 		if f.items[i].ID == e.ID {
@@ -28,27 +38,82 @@ func (f *fakeEndpointRepo) UpdateByID(_ context.Context, e webhook.Endpoint) (we
 	return webhook.Endpoint{}, webhook.ErrNotFound
 }
 
+func (f *fakeEndpointRepo) UpdateSecrets(_ context.Context, id string, update func([]webhook.Secret) ([]webhook.Secret, error)) (webhook.Endpoint, error) {
+	for i := range f.items {
+		if f.items[i].ID == id {
+			secrets, err := update(f.items[i].Secrets)
+			if err != nil {
+				return webhook.Endpoint{}, err
+			}
+			f.items[i].Secrets = secrets
+			return f.items[i], nil
+		}
+	}
+	return webhook.Endpoint{}, webhook.ErrNotFound
+}
+
+func (f *fakeEndpointRepo) UpdateHealth(_ context.Context, id string, succeeded bool, disableAfter int) (webhook.Endpoint, error) {
+	for i := range f.items {
+		if f.items[i].ID != id {
+			continue
+		}
+		if succeeded {
+			f.items[i].ConsecutiveFailures = 0
+		} else {
+			f.items[i].ConsecutiveFailures++
+			if disableAfter > 0 && f.items[i].ConsecutiveFailures >= disableAfter {
+				f.items[i].State = webhook.Disabled
+			}
+		}
+		return f.items[i], nil
+	}
+	return webhook.Endpoint{}, webhook.ErrNotFound
+}
+
 func (f *fakeEndpointRepo) Delete(_ context.Context, _ string) error { return nil }
 
-func (f *fakeEndpointRepo) List(_ context.Context, _ webhook.EndpointFilter) ([]webhook.Endpoint, error) {
-	return f.items, nil
+func (f *fakeEndpointRepo) List(_ context.Context, flt webhook.EndpointFilter) ([]webhook.Endpoint, error) {
+	var endpoints []webhook.Endpoint
+	for _, e := range f.items {
+		if (flt.State != "" && e.State != flt.State) ||
+			(flt.OrgID != "" && e.OrgID != flt.OrgID) ||
+			(flt.Platform && e.OrgID != "") {
+			continue
+		}
+		endpoints = append(endpoints, e)
+	}
+	return endpoints, nil
+}
+
+// fakeAuditRecordService collects the audit records created by the service.
+type fakeAuditRecordService struct {
+	records []models.AuditRecord
+}
+
+func (f *fakeAuditRecordService) Create(_ context.Context, r models.AuditRecord) (models.AuditRecord, bool, error) {
+	f.records = append(f.records, r)
+	return r, false, nil
+}
+
+func newService(repo webhook.EndpointRepository) *webhook.Service {
+	return webhook.NewService(repo, &fakeDeliveryRepo{}, &fakeAuditRecordService{}, webhook.DeliveryConfig{})
 }
 
 func TestServiceCreateEndpointValidation(t *testing.T) {
 	t.Run("rejects a non-absolute url", func(t *testing.T) {
-		s := webhook.NewService(&fakeEndpointRepo{})
+		s := newService(&fakeEndpointRepo{})
 		_, err := s.CreateEndpoint(context.Background(), webhook.Endpoint{URL: "not-a-url"})
 		assert.ErrorIs(t, err, webhook.ErrInvalidDetail)
 	})
 
 	t.Run("rejects an empty url", func(t *testing.T) {
-		s := webhook.NewService(&fakeEndpointRepo{})
+		s := newService(&fakeEndpointRepo{})
 		_, err := s.CreateEndpoint(context.Background(), webhook.Endpoint{URL: "   "})
 		assert.ErrorIs(t, err, webhook.ErrInvalidDetail)
 	})
 
 	t.Run("rejects a non-http(s) scheme", func(t *testing.T) {
-		s := webhook.NewService(&fakeEndpointRepo{})
+		s := newService(&fakeEndpointRepo{})
 		_, err := s.CreateEndpoint(context.Background(), webhook.Endpoint{URL: "ftp://a.example/hook"})
 		assert.ErrorIs(t, err, webhook.ErrInvalidDetail)
 	})
@@ -56,25 +121,41 @@ func TestServiceCreateEndpointValidation(t *testing.T) {
 	t.Run("rejects an http(s) url with no host", func(t *testing.T) {
 		// "https://" and "http:///path" parse as absolute http(s) URLs with an
 		// empty host, but a webhook with no host to deliver to is useless.
-		s := webhook.NewService(&fakeEndpointRepo{})
+		s := newService(&fakeEndpointRepo{})
 		_, err := s.CreateEndpoint(context.Background(), webhook.Endpoint{URL: "https://"})
 		assert.ErrorIs(t, err, webhook.ErrInvalidDetail)
 	})
 
 	t.Run("rejects an unknown state", func(t *testing.T) {
-		s := webhook.NewService(&fakeEndpointRepo{})
+		s := newService(&fakeEndpointRepo{})
 		_, err := s.CreateEndpoint(context.Background(), webhook.Endpoint{URL: "https://a.example/hook", State: "paused"})
 		assert.ErrorIs(t, err, webhook.ErrInvalidDetail)
 	})
 
 	t.Run("rejects a url another endpoint already uses", func(t *testing.T) {
 		repo := &fakeEndpointRepo{items: []webhook.Endpoint{{ID: "e1", URL: "https://a.example/hook"}}}
-		_, err := webhook.NewService(repo).CreateEndpoint(context.Background(), webhook.Endpoint{URL: "https://a.example/hook"})
+		_, err := newService(repo).CreateEndpoint(context.Background(), webhook.Endpoint{URL: "https://a.example/hook"})
+		assert.ErrorIs(t, err, webhook.ErrConflict)
+	})
+
+	t.Run("scopes url uniqueness to the owner of the endpoint", func(t *testing.T) {
+		repo := &fakeEndpointRepo{items: []webhook.Endpoint{
+			{ID: "e1", URL: "https://a.example/hook"},
+			{ID: "e2", OrgID: "org-1", URL: "https://b.example/hook"},
+		}}
+		s := newService(repo)
+		_, err := s.CreateEndpoint(context.Background(), webhook.Endpoint{OrgID: "org-2", URL: "https://a.example/hook"})
+		assert.NoError(t, err)
+		_, err = s.CreateEndpoint(context.Background(), webhook.Endpoint{OrgID: "org-2", URL: "https://b.example/hook"})
+		assert.NoError(t, err)
+		_, err = s.CreateEndpoint(context.Background(), webhook.Endpoint{URL: "https://b.example/hook"})
+		assert.NoError(t, err)
+		_, err = s.CreateEndpoint(context.Background(), webhook.Endpoint{OrgID: "org-1", URL: "https://b.example/hook"})
 		assert.ErrorIs(t, err, webhook.ErrConflict)
 	})
 
 	t.Run("creates a valid endpoint, defaulting state and generating a secret", func(t *testing.T) {
-		got, err := webhook.NewService(&fakeEndpointRepo{}).CreateEndpoint(
+		got, err := newService(&fakeEndpointRepo{}).CreateEndpoint(
 			context.Background(), webhook.Endpoint{URL: "https://a.example/hook"})
 		assert.NoError(t, err)
 		assert.Equal(t, webhook.Enabled, got.State) // defaulted
@@ -88,7 +169,7 @@ func TestServiceUpdateEndpointURLUniqueness(t *testing.T) {
 			{ID: "e1", URL: "https://a.example/hook", State: webhook.Enabled},
 			{ID: "e2", URL: "https://b.example/hook", State: webhook.Enabled},
 		}}
-		_, err := webhook.NewService(repo).UpdateEndpoint(context.Background(),
+		_, err := newService(repo).UpdateEndpoint(context.Background(),
 			webhook.Endpoint{ID: "e2", URL: "https://a.example/hook", State: webhook.Enabled})
 		assert.ErrorIs(t, err, webhook.ErrConflict)
 	})
@@ -97,8 +178,50 @@ func TestServiceUpdateEndpointURLUniqueness(t *testing.T) {
 		repo := &fakeEndpointRepo{items: []webhook.Endpoint{
 			{ID: "e1", URL: "https://a.example/hook", State: webhook.Enabled},
 		}}
-		_, err := webhook.NewService(repo).UpdateEndpoint(context.Background(),
+		_, err := newService(repo).UpdateEndpoint(context.Background(),
 			webhook.Endpoint{ID: "e1", URL: "https://a.example/hook", State: webhook.Disabled})
 		assert.NoError(t, err)
 	})
 }
+
+func TestServiceSecretRotation(t *testing.T) {
+	repo := &fakeEndpointRepo{items: []webhook.Endpoint{
+		{ID: "e1", URL: "https://a.example/hook", State: webhook.Enabled, Secrets: []webhook.Secret{{ID: webhook.DefaultSecretID, Value: "00"}}},
+	}}
+	s := newService(repo)
+
+	secret, err := s.RotateSecret(context.Background(), "e1")
+	assert.NoError(t, err)
+	assert.Equal(t, "2", secret.ID)
+	assert.NotEmpty(t, secret.Value)
+	assert.Len(t, repo.items[0].Secrets, 2)
+
+	assert.ErrorIs(t, s.RevokeSecret(context.Background(), "e1", "9"), webhook.ErrSecretNotFound)
+	assert.NoError(t, s.RevokeSecret(context.Background(), "e1", webhook.DefaultSecretID))
+	assert.Equal(t, []webhook.Secret{secret}, repo.items[0].Secrets)
+
+	// the last secret stays
+	assert.ErrorIs(t, s.RevokeSecret(context.Background(), "e1", "2"), webhook.ErrInvalidDetail)
+
+	// ids are not reused after a revoke
+	next, err := s.RotateSecret(context.Background(), "e1")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", next.ID)
+
+	_, err = s.RotateSecret(context.Background(), "missing")
+	assert.ErrorIs(t, err, webhook.ErrNotFound)
+}
+
+func TestServiceUpdateOrganizationEndpoint(t *testing.T) {
+	repo := &fakeEndpointRepo{items: []webhook.Endpoint{
+		{ID: "e1", OrgID: "org-1", URL: "https://a.example/hook", State: webhook.Enabled},
+	}}
+
+	_, err := newService(repo).UpdateEndpoint(context.Background(),
+		webhook.Endpoint{ID: "e1", OrgID: "org-2", URL: "https://a.example/hook", State: webhook.Disabled})
+	assert.ErrorIs(t, err, webhook.ErrNotFound)
+
+	_, err = newService(repo).UpdateEndpoint(context.Background(),
+		webhook.Endpoint{ID: "e1", OrgID: "org-1", URL: "https://a.example/hook", State: webhook.Disabled})
+	assert.NoError(t, err)
+}
@@ -25,14 +25,29 @@ type Endpoint struct {
 	Description string
 	// URL is the URL of the webhook
 	URL string
-	// SubscribedEvents is the list of events that the webhook is subscribed to
+	// OrgID is the organization owning the webhook. An organization webhook
+	// only receives events of its own organization, platform webhooks have no
+	// organization and receive events of every organization.
+	OrgID string
+	// SubscribedEvents is the list of events that the webhook is subscribed to.
+	// An entry is either an exact action or a pattern like "app.organization.*",
+	// where a "*" matches any run of characters, dots included.
 	SubscribedEvents []string
+	// Filters restrict the subscribed events by their data. Each key is a dot
+	// separated path into the event data, e.g. "org_id" or "target.type", and
+	// the event must hold one of the listed values at every key.
+	Filters map[string][]string
+	// PayloadFormat is the format of the requests sent to the webhook
+	PayloadFormat PayloadFormat
 	// Headers is the headers to be sent with the webhook
 	Headers map[string]string
 	// Secrets is the list of secrets to sign the payload
 	Secrets []Secret
 	// State is the state of the webhook
 	State State
+	// ConsecutiveFailures is the number of failed delivery attempts since the
+	// last successful one
+	ConsecutiveFailures int
 
 	// Metadata is the metadata of the webhook
 	Metadata metadata.Metadata
@@ -42,6 +57,13 @@ type Endpoint struct {
 	UpdatedAt time.Time
 }
 
+func (e Endpoint) payloadFormat() PayloadFormat {
+	if e.PayloadFormat == "" {
+		return PayloadFormatFrontier
+	}
+	return e.PayloadFormat
+}
+
 type Event struct {
 	ID        string
 	Action    string
@@ -51,4 +73,8 @@ type Event struct {
 
 type EndpointFilter struct {
 	State State
+	// OrgID limits the list to the webhooks of an organization
+	OrgID string
+	// Platform limits the list to webhooks not owned by any organization
+	Platform bool
 }
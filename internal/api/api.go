@@ -10,6 +10,8 @@ import (
 	"github.com/raystack/frontier/billing/product"
 	"github.com/raystack/frontier/billing/subscription"
 	"github.com/raystack/frontier/billing/usage"
+	"github.com/raystack/frontier/core/accessrequest"
+	"github.com/raystack/frontier/core/accessreview"
 	"github.com/raystack/frontier/core/aggregates/orgbilling"
 	"github.com/raystack/frontier/core/aggregates/orginvoices"
 	"github.com/raystack/frontier/core/aggregates/orgpats"
@@ -24,10 +26,18 @@ import (
 	"github.com/raystack/frontier/core/audit"
 	"github.com/raystack/frontier/core/auditrecord"
 	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/loginalert"
+	"github.com/raystack/frontier/core/authenticate/mfa"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	"github.com/raystack/frontier/core/authenticate/ratelimit"
+	"github.com/raystack/frontier/core/authenticate/refreshtoken"
 	"github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/core/authenticate/token"
+	"github.com/raystack/frontier/core/certification"
 	"github.com/raystack/frontier/core/deleter"
 	"github.com/raystack/frontier/core/domain"
 	"github.com/raystack/frontier/core/event"
+	"github.com/raystack/frontier/core/explain"
 	"github.com/raystack/frontier/core/group"
 	"github.com/raystack/frontier/core/invitation"
 	"github.com/raystack/frontier/core/kyc"
@@ -100,8 +110,21 @@ type Deps struct {
 
 	ProspectService *prospect.Service
 
-	AuditRecordService *auditrecord.Service
-	UserPATService     *userpat.Service
-	PATAlertService    *userpat.AlertService
-	MembershipService  *membership.Service
+	AuditRecordService  *auditrecord.Service
+	AuditArchiveService *auditrecord.ArchiveService
+	UserPATService      *userpat.Service
+	PATAlertService     *userpat.AlertService
+	MembershipService   *membership.Service
+
+	OIDCProviderService  *oidcprovider.Service
+	RefreshTokenService  *refreshtoken.Service
+	TokenRevocationStore *token.RevocationStore
+	TokenKeyStore        *token.KeyStore
+	MFAService           *mfa.Service
+	RateLimitService     *ratelimit.Service
+	LoginAlertService    *loginalert.Service
+	AccessRequestService *accessrequest.Service
+	ExplainService       *explain.Service
+	AccessReviewService  *accessreview.Service
+	CertificationService *certification.Service
 }
@@ -0,0 +1,201 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/accessrequest"
+	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func accessRequestErrCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, accessrequest.ErrNotExist),
+		errors.Is(err, organization.ErrNotExist),
+		errors.Is(err, project.ErrNotExist),
+		errors.Is(err, group.ErrNotExist),
+		errors.Is(err, role.ErrNotExist):
+		return connect.CodeNotFound
+	case errors.Is(err, accessrequest.ErrInvalidID),
+		errors.Is(err, accessrequest.ErrInvalidDetail),
+		errors.Is(err, accessrequest.ErrDurationTooLong),
+		errors.Is(err, accessrequest.ErrInvalidResource),
+		errors.Is(err, accessrequest.ErrInvalidRole):
+		return connect.CodeInvalidArgument
+	case errors.Is(err, accessrequest.ErrNotOrgMember),
+		errors.Is(err, accessrequest.ErrNotReviewer),
+		errors.Is(err, accessrequest.ErrNotRequester),
+		errors.Is(err, accessrequest.ErrSelfReview):
+		return connect.CodePermissionDenied
+	case errors.Is(err, accessrequest.ErrConflict),
+		errors.Is(err, accessrequest.ErrNotPending),
+		errors.Is(err, accessrequest.ErrAlreadyGranted):
+		return connect.CodeFailedPrecondition
+	default:
+		return connect.CodeInternal
+	}
+}
+
+func (h *ConnectHandler) CreateAccessRequest(ctx context.Context, request *connect.Request[frontierv1beta1.CreateAccessRequestRequest]) (*connect.Response[frontierv1beta1.CreateAccessRequestResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	namespace, resourceID, err := schema.SplitNamespaceAndResourceID(request.Msg.GetResource())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, accessrequest.ErrInvalidResource)
+	}
+	created, err := h.accessRequestService.Create(ctx, accessrequest.AccessRequest{
+		ResourceID:    resourceID,
+		ResourceType:  namespace,
+		RoleID:        request.Msg.GetRoleId(),
+		RequesterID:   userID,
+		Duration:      request.Msg.GetDuration().AsDuration(),
+		Justification: request.Msg.GetJustification(),
+	})
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "CreateAccessRequest.Create", err,
+			"resource", request.Msg.GetResource(), "role_id", request.Msg.GetRoleId())
+		return nil, connect.NewError(accessRequestErrCode(err), fmt.Errorf("CreateAccessRequest: resource=%s: %w", request.Msg.GetResource(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.CreateAccessRequestResponse{
+		AccessRequest: toProtoAccessRequest(created),
+	}), nil
+}
+
+// ListAccessRequests returns the requests of the user, or the requests of an
+// organization or resource to its reviewers
+func (h *ConnectHandler) ListAccessRequests(ctx context.Context, request *connect.Request[frontierv1beta1.ListAccessRequestsRequest]) (*connect.Response[frontierv1beta1.ListAccessRequestsResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	flt := accessrequest.Filter{
+		State: accessrequest.State(request.Msg.GetState()),
+	}
+	var namespace, resourceID string
+	switch {
+	case request.Msg.GetResource() != "":
+		namespace, resourceID, err = schema.SplitNamespaceAndResourceID(request.Msg.GetResource())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, accessrequest.ErrInvalidResource)
+		}
+		flt.ResourceType = namespace
+		flt.ResourceID = resourceID
+	case request.Msg.GetOrgId() != "":
+		namespace, resourceID = schema.OrganizationNamespace, request.Msg.GetOrgId()
+		flt.OrgID = resourceID
+	default:
+		flt.RequesterID = userID
+	}
+	if flt.RequesterID == "" {
+		reviewer, err := h.accessRequestService.IsReviewer(ctx, namespace, resourceID, userID)
+		if err != nil {
+			errorLogger.LogServiceError(ctx, request, "ListAccessRequests.IsReviewer", err,
+				"namespace", namespace, "resource_id", resourceID)
+			return nil, connect.NewError(accessRequestErrCode(err), fmt.Errorf("ListAccessRequests: resource_id=%s: %w", resourceID, err))
+		}
+		if !reviewer {
+			return nil, connect.NewError(connect.CodePermissionDenied, accessrequest.ErrNotReviewer)
+		}
+	}
+
+	requests, err := h.accessRequestService.List(ctx, flt)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ListAccessRequests.List", err)
+		return nil, connect.NewError(accessRequestErrCode(err), fmt.Errorf("ListAccessRequests: %w", err))
+	}
+	pbRequests := make([]*frontierv1beta1.AccessRequest, 0, len(requests))
+	for _, accessRequest := range requests {
+		pbRequests = append(pbRequests, toProtoAccessRequest(accessRequest))
+	}
+	return connect.NewResponse(&frontierv1beta1.ListAccessRequestsResponse{AccessRequests: pbRequests}), nil
+}
+
+func (h *ConnectHandler) ApproveAccessRequest(ctx context.Context, request *connect.Request[frontierv1beta1.ApproveAccessRequestRequest]) (*connect.Response[frontierv1beta1.ApproveAccessRequestResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	approved, err := h.accessRequestService.Approve(ctx, request.Msg.GetId(), userID, request.Msg.GetComment())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ApproveAccessRequest.Approve", err, "id", request.Msg.GetId())
+		return nil, connect.NewError(accessRequestErrCode(err), fmt.Errorf("ApproveAccessRequest: id=%s: %w", request.Msg.GetId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.ApproveAccessRequestResponse{
+		AccessRequest: toProtoAccessRequest(approved),
+	}), nil
+}
+
+func (h *ConnectHandler) DenyAccessRequest(ctx context.Context, request *connect.Request[frontierv1beta1.DenyAccessRequestRequest]) (*connect.Response[frontierv1beta1.DenyAccessRequestResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	denied, err := h.accessRequestService.Deny(ctx, request.Msg.GetId(), userID, request.Msg.GetComment())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "DenyAccessRequest.Deny", err, "id", request.Msg.GetId())
+		return nil, connect.NewError(accessRequestErrCode(err), fmt.Errorf("DenyAccessRequest: id=%s: %w", request.Msg.GetId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.DenyAccessRequestResponse{
+		AccessRequest: toProtoAccessRequest(denied),
+	}), nil
+}
+
+func (h *ConnectHandler) CancelAccessRequest(ctx context.Context, request *connect.Request[frontierv1beta1.CancelAccessRequestRequest]) (*connect.Response[frontierv1beta1.CancelAccessRequestResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cancelled, err := h.accessRequestService.Cancel(ctx, request.Msg.GetId(), userID)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "CancelAccessRequest.Cancel", err, "id", request.Msg.GetId())
+		return nil, connect.NewError(accessRequestErrCode(err), fmt.Errorf("CancelAccessRequest: id=%s: %w", request.Msg.GetId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.CancelAccessRequestResponse{
+		AccessRequest: toProtoAccessRequest(cancelled),
+	}), nil
+}
+
+func toProtoAccessRequest(request accessrequest.AccessRequest) *frontierv1beta1.AccessRequest {
+	pbRequest := &frontierv1beta1.AccessRequest{
+		Id:            request.ID,
+		OrgId:         request.OrgID,
+		Resource:      schema.JoinNamespaceAndResourceID(request.ResourceType, request.ResourceID),
+		RoleId:        request.RoleID,
+		RequesterId:   request.RequesterID,
+		Duration:      durationpb.New(request.Duration),
+		Justification: request.Justification,
+		State:         request.State.String(),
+		ReviewerId:    request.ReviewerID,
+		ReviewComment: request.ReviewComment,
+		PolicyId:      request.PolicyID,
+		CreatedAt:     timestamppb.New(request.CreatedAt),
+	}
+	if !request.ReviewedAt.IsZero() {
+		pbRequest.ReviewedAt = timestamppb.New(request.ReviewedAt)
+	}
+	if !request.ExpiresAt.IsZero() {
+		pbRequest.ExpiresAt = timestamppb.New(request.ExpiresAt)
+	}
+	return pbRequest
+}
@@ -0,0 +1,160 @@
+package v1beta1connect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/accessrequest"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestHandler_AccessRequests(t *testing.T) {
+	userID := uuid.New().String()
+	orgID := uuid.New().String()
+	projectID := uuid.New().String()
+	requestID := uuid.New().String()
+
+	setup := func(t *testing.T, principal authenticate.Principal) (*ConnectHandler, *mocks.AccessRequestService) {
+		ars := mocks.NewAccessRequestService(t)
+		as := mocks.NewAuthnService(t)
+		as.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+		return &ConnectHandler{accessRequestService: ars, authnService: as}, ars
+	}
+	user := authenticate.Principal{ID: userID, Type: schema.UserPrincipal}
+
+	t.Run("requests a role for the current user", func(t *testing.T) {
+		h, ars := setup(t, user)
+		ars.EXPECT().Create(mock.Anything, accessrequest.AccessRequest{
+			ResourceID:    projectID,
+			ResourceType:  schema.ProjectNamespace,
+			RoleID:        "app_project_owner",
+			RequesterID:   userID,
+			Duration:      4 * time.Hour,
+			Justification: "INC-42",
+		}).Return(accessrequest.AccessRequest{
+			ID:           requestID,
+			OrgID:        orgID,
+			ResourceID:   projectID,
+			ResourceType: schema.ProjectNamespace,
+			RoleID:       "app_project_owner",
+			RequesterID:  userID,
+			Duration:     4 * time.Hour,
+			State:        accessrequest.Pending,
+		}, nil)
+
+		resp, err := h.CreateAccessRequest(context.Background(), connect.NewRequest(&frontierv1beta1.CreateAccessRequestRequest{
+			Resource:      schema.JoinNamespaceAndResourceID(schema.ProjectNamespace, projectID),
+			RoleId:        "app_project_owner",
+			Duration:      durationpb.New(4 * time.Hour),
+			Justification: "INC-42",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, requestID, resp.Msg.GetAccessRequest().GetId())
+		assert.Equal(t, "pending", resp.Msg.GetAccessRequest().GetState())
+		assert.Equal(t, 4*time.Hour, resp.Msg.GetAccessRequest().GetDuration().AsDuration())
+		assert.Nil(t, resp.Msg.GetAccessRequest().GetExpiresAt())
+	})
+
+	t.Run("rejects a malformed resource", func(t *testing.T) {
+		h, _ := setup(t, user)
+
+		_, err := h.CreateAccessRequest(context.Background(), connect.NewRequest(&frontierv1beta1.CreateAccessRequestRequest{
+			Resource:      projectID,
+			RoleId:        "app_project_owner",
+			Duration:      durationpb.New(time.Hour),
+			Justification: "INC-42",
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("lists the requests of the current user", func(t *testing.T) {
+		h, ars := setup(t, user)
+		ars.EXPECT().List(mock.Anything, accessrequest.Filter{RequesterID: userID}).
+			Return([]accessrequest.AccessRequest{{ID: requestID, RequesterID: userID}}, nil)
+
+		resp, err := h.ListAccessRequests(context.Background(), connect.NewRequest(&frontierv1beta1.ListAccessRequestsRequest{}))
+		require.NoError(t, err)
+		assert.Len(t, resp.Msg.GetAccessRequests(), 1)
+	})
+
+	t.Run("lists the requests of an organization to its reviewers", func(t *testing.T) {
+		h, ars := setup(t, user)
+		ars.EXPECT().IsReviewer(mock.Anything, schema.OrganizationNamespace, orgID, userID).Return(true, nil)
+		ars.EXPECT().List(mock.Anything, accessrequest.Filter{OrgID: orgID, State: accessrequest.Pending}).
+			Return([]accessrequest.AccessRequest{{ID: requestID, OrgID: orgID}}, nil)
+
+		resp, err := h.ListAccessRequests(context.Background(), connect.NewRequest(&frontierv1beta1.ListAccessRequestsRequest{
+			OrgId: orgID,
+			State: "pending",
+		}))
+		require.NoError(t, err)
+		assert.Len(t, resp.Msg.GetAccessRequests(), 1)
+	})
+
+	t.Run("hides the requests of a resource from other users", func(t *testing.T) {
+		h, ars := setup(t, user)
+		ars.EXPECT().IsReviewer(mock.Anything, schema.ProjectNamespace, projectID, userID).Return(false, nil)
+
+		_, err := h.ListAccessRequests(context.Background(), connect.NewRequest(&frontierv1beta1.ListAccessRequestsRequest{
+			Resource: schema.JoinNamespaceAndResourceID(schema.ProjectNamespace, projectID),
+		}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("approves a request as the current user", func(t *testing.T) {
+		h, ars := setup(t, user)
+		expiresAt := time.Now().Add(time.Hour)
+		ars.EXPECT().Approve(mock.Anything, requestID, userID, "ok").Return(accessrequest.AccessRequest{
+			ID:         requestID,
+			State:      accessrequest.Approved,
+			ReviewerID: userID,
+			ExpiresAt:  expiresAt,
+		}, nil)
+
+		resp, err := h.ApproveAccessRequest(context.Background(), connect.NewRequest(&frontierv1beta1.ApproveAccessRequestRequest{
+			Id:      requestID,
+			Comment: "ok",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, expiresAt.Unix(), resp.Msg.GetAccessRequest().GetExpiresAt().AsTime().Unix())
+	})
+
+	t.Run("rejects reviews of the own request", func(t *testing.T) {
+		h, ars := setup(t, user)
+		ars.EXPECT().Deny(mock.Anything, requestID, userID, "").Return(accessrequest.AccessRequest{}, accessrequest.ErrSelfReview)
+
+		_, err := h.DenyAccessRequest(context.Background(), connect.NewRequest(&frontierv1beta1.DenyAccessRequestRequest{
+			Id: requestID,
+		}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("rejects cancelling a decided request", func(t *testing.T) {
+		h, ars := setup(t, user)
+		ars.EXPECT().Cancel(mock.Anything, requestID, userID).Return(accessrequest.AccessRequest{}, accessrequest.ErrNotPending)
+
+		_, err := h.CancelAccessRequest(context.Background(), connect.NewRequest(&frontierv1beta1.CancelAccessRequestRequest{
+			Id: requestID,
+		}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+
+	t.Run("rejects principals other than users", func(t *testing.T) {
+		h, _ := setup(t, authenticate.Principal{ID: uuid.New().String(), Type: schema.ServiceUserPrincipal})
+
+		_, err := h.CancelAccessRequest(context.Background(), connect.NewRequest(&frontierv1beta1.CancelAccessRequestRequest{
+			Id: requestID,
+		}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+}
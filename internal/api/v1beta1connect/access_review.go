@@ -0,0 +1,185 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/accessreview"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func accessReviewErrCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, organization.ErrNotExist),
+		errors.Is(err, project.ErrNotExist):
+		return connect.CodeNotFound
+	case errors.Is(err, accessreview.ErrInvalidDetail),
+		errors.Is(err, accessreview.ErrInvalidPrincipal):
+		return connect.CodeInvalidArgument
+	case errors.Is(err, accessreview.ErrNotAllowed):
+		return connect.CodePermissionDenied
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// ReviewPrincipalAccess reports the access of the principal of the request,
+// or of the current user
+func (h *ConnectHandler) ReviewPrincipalAccess(ctx context.Context, request *connect.Request[frontierv1beta1.ReviewPrincipalAccessRequest]) (*connect.Response[frontierv1beta1.ReviewPrincipalAccessResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, principal, err := h.accessReviewPrincipal(ctx, request.Msg.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	report, err := h.accessReviewService.ReviewPrincipal(ctx, userID, principal, request.Msg.GetOrgId(), accessreview.Page{
+		Offset: int(request.Msg.GetOffset()),
+		Limit:  int(request.Msg.GetLimit()),
+	})
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ReviewPrincipalAccess.ReviewPrincipal", err,
+			"principal_id", principal.ID, "org_id", request.Msg.GetOrgId())
+		return nil, connect.NewError(accessReviewErrCode(err), fmt.Errorf("ReviewPrincipalAccess: principal_id=%s: %w", principal.ID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.ReviewPrincipalAccessResponse{
+		Principal: toProtoAccessReviewPrincipal(report.Principal),
+		Total:     int32(report.Total),
+	}), nil
+}
+
+// ReviewResourceAccess reports the principals with the permission on the
+// resource of the request
+func (h *ConnectHandler) ReviewResourceAccess(ctx context.Context, request *connect.Request[frontierv1beta1.ReviewResourceAccessRequest]) (*connect.Response[frontierv1beta1.ReviewResourceAccessResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, object, err := h.accessReviewObject(ctx, request.Msg.GetResource())
+	if err != nil {
+		return nil, err
+	}
+	report, err := h.accessReviewService.ReviewResource(ctx, userID, object, request.Msg.GetPermission(), accessreview.Page{
+		Offset: int(request.Msg.GetOffset()),
+		Limit:  int(request.Msg.GetLimit()),
+	})
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ReviewResourceAccess.ReviewResource", err,
+			"resource", request.Msg.GetResource(), "permission", request.Msg.GetPermission())
+		return nil, connect.NewError(accessReviewErrCode(err), fmt.Errorf("ReviewResourceAccess: resource=%s: %w", request.Msg.GetResource(), err))
+	}
+	principals := make([]*frontierv1beta1.AccessReviewPrincipal, 0, len(report.Principals))
+	for _, principal := range report.Principals {
+		principals = append(principals, toProtoAccessReviewPrincipal(principal))
+	}
+	return connect.NewResponse(&frontierv1beta1.ReviewResourceAccessResponse{
+		Resource:   schema.JoinNamespaceAndResourceID(report.Object.Namespace, report.Object.ID),
+		Permission: report.Permission,
+		Principals: principals,
+		Total:      int32(report.Total),
+	}), nil
+}
+
+func (h *ConnectHandler) ExportPrincipalAccess(ctx context.Context, request *connect.Request[frontierv1beta1.ExportPrincipalAccessRequest], stream *connect.ServerStream[httpbody.HttpBody]) error {
+	errorLogger := NewErrorLogger()
+
+	userID, principal, err := h.accessReviewPrincipal(ctx, request.Msg.GetPrincipal())
+	if err != nil {
+		return err
+	}
+	reader, contentType, err := h.accessReviewService.ExportPrincipal(ctx, userID, principal, request.Msg.GetOrgId())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ExportPrincipalAccess.ExportPrincipal", err,
+			"principal_id", principal.ID, "org_id", request.Msg.GetOrgId())
+		return connect.NewError(accessReviewErrCode(err), fmt.Errorf("ExportPrincipalAccess: principal_id=%s: %w", principal.ID, err))
+	}
+	return streamAccessReviewExport(reader, contentType, stream)
+}
+
+func (h *ConnectHandler) ExportResourceAccess(ctx context.Context, request *connect.Request[frontierv1beta1.ExportResourceAccessRequest], stream *connect.ServerStream[httpbody.HttpBody]) error {
+	errorLogger := NewErrorLogger()
+
+	userID, object, err := h.accessReviewObject(ctx, request.Msg.GetResource())
+	if err != nil {
+		return err
+	}
+	reader, contentType, err := h.accessReviewService.ExportResource(ctx, userID, object, request.Msg.GetPermission())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ExportResourceAccess.ExportResource", err,
+			"resource", request.Msg.GetResource(), "permission", request.Msg.GetPermission())
+		return connect.NewError(accessReviewErrCode(err), fmt.Errorf("ExportResourceAccess: resource=%s: %w", request.Msg.GetResource(), err))
+	}
+	return streamAccessReviewExport(reader, contentType, stream)
+}
+
+// accessReviewPrincipal returns the current user and the reviewed principal,
+// the principal defaults to the current user
+func (h *ConnectHandler) accessReviewPrincipal(ctx context.Context, principal string) (string, relation.Subject, error) {
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return "", relation.Subject{}, err
+	}
+	subject := relation.Subject{ID: userID, Namespace: schema.UserPrincipal}
+	if principal != "" {
+		subject.Namespace, subject.ID, err = schema.SplitNamespaceAndResourceID(principal)
+		if err != nil {
+			return "", relation.Subject{}, connect.NewError(connect.CodeInvalidArgument, accessreview.ErrInvalidPrincipal)
+		}
+	}
+	return userID, subject, nil
+}
+
+// accessReviewObject returns the current user and the reviewed resource
+func (h *ConnectHandler) accessReviewObject(ctx context.Context, resource string) (string, relation.Object, error) {
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return "", relation.Object{}, err
+	}
+	namespace, resourceID, err := schema.SplitNamespaceAndResourceID(resource)
+	if err != nil {
+		return "", relation.Object{}, connect.NewError(connect.CodeInvalidArgument, accessreview.ErrInvalidDetail)
+	}
+	return userID, relation.Object{ID: resourceID, Namespace: namespace}, nil
+}
+
+// streamAccessReviewExport streams an export, closing the reader stops the
+// export when the stream ends early
+func streamAccessReviewExport(reader io.Reader, contentType string, stream *connect.ServerStream[httpbody.HttpBody]) error {
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+	return streamReaderInChunks(reader, contentType, stream)
+}
+
+func toProtoAccessReviewPrincipal(principal accessreview.Principal) *frontierv1beta1.AccessReviewPrincipal {
+	pbPrincipal := &frontierv1beta1.AccessReviewPrincipal{
+		Principal: schema.JoinNamespaceAndResourceID(principal.Type, principal.ID),
+		Name:      principal.Name,
+		Grants:    make([]*frontierv1beta1.AccessReviewGrant, 0, len(principal.Grants)),
+	}
+	for _, grant := range principal.Grants {
+		pbGrant := &frontierv1beta1.AccessReviewGrant{
+			PolicyId:      grant.PolicyID,
+			Resource:      schema.JoinNamespaceAndResourceID(grant.ResourceType, grant.ResourceID),
+			ResourceTitle: grant.ResourceTitle,
+			OrgId:         grant.OrgID,
+			RoleId:        grant.RoleID,
+			RoleName:      grant.RoleName,
+			Permissions:   grant.Permissions,
+			GroupId:       grant.GroupID,
+			GroupTitle:    grant.GroupTitle,
+			Superuser:     grant.Superuser,
+		}
+		if !grant.ExpiresAt.IsZero() {
+			pbGrant.ExpiresAt = timestamppb.New(grant.ExpiresAt)
+		}
+		pbPrincipal.Grants = append(pbPrincipal.Grants, pbGrant)
+	}
+	return pbPrincipal
+}
@@ -0,0 +1,115 @@
+package v1beta1connect
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/accessreview"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_AccessReviews(t *testing.T) {
+	userID := uuid.New().String()
+	serviceUserID := uuid.New().String()
+	orgID := uuid.New().String()
+	projectID := uuid.New().String()
+
+	setup := func(t *testing.T, principal authenticate.Principal) (*ConnectHandler, *mocks.AccessReviewService) {
+		ars := mocks.NewAccessReviewService(t)
+		as := mocks.NewAuthnService(t)
+		as.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+		return &ConnectHandler{accessReviewService: ars, authnService: as}, ars
+	}
+	user := authenticate.Principal{ID: userID, Type: schema.UserPrincipal}
+
+	t.Run("reviews the access of the current user by default", func(t *testing.T) {
+		h, ars := setup(t, user)
+		ars.EXPECT().ReviewPrincipal(mock.Anything, userID, relation.Subject{ID: userID, Namespace: schema.UserPrincipal},
+			"", accessreview.Page{Limit: 10}).Return(accessreview.PrincipalReport{
+			Principal: accessreview.Principal{
+				ID:   userID,
+				Type: schema.UserPrincipal,
+				Grants: []accessreview.Grant{{
+					ResourceID:   orgID,
+					ResourceType: schema.OrganizationNamespace,
+					RoleName:     "app_organization_owner",
+				}},
+			},
+			Total: 3,
+		}, nil)
+
+		resp, err := h.ReviewPrincipalAccess(context.Background(), connect.NewRequest(&frontierv1beta1.ReviewPrincipalAccessRequest{
+			Limit: 10,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), resp.Msg.GetTotal())
+		require.Len(t, resp.Msg.GetPrincipal().GetGrants(), 1)
+		assert.Equal(t, schema.JoinNamespaceAndResourceID(schema.OrganizationNamespace, orgID),
+			resp.Msg.GetPrincipal().GetGrants()[0].GetResource())
+	})
+
+	t.Run("rejects reviewing the access of others without managing the organization", func(t *testing.T) {
+		h, ars := setup(t, user)
+		ars.EXPECT().ReviewPrincipal(mock.Anything, userID, relation.Subject{ID: serviceUserID, Namespace: schema.ServiceUserPrincipal},
+			orgID, accessreview.Page{}).Return(accessreview.PrincipalReport{}, accessreview.ErrNotAllowed)
+
+		_, err := h.ReviewPrincipalAccess(context.Background(), connect.NewRequest(&frontierv1beta1.ReviewPrincipalAccessRequest{
+			Principal: schema.JoinNamespaceAndResourceID(schema.ServiceUserPrincipal, serviceUserID),
+			OrgId:     orgID,
+		}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("reviews the principals with a permission on a resource", func(t *testing.T) {
+		h, ars := setup(t, user)
+		object := relation.Object{ID: projectID, Namespace: schema.ProjectNamespace}
+		ars.EXPECT().ReviewResource(mock.Anything, userID, object, "delete", accessreview.Page{Offset: 20}).
+			Return(accessreview.ResourceReport{
+				Object:     object,
+				Permission: "delete",
+				Principals: []accessreview.Principal{{ID: userID, Type: schema.UserPrincipal}},
+				Total:      21,
+			}, nil)
+
+		resp, err := h.ReviewResourceAccess(context.Background(), connect.NewRequest(&frontierv1beta1.ReviewResourceAccessRequest{
+			Resource:   schema.JoinNamespaceAndResourceID(schema.ProjectNamespace, projectID),
+			Permission: "delete",
+			Offset:     20,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, int32(21), resp.Msg.GetTotal())
+		assert.Len(t, resp.Msg.GetPrincipals(), 1)
+	})
+
+	t.Run("returns not found for unknown resources", func(t *testing.T) {
+		h, ars := setup(t, user)
+		ars.EXPECT().ReviewResource(mock.Anything, userID, relation.Object{ID: "production", Namespace: schema.ProjectNamespace},
+			"delete", accessreview.Page{}).Return(accessreview.ResourceReport{}, project.ErrNotExist)
+
+		_, err := h.ReviewResourceAccess(context.Background(), connect.NewRequest(&frontierv1beta1.ReviewResourceAccessRequest{
+			Resource:   schema.JoinNamespaceAndResourceID(schema.ProjectNamespace, "production"),
+			Permission: "delete",
+		}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("rejects a malformed resource", func(t *testing.T) {
+		h, _ := setup(t, user)
+
+		_, err := h.ReviewResourceAccess(context.Background(), connect.NewRequest(&frontierv1beta1.ReviewResourceAccessRequest{
+			Resource:   projectID,
+			Permission: "delete",
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
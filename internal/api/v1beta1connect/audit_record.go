@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"slices"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -197,6 +198,38 @@ func (h *ConnectHandler) ExportAuditRecords(ctx context.Context, request *connec
 	return streamReaderInChunks(reader, contentType, stream)
 }
 
+func (h *ConnectHandler) VerifyAuditRecordChain(ctx context.Context, request *connect.Request[frontierv1beta1.VerifyAuditRecordChainRequest]) (*connect.Response[frontierv1beta1.VerifyAuditRecordChainResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	var since, until time.Time
+	if request.Msg.GetSince() != nil {
+		since = request.Msg.GetSince().AsTime()
+	}
+	if request.Msg.GetUntil() != nil {
+		until = request.Msg.GetUntil().AsTime()
+	}
+	result, err := h.auditRecordService.VerifyChain(ctx, request.Msg.GetOrgId(), since, until)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "VerifyAuditRecordChain.VerifyChain", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("VerifyAuditRecordChain: %w", err))
+	}
+
+	response := &frontierv1beta1.VerifyAuditRecordChainResponse{
+		Valid:    result.Valid(),
+		Checked:  int32(result.Checked),
+		LastSeq:  result.LastSeq,
+		LastHash: result.LastHash,
+	}
+	if result.Break != nil {
+		response.Break = &frontierv1beta1.AuditRecordChainBreak{
+			Seq:      result.Break.Seq,
+			RecordId: result.Break.RecordID,
+			Reason:   result.Break.Reason,
+		}
+	}
+	return connect.NewResponse(response), nil
+}
+
 func TransformAuditRecordToPB(record auditrecord.AuditRecord) (*frontierv1beta1.CreateAuditRecordResponse, error) {
 	actorMetaData, err := record.Actor.Metadata.ToStructPB()
 	if err != nil {
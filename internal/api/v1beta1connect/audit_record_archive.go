@@ -0,0 +1,113 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/auditrecord"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func auditArchiveErrCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, auditrecord.ErrArchiveNotFound):
+		return connect.CodeNotFound
+	default:
+		return connect.CodeInternal
+	}
+}
+
+func (h *ConnectHandler) ListAuditRecordArchives(ctx context.Context, request *connect.Request[frontierv1beta1.ListAuditRecordArchivesRequest]) (*connect.Response[frontierv1beta1.ListAuditRecordArchivesResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	filter := auditrecord.ArchiveFilter{OrgID: request.Msg.GetOrgId()}
+	if request.Msg.GetSince() != nil {
+		filter.Since = request.Msg.GetSince().AsTime()
+	}
+	if request.Msg.GetUntil() != nil {
+		filter.Until = request.Msg.GetUntil().AsTime()
+	}
+	archives, err := h.auditArchiveService.ListArchives(ctx, filter)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ListAuditRecordArchives.ListArchives", err)
+		return nil, connect.NewError(auditArchiveErrCode(err), fmt.Errorf("ListAuditRecordArchives: %w", err))
+	}
+
+	pbArchives := make([]*frontierv1beta1.AuditRecordArchive, 0, len(archives))
+	for _, archive := range archives {
+		pbArchives = append(pbArchives, toProtoAuditRecordArchive(archive))
+	}
+	return connect.NewResponse(&frontierv1beta1.ListAuditRecordArchivesResponse{Archives: pbArchives}), nil
+}
+
+func (h *ConnectHandler) GetAuditRecordArchive(ctx context.Context, request *connect.Request[frontierv1beta1.GetAuditRecordArchiveRequest]) (*connect.Response[frontierv1beta1.GetAuditRecordArchiveResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	archive, records, err := h.auditArchiveService.ReadArchive(ctx, request.Msg.GetId())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "GetAuditRecordArchive.ReadArchive", err)
+		return nil, connect.NewError(auditArchiveErrCode(err), fmt.Errorf("GetAuditRecordArchive: id=%s: %w", request.Msg.GetId(), err))
+	}
+
+	pbRecords := make([]*structpb.Struct, 0, len(records))
+	for _, record := range records {
+		pbRecord, err := auditRecordToStruct(record)
+		if err != nil {
+			errorLogger.LogTransformError(ctx, request, "GetAuditRecordArchive", record.ID, err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		pbRecords = append(pbRecords, pbRecord)
+	}
+	return connect.NewResponse(&frontierv1beta1.GetAuditRecordArchiveResponse{
+		Archive: toProtoAuditRecordArchive(archive),
+		Records: pbRecords,
+	}), nil
+}
+
+func (h *ConnectHandler) RestoreAuditRecordArchive(ctx context.Context, request *connect.Request[frontierv1beta1.RestoreAuditRecordArchiveRequest]) (*connect.Response[frontierv1beta1.RestoreAuditRecordArchiveResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	archive, err := h.auditArchiveService.RestoreArchive(ctx, request.Msg.GetId())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "RestoreAuditRecordArchive.RestoreArchive", err)
+		return nil, connect.NewError(auditArchiveErrCode(err), fmt.Errorf("RestoreAuditRecordArchive: id=%s: %w", request.Msg.GetId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.RestoreAuditRecordArchiveResponse{
+		Archive: toProtoAuditRecordArchive(archive),
+	}), nil
+}
+
+func toProtoAuditRecordArchive(archive auditrecord.Archive) *frontierv1beta1.AuditRecordArchive {
+	return &frontierv1beta1.AuditRecordArchive{
+		Id:             archive.ID,
+		OrgId:          archive.OrgID,
+		Key:            archive.Key,
+		FirstCreatedAt: timestamppb.New(archive.FirstCreatedAt),
+		LastCreatedAt:  timestamppb.New(archive.LastCreatedAt),
+		RecordCount:    archive.RecordCount,
+		CreatedAt:      timestamppb.New(archive.CreatedAt),
+	}
+}
+
+// auditRecordToStruct returns the record in the JSON form of the AuditRecord
+// message listed by ListAuditRecords
+func auditRecordToStruct(record auditrecord.AuditRecord) (*structpb.Struct, error) {
+	pbRecord, err := TransformAuditRecordToPB(record)
+	if err != nil {
+		return nil, err
+	}
+	data, err := protojson.Marshal(pbRecord.GetAuditRecord())
+	if err != nil {
+		return nil, err
+	}
+	result := &structpb.Struct{}
+	if err := protojson.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package v1beta1connect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/auditrecord"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHandler_AuditRecordArchives(t *testing.T) {
+	orgID := uuid.New().String()
+	archiveID := uuid.New().String()
+	createdAt := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	archive := auditrecord.Archive{
+		ID:             archiveID,
+		OrgID:          orgID,
+		Key:            "audit_records/" + orgID + "/2025/10/17/" + archiveID + ".ndjson.gz",
+		FirstCreatedAt: createdAt.AddDate(-1, 0, 0),
+		LastCreatedAt:  createdAt.AddDate(-1, 0, 0).Add(time.Hour),
+		RecordCount:    1,
+		CreatedAt:      createdAt,
+	}
+
+	aas := mocks.NewAuditRecordArchiveService(t)
+	h := &ConnectHandler{auditArchiveService: aas}
+
+	t.Run("lists archives of an organization", func(t *testing.T) {
+		aas.EXPECT().ListArchives(mock.Anything, auditrecord.ArchiveFilter{OrgID: orgID, Since: createdAt.AddDate(-2, 0, 0)}).
+			Return([]auditrecord.Archive{archive}, nil).Once()
+
+		resp, err := h.ListAuditRecordArchives(context.Background(), connect.NewRequest(&frontierv1beta1.ListAuditRecordArchivesRequest{
+			OrgId: orgID,
+			Since: timestamppb.New(createdAt.AddDate(-2, 0, 0)),
+		}))
+		require.NoError(t, err)
+		require.Len(t, resp.Msg.GetArchives(), 1)
+		assert.Equal(t, archive.Key, resp.Msg.GetArchives()[0].GetKey())
+		assert.EqualValues(t, 1, resp.Msg.GetArchives()[0].GetRecordCount())
+	})
+
+	t.Run("returns the records of an archive", func(t *testing.T) {
+		aas.EXPECT().ReadArchive(mock.Anything, archiveID).Return(archive, []auditrecord.AuditRecord{{
+			ID:        "record-1",
+			Event:     "app.user.created",
+			OrgID:     orgID,
+			CreatedAt: archive.FirstCreatedAt,
+		}}, nil).Once()
+
+		resp, err := h.GetAuditRecordArchive(context.Background(), connect.NewRequest(&frontierv1beta1.GetAuditRecordArchiveRequest{Id: archiveID}))
+		require.NoError(t, err)
+		require.Len(t, resp.Msg.GetRecords(), 1)
+		assert.Equal(t, "record-1", resp.Msg.GetRecords()[0].GetFields()["id"].GetStringValue())
+		assert.Equal(t, "app.user.created", resp.Msg.GetRecords()[0].GetFields()["event"].GetStringValue())
+	})
+
+	t.Run("restores an archive", func(t *testing.T) {
+		aas.EXPECT().RestoreArchive(mock.Anything, archiveID).Return(archive, nil).Once()
+		aas.EXPECT().RestoreArchive(mock.Anything, archiveID).Return(auditrecord.Archive{}, auditrecord.ErrArchiveNotFound).Once()
+
+		resp, err := h.RestoreAuditRecordArchive(context.Background(), connect.NewRequest(&frontierv1beta1.RestoreAuditRecordArchiveRequest{Id: archiveID}))
+		require.NoError(t, err)
+		assert.Equal(t, archiveID, resp.Msg.GetArchive().GetId())
+
+		_, err = h.RestoreAuditRecordArchive(context.Background(), connect.NewRequest(&frontierv1beta1.RestoreAuditRecordArchiveRequest{Id: archiveID}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+}
@@ -1630,3 +1630,29 @@ func TestHandler_ListAuditRecords(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_VerifyAuditRecordChain(t *testing.T) {
+	orgID := uuid.New().String()
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ars := mocks.NewAuditRecordService(t)
+	ars.EXPECT().VerifyChain(mock.Anything, orgID, since, time.Time{}).Return(auditrecord.ChainVerification{
+		OrgID:    orgID,
+		Checked:  4,
+		LastSeq:  4,
+		LastHash: "ab12",
+		Break:    &auditrecord.ChainBreak{Seq: 5, Reason: "record is missing"},
+	}, nil)
+	h := &ConnectHandler{auditRecordService: ars}
+
+	resp, err := h.VerifyAuditRecordChain(context.Background(), connect.NewRequest(&frontierv1beta1.VerifyAuditRecordChainRequest{
+		OrgId: orgID,
+		Since: timestamppb.New(since),
+	}))
+	assert.NoError(t, err)
+	assert.False(t, resp.Msg.GetValid())
+	assert.EqualValues(t, 4, resp.Msg.GetChecked())
+	assert.Equal(t, "ab12", resp.Msg.GetLastHash())
+	assert.EqualValues(t, 5, resp.Msg.GetBreak().GetSeq())
+	assert.Equal(t, "record is missing", resp.Msg.GetBreak().GetReason())
+}
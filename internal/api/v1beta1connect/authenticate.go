@@ -10,6 +10,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/ratelimit"
 	frontiersession "github.com/raystack/frontier/core/authenticate/session"
 	"github.com/raystack/frontier/core/authenticate/token"
 	"github.com/raystack/frontier/core/organization"
@@ -29,9 +30,10 @@ func (h *ConnectHandler) Authenticate(ctx context.Context, request *connect.Requ
 	returnToURL := h.authnService.SanitizeReturnToURL(request.Msg.GetReturnTo())
 	callbackURL := h.authnService.SanitizeCallbackURL(request.Msg.GetCallbackUrl())
 
-	// check if user is already logged in
+	// check if user is already logged in, unless the user re-authenticates
+	reauthenticate := request.Header().Get(consts.ReauthenticateRequestKey) == "true"
 	session, err := h.sessionService.ExtractFromContext(ctx)
-	if err == nil && session.IsValid(time.Now().UTC()) {
+	if err == nil && session.IsValid(time.Now().UTC()) && !session.MFAPending() && !reauthenticate {
 		// already logged in, set location header for return to?
 		resp := connect.NewResponse(&frontierv1beta1.AuthenticateResponse{})
 		if len(returnToURL) != 0 {
@@ -42,7 +44,7 @@ func (h *ConnectHandler) Authenticate(ctx context.Context, request *connect.Requ
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Authenticate: %w", err))
 	}
 
-	if (request.Msg.GetStrategyName() == authenticate.MailLinkAuthMethod.String() || request.Msg.GetStrategyName() == authenticate.MailOTPAuthMethod.String()) && !isValidEmail(request.Msg.GetEmail()) {
+	if (request.Msg.GetStrategyName() == authenticate.MailLinkAuthMethod.String() || request.Msg.GetStrategyName() == authenticate.MailOTPAuthMethod.String() || request.Msg.GetStrategyName() == authenticate.SSOAuthMethod.String()) && !isValidEmail(request.Msg.GetEmail()) {
 		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidEmail)
 	}
 
@@ -52,8 +54,17 @@ func (h *ConnectHandler) Authenticate(ctx context.Context, request *connect.Requ
 		ReturnToURL: returnToURL,
 		CallbackUrl: callbackURL,
 		Email:       request.Msg.GetEmail(),
+		IPAddress:   sessionutils.ExtractClientIP(request, h.authConfig.Session.Headers),
 	})
 	if err != nil {
+		switch {
+		case errors.Is(err, ratelimit.ErrLimitExceeded):
+			return nil, connect.NewError(connect.CodeResourceExhausted, err)
+		case errors.Is(err, authenticate.ErrSSORequired):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, authenticate.ErrSSONotConfigured):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("Authenticate: strategy=%s email=%s: %w", request.Msg.GetStrategyName(), request.Msg.GetEmail(), err))
 	}
 
@@ -105,8 +116,12 @@ func (h *ConnectHandler) AuthCallback(ctx context.Context, request *connect.Requ
 		Code:        request.Msg.GetCode(),
 		State:       request.Msg.GetState(),
 		StateConfig: request.Msg.GetStateOptions().AsMap(),
+		IPAddress:   sessionutils.ExtractClientIP(request, h.authConfig.Session.Headers),
 	})
 	if err != nil {
+		if errors.Is(err, ratelimit.ErrLimitExceeded) {
+			return nil, connect.NewError(connect.CodeResourceExhausted, err)
+		}
 		// ErrUnsupportedMethod here means the strategy and state the client sent match
 		// no known method (e.g. a malformed, non-base64 state). That is bad client
 		// input, same class as an empty or invalid state, so return a 4xx not a 500.
@@ -116,14 +131,33 @@ func (h *ConnectHandler) AuthCallback(ctx context.Context, request *connect.Requ
 				"state", request.Msg.GetState())
 			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
+		if errors.Is(err, authenticate.ErrSSORequired) || errors.Is(err, authenticate.ErrIDPUserNotAllowed) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("AuthCallback: strategy=%s state=%s: %w", request.Msg.GetStrategyName(), request.Msg.GetState(), err))
 	}
 
+	// a user re-authenticating in the session keeps it, unless the user now
+	// requires a second factor the session hasn't verified
+	current, err := h.sessionService.ExtractFromContext(ctx)
+	if err == nil && current.IsValid(time.Now().UTC()) && !current.MFAPending() && current.UserID == response.User.ID &&
+		(!response.MFARequired || current.AssuranceLevel == frontiersession.AssuranceLevelMultiFactor) {
+		if err := h.sessionService.Reauthenticate(ctx, current.ID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("AuthCallback: user_id=%s: %w", response.User.ID, err))
+		}
+		resp := connect.NewResponse(&frontierv1beta1.AuthCallbackResponse{})
+		if len(response.Flow.FinishURL) > 0 {
+			resp.Header().Set(consts.LocationGatewayKey, response.Flow.FinishURL)
+		}
+		return resp, nil
+	}
+
 	// Extract session metadata from request headers
 	sessionMetadata := sessionutils.ExtractSessionMetadata(ctx, request, h.authConfig.Session.Headers)
 
-	// registration/login complete, build a session
-	session, err := h.sessionService.Create(ctx, response.User.ID, sessionMetadata)
+	// registration/login complete, build a session, it authenticates requests
+	// once the second factor is verified if the user requires mfa
+	session, err := h.sessionService.Create(ctx, response.User.ID, sessionMetadata, response.MFARequired)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("AuthCallback: user_id=%s: %w", response.User.ID, err))
 	}
@@ -261,6 +295,10 @@ func (h *ConnectHandler) AuthLogout(ctx context.Context, request *connect.Reques
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("AuthLogout: session_id=%s: %w", sessionID.String(), err))
 		}
 	}
+	// revoke the access token the user logged out with
+	if err := h.authnService.RevokeToken(ctx); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("AuthLogout: %w", err))
+	}
 
 	resp := connect.NewResponse(&frontierv1beta1.AuthLogoutResponse{})
 
@@ -285,6 +323,8 @@ func (h *ConnectHandler) GetLoggedInPrincipal(ctx context.Context, via ...authen
 			return principal, connect.NewError(connect.CodeNotFound, ErrUserNotExist)
 		case errors.Is(err, errors.ErrUnauthenticated):
 			return principal, connect.NewError(connect.CodeUnauthenticated, ErrUnauthenticated)
+		case errors.Is(err, authenticate.ErrMFARequired):
+			return principal, connect.NewError(connect.CodeUnauthenticated, err)
 		case errors.Is(err, errors.ErrForbidden):
 			return principal, connect.NewError(connect.CodePermissionDenied, ErrUnauthorized)
 		case errors.Is(err, patErrors.ErrMalformedPAT),
@@ -299,6 +339,61 @@ func (h *ConnectHandler) GetLoggedInPrincipal(ctx context.Context, via ...authen
 	return principal, nil
 }
 
+// currentUserID returns the id of the logged in user, for rpcs acting on
+// behalf of users only
+func (h *ConnectHandler) currentUserID(ctx context.Context) (string, error) {
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return "", err
+	}
+	if principal.Type != schema.UserPrincipal {
+		return "", connect.NewError(connect.CodePermissionDenied, ErrUnauthorized)
+	}
+	return principal.ID, nil
+}
+
+// RequireRecentAuthentication rejects users whose session wasn't
+// authenticated within the max age. Service users aren't bound to a session
+// and aren't subject to it. PATs can't prove a recent authentication of their
+// user, a leaked one would reach every sensitive operation, so they're
+// refused.
+func (h *ConnectHandler) RequireRecentAuthentication(ctx context.Context, maxAge time.Duration) error {
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+	if principal.Type == schema.PATPrincipal {
+		return connect.NewError(connect.CodePermissionDenied, ErrPATRecentAuthentication)
+	}
+	if principal.Type != schema.UserPrincipal || principal.AuthVia == authenticate.PassthroughHeaderClientAssertion {
+		return nil
+	}
+
+	reauthErr := connect.NewError(connect.CodeUnauthenticated, authenticate.ErrReauthRequired)
+	// step up challenge of RFC 9470, the client re-authenticates the user and
+	// retries
+	reauthErr.Meta().Set("WWW-Authenticate", fmt.Sprintf(`Bearer error="insufficient_user_authentication", error_description="%s", max_age=%d`,
+		authenticate.ErrReauthRequired.Error(), int(maxAge.Seconds())))
+
+	// a token without the session id claim can't prove a recent authentication
+	sessionID, err := uuid.Parse(principal.SessionID)
+	if err != nil {
+		return reauthErr
+	}
+	session, err := h.sessionService.GetByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, frontiersession.ErrNoSession) {
+			return reauthErr
+		}
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("RequireRecentAuthentication: %w", err))
+	}
+	now := time.Now().UTC()
+	if !session.IsValid(now) || !session.AuthenticatedWithin(now, maxAge) {
+		return reauthErr
+	}
+	return nil
+}
+
 func (h *ConnectHandler) ListAuthStrategies(ctx context.Context, request *connect.Request[frontierv1beta1.ListAuthStrategiesRequest]) (*connect.Response[frontierv1beta1.ListAuthStrategiesResponse], error) {
 	var pbstrategy []*frontierv1beta1.AuthStrategy
 	for _, strategy := range h.authnService.SupportedStrategies() {
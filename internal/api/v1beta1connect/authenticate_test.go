@@ -4,15 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/ratelimit"
+	frontiersession "github.com/raystack/frontier/core/authenticate/session"
 	"github.com/raystack/frontier/core/organization"
 	"github.com/raystack/frontier/core/serviceuser"
+	"github.com/raystack/frontier/core/user"
 	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
 	frontiererrors "github.com/raystack/frontier/pkg/errors"
+	"github.com/raystack/frontier/pkg/server/consts"
 	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -162,6 +168,192 @@ func TestConnectHandler_AuthToken_RejectsDisallowedCredential(t *testing.T) {
 	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
 }
 
+func TestConnectHandler_AuthCallback_Reauthenticate(t *testing.T) {
+	userID := uuid.NewString()
+	sess := &frontiersession.Session{
+		ID:              uuid.New(),
+		UserID:          userID,
+		AuthenticatedAt: time.Now().UTC().Add(-time.Hour),
+		ExpiresAt:       time.Now().UTC().Add(time.Hour),
+		AssuranceLevel:  frontiersession.AssuranceLevelSingleFactor,
+	}
+	request := connect.NewRequest(&frontierv1beta1.AuthCallbackRequest{StrategyName: "mailotp"})
+
+	t.Run("should authenticate the session of the user again", func(t *testing.T) {
+		mockAuthnSrv := mocks.NewAuthnService(t)
+		mockSessionSrv := mocks.NewSessionService(t)
+		mockAuthnSrv.EXPECT().FinishFlow(mock.Anything, mock.Anything).Return(&authenticate.RegistrationFinishResponse{
+			User: user.User{ID: userID},
+			Flow: &authenticate.Flow{FinishURL: "http://localhost/settings"},
+		}, nil)
+		mockSessionSrv.EXPECT().ExtractFromContext(mock.Anything).Return(sess, nil)
+		mockSessionSrv.EXPECT().Reauthenticate(mock.Anything, sess.ID).Return(nil)
+
+		handler := &ConnectHandler{authnService: mockAuthnSrv, sessionService: mockSessionSrv}
+		resp, err := handler.AuthCallback(context.Background(), request)
+		require.NoError(t, err)
+		assert.Empty(t, resp.Header().Get(consts.SessionIDGatewayKey))
+		assert.Equal(t, "http://localhost/settings", resp.Header().Get(consts.LocationGatewayKey))
+	})
+
+	t.Run("should create a session for another user", func(t *testing.T) {
+		mockAuthnSrv := mocks.NewAuthnService(t)
+		mockSessionSrv := mocks.NewSessionService(t)
+		otherUserID := uuid.NewString()
+		mockAuthnSrv.EXPECT().FinishFlow(mock.Anything, mock.Anything).Return(&authenticate.RegistrationFinishResponse{
+			User: user.User{ID: otherUserID},
+			Flow: &authenticate.Flow{},
+		}, nil)
+		mockSessionSrv.EXPECT().ExtractFromContext(mock.Anything).Return(sess, nil)
+		newSession := &frontiersession.Session{ID: uuid.New(), UserID: otherUserID}
+		mockSessionSrv.EXPECT().Create(mock.Anything, otherUserID, mock.Anything, false).Return(newSession, nil)
+
+		handler := &ConnectHandler{authnService: mockAuthnSrv, sessionService: mockSessionSrv}
+		resp, err := handler.AuthCallback(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, newSession.ID.String(), resp.Header().Get(consts.SessionIDGatewayKey))
+	})
+}
+
+func TestConnectHandler_RequireRecentAuthentication(t *testing.T) {
+	maxAge := 15 * time.Minute
+	now := time.Now().UTC()
+	userID := uuid.NewString()
+	sessionID := uuid.New()
+	userPrincipal := authenticate.Principal{
+		ID:        userID,
+		Type:      schema.UserPrincipal,
+		User:      &user.User{ID: userID},
+		AuthVia:   authenticate.SessionClientAssertion,
+		SessionID: sessionID.String(),
+	}
+	newSession := func(authenticatedAt time.Time) *frontiersession.Session {
+		return &frontiersession.Session{
+			ID:              sessionID,
+			UserID:          userID,
+			AuthenticatedAt: authenticatedAt,
+			ExpiresAt:       now.Add(time.Hour),
+			AssuranceLevel:  frontiersession.AssuranceLevelSingleFactor,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		setup    func(authn *mocks.AuthnService, sessions *mocks.SessionService)
+		wantErr  bool
+		wantCode connect.Code
+	}{
+		{
+			name: "should allow a session authenticated within the max age",
+			setup: func(authn *mocks.AuthnService, sessions *mocks.SessionService) {
+				authn.EXPECT().GetPrincipal(mock.Anything).Return(userPrincipal, nil)
+				sessions.EXPECT().GetByID(mock.Anything, sessionID).Return(newSession(now.Add(-time.Minute)), nil)
+			},
+		},
+		{
+			name: "should ask a stale session to re-authenticate",
+			setup: func(authn *mocks.AuthnService, sessions *mocks.SessionService) {
+				authn.EXPECT().GetPrincipal(mock.Anything).Return(userPrincipal, nil)
+				sessions.EXPECT().GetByID(mock.Anything, sessionID).Return(newSession(now.Add(-time.Hour)), nil)
+			},
+			wantErr:  true,
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name: "should ask a user token without a session to re-authenticate",
+			setup: func(authn *mocks.AuthnService, sessions *mocks.SessionService) {
+				principal := userPrincipal
+				principal.AuthVia = authenticate.AccessTokenClientAssertion
+				principal.SessionID = ""
+				authn.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+			},
+			wantErr:  true,
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name: "should refuse personal access tokens",
+			setup: func(authn *mocks.AuthnService, sessions *mocks.SessionService) {
+				authn.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{
+					ID:      uuid.NewString(),
+					Type:    schema.PATPrincipal,
+					User:    &user.User{ID: userID},
+					AuthVia: authenticate.PATClientAssertion,
+				}, nil)
+			},
+			wantErr:  true,
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name: "should allow service users",
+			setup: func(authn *mocks.AuthnService, sessions *mocks.SessionService) {
+				authn.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{
+					ID:   uuid.NewString(),
+					Type: schema.ServiceUserPrincipal,
+				}, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAuthnSrv := mocks.NewAuthnService(t)
+			mockSessionSrv := mocks.NewSessionService(t)
+			tt.setup(mockAuthnSrv, mockSessionSrv)
+
+			handler := &ConnectHandler{authnService: mockAuthnSrv, sessionService: mockSessionSrv}
+			err := handler.RequireRecentAuthentication(context.Background(), maxAge)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+			if tt.wantCode == connect.CodePermissionDenied {
+				assert.ErrorIs(t, err, ErrPATRecentAuthentication)
+				return
+			}
+			assert.ErrorIs(t, err, authenticate.ErrReauthRequired)
+
+			var connectErr *connect.Error
+			require.ErrorAs(t, err, &connectErr)
+			assert.Contains(t, connectErr.Meta().Get("WWW-Authenticate"), `error="insufficient_user_authentication"`)
+		})
+	}
+}
+
+func TestConnectHandler_RateLimited(t *testing.T) {
+	authConfig := authenticate.Config{Session: authenticate.SessionConfig{
+		Headers: authenticate.SessionMetadataHeaders{ClientIP: "x-forwarded-for"},
+	}}
+
+	t.Run("should reject the authentication when the limit is exceeded", func(t *testing.T) {
+		mockAuthnSrv := mocks.NewAuthnService(t)
+		mockSessionSrv := mocks.NewSessionService(t)
+		mockAuthnSrv.EXPECT().SanitizeReturnToURL(mock.Anything).Return("")
+		mockAuthnSrv.EXPECT().SanitizeCallbackURL(mock.Anything).Return("")
+		mockSessionSrv.EXPECT().ExtractFromContext(mock.Anything).Return(nil, frontiersession.ErrNoSession)
+		mockAuthnSrv.EXPECT().StartFlow(mock.Anything, authenticate.RegistrationStartRequest{
+			Method:    "mailotp",
+			Email:     "john@example.com",
+			IPAddress: "10.0.0.1",
+		}).Return(nil, ratelimit.ErrLimitExceeded)
+
+		request := connect.NewRequest(&frontierv1beta1.AuthenticateRequest{StrategyName: "mailotp", Email: "john@example.com"})
+		request.Header().Set("x-forwarded-for", "10.0.0.1, 10.0.0.2")
+		handler := &ConnectHandler{authnService: mockAuthnSrv, sessionService: mockSessionSrv, authConfig: authConfig}
+		_, err := handler.Authenticate(context.Background(), request)
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	})
+
+	t.Run("should reject the callback when the limit is exceeded", func(t *testing.T) {
+		mockAuthnSrv := mocks.NewAuthnService(t)
+		mockAuthnSrv.EXPECT().FinishFlow(mock.Anything, mock.Anything).Return(nil, ratelimit.ErrLimitExceeded)
+
+		request := connect.NewRequest(&frontierv1beta1.AuthCallbackRequest{StrategyName: "mailotp"})
+		handler := &ConnectHandler{authnService: mockAuthnSrv, authConfig: authConfig}
+		_, err := handler.AuthCallback(context.Background(), request)
+		assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+	})
+}
+
 func TestConnectHandler_GetJWKs(t *testing.T) {
 	tests := []struct {
 		name        string
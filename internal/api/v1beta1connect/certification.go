@@ -0,0 +1,213 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/certification"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func certificationErrCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, certification.ErrNotExist),
+		errors.Is(err, organization.ErrNotExist),
+		errors.Is(err, project.ErrNotExist):
+		return connect.CodeNotFound
+	case errors.Is(err, certification.ErrInvalidID),
+		errors.Is(err, certification.ErrInvalidDetail),
+		errors.Is(err, certification.ErrInvalidScope),
+		errors.Is(err, certification.ErrNoBindings):
+		return connect.CodeInvalidArgument
+	case errors.Is(err, certification.ErrNotManager),
+		errors.Is(err, certification.ErrNotReviewer),
+		errors.Is(err, certification.ErrSelfReview):
+		return connect.CodePermissionDenied
+	case errors.Is(err, certification.ErrNotActive),
+		errors.Is(err, certification.ErrAlreadyDecided):
+		return connect.CodeFailedPrecondition
+	default:
+		return connect.CodeInternal
+	}
+}
+
+func (h *ConnectHandler) CreateCertificationCampaign(ctx context.Context, request *connect.Request[frontierv1beta1.CreateCertificationCampaignRequest]) (*connect.Response[frontierv1beta1.CreateCertificationCampaignResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created, err := h.certificationService.Create(ctx, certification.Campaign{
+		OrgID:      request.Msg.GetOrgId(),
+		Name:       request.Msg.GetName(),
+		ProjectIDs: request.Msg.GetProjectIds(),
+		RoleIDs:    request.Msg.GetRoleIds(),
+		Deadline:   request.Msg.GetDeadline().AsTime(),
+		AutoRevoke: request.Msg.GetAutoRevoke(),
+		CreatedBy:  userID,
+	})
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "CreateCertificationCampaign.Create", err,
+			"org_id", request.Msg.GetOrgId(), "name", request.Msg.GetName())
+		return nil, connect.NewError(certificationErrCode(err), fmt.Errorf("CreateCertificationCampaign: org_id=%s: %w", request.Msg.GetOrgId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.CreateCertificationCampaignResponse{
+		Campaign: toProtoCertificationCampaign(created),
+	}), nil
+}
+
+func (h *ConnectHandler) ListCertificationCampaigns(ctx context.Context, request *connect.Request[frontierv1beta1.ListCertificationCampaignsRequest]) (*connect.Response[frontierv1beta1.ListCertificationCampaignsResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	campaigns, err := h.certificationService.List(ctx, request.Msg.GetOrgId(), userID,
+		certification.State(request.Msg.GetState()))
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ListCertificationCampaigns.List", err, "org_id", request.Msg.GetOrgId())
+		return nil, connect.NewError(certificationErrCode(err), fmt.Errorf("ListCertificationCampaigns: org_id=%s: %w", request.Msg.GetOrgId(), err))
+	}
+	pbCampaigns := make([]*frontierv1beta1.CertificationCampaign, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		pbCampaigns = append(pbCampaigns, toProtoCertificationCampaign(campaign))
+	}
+	return connect.NewResponse(&frontierv1beta1.ListCertificationCampaignsResponse{Campaigns: pbCampaigns}), nil
+}
+
+func (h *ConnectHandler) CancelCertificationCampaign(ctx context.Context, request *connect.Request[frontierv1beta1.CancelCertificationCampaignRequest]) (*connect.Response[frontierv1beta1.CancelCertificationCampaignResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cancelled, err := h.certificationService.Cancel(ctx, request.Msg.GetId(), userID)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "CancelCertificationCampaign.Cancel", err, "id", request.Msg.GetId())
+		return nil, connect.NewError(certificationErrCode(err), fmt.Errorf("CancelCertificationCampaign: id=%s: %w", request.Msg.GetId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.CancelCertificationCampaignResponse{
+		Campaign: toProtoCertificationCampaign(cancelled),
+	}), nil
+}
+
+// ListCertificationItems returns the policies of the campaign the current
+// user reviews
+func (h *ConnectHandler) ListCertificationItems(ctx context.Context, request *connect.Request[frontierv1beta1.ListCertificationItemsRequest]) (*connect.Response[frontierv1beta1.ListCertificationItemsResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items, err := h.certificationService.Items(ctx, request.Msg.GetCampaignId(), userID,
+		certification.Decision(request.Msg.GetDecision()))
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ListCertificationItems.Items", err, "campaign_id", request.Msg.GetCampaignId())
+		return nil, connect.NewError(certificationErrCode(err), fmt.Errorf("ListCertificationItems: campaign_id=%s: %w", request.Msg.GetCampaignId(), err))
+	}
+	pbItems := make([]*frontierv1beta1.CertificationItem, 0, len(items))
+	for _, item := range items {
+		pbItems = append(pbItems, toProtoCertificationItem(item))
+	}
+	return connect.NewResponse(&frontierv1beta1.ListCertificationItemsResponse{Items: pbItems}), nil
+}
+
+func (h *ConnectHandler) CertifyCertificationItem(ctx context.Context, request *connect.Request[frontierv1beta1.CertifyCertificationItemRequest]) (*connect.Response[frontierv1beta1.CertifyCertificationItemResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	certified, err := h.certificationService.Certify(ctx, request.Msg.GetId(), userID, request.Msg.GetComment())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "CertifyCertificationItem.Certify", err, "id", request.Msg.GetId())
+		return nil, connect.NewError(certificationErrCode(err), fmt.Errorf("CertifyCertificationItem: id=%s: %w", request.Msg.GetId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.CertifyCertificationItemResponse{
+		Item: toProtoCertificationItem(certified),
+	}), nil
+}
+
+func (h *ConnectHandler) RevokeCertificationItem(ctx context.Context, request *connect.Request[frontierv1beta1.RevokeCertificationItemRequest]) (*connect.Response[frontierv1beta1.RevokeCertificationItemResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := h.certificationService.Revoke(ctx, request.Msg.GetId(), userID, request.Msg.GetComment())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "RevokeCertificationItem.Revoke", err, "id", request.Msg.GetId())
+		return nil, connect.NewError(certificationErrCode(err), fmt.Errorf("RevokeCertificationItem: id=%s: %w", request.Msg.GetId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.RevokeCertificationItemResponse{
+		Item: toProtoCertificationItem(revoked),
+	}), nil
+}
+
+func (h *ConnectHandler) ExportCertificationCampaign(ctx context.Context, request *connect.Request[frontierv1beta1.ExportCertificationCampaignRequest], stream *connect.ServerStream[httpbody.HttpBody]) error {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	reader, contentType, err := h.certificationService.Export(ctx, request.Msg.GetCampaignId(), userID)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ExportCertificationCampaign.Export", err, "campaign_id", request.Msg.GetCampaignId())
+		return connect.NewError(certificationErrCode(err), fmt.Errorf("ExportCertificationCampaign: campaign_id=%s: %w", request.Msg.GetCampaignId(), err))
+	}
+	return streamReaderInChunks(reader, contentType, stream)
+}
+
+func toProtoCertificationCampaign(campaign certification.Campaign) *frontierv1beta1.CertificationCampaign {
+	pbCampaign := &frontierv1beta1.CertificationCampaign{
+		Id:         campaign.ID,
+		OrgId:      campaign.OrgID,
+		Name:       campaign.Name,
+		ProjectIds: campaign.ProjectIDs,
+		RoleIds:    campaign.RoleIDs,
+		Deadline:   timestamppb.New(campaign.Deadline),
+		AutoRevoke: campaign.AutoRevoke,
+		State:      campaign.State.String(),
+		CreatedBy:  campaign.CreatedBy,
+		CreatedAt:  timestamppb.New(campaign.CreatedAt),
+	}
+	if !campaign.RemindedAt.IsZero() {
+		pbCampaign.RemindedAt = timestamppb.New(campaign.RemindedAt)
+	}
+	if !campaign.CompletedAt.IsZero() {
+		pbCampaign.CompletedAt = timestamppb.New(campaign.CompletedAt)
+	}
+	return pbCampaign
+}
+
+func toProtoCertificationItem(item certification.Item) *frontierv1beta1.CertificationItem {
+	pbItem := &frontierv1beta1.CertificationItem{
+		Id:         item.ID,
+		CampaignId: item.CampaignID,
+		PolicyId:   item.PolicyID,
+		Resource:   schema.JoinNamespaceAndResourceID(item.ResourceType, item.ResourceID),
+		RoleId:     item.RoleID,
+		Principal:  schema.JoinNamespaceAndResourceID(item.PrincipalType, item.PrincipalID),
+		Decision:   item.Decision.String(),
+		ReviewerId: item.ReviewerID,
+		Comment:    item.Comment,
+	}
+	if !item.DecidedAt.IsZero() {
+		pbItem.DecidedAt = timestamppb.New(item.DecidedAt)
+	}
+	return pbItem
+}
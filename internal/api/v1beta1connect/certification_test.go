@@ -0,0 +1,136 @@
+package v1beta1connect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/certification"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHandler_Certifications(t *testing.T) {
+	userID := uuid.New().String()
+	orgID := uuid.New().String()
+	campaignID := uuid.New().String()
+	itemID := uuid.New().String()
+	projectID := uuid.New().String()
+
+	setup := func(t *testing.T, principal authenticate.Principal) (*ConnectHandler, *mocks.CertificationService) {
+		cs := mocks.NewCertificationService(t)
+		as := mocks.NewAuthnService(t)
+		as.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+		return &ConnectHandler{certificationService: cs, authnService: as}, cs
+	}
+	user := authenticate.Principal{ID: userID, Type: schema.UserPrincipal}
+
+	t.Run("starts a campaign of the current user", func(t *testing.T) {
+		h, cs := setup(t, user)
+		deadline := time.Date(2026, 12, 15, 0, 0, 0, 0, time.UTC)
+		cs.EXPECT().Create(mock.Anything, certification.Campaign{
+			OrgID:      orgID,
+			Name:       "Q4",
+			Deadline:   deadline,
+			AutoRevoke: true,
+			CreatedBy:  userID,
+		}).Return(certification.Campaign{
+			ID:         campaignID,
+			OrgID:      orgID,
+			Name:       "Q4",
+			Deadline:   deadline,
+			AutoRevoke: true,
+			State:      certification.Active,
+			CreatedBy:  userID,
+		}, nil)
+
+		resp, err := h.CreateCertificationCampaign(context.Background(), connect.NewRequest(&frontierv1beta1.CreateCertificationCampaignRequest{
+			OrgId:      orgID,
+			Name:       "Q4",
+			Deadline:   timestamppb.New(deadline),
+			AutoRevoke: true,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, campaignID, resp.Msg.GetCampaign().GetId())
+		assert.Equal(t, "active", resp.Msg.GetCampaign().GetState())
+		assert.Nil(t, resp.Msg.GetCampaign().GetCompletedAt())
+	})
+
+	t.Run("rejects campaigns of users not managing the organization", func(t *testing.T) {
+		h, cs := setup(t, user)
+		cs.EXPECT().List(mock.Anything, orgID, userID, certification.Active).Return(nil, certification.ErrNotManager)
+
+		_, err := h.ListCertificationCampaigns(context.Background(), connect.NewRequest(&frontierv1beta1.ListCertificationCampaignsRequest{
+			OrgId: orgID,
+			State: "active",
+		}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("lists the items the current user reviews", func(t *testing.T) {
+		h, cs := setup(t, user)
+		cs.EXPECT().Items(mock.Anything, campaignID, userID, certification.Pending).Return([]certification.Item{{
+			ID:            itemID,
+			CampaignID:    campaignID,
+			ResourceID:    projectID,
+			ResourceType:  schema.ProjectNamespace,
+			PrincipalID:   userID,
+			PrincipalType: schema.UserPrincipal,
+			Decision:      certification.Pending,
+		}}, nil)
+
+		resp, err := h.ListCertificationItems(context.Background(), connect.NewRequest(&frontierv1beta1.ListCertificationItemsRequest{
+			CampaignId: campaignID,
+			Decision:   "pending",
+		}))
+		require.NoError(t, err)
+		require.Len(t, resp.Msg.GetItems(), 1)
+		assert.Equal(t, schema.JoinNamespaceAndResourceID(schema.ProjectNamespace, projectID), resp.Msg.GetItems()[0].GetResource())
+	})
+
+	t.Run("revokes an item as the current user", func(t *testing.T) {
+		h, cs := setup(t, user)
+		decidedAt := time.Now()
+		cs.EXPECT().Revoke(mock.Anything, itemID, userID, "left the team").Return(certification.Item{
+			ID:         itemID,
+			Decision:   certification.Revoked,
+			ReviewerID: userID,
+			DecidedAt:  decidedAt,
+		}, nil)
+
+		resp, err := h.RevokeCertificationItem(context.Background(), connect.NewRequest(&frontierv1beta1.RevokeCertificationItemRequest{
+			Id:      itemID,
+			Comment: "left the team",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "revoked", resp.Msg.GetItem().GetDecision())
+		assert.Equal(t, decidedAt.Unix(), resp.Msg.GetItem().GetDecidedAt().AsTime().Unix())
+	})
+
+	t.Run("rejects deciding an item twice", func(t *testing.T) {
+		h, cs := setup(t, user)
+		cs.EXPECT().Certify(mock.Anything, itemID, userID, "").Return(certification.Item{}, certification.ErrAlreadyDecided)
+
+		_, err := h.CertifyCertificationItem(context.Background(), connect.NewRequest(&frontierv1beta1.CertifyCertificationItemRequest{
+			Id: itemID,
+		}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+
+	t.Run("rejects principals other than users", func(t *testing.T) {
+		h, _ := setup(t, authenticate.Principal{ID: uuid.New().String(), Type: schema.ServiceUserPrincipal})
+
+		_, err := h.CancelCertificationCampaign(context.Background(), connect.NewRequest(&frontierv1beta1.CancelCertificationCampaignRequest{
+			Id: campaignID,
+		}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+}
@@ -58,6 +58,7 @@ var (
 	ErrNamespaceSplitNotation      = errors.New("subject/object should be provided as 'namespace:uuid'")
 	ErrPermissionKeyNotation       = errors.New("permission key should be provided as 'service.resource.verb'")
 	ErrPolicyNotFound              = errors.New("policy doesn't exist")
+	ErrInvalidPolicyWindow         = errors.New("policy metadata not_before and expires_at should be RFC 3339 timestamps, expiring in the future after the policy starts")
 	ErrProjectNotFound             = errors.New("project doesn't exist")
 	ErrGroupNotFound               = errors.New("group doesn't exist")
 	ErrOrgNotFound                 = errors.New("org doesn't exist")
@@ -73,4 +74,6 @@ var (
 	ErrInvalidSessionID            = errors.New("invalid session_id format: must be a valid UUID")
 	ErrInvalidUserID               = errors.New("invalid user_id format: must be a valid UUID")
 	ErrRoleNotFound                = errors.New("role doesn't exist")
+	ErrLoginAlertDisabled          = errors.New("login alerts are disabled")
+	ErrPATRecentAuthentication     = errors.New("personal access tokens can't call operations requiring a recent authentication")
 )
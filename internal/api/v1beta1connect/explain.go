@@ -0,0 +1,90 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/explain"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExplainPermission explains the permission of the principal of the request,
+// or of the current user, on the resource
+func (h *ConnectHandler) ExplainPermission(ctx context.Context, request *connect.Request[frontierv1beta1.ExplainPermissionRequest]) (*connect.Response[frontierv1beta1.ExplainPermissionResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	namespace, resourceID, err := schema.SplitNamespaceAndResourceID(request.Msg.GetResource())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, explain.ErrInvalidDetail)
+	}
+	var subject relation.Subject
+	if principal := request.Msg.GetPrincipal(); principal != "" {
+		subject.Namespace, subject.ID, err = schema.SplitNamespaceAndResourceID(principal)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidNamesapceOrID)
+		}
+	}
+
+	explanation, err := h.explainService.Explain(ctx, userID, relation.Object{
+		ID:        resourceID,
+		Namespace: namespace,
+	}, subject, request.Msg.GetPermission())
+	if err != nil {
+		switch {
+		case errors.Is(err, organization.ErrNotExist),
+			errors.Is(err, project.ErrNotExist):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		case errors.Is(err, explain.ErrInvalidDetail):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, explain.ErrNotAllowed):
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		errorLogger.LogServiceError(ctx, request, "ExplainPermission.Explain", err,
+			"resource", request.Msg.GetResource(), "permission", request.Msg.GetPermission())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("ExplainPermission: resource=%s: %w", request.Msg.GetResource(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.ExplainPermissionResponse{
+		Explanation: toProtoPermissionExplanation(explanation),
+	}), nil
+}
+
+func toProtoPermissionExplanation(explanation explain.Explanation) *frontierv1beta1.PermissionExplanation {
+	pbExplanation := &frontierv1beta1.PermissionExplanation{
+		Resource:   schema.JoinNamespaceAndResourceID(explanation.Object.Namespace, explanation.Object.ID),
+		Principal:  schema.JoinNamespaceAndResourceID(explanation.Subject.Namespace, explanation.Subject.ID),
+		Permission: explanation.Permission,
+		Allowed:    explanation.Allowed,
+		Path:       make([]*frontierv1beta1.PermissionExplanationStep, 0, len(explanation.Path)),
+	}
+	for _, step := range explanation.Path {
+		pbStep := &frontierv1beta1.PermissionExplanationStep{
+			Type:     step.Type.String(),
+			Object:   schema.JoinNamespaceAndResourceID(step.Namespace, step.ID),
+			Relation: step.Relation,
+			Title:    step.Title,
+		}
+		if step.Type == explain.PolicyStep {
+			pbStep.PolicyId = step.Policy.ID
+			pbStep.RoleId = step.Role.ID
+			pbStep.RoleName = step.Role.Name
+			pbStep.Principal = schema.JoinNamespaceAndResourceID(step.Policy.PrincipalType, step.Policy.PrincipalID)
+			pbStep.Resource = schema.JoinNamespaceAndResourceID(step.Policy.ResourceType, step.Policy.ResourceID)
+			if !step.Policy.ExpiresAt.IsZero() {
+				pbStep.ExpiresAt = timestamppb.New(step.Policy.ExpiresAt)
+			}
+		}
+		pbExplanation.Path = append(pbExplanation.Path, pbStep)
+	}
+	return pbExplanation
+}
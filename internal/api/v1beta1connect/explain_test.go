@@ -0,0 +1,104 @@
+package v1beta1connect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/explain"
+	"github.com/raystack/frontier/core/policy"
+	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_ExplainPermission(t *testing.T) {
+	userID := uuid.New().String()
+	otherUserID := uuid.New().String()
+	projectID := uuid.New().String()
+	policyID := uuid.New().String()
+	project := relation.Object{ID: projectID, Namespace: schema.ProjectNamespace}
+
+	setup := func(t *testing.T, principal authenticate.Principal) (*ConnectHandler, *mocks.ExplainService) {
+		es := mocks.NewExplainService(t)
+		as := mocks.NewAuthnService(t)
+		as.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+		return &ConnectHandler{explainService: es, authnService: as}, es
+	}
+	user := authenticate.Principal{ID: userID, Type: schema.UserPrincipal}
+
+	t.Run("explains the access of the current user", func(t *testing.T) {
+		h, es := setup(t, user)
+		expiresAt := time.Now().Add(time.Hour)
+		es.EXPECT().Explain(mock.Anything, userID, project, relation.Subject{}, "get").Return(explain.Explanation{
+			Object:     project,
+			Subject:    relation.Subject{ID: userID, Namespace: schema.UserPrincipal},
+			Permission: "get",
+			Allowed:    true,
+			Path: []explain.Step{
+				{
+					Type:      explain.PolicyStep,
+					Namespace: schema.RoleBindingNamespace,
+					ID:        policyID,
+					Relation:  "get",
+					Policy: policy.Policy{
+						ID:            policyID,
+						PrincipalID:   userID,
+						PrincipalType: schema.UserPrincipal,
+						ResourceID:    projectID,
+						ResourceType:  schema.ProjectNamespace,
+						ExpiresAt:     expiresAt,
+					},
+					Role: role.Role{ID: "viewer", Name: "app_project_viewer"},
+				},
+				{Type: explain.ResourceStep, Namespace: schema.ProjectNamespace, ID: projectID, Relation: "get"},
+			},
+		}, nil)
+
+		resp, err := h.ExplainPermission(context.Background(), connect.NewRequest(&frontierv1beta1.ExplainPermissionRequest{
+			Resource:   schema.JoinNamespaceAndResourceID(schema.ProjectNamespace, projectID),
+			Permission: "get",
+		}))
+		require.NoError(t, err)
+		explanation := resp.Msg.GetExplanation()
+		assert.True(t, explanation.GetAllowed())
+		assert.Equal(t, schema.JoinNamespaceAndResourceID(schema.UserPrincipal, userID), explanation.GetPrincipal())
+		require.Len(t, explanation.GetPath(), 2)
+		assert.Equal(t, "app_project_viewer", explanation.GetPath()[0].GetRoleName())
+		assert.Equal(t, expiresAt.Unix(), explanation.GetPath()[0].GetExpiresAt().AsTime().Unix())
+		assert.Empty(t, explanation.GetPath()[1].GetPolicyId())
+	})
+
+	t.Run("rejects explaining the access of others without managing the resource", func(t *testing.T) {
+		h, es := setup(t, user)
+		es.EXPECT().Explain(mock.Anything, userID, project,
+			relation.Subject{ID: otherUserID, Namespace: schema.UserPrincipal}, "get").
+			Return(explain.Explanation{}, explain.ErrNotAllowed)
+
+		_, err := h.ExplainPermission(context.Background(), connect.NewRequest(&frontierv1beta1.ExplainPermissionRequest{
+			Resource:   schema.JoinNamespaceAndResourceID(schema.ProjectNamespace, projectID),
+			Permission: "get",
+			Principal:  schema.JoinNamespaceAndResourceID(schema.UserPrincipal, otherUserID),
+		}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("rejects a malformed principal", func(t *testing.T) {
+		h, _ := setup(t, user)
+
+		_, err := h.ExplainPermission(context.Background(), connect.NewRequest(&frontierv1beta1.ExplainPermissionRequest{
+			Resource:   schema.JoinNamespaceAndResourceID(schema.ProjectNamespace, projectID),
+			Permission: "get",
+			Principal:  otherUserID,
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
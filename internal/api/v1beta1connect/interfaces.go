@@ -15,6 +15,8 @@ import (
 	"github.com/raystack/frontier/billing/product"
 	"github.com/raystack/frontier/billing/subscription"
 	"github.com/raystack/frontier/billing/usage"
+	"github.com/raystack/frontier/core/accessrequest"
+	"github.com/raystack/frontier/core/accessreview"
 	"github.com/raystack/frontier/core/aggregates/orgbilling"
 	"github.com/raystack/frontier/core/aggregates/orginvoices"
 	"github.com/raystack/frontier/core/aggregates/orgpats"
@@ -28,9 +30,14 @@ import (
 	"github.com/raystack/frontier/core/aggregates/userprojects"
 	"github.com/raystack/frontier/core/auditrecord"
 	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/mfa"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
 	frontiersession "github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/core/authenticate/token"
+	"github.com/raystack/frontier/core/certification"
 	"github.com/raystack/frontier/core/domain"
 	"github.com/raystack/frontier/core/event"
+	"github.com/raystack/frontier/core/explain"
 	"github.com/raystack/frontier/core/group"
 	"github.com/raystack/frontier/core/invitation"
 	"github.com/raystack/frontier/core/kyc"
@@ -74,6 +81,17 @@ type WebhookService interface {
 	UpdateEndpoint(ctx context.Context, endpoint webhook.Endpoint) (webhook.Endpoint, error)
 	DeleteEndpoint(ctx context.Context, id string) error
 	ListEndpoints(ctx context.Context, filter webhook.EndpointFilter) ([]webhook.Endpoint, error)
+	GetEndpoint(ctx context.Context, id string) (webhook.Endpoint, error)
+	UpdateFilters(ctx context.Context, endpointID string, filters map[string][]string) (webhook.Endpoint, error)
+	UpdatePayloadFormat(ctx context.Context, endpointID string, format webhook.PayloadFormat) (webhook.Endpoint, error)
+	RotateSecret(ctx context.Context, endpointID string) (webhook.Secret, error)
+	RevokeSecret(ctx context.Context, endpointID, secretID string) error
+	SendTestEvent(ctx context.Context, endpointID string) (webhook.Attempt, error)
+	ListDeliveries(ctx context.Context, filter webhook.DeliveryFilter) ([]webhook.Delivery, error)
+	GetDelivery(ctx context.Context, id string) (webhook.Delivery, error)
+	ListDeliveryAttempts(ctx context.Context, deliveryID string) ([]webhook.Attempt, error)
+	Redeliver(ctx context.Context, id string) error
+	RedeliverRange(ctx context.Context, filter webhook.DeliveryFilter) (int, error)
 }
 
 type UserOrgsService interface {
@@ -366,16 +384,30 @@ type AuthnService interface {
 	InitFlows(ctx context.Context) error
 	SanitizeReturnToURL(url string) string
 	SanitizeCallbackURL(url string) string
+	RevokeToken(ctx context.Context) error
+}
+
+type RefreshTokenService interface {
+	Enabled() bool
+	Issue(ctx context.Context, sess *frontiersession.Session) (string, error)
+	Validate(ctx context.Context, value string) (*frontiersession.Session, error)
+	Rotate(ctx context.Context, value string) (*frontiersession.Session, string, error)
+}
+
+type SigningKeyService interface {
+	Replace(ctx context.Context) (token.SigningKey, error)
 }
 
 type SessionService interface {
 	ExtractFromContext(ctx context.Context) (*frontiersession.Session, error)
-	Create(ctx context.Context, userID string, metadata frontiersession.SessionMetadata) (*frontiersession.Session, error)
+	Create(ctx context.Context, userID string, metadata frontiersession.SessionMetadata, mfaRequired bool) (*frontiersession.Session, error)
 	GetByID(ctx context.Context, sessionID uuid.UUID) (*frontiersession.Session, error)
 	Refresh(ctx context.Context, sessionID uuid.UUID) error
 	List(ctx context.Context, userID string) ([]*frontiersession.Session, error)
 	Delete(ctx context.Context, sessionID uuid.UUID) error
 	Ping(ctx context.Context, sessionID uuid.UUID, metadata frontiersession.SessionMetadata) error
+	Reauthenticate(ctx context.Context, sessionID uuid.UUID) error
+	SetAssuranceLevel(ctx context.Context, sessionID uuid.UUID, level frontiersession.AssuranceLevel) error
 }
 
 type NamespaceService interface {
@@ -396,6 +428,73 @@ type AuditRecordService interface {
 	Create(ctx context.Context, record auditrecord.AuditRecord) (auditrecord.AuditRecord, bool, error)
 	List(ctx context.Context, query *rql.Query) (auditrecord.AuditRecordsList, error)
 	Export(ctx context.Context, query *rql.Query) (io.Reader, string, error)
+	VerifyChain(ctx context.Context, orgID string, since, until time.Time) (auditrecord.ChainVerification, error)
+}
+
+type AuditRecordArchiveService interface {
+	ListArchives(ctx context.Context, filter auditrecord.ArchiveFilter) ([]auditrecord.Archive, error)
+	ReadArchive(ctx context.Context, id string) (auditrecord.Archive, []auditrecord.AuditRecord, error)
+	RestoreArchive(ctx context.Context, id string) (auditrecord.Archive, error)
+}
+
+type OIDCProviderService interface {
+	Enabled() bool
+	GetConsentRequest(ctx context.Context, authorizationID, userID string) (oidcprovider.ConsentRequest, error)
+	Consent(ctx context.Context, authorizationID, userID string, approve bool) (string, error)
+	CreateClient(ctx context.Context, client oidcprovider.Client) (oidcprovider.Client, string, error)
+	GetClient(ctx context.Context, id string) (oidcprovider.Client, error)
+	ListClients(ctx context.Context, orgID string) ([]oidcprovider.Client, error)
+	RotateClientSecret(ctx context.Context, id string) (string, error)
+	DeleteClient(ctx context.Context, id string) error
+}
+
+type MFAService interface {
+	IsEnrolled(ctx context.Context, userID string) (bool, error)
+	Enroll(ctx context.Context, userID, accountName string) (mfa.Enrollment, error)
+	Confirm(ctx context.Context, userID, code string) ([]string, error)
+	Verify(ctx context.Context, userID, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, userID, code string) ([]string, error)
+	Disable(ctx context.Context, userID, code string) error
+}
+
+type LoginAlertService interface {
+	Enabled() bool
+	Revoke(ctx context.Context, token string) error
+}
+
+type AccessRequestService interface {
+	Create(ctx context.Context, request accessrequest.AccessRequest) (accessrequest.AccessRequest, error)
+	List(ctx context.Context, flt accessrequest.Filter) ([]accessrequest.AccessRequest, error)
+	IsReviewer(ctx context.Context, namespace, id, userID string) (bool, error)
+	Approve(ctx context.Context, id, reviewerID, comment string) (accessrequest.AccessRequest, error)
+	Deny(ctx context.Context, id, reviewerID, comment string) (accessrequest.AccessRequest, error)
+	Cancel(ctx context.Context, id, requesterID string) (accessrequest.AccessRequest, error)
+}
+
+type ExplainService interface {
+	Explain(ctx context.Context, requesterID string, object relation.Object,
+		subject relation.Subject, permission string) (explain.Explanation, error)
+}
+
+type AccessReviewService interface {
+	ReviewPrincipal(ctx context.Context, requesterID string, principal relation.Subject,
+		orgID string, page accessreview.Page) (accessreview.PrincipalReport, error)
+	ReviewResource(ctx context.Context, requesterID string, object relation.Object,
+		permission string, page accessreview.Page) (accessreview.ResourceReport, error)
+	ExportPrincipal(ctx context.Context, requesterID string, principal relation.Subject,
+		orgID string) (io.Reader, string, error)
+	ExportResource(ctx context.Context, requesterID string, object relation.Object,
+		permission string) (io.Reader, string, error)
+}
+
+type CertificationService interface {
+	Create(ctx context.Context, campaign certification.Campaign) (certification.Campaign, error)
+	List(ctx context.Context, orgID, userID string, state certification.State) ([]certification.Campaign, error)
+	Cancel(ctx context.Context, id, userID string) (certification.Campaign, error)
+	Items(ctx context.Context, campaignID, userID string, decision certification.Decision) ([]certification.Item, error)
+	Certify(ctx context.Context, id, reviewerID, comment string) (certification.Item, error)
+	Revoke(ctx context.Context, id, reviewerID, comment string) (certification.Item, error)
+	Export(ctx context.Context, id, userID string) (io.Reader, string, error)
 }
 
 type MembershipService interface {
@@ -0,0 +1,29 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/authenticate/loginalert"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+)
+
+// RevokeLoginAlertSession is called without a frontier session, the token of
+// the link identifies the session to sign out
+func (h *ConnectHandler) RevokeLoginAlertSession(ctx context.Context, request *connect.Request[frontierv1beta1.RevokeLoginAlertSessionRequest]) (*connect.Response[frontierv1beta1.RevokeLoginAlertSessionResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	if !h.loginAlertService.Enabled() {
+		return nil, connect.NewError(connect.CodeUnimplemented, ErrLoginAlertDisabled)
+	}
+	if err := h.loginAlertService.Revoke(ctx, request.Msg.GetToken()); err != nil {
+		if errors.Is(err, loginalert.ErrInvalidLink) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		errorLogger.LogServiceError(ctx, request, "RevokeLoginAlertSession.Revoke", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("RevokeLoginAlertSession: %w", err))
+	}
+	return connect.NewResponse(&frontierv1beta1.RevokeLoginAlertSessionResponse{}), nil
+}
@@ -0,0 +1,69 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/authenticate/loginalert"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_RevokeLoginAlertSession(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(las *mocks.LoginAlertService)
+		wantCode connect.Code
+	}{
+		{
+			name: "should revoke the session of the link",
+			setup: func(las *mocks.LoginAlertService) {
+				las.EXPECT().Enabled().Return(true)
+				las.EXPECT().Revoke(mock.Anything, "token").Return(nil)
+			},
+		},
+		{
+			name: "should reject an invalid or expired link",
+			setup: func(las *mocks.LoginAlertService) {
+				las.EXPECT().Enabled().Return(true)
+				las.EXPECT().Revoke(mock.Anything, "token").Return(loginalert.ErrInvalidLink)
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "should hide other errors",
+			setup: func(las *mocks.LoginAlertService) {
+				las.EXPECT().Enabled().Return(true)
+				las.EXPECT().Revoke(mock.Anything, "token").Return(errors.New("db down"))
+			},
+			wantCode: connect.CodeInternal,
+		},
+		{
+			name: "should return unimplemented when login alerts are disabled",
+			setup: func(las *mocks.LoginAlertService) {
+				las.EXPECT().Enabled().Return(false)
+			},
+			wantCode: connect.CodeUnimplemented,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			las := mocks.NewLoginAlertService(t)
+			tt.setup(las)
+			h := &ConnectHandler{loginAlertService: las}
+
+			_, err := h.RevokeLoginAlertSession(context.Background(), connect.NewRequest(&frontierv1beta1.RevokeLoginAlertSessionRequest{
+				Token: "token",
+			}))
+			if tt.wantCode == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate/mfa"
+	frontiersession "github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+)
+
+func mfaErrCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, mfa.ErrInvalidCode):
+		return connect.CodeInvalidArgument
+	case errors.Is(err, mfa.ErrNotEnrolled):
+		return connect.CodeFailedPrecondition
+	case errors.Is(err, mfa.ErrAlreadyEnrolled):
+		return connect.CodeAlreadyExists
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// mfaSession returns the session of the current user, it may still wait for
+// its second factor
+func (h *ConnectHandler) mfaSession(ctx context.Context) (*frontiersession.Session, error) {
+	principal, err := h.GetLoggedInPrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessionID, err := uuid.Parse(principal.SessionID)
+	if principal.Type != schema.UserPrincipal || err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthenticated)
+	}
+	sess, err := h.sessionService.GetByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, frontiersession.ErrNoSession) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, ErrUnauthenticated)
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("mfaSession: %w", err))
+	}
+	return sess, nil
+}
+
+func (h *ConnectHandler) GetMFAStatus(ctx context.Context, request *connect.Request[frontierv1beta1.GetMFAStatusRequest]) (*connect.Response[frontierv1beta1.GetMFAStatusResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	sess, err := h.mfaSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	enrolled, err := h.mfaService.IsEnrolled(ctx, sess.UserID)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "GetMFAStatus.IsEnrolled", err, "user_id", sess.UserID)
+		return nil, connect.NewError(mfaErrCode(err), fmt.Errorf("GetMFAStatus: user_id=%s: %w", sess.UserID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.GetMFAStatusResponse{
+		TotpEnrolled:   enrolled,
+		AssuranceLevel: string(sess.AssuranceLevel),
+		MfaRequired:    sess.MFARequired,
+	}), nil
+}
+
+func (h *ConnectHandler) EnrollTOTP(ctx context.Context, request *connect.Request[frontierv1beta1.EnrollTOTPRequest]) (*connect.Response[frontierv1beta1.EnrollTOTPResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	sess, err := h.mfaSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentUser, err := h.userService.GetByID(ctx, sess.UserID)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "EnrollTOTP.GetByID", err, "user_id", sess.UserID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("EnrollTOTP: user_id=%s: %w", sess.UserID, err))
+	}
+	enrollment, err := h.mfaService.Enroll(ctx, sess.UserID, currentUser.Email)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "EnrollTOTP.Enroll", err, "user_id", sess.UserID)
+		return nil, connect.NewError(mfaErrCode(err), fmt.Errorf("EnrollTOTP: user_id=%s: %w", sess.UserID, err))
+	}
+	response := connect.NewResponse(&frontierv1beta1.EnrollTOTPResponse{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.URI,
+	})
+	response.Header().Set("Cache-Control", "no-store")
+	return response, nil
+}
+
+// ConfirmTOTP completes the enrollment, the session proved the second factor
+// with the code
+func (h *ConnectHandler) ConfirmTOTP(ctx context.Context, request *connect.Request[frontierv1beta1.ConfirmTOTPRequest]) (*connect.Response[frontierv1beta1.ConfirmTOTPResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	sess, err := h.mfaSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := h.mfaService.Confirm(ctx, sess.UserID, request.Msg.GetCode())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ConfirmTOTP.Confirm", err, "user_id", sess.UserID)
+		return nil, connect.NewError(mfaErrCode(err), fmt.Errorf("ConfirmTOTP: user_id=%s: %w", sess.UserID, err))
+	}
+	if err := h.sessionService.SetAssuranceLevel(ctx, sess.ID, frontiersession.AssuranceLevelMultiFactor); err != nil {
+		errorLogger.LogServiceError(ctx, request, "ConfirmTOTP.SetAssuranceLevel", err, "session_id", sess.ID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("ConfirmTOTP: session_id=%s: %w", sess.ID, err))
+	}
+	response := connect.NewResponse(&frontierv1beta1.ConfirmTOTPResponse{RecoveryCodes: codes})
+	response.Header().Set("Cache-Control", "no-store")
+	return response, nil
+}
+
+// VerifyMFA completes the login of a session waiting for its second factor
+func (h *ConnectHandler) VerifyMFA(ctx context.Context, request *connect.Request[frontierv1beta1.VerifyMFARequest]) (*connect.Response[frontierv1beta1.VerifyMFAResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	sess, err := h.mfaSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.mfaService.Verify(ctx, sess.UserID, request.Msg.GetCode()); err != nil {
+		errorLogger.LogServiceError(ctx, request, "VerifyMFA.Verify", err, "user_id", sess.UserID)
+		return nil, connect.NewError(mfaErrCode(err), fmt.Errorf("VerifyMFA: user_id=%s: %w", sess.UserID, err))
+	}
+	if err := h.sessionService.SetAssuranceLevel(ctx, sess.ID, frontiersession.AssuranceLevelMultiFactor); err != nil {
+		errorLogger.LogServiceError(ctx, request, "VerifyMFA.SetAssuranceLevel", err, "session_id", sess.ID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("VerifyMFA: session_id=%s: %w", sess.ID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.VerifyMFAResponse{}), nil
+}
+
+func (h *ConnectHandler) RegenerateMFARecoveryCodes(ctx context.Context, request *connect.Request[frontierv1beta1.RegenerateMFARecoveryCodesRequest]) (*connect.Response[frontierv1beta1.RegenerateMFARecoveryCodesResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	sess, err := h.mfaSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := h.mfaService.RegenerateRecoveryCodes(ctx, sess.UserID, request.Msg.GetCode())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "RegenerateMFARecoveryCodes.RegenerateRecoveryCodes", err, "user_id", sess.UserID)
+		return nil, connect.NewError(mfaErrCode(err), fmt.Errorf("RegenerateMFARecoveryCodes: user_id=%s: %w", sess.UserID, err))
+	}
+	response := connect.NewResponse(&frontierv1beta1.RegenerateMFARecoveryCodesResponse{RecoveryCodes: codes})
+	response.Header().Set("Cache-Control", "no-store")
+	return response, nil
+}
+
+func (h *ConnectHandler) DisableTOTP(ctx context.Context, request *connect.Request[frontierv1beta1.DisableTOTPRequest]) (*connect.Response[frontierv1beta1.DisableTOTPResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	sess, err := h.mfaSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.mfaService.Disable(ctx, sess.UserID, request.Msg.GetCode()); err != nil {
+		errorLogger.LogServiceError(ctx, request, "DisableTOTP.Disable", err, "user_id", sess.UserID)
+		return nil, connect.NewError(mfaErrCode(err), fmt.Errorf("DisableTOTP: user_id=%s: %w", sess.UserID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.DisableTOTPResponse{}), nil
+}
@@ -0,0 +1,81 @@
+package v1beta1connect
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/mfa"
+	frontiersession "github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_MFA(t *testing.T) {
+	userID := uuid.New().String()
+	pendingSession := &frontiersession.Session{
+		ID:             uuid.New(),
+		UserID:         userID,
+		AssuranceLevel: frontiersession.AssuranceLevelSingleFactor,
+		MFARequired:    true,
+	}
+
+	setup := func(t *testing.T) (*ConnectHandler, *mocks.MFAService, *mocks.SessionService) {
+		ms := mocks.NewMFAService(t)
+		ss := mocks.NewSessionService(t)
+		as := mocks.NewAuthnService(t)
+		as.EXPECT().GetPrincipal(mock.Anything).Return(authenticate.Principal{
+			ID:        userID,
+			Type:      schema.UserPrincipal,
+			AuthVia:   authenticate.MFAPendingSessionClientAssertion,
+			SessionID: pendingSession.ID.String(),
+		}, nil)
+		ss.EXPECT().GetByID(mock.Anything, pendingSession.ID).Return(pendingSession, nil)
+		return &ConnectHandler{mfaService: ms, sessionService: ss, authnService: as}, ms, ss
+	}
+
+	t.Run("returns the status of a session waiting for its second factor", func(t *testing.T) {
+		h, ms, _ := setup(t)
+		ms.EXPECT().IsEnrolled(mock.Anything, userID).Return(true, nil)
+
+		resp, err := h.GetMFAStatus(context.Background(), connect.NewRequest(&frontierv1beta1.GetMFAStatusRequest{}))
+		require.NoError(t, err)
+		assert.True(t, resp.Msg.GetTotpEnrolled())
+		assert.True(t, resp.Msg.GetMfaRequired())
+		assert.Equal(t, "aal1", resp.Msg.GetAssuranceLevel())
+	})
+
+	t.Run("raises the session once the code is verified", func(t *testing.T) {
+		h, ms, ss := setup(t)
+		ms.EXPECT().Verify(mock.Anything, userID, "123456").Return(nil)
+		ss.EXPECT().SetAssuranceLevel(mock.Anything, pendingSession.ID, frontiersession.AssuranceLevelMultiFactor).Return(nil)
+
+		_, err := h.VerifyMFA(context.Background(), connect.NewRequest(&frontierv1beta1.VerifyMFARequest{Code: "123456"}))
+		require.NoError(t, err)
+	})
+
+	t.Run("keeps the session on an invalid code", func(t *testing.T) {
+		h, ms, _ := setup(t)
+		ms.EXPECT().Verify(mock.Anything, userID, "000000").Return(mfa.ErrInvalidCode)
+
+		_, err := h.VerifyMFA(context.Background(), connect.NewRequest(&frontierv1beta1.VerifyMFARequest{Code: "000000"}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("returns the recovery codes once the enrollment is confirmed", func(t *testing.T) {
+		h, ms, ss := setup(t)
+		ms.EXPECT().Confirm(mock.Anything, userID, "123456").Return([]string{"code-1", "code-2"}, nil)
+		ss.EXPECT().SetAssuranceLevel(mock.Anything, pendingSession.ID, frontiersession.AssuranceLevelMultiFactor).Return(nil)
+
+		resp, err := h.ConfirmTOTP(context.Background(), connect.NewRequest(&frontierv1beta1.ConfirmTOTPRequest{Code: "123456"}))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"code-1", "code-2"}, resp.Msg.GetRecoveryCodes())
+		assert.Equal(t, "no-store", resp.Header().Get("Cache-Control"))
+	})
+}
@@ -4,13 +4,18 @@ package mocks
 
 import (
 	context "context"
+
 	io "io"
 
 	mock "github.com/stretchr/testify/mock"
 
+	rql "github.com/raystack/salt/rql"
+
+	auditrecord "github.com/raystack/frontier/core/auditrecord"
+
 	models "github.com/raystack/frontier/core/auditrecord/models"
 
-	rql "github.com/raystack/salt/rql"
+	time "time"
 )
 
 // AuditRecordService is an autogenerated mock type for the AuditRecordService type
@@ -213,6 +218,65 @@ func (_c *AuditRecordService_List_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// VerifyChain provides a mock function with given fields: ctx, orgID, since, until
+func (_m *AuditRecordService) VerifyChain(ctx context.Context, orgID string, since time.Time, until time.Time) (auditrecord.ChainVerification, error) {
+	ret := _m.Called(ctx, orgID, since, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyChain")
+	}
+
+	var r0 auditrecord.ChainVerification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) (auditrecord.ChainVerification, error)); ok {
+		return rf(ctx, orgID, since, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) auditrecord.ChainVerification); ok {
+		r0 = rf(ctx, orgID, since, until)
+	} else {
+		r0 = ret.Get(0).(auditrecord.ChainVerification)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, orgID, since, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditRecordService_VerifyChain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyChain'
+type AuditRecordService_VerifyChain_Call struct {
+	*mock.Call
+}
+
+// VerifyChain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orgID string
+//   - since time.Time
+//   - until time.Time
+func (_e *AuditRecordService_Expecter) VerifyChain(ctx interface{}, orgID interface{}, since interface{}, until interface{}) *AuditRecordService_VerifyChain_Call {
+	return &AuditRecordService_VerifyChain_Call{Call: _e.mock.On("VerifyChain", ctx, orgID, since, until)}
+}
+
+func (_c *AuditRecordService_VerifyChain_Call) Run(run func(ctx context.Context, orgID string, since time.Time, until time.Time)) *AuditRecordService_VerifyChain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AuditRecordService_VerifyChain_Call) Return(_a0 auditrecord.ChainVerification, _a1 error) *AuditRecordService_VerifyChain_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditRecordService_VerifyChain_Call) RunAndReturn(run func(context.Context, string, time.Time, time.Time) (auditrecord.ChainVerification, error)) *AuditRecordService_VerifyChain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAuditRecordService creates a new instance of AuditRecordService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuditRecordService(t interface {
@@ -309,6 +309,52 @@ func (_c *AuthnService_JWKs_Call) RunAndReturn(run func(context.Context) jwk.Set
 	return _c
 }
 
+// RevokeToken provides a mock function with given fields: ctx
+func (_m *AuthnService) RevokeToken(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthnService_RevokeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeToken'
+type AuthnService_RevokeToken_Call struct {
+	*mock.Call
+}
+
+// RevokeToken is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuthnService_Expecter) RevokeToken(ctx interface{}) *AuthnService_RevokeToken_Call {
+	return &AuthnService_RevokeToken_Call{Call: _e.mock.On("RevokeToken", ctx)}
+}
+
+func (_c *AuthnService_RevokeToken_Call) Run(run func(ctx context.Context)) *AuthnService_RevokeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuthnService_RevokeToken_Call) Return(_a0 error) *AuthnService_RevokeToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthnService_RevokeToken_Call) RunAndReturn(run func(context.Context) error) *AuthnService_RevokeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SanitizeCallbackURL provides a mock function with given fields: url
 func (_m *AuthnService) SanitizeCallbackURL(url string) string {
 	ret := _m.Called(url)
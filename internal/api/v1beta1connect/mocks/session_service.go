@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	session "github.com/raystack/frontier/core/authenticate/session"
+
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -24,9 +25,9 @@ func (_m *SessionService) EXPECT() *SessionService_Expecter {
 	return &SessionService_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, userID, metadata
-func (_m *SessionService) Create(ctx context.Context, userID string, metadata session.SessionMetadata) (*session.Session, error) {
-	ret := _m.Called(ctx, userID, metadata)
+// Create provides a mock function with given fields: ctx, userID, metadata, mfaRequired
+func (_m *SessionService) Create(ctx context.Context, userID string, metadata session.SessionMetadata, mfaRequired bool) (*session.Session, error) {
+	ret := _m.Called(ctx, userID, metadata, mfaRequired)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -34,19 +35,19 @@ func (_m *SessionService) Create(ctx context.Context, userID string, metadata se
 
 	var r0 *session.Session
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, session.SessionMetadata) (*session.Session, error)); ok {
-		return rf(ctx, userID, metadata)
+	if rf, ok := ret.Get(0).(func(context.Context, string, session.SessionMetadata, bool) (*session.Session, error)); ok {
+		return rf(ctx, userID, metadata, mfaRequired)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, session.SessionMetadata) *session.Session); ok {
-		r0 = rf(ctx, userID, metadata)
+	if rf, ok := ret.Get(0).(func(context.Context, string, session.SessionMetadata, bool) *session.Session); ok {
+		r0 = rf(ctx, userID, metadata, mfaRequired)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*session.Session)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, session.SessionMetadata) error); ok {
-		r1 = rf(ctx, userID, metadata)
+	if rf, ok := ret.Get(1).(func(context.Context, string, session.SessionMetadata, bool) error); ok {
+		r1 = rf(ctx, userID, metadata, mfaRequired)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -63,13 +64,14 @@ type SessionService_Create_Call struct {
 //   - ctx context.Context
 //   - userID string
 //   - metadata session.SessionMetadata
-func (_e *SessionService_Expecter) Create(ctx interface{}, userID interface{}, metadata interface{}) *SessionService_Create_Call {
-	return &SessionService_Create_Call{Call: _e.mock.On("Create", ctx, userID, metadata)}
+//   - mfaRequired bool
+func (_e *SessionService_Expecter) Create(ctx interface{}, userID interface{}, metadata interface{}, mfaRequired interface{}) *SessionService_Create_Call {
+	return &SessionService_Create_Call{Call: _e.mock.On("Create", ctx, userID, metadata, mfaRequired)}
 }
 
-func (_c *SessionService_Create_Call) Run(run func(ctx context.Context, userID string, metadata session.SessionMetadata)) *SessionService_Create_Call {
+func (_c *SessionService_Create_Call) Run(run func(ctx context.Context, userID string, metadata session.SessionMetadata, mfaRequired bool)) *SessionService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(session.SessionMetadata))
+		run(args[0].(context.Context), args[1].(string), args[2].(session.SessionMetadata), args[3].(bool))
 	})
 	return _c
 }
@@ -79,7 +81,7 @@ func (_c *SessionService_Create_Call) Return(_a0 *session.Session, _a1 error) *S
 	return _c
 }
 
-func (_c *SessionService_Create_Call) RunAndReturn(run func(context.Context, string, session.SessionMetadata) (*session.Session, error)) *SessionService_Create_Call {
+func (_c *SessionService_Create_Call) RunAndReturn(run func(context.Context, string, session.SessionMetadata, bool) (*session.Session, error)) *SessionService_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -355,6 +357,53 @@ func (_c *SessionService_Ping_Call) RunAndReturn(run func(context.Context, uuid.
 	return _c
 }
 
+// Reauthenticate provides a mock function with given fields: ctx, sessionID
+func (_m *SessionService) Reauthenticate(ctx context.Context, sessionID uuid.UUID) error {
+	ret := _m.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reauthenticate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionService_Reauthenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reauthenticate'
+type SessionService_Reauthenticate_Call struct {
+	*mock.Call
+}
+
+// Reauthenticate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+func (_e *SessionService_Expecter) Reauthenticate(ctx interface{}, sessionID interface{}) *SessionService_Reauthenticate_Call {
+	return &SessionService_Reauthenticate_Call{Call: _e.mock.On("Reauthenticate", ctx, sessionID)}
+}
+
+func (_c *SessionService_Reauthenticate_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *SessionService_Reauthenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SessionService_Reauthenticate_Call) Return(_a0 error) *SessionService_Reauthenticate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionService_Reauthenticate_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *SessionService_Reauthenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Refresh provides a mock function with given fields: ctx, sessionID
 func (_m *SessionService) Refresh(ctx context.Context, sessionID uuid.UUID) error {
 	ret := _m.Called(ctx, sessionID)
@@ -402,6 +451,54 @@ func (_c *SessionService_Refresh_Call) RunAndReturn(run func(context.Context, uu
 	return _c
 }
 
+// SetAssuranceLevel provides a mock function with given fields: ctx, sessionID, level
+func (_m *SessionService) SetAssuranceLevel(ctx context.Context, sessionID uuid.UUID, level session.AssuranceLevel) error {
+	ret := _m.Called(ctx, sessionID, level)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAssuranceLevel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, session.AssuranceLevel) error); ok {
+		r0 = rf(ctx, sessionID, level)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionService_SetAssuranceLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAssuranceLevel'
+type SessionService_SetAssuranceLevel_Call struct {
+	*mock.Call
+}
+
+// SetAssuranceLevel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+//   - level session.AssuranceLevel
+func (_e *SessionService_Expecter) SetAssuranceLevel(ctx interface{}, sessionID interface{}, level interface{}) *SessionService_SetAssuranceLevel_Call {
+	return &SessionService_SetAssuranceLevel_Call{Call: _e.mock.On("SetAssuranceLevel", ctx, sessionID, level)}
+}
+
+func (_c *SessionService_SetAssuranceLevel_Call) Run(run func(ctx context.Context, sessionID uuid.UUID, level session.AssuranceLevel)) *SessionService_SetAssuranceLevel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(session.AssuranceLevel))
+	})
+	return _c
+}
+
+func (_c *SessionService_SetAssuranceLevel_Call) Return(_a0 error) *SessionService_SetAssuranceLevel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionService_SetAssuranceLevel_Call) RunAndReturn(run func(context.Context, uuid.UUID, session.AssuranceLevel) error) *SessionService_SetAssuranceLevel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewSessionService creates a new instance of SessionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSessionService(t interface {
@@ -127,6 +127,238 @@ func (_c *WebhookService_DeleteEndpoint_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetDelivery provides a mock function with given fields: ctx, id
+func (_m *WebhookService) GetDelivery(ctx context.Context, id string) (webhook.Delivery, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelivery")
+	}
+
+	var r0 webhook.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (webhook.Delivery, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) webhook.Delivery); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(webhook.Delivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_GetDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelivery'
+type WebhookService_GetDelivery_Call struct {
+	*mock.Call
+}
+
+// GetDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *WebhookService_Expecter) GetDelivery(ctx interface{}, id interface{}) *WebhookService_GetDelivery_Call {
+	return &WebhookService_GetDelivery_Call{Call: _e.mock.On("GetDelivery", ctx, id)}
+}
+
+func (_c *WebhookService_GetDelivery_Call) Run(run func(ctx context.Context, id string)) *WebhookService_GetDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_GetDelivery_Call) Return(_a0 webhook.Delivery, _a1 error) *WebhookService_GetDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_GetDelivery_Call) RunAndReturn(run func(context.Context, string) (webhook.Delivery, error)) *WebhookService_GetDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEndpoint provides a mock function with given fields: ctx, id
+func (_m *WebhookService) GetEndpoint(ctx context.Context, id string) (webhook.Endpoint, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEndpoint")
+	}
+
+	var r0 webhook.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (webhook.Endpoint, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) webhook.Endpoint); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(webhook.Endpoint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_GetEndpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEndpoint'
+type WebhookService_GetEndpoint_Call struct {
+	*mock.Call
+}
+
+// GetEndpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *WebhookService_Expecter) GetEndpoint(ctx interface{}, id interface{}) *WebhookService_GetEndpoint_Call {
+	return &WebhookService_GetEndpoint_Call{Call: _e.mock.On("GetEndpoint", ctx, id)}
+}
+
+func (_c *WebhookService_GetEndpoint_Call) Run(run func(ctx context.Context, id string)) *WebhookService_GetEndpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_GetEndpoint_Call) Return(_a0 webhook.Endpoint, _a1 error) *WebhookService_GetEndpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_GetEndpoint_Call) RunAndReturn(run func(context.Context, string) (webhook.Endpoint, error)) *WebhookService_GetEndpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeliveries provides a mock function with given fields: ctx, filter
+func (_m *WebhookService) ListDeliveries(ctx context.Context, filter webhook.DeliveryFilter) ([]webhook.Delivery, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 []webhook.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.DeliveryFilter) ([]webhook.Delivery, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.DeliveryFilter) []webhook.Delivery); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhook.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, webhook.DeliveryFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ListDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeliveries'
+type WebhookService_ListDeliveries_Call struct {
+	*mock.Call
+}
+
+// ListDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter webhook.DeliveryFilter
+func (_e *WebhookService_Expecter) ListDeliveries(ctx interface{}, filter interface{}) *WebhookService_ListDeliveries_Call {
+	return &WebhookService_ListDeliveries_Call{Call: _e.mock.On("ListDeliveries", ctx, filter)}
+}
+
+func (_c *WebhookService_ListDeliveries_Call) Run(run func(ctx context.Context, filter webhook.DeliveryFilter)) *WebhookService_ListDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhook.DeliveryFilter))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ListDeliveries_Call) Return(_a0 []webhook.Delivery, _a1 error) *WebhookService_ListDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_ListDeliveries_Call) RunAndReturn(run func(context.Context, webhook.DeliveryFilter) ([]webhook.Delivery, error)) *WebhookService_ListDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeliveryAttempts provides a mock function with given fields: ctx, deliveryID
+func (_m *WebhookService) ListDeliveryAttempts(ctx context.Context, deliveryID string) ([]webhook.Attempt, error) {
+	ret := _m.Called(ctx, deliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveryAttempts")
+	}
+
+	var r0 []webhook.Attempt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]webhook.Attempt, error)); ok {
+		return rf(ctx, deliveryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []webhook.Attempt); ok {
+		r0 = rf(ctx, deliveryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhook.Attempt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_ListDeliveryAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeliveryAttempts'
+type WebhookService_ListDeliveryAttempts_Call struct {
+	*mock.Call
+}
+
+// ListDeliveryAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveryID string
+func (_e *WebhookService_Expecter) ListDeliveryAttempts(ctx interface{}, deliveryID interface{}) *WebhookService_ListDeliveryAttempts_Call {
+	return &WebhookService_ListDeliveryAttempts_Call{Call: _e.mock.On("ListDeliveryAttempts", ctx, deliveryID)}
+}
+
+func (_c *WebhookService_ListDeliveryAttempts_Call) Run(run func(ctx context.Context, deliveryID string)) *WebhookService_ListDeliveryAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_ListDeliveryAttempts_Call) Return(_a0 []webhook.Attempt, _a1 error) *WebhookService_ListDeliveryAttempts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_ListDeliveryAttempts_Call) RunAndReturn(run func(context.Context, string) ([]webhook.Attempt, error)) *WebhookService_ListDeliveryAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListEndpoints provides a mock function with given fields: ctx, filter
 func (_m *WebhookService) ListEndpoints(ctx context.Context, filter webhook.EndpointFilter) ([]webhook.Endpoint, error) {
 	ret := _m.Called(ctx, filter)
@@ -186,6 +418,272 @@ func (_c *WebhookService_ListEndpoints_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// Redeliver provides a mock function with given fields: ctx, id
+func (_m *WebhookService) Redeliver(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Redeliver")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookService_Redeliver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Redeliver'
+type WebhookService_Redeliver_Call struct {
+	*mock.Call
+}
+
+// Redeliver is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *WebhookService_Expecter) Redeliver(ctx interface{}, id interface{}) *WebhookService_Redeliver_Call {
+	return &WebhookService_Redeliver_Call{Call: _e.mock.On("Redeliver", ctx, id)}
+}
+
+func (_c *WebhookService_Redeliver_Call) Run(run func(ctx context.Context, id string)) *WebhookService_Redeliver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_Redeliver_Call) Return(_a0 error) *WebhookService_Redeliver_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookService_Redeliver_Call) RunAndReturn(run func(context.Context, string) error) *WebhookService_Redeliver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedeliverRange provides a mock function with given fields: ctx, filter
+func (_m *WebhookService) RedeliverRange(ctx context.Context, filter webhook.DeliveryFilter) (int, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedeliverRange")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.DeliveryFilter) (int, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.DeliveryFilter) int); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, webhook.DeliveryFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_RedeliverRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedeliverRange'
+type WebhookService_RedeliverRange_Call struct {
+	*mock.Call
+}
+
+// RedeliverRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter webhook.DeliveryFilter
+func (_e *WebhookService_Expecter) RedeliverRange(ctx interface{}, filter interface{}) *WebhookService_RedeliverRange_Call {
+	return &WebhookService_RedeliverRange_Call{Call: _e.mock.On("RedeliverRange", ctx, filter)}
+}
+
+func (_c *WebhookService_RedeliverRange_Call) Run(run func(ctx context.Context, filter webhook.DeliveryFilter)) *WebhookService_RedeliverRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(webhook.DeliveryFilter))
+	})
+	return _c
+}
+
+func (_c *WebhookService_RedeliverRange_Call) Return(_a0 int, _a1 error) *WebhookService_RedeliverRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_RedeliverRange_Call) RunAndReturn(run func(context.Context, webhook.DeliveryFilter) (int, error)) *WebhookService_RedeliverRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSecret provides a mock function with given fields: ctx, endpointID, secretID
+func (_m *WebhookService) RevokeSecret(ctx context.Context, endpointID string, secretID string) error {
+	ret := _m.Called(ctx, endpointID, secretID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSecret")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, endpointID, secretID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookService_RevokeSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSecret'
+type WebhookService_RevokeSecret_Call struct {
+	*mock.Call
+}
+
+// RevokeSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointID string
+//   - secretID string
+func (_e *WebhookService_Expecter) RevokeSecret(ctx interface{}, endpointID interface{}, secretID interface{}) *WebhookService_RevokeSecret_Call {
+	return &WebhookService_RevokeSecret_Call{Call: _e.mock.On("RevokeSecret", ctx, endpointID, secretID)}
+}
+
+func (_c *WebhookService_RevokeSecret_Call) Run(run func(ctx context.Context, endpointID string, secretID string)) *WebhookService_RevokeSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_RevokeSecret_Call) Return(_a0 error) *WebhookService_RevokeSecret_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookService_RevokeSecret_Call) RunAndReturn(run func(context.Context, string, string) error) *WebhookService_RevokeSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSecret provides a mock function with given fields: ctx, endpointID
+func (_m *WebhookService) RotateSecret(ctx context.Context, endpointID string) (webhook.Secret, error) {
+	ret := _m.Called(ctx, endpointID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSecret")
+	}
+
+	var r0 webhook.Secret
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (webhook.Secret, error)); ok {
+		return rf(ctx, endpointID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) webhook.Secret); ok {
+		r0 = rf(ctx, endpointID)
+	} else {
+		r0 = ret.Get(0).(webhook.Secret)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, endpointID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_RotateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSecret'
+type WebhookService_RotateSecret_Call struct {
+	*mock.Call
+}
+
+// RotateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointID string
+func (_e *WebhookService_Expecter) RotateSecret(ctx interface{}, endpointID interface{}) *WebhookService_RotateSecret_Call {
+	return &WebhookService_RotateSecret_Call{Call: _e.mock.On("RotateSecret", ctx, endpointID)}
+}
+
+func (_c *WebhookService_RotateSecret_Call) Run(run func(ctx context.Context, endpointID string)) *WebhookService_RotateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_RotateSecret_Call) Return(_a0 webhook.Secret, _a1 error) *WebhookService_RotateSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_RotateSecret_Call) RunAndReturn(run func(context.Context, string) (webhook.Secret, error)) *WebhookService_RotateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendTestEvent provides a mock function with given fields: ctx, endpointID
+func (_m *WebhookService) SendTestEvent(ctx context.Context, endpointID string) (webhook.Attempt, error) {
+	ret := _m.Called(ctx, endpointID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendTestEvent")
+	}
+
+	var r0 webhook.Attempt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (webhook.Attempt, error)); ok {
+		return rf(ctx, endpointID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) webhook.Attempt); ok {
+		r0 = rf(ctx, endpointID)
+	} else {
+		r0 = ret.Get(0).(webhook.Attempt)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, endpointID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_SendTestEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendTestEvent'
+type WebhookService_SendTestEvent_Call struct {
+	*mock.Call
+}
+
+// SendTestEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointID string
+func (_e *WebhookService_Expecter) SendTestEvent(ctx interface{}, endpointID interface{}) *WebhookService_SendTestEvent_Call {
+	return &WebhookService_SendTestEvent_Call{Call: _e.mock.On("SendTestEvent", ctx, endpointID)}
+}
+
+func (_c *WebhookService_SendTestEvent_Call) Run(run func(ctx context.Context, endpointID string)) *WebhookService_SendTestEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_SendTestEvent_Call) Return(_a0 webhook.Attempt, _a1 error) *WebhookService_SendTestEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_SendTestEvent_Call) RunAndReturn(run func(context.Context, string) (webhook.Attempt, error)) *WebhookService_SendTestEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateEndpoint provides a mock function with given fields: ctx, endpoint
 func (_m *WebhookService) UpdateEndpoint(ctx context.Context, endpoint webhook.Endpoint) (webhook.Endpoint, error) {
 	ret := _m.Called(ctx, endpoint)
@@ -243,6 +741,122 @@ func (_c *WebhookService_UpdateEndpoint_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// UpdateFilters provides a mock function with given fields: ctx, endpointID, filters
+func (_m *WebhookService) UpdateFilters(ctx context.Context, endpointID string, filters map[string][]string) (webhook.Endpoint, error) {
+	ret := _m.Called(ctx, endpointID, filters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFilters")
+	}
+
+	var r0 webhook.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string][]string) (webhook.Endpoint, error)); ok {
+		return rf(ctx, endpointID, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string][]string) webhook.Endpoint); ok {
+		r0 = rf(ctx, endpointID, filters)
+	} else {
+		r0 = ret.Get(0).(webhook.Endpoint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, map[string][]string) error); ok {
+		r1 = rf(ctx, endpointID, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_UpdateFilters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFilters'
+type WebhookService_UpdateFilters_Call struct {
+	*mock.Call
+}
+
+// UpdateFilters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointID string
+//   - filters map[string][]string
+func (_e *WebhookService_Expecter) UpdateFilters(ctx interface{}, endpointID interface{}, filters interface{}) *WebhookService_UpdateFilters_Call {
+	return &WebhookService_UpdateFilters_Call{Call: _e.mock.On("UpdateFilters", ctx, endpointID, filters)}
+}
+
+func (_c *WebhookService_UpdateFilters_Call) Run(run func(ctx context.Context, endpointID string, filters map[string][]string)) *WebhookService_UpdateFilters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(map[string][]string))
+	})
+	return _c
+}
+
+func (_c *WebhookService_UpdateFilters_Call) Return(_a0 webhook.Endpoint, _a1 error) *WebhookService_UpdateFilters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_UpdateFilters_Call) RunAndReturn(run func(context.Context, string, map[string][]string) (webhook.Endpoint, error)) *WebhookService_UpdateFilters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePayloadFormat provides a mock function with given fields: ctx, endpointID, format
+func (_m *WebhookService) UpdatePayloadFormat(ctx context.Context, endpointID string, format webhook.PayloadFormat) (webhook.Endpoint, error) {
+	ret := _m.Called(ctx, endpointID, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePayloadFormat")
+	}
+
+	var r0 webhook.Endpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, webhook.PayloadFormat) (webhook.Endpoint, error)); ok {
+		return rf(ctx, endpointID, format)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, webhook.PayloadFormat) webhook.Endpoint); ok {
+		r0 = rf(ctx, endpointID, format)
+	} else {
+		r0 = ret.Get(0).(webhook.Endpoint)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, webhook.PayloadFormat) error); ok {
+		r1 = rf(ctx, endpointID, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookService_UpdatePayloadFormat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePayloadFormat'
+type WebhookService_UpdatePayloadFormat_Call struct {
+	*mock.Call
+}
+
+// UpdatePayloadFormat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - endpointID string
+//   - format webhook.PayloadFormat
+func (_e *WebhookService_Expecter) UpdatePayloadFormat(ctx interface{}, endpointID interface{}, format interface{}) *WebhookService_UpdatePayloadFormat_Call {
+	return &WebhookService_UpdatePayloadFormat_Call{Call: _e.mock.On("UpdatePayloadFormat", ctx, endpointID, format)}
+}
+
+func (_c *WebhookService_UpdatePayloadFormat_Call) Run(run func(ctx context.Context, endpointID string, format webhook.PayloadFormat)) *WebhookService_UpdatePayloadFormat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(webhook.PayloadFormat))
+	})
+	return _c
+}
+
+func (_c *WebhookService_UpdatePayloadFormat_Call) Return(_a0 webhook.Endpoint, _a1 error) *WebhookService_UpdatePayloadFormat_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookService_UpdatePayloadFormat_Call) RunAndReturn(run func(context.Context, string, webhook.PayloadFormat) (webhook.Endpoint, error)) *WebhookService_UpdatePayloadFormat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewWebhookService creates a new instance of WebhookService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebhookService(t interface {
@@ -0,0 +1,117 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func oauthClientErrCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, oidcprovider.ErrClientNotFound):
+		return connect.CodeNotFound
+	case errors.Is(err, oidcprovider.ErrInvalidClient):
+		return connect.CodeInvalidArgument
+	default:
+		return connect.CodeInternal
+	}
+}
+
+// GetOrgIDFromOAuthClientID returns the organization owning the client
+func (h *ConnectHandler) GetOrgIDFromOAuthClientID(ctx context.Context, clientID string) (string, error) {
+	client, err := h.oidcProviderService.GetClient(ctx, clientID)
+	if err != nil {
+		return "", connect.NewError(oauthClientErrCode(err), fmt.Errorf("GetOrgIDFromOAuthClientID: client_id=%s: %w", clientID, err))
+	}
+	return client.OrgID, nil
+}
+
+func (h *ConnectHandler) CreateOrganizationOAuthClient(ctx context.Context, request *connect.Request[frontierv1beta1.CreateOrganizationOAuthClientRequest]) (*connect.Response[frontierv1beta1.CreateOrganizationOAuthClientResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	if !h.oidcProviderService.Enabled() {
+		return nil, connect.NewError(connect.CodeUnimplemented, oidcprovider.ErrDisabled)
+	}
+	client, secret, err := h.oidcProviderService.CreateClient(ctx, oidcprovider.Client{
+		OrgID:        request.Msg.GetOrgId(),
+		Name:         request.Msg.GetName(),
+		RedirectURIs: request.Msg.GetRedirectUris(),
+		Scopes:       request.Msg.GetScopes(),
+		Public:       request.Msg.GetPublic(),
+	})
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "CreateOrganizationOAuthClient.CreateClient", err,
+			"org_id", request.Msg.GetOrgId())
+		return nil, connect.NewError(oauthClientErrCode(err), fmt.Errorf("CreateOrganizationOAuthClient: org_id=%s: %w", request.Msg.GetOrgId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.CreateOrganizationOAuthClientResponse{
+		Client:       toProtoOAuthClient(client),
+		ClientSecret: secret,
+	}), nil
+}
+
+func (h *ConnectHandler) ListOrganizationOAuthClients(ctx context.Context, request *connect.Request[frontierv1beta1.ListOrganizationOAuthClientsRequest]) (*connect.Response[frontierv1beta1.ListOrganizationOAuthClientsResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	if !h.oidcProviderService.Enabled() {
+		return nil, connect.NewError(connect.CodeUnimplemented, oidcprovider.ErrDisabled)
+	}
+	clients, err := h.oidcProviderService.ListClients(ctx, request.Msg.GetOrgId())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "ListOrganizationOAuthClients.ListClients", err,
+			"org_id", request.Msg.GetOrgId())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("ListOrganizationOAuthClients: org_id=%s: %w", request.Msg.GetOrgId(), err))
+	}
+	pbClients := make([]*frontierv1beta1.OrganizationOAuthClient, 0, len(clients))
+	for _, client := range clients {
+		pbClients = append(pbClients, toProtoOAuthClient(client))
+	}
+	return connect.NewResponse(&frontierv1beta1.ListOrganizationOAuthClientsResponse{Clients: pbClients}), nil
+}
+
+func (h *ConnectHandler) RotateOrganizationOAuthClientSecret(ctx context.Context, request *connect.Request[frontierv1beta1.RotateOrganizationOAuthClientSecretRequest]) (*connect.Response[frontierv1beta1.RotateOrganizationOAuthClientSecretResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	if !h.oidcProviderService.Enabled() {
+		return nil, connect.NewError(connect.CodeUnimplemented, oidcprovider.ErrDisabled)
+	}
+	secret, err := h.oidcProviderService.RotateClientSecret(ctx, request.Msg.GetId())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "RotateOrganizationOAuthClientSecret.RotateClientSecret", err,
+			"client_id", request.Msg.GetId())
+		return nil, connect.NewError(oauthClientErrCode(err), fmt.Errorf("RotateOrganizationOAuthClientSecret: client_id=%s: %w", request.Msg.GetId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.RotateOrganizationOAuthClientSecretResponse{ClientSecret: secret}), nil
+}
+
+func (h *ConnectHandler) DeleteOrganizationOAuthClient(ctx context.Context, request *connect.Request[frontierv1beta1.DeleteOrganizationOAuthClientRequest]) (*connect.Response[frontierv1beta1.DeleteOrganizationOAuthClientResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	if !h.oidcProviderService.Enabled() {
+		return nil, connect.NewError(connect.CodeUnimplemented, oidcprovider.ErrDisabled)
+	}
+	if err := h.oidcProviderService.DeleteClient(ctx, request.Msg.GetId()); err != nil {
+		errorLogger.LogServiceError(ctx, request, "DeleteOrganizationOAuthClient.DeleteClient", err,
+			"client_id", request.Msg.GetId())
+		return nil, connect.NewError(oauthClientErrCode(err), fmt.Errorf("DeleteOrganizationOAuthClient: client_id=%s: %w", request.Msg.GetId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.DeleteOrganizationOAuthClientResponse{}), nil
+}
+
+func toProtoOAuthClient(client oidcprovider.Client) *frontierv1beta1.OrganizationOAuthClient {
+	return &frontierv1beta1.OrganizationOAuthClient{
+		Id:           client.ID,
+		OrgId:        client.OrgID,
+		Name:         client.Name,
+		RedirectUris: client.RedirectURIs,
+		Scopes:       client.Scopes,
+		Public:       client.Public,
+		CreatedAt:    timestamppb.New(client.CreatedAt),
+		UpdatedAt:    timestamppb.New(client.UpdatedAt),
+	}
+}
@@ -0,0 +1,123 @@
+package v1beta1connect
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_OAuthClient(t *testing.T) {
+	orgID := uuid.New().String()
+	clientID := uuid.New().String()
+
+	setup := func(t *testing.T) (*ConnectHandler, *mocks.OIDCProviderService) {
+		ops := mocks.NewOIDCProviderService(t)
+		ops.EXPECT().Enabled().Return(true).Maybe()
+		return &ConnectHandler{oidcProviderService: ops}, ops
+	}
+
+	t.Run("creates a client of the organization and returns its secret", func(t *testing.T) {
+		h, ops := setup(t)
+		ops.EXPECT().CreateClient(mock.Anything, oidcprovider.Client{
+			OrgID:        orgID,
+			Name:         "wiki",
+			RedirectURIs: []string{"https://wiki.example.com/callback"},
+		}).Return(oidcprovider.Client{
+			ID:           clientID,
+			OrgID:        orgID,
+			Name:         "wiki",
+			RedirectURIs: []string{"https://wiki.example.com/callback"},
+			Scopes:       []string{"openid", "profile", "email"},
+		}, "secret", nil)
+
+		resp, err := h.CreateOrganizationOAuthClient(context.Background(), connect.NewRequest(&frontierv1beta1.CreateOrganizationOAuthClientRequest{
+			OrgId:        orgID,
+			Name:         "wiki",
+			RedirectUris: []string{"https://wiki.example.com/callback"},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, clientID, resp.Msg.GetClient().GetId())
+		assert.Equal(t, []string{"openid", "profile", "email"}, resp.Msg.GetClient().GetScopes())
+		assert.Equal(t, "secret", resp.Msg.GetClientSecret())
+	})
+
+	t.Run("rejects an invalid client", func(t *testing.T) {
+		h, ops := setup(t)
+		ops.EXPECT().CreateClient(mock.Anything, mock.Anything).
+			Return(oidcprovider.Client{}, "", fmt.Errorf("%w: unsupported scope", oidcprovider.ErrInvalidClient))
+
+		_, err := h.CreateOrganizationOAuthClient(context.Background(), connect.NewRequest(&frontierv1beta1.CreateOrganizationOAuthClientRequest{
+			OrgId:        orgID,
+			Name:         "wiki",
+			RedirectUris: []string{"https://wiki.example.com/callback"},
+			Scopes:       []string{"admin"},
+		}))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	t.Run("lists the clients of the organization", func(t *testing.T) {
+		h, ops := setup(t)
+		ops.EXPECT().ListClients(mock.Anything, orgID).Return([]oidcprovider.Client{
+			{ID: clientID, OrgID: orgID, Name: "wiki"},
+		}, nil)
+
+		resp, err := h.ListOrganizationOAuthClients(context.Background(), connect.NewRequest(&frontierv1beta1.ListOrganizationOAuthClientsRequest{
+			OrgId: orgID,
+		}))
+		require.NoError(t, err)
+		require.Len(t, resp.Msg.GetClients(), 1)
+		assert.Equal(t, "wiki", resp.Msg.GetClients()[0].GetName())
+	})
+
+	t.Run("rotates the secret of a client", func(t *testing.T) {
+		h, ops := setup(t)
+		ops.EXPECT().RotateClientSecret(mock.Anything, clientID).Return("new-secret", nil)
+
+		resp, err := h.RotateOrganizationOAuthClientSecret(context.Background(), connect.NewRequest(&frontierv1beta1.RotateOrganizationOAuthClientSecretRequest{
+			OrgId: orgID,
+			Id:    clientID,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "new-secret", resp.Msg.GetClientSecret())
+	})
+
+	t.Run("reports an unknown client on delete", func(t *testing.T) {
+		h, ops := setup(t)
+		ops.EXPECT().DeleteClient(mock.Anything, clientID).Return(oidcprovider.ErrClientNotFound)
+
+		_, err := h.DeleteOrganizationOAuthClient(context.Background(), connect.NewRequest(&frontierv1beta1.DeleteOrganizationOAuthClientRequest{
+			OrgId: orgID,
+			Id:    clientID,
+		}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("returns the organization of a client", func(t *testing.T) {
+		h, ops := setup(t)
+		ops.EXPECT().GetClient(mock.Anything, clientID).Return(oidcprovider.Client{ID: clientID, OrgID: orgID}, nil)
+
+		clientOrgID, err := h.GetOrgIDFromOAuthClientID(context.Background(), clientID)
+		require.NoError(t, err)
+		assert.Equal(t, orgID, clientOrgID)
+	})
+
+	t.Run("fails when the openid provider is disabled", func(t *testing.T) {
+		ops := mocks.NewOIDCProviderService(t)
+		ops.EXPECT().Enabled().Return(false)
+		h := &ConnectHandler{oidcProviderService: ops}
+
+		_, err := h.ListOrganizationOAuthClients(context.Background(), connect.NewRequest(&frontierv1beta1.ListOrganizationOAuthClientsRequest{
+			OrgId: orgID,
+		}))
+		assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+	})
+}
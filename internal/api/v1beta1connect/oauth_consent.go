@@ -0,0 +1,68 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+)
+
+func oauthConsentErrCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, oidcprovider.ErrAuthorizationNotFound),
+		errors.Is(err, oidcprovider.ErrClientNotFound):
+		return connect.CodeNotFound
+	default:
+		return connect.CodeInternal
+	}
+}
+
+func (h *ConnectHandler) GetOAuthConsentRequest(ctx context.Context, request *connect.Request[frontierv1beta1.GetOAuthConsentRequestRequest]) (*connect.Response[frontierv1beta1.GetOAuthConsentRequestResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	if !h.oidcProviderService.Enabled() {
+		return nil, connect.NewError(connect.CodeUnimplemented, oidcprovider.ErrDisabled)
+	}
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	consentRequest, err := h.oidcProviderService.GetConsentRequest(ctx, request.Msg.GetAuthorizationId(), userID)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "GetOAuthConsentRequest.GetConsentRequest", err,
+			"authorization_id", request.Msg.GetAuthorizationId())
+		return nil, connect.NewError(oauthConsentErrCode(err), fmt.Errorf("GetOAuthConsentRequest: authorization_id=%s: %w", request.Msg.GetAuthorizationId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.GetOAuthConsentRequestResponse{
+		ConsentRequest: &frontierv1beta1.OAuthConsentRequest{
+			AuthorizationId: consentRequest.AuthorizationID,
+			ClientId:        consentRequest.ClientID,
+			ClientName:      consentRequest.ClientName,
+			OrgId:           consentRequest.OrgID,
+			Scopes:          consentRequest.Scopes,
+			RedirectUri:     consentRequest.RedirectURI,
+		},
+	}), nil
+}
+
+func (h *ConnectHandler) DecideOAuthConsent(ctx context.Context, request *connect.Request[frontierv1beta1.DecideOAuthConsentRequest]) (*connect.Response[frontierv1beta1.DecideOAuthConsentResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	if !h.oidcProviderService.Enabled() {
+		return nil, connect.NewError(connect.CodeUnimplemented, oidcprovider.ErrDisabled)
+	}
+	userID, err := h.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	redirectTo, err := h.oidcProviderService.Consent(ctx, request.Msg.GetAuthorizationId(), userID, request.Msg.GetApprove())
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "DecideOAuthConsent.Consent", err,
+			"authorization_id", request.Msg.GetAuthorizationId())
+		return nil, connect.NewError(oauthConsentErrCode(err), fmt.Errorf("DecideOAuthConsent: authorization_id=%s: %w", request.Msg.GetAuthorizationId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.DecideOAuthConsentResponse{RedirectTo: redirectTo}), nil
+}
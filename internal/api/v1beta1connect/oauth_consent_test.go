@@ -0,0 +1,83 @@
+package v1beta1connect
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_OAuthConsent(t *testing.T) {
+	userID := uuid.New().String()
+	authorizationID := uuid.New().String()
+
+	setup := func(t *testing.T, principal authenticate.Principal) (*ConnectHandler, *mocks.OIDCProviderService) {
+		ops := mocks.NewOIDCProviderService(t)
+		as := mocks.NewAuthnService(t)
+		ops.EXPECT().Enabled().Return(true)
+		as.EXPECT().GetPrincipal(mock.Anything).Return(principal, nil)
+		return &ConnectHandler{oidcProviderService: ops, authnService: as}, ops
+	}
+	user := authenticate.Principal{ID: userID, Type: schema.UserPrincipal}
+
+	t.Run("returns the pending authorization of the current user", func(t *testing.T) {
+		h, ops := setup(t, user)
+		ops.EXPECT().GetConsentRequest(mock.Anything, authorizationID, userID).Return(oidcprovider.ConsentRequest{
+			AuthorizationID: authorizationID,
+			ClientID:        "client-1",
+			ClientName:      "wiki",
+			Scopes:          []string{"openid", "email"},
+			RedirectURI:     "https://wiki.example.com/callback",
+		}, nil)
+
+		resp, err := h.GetOAuthConsentRequest(context.Background(), connect.NewRequest(&frontierv1beta1.GetOAuthConsentRequestRequest{
+			AuthorizationId: authorizationID,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "wiki", resp.Msg.GetConsentRequest().GetClientName())
+		assert.Equal(t, []string{"openid", "email"}, resp.Msg.GetConsentRequest().GetScopes())
+	})
+
+	t.Run("hides authorizations of other users", func(t *testing.T) {
+		h, ops := setup(t, user)
+		ops.EXPECT().GetConsentRequest(mock.Anything, authorizationID, userID).
+			Return(oidcprovider.ConsentRequest{}, oidcprovider.ErrAuthorizationNotFound)
+
+		_, err := h.GetOAuthConsentRequest(context.Background(), connect.NewRequest(&frontierv1beta1.GetOAuthConsentRequestRequest{
+			AuthorizationId: authorizationID,
+		}))
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	})
+
+	t.Run("records the decision of the user", func(t *testing.T) {
+		h, ops := setup(t, user)
+		ops.EXPECT().Consent(mock.Anything, authorizationID, userID, true).
+			Return("https://wiki.example.com/callback?code=abc", nil)
+
+		resp, err := h.DecideOAuthConsent(context.Background(), connect.NewRequest(&frontierv1beta1.DecideOAuthConsentRequest{
+			AuthorizationId: authorizationID,
+			Approve:         true,
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "https://wiki.example.com/callback?code=abc", resp.Msg.GetRedirectTo())
+	})
+
+	t.Run("rejects principals other than users", func(t *testing.T) {
+		h, _ := setup(t, authenticate.Principal{ID: uuid.New().String(), Type: schema.ServiceUserPrincipal})
+
+		_, err := h.DecideOAuthConsent(context.Background(), connect.NewRequest(&frontierv1beta1.DecideOAuthConsentRequest{
+			AuthorizationId: authorizationID,
+			Approve:         true,
+		}))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+}
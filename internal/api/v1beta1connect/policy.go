@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/raystack/frontier/core/audit"
@@ -19,6 +21,13 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// policyNotBeforeKey and policyExpiresAtKey of the policy metadata bound
+	// the validity of a time bound policy
+	policyNotBeforeKey = "not_before"
+	policyExpiresAtKey = "expires_at"
+)
+
 func (h *ConnectHandler) CreatePolicy(ctx context.Context, request *connect.Request[frontierv1beta1.CreatePolicyRequest]) (*connect.Response[frontierv1beta1.CreatePolicyResponse], error) {
 	errorLogger := NewErrorLogger()
 
@@ -35,6 +44,10 @@ func (h *ConnectHandler) CreatePolicy(ctx context.Context, request *connect.Requ
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, ErrNamespaceSplitNotation)
 	}
+	notBefore, expiresAt, err := policyWindowFromMetadata(metaDataMap)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidPolicyWindow)
+	}
 
 	newPolicy, err := h.policyService.Create(ctx, policy.Policy{
 		RoleID:        request.Msg.GetBody().GetRoleId(),
@@ -43,6 +56,8 @@ func (h *ConnectHandler) CreatePolicy(ctx context.Context, request *connect.Requ
 		PrincipalID:   principalID,
 		PrincipalType: principalType,
 		Metadata:      metaDataMap,
+		NotBefore:     notBefore,
+		ExpiresAt:     expiresAt,
 	})
 	if err != nil {
 		errorLogger.LogServiceError(ctx, request, "CreatePolicy", err,
@@ -57,6 +72,8 @@ func (h *ConnectHandler) CreatePolicy(ctx context.Context, request *connect.Requ
 			return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidRoleID)
 		case errors.Is(err, policy.ErrInvalidDetail):
 			return nil, connect.NewError(connect.CodeInvalidArgument, ErrBadRequest)
+		case errors.Is(err, policy.ErrInvalidWindow):
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidPolicyWindow)
 		default:
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("CreatePolicy: role_id=%s resource_type=%s resource_id=%s principal_type=%s principal_id=%s: %w", request.Msg.GetBody().GetRoleId(), resourceType, resourceID, principalType, principalID, err))
 		}
@@ -212,11 +229,46 @@ func (h *ConnectHandler) ListPolicies(ctx context.Context, request *connect.Requ
 	return connect.NewResponse(&frontierv1beta1.ListPoliciesResponse{Policies: policies}), nil
 }
 
+// policyWindowFromMetadata reads the validity window of a time bound policy
+// from its metadata, the policy proto has no fields for it
+func policyWindowFromMetadata(m metadata.Metadata) (time.Time, time.Time, error) {
+	var window [2]time.Time
+	for idx, key := range []string{policyNotBeforeKey, policyExpiresAtKey} {
+		value, ok := m[key]
+		if !ok {
+			continue
+		}
+		str, ok := value.(string)
+		if !ok {
+			return time.Time{}, time.Time{}, fmt.Errorf("%s should be a string", key)
+		}
+		parsed, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%s: %w", key, err)
+		}
+		window[idx] = parsed.UTC()
+	}
+	return window[0], window[1], nil
+}
+
 func transformPolicyToPB(policy policy.Policy) (*frontierv1beta1.Policy, error) {
 	var metadata *structpb.Struct
 	var err error
-	if len(policy.Metadata) > 0 {
-		metadata, err = structpb.NewStruct(policy.Metadata)
+	policyMetadata := policy.Metadata
+	if policy.TimeBound() {
+		policyMetadata = maps.Clone(policy.Metadata)
+		if policyMetadata == nil {
+			policyMetadata = map[string]any{}
+		}
+		if !policy.NotBefore.IsZero() {
+			policyMetadata[policyNotBeforeKey] = policy.NotBefore.UTC().Format(time.RFC3339)
+		}
+		if !policy.ExpiresAt.IsZero() {
+			policyMetadata[policyExpiresAtKey] = policy.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+	}
+	if len(policyMetadata) > 0 {
+		metadata, err = structpb.NewStruct(policyMetadata)
 		if err != nil {
 			return nil, err
 		}
@@ -273,6 +273,74 @@ func TestConnectHandler_CreatePolicy(t *testing.T) {
 				},
 			}),
 		},
+		{
+			name: "should return invalid argument error when the policy window isn't a timestamp",
+			setup: func(ps *mocks.PolicyService) {
+				// No expectations as we return early on an invalid window
+			},
+			request: connect.NewRequest(&frontierv1beta1.CreatePolicyRequest{
+				Body: &frontierv1beta1.PolicyRequestBody{
+					RoleId:    "admin",
+					Resource:  "project:" + testResourceID,
+					Principal: "user:" + testUserID,
+					Metadata: func() *structpb.Struct {
+						s, _ := structpb.NewStruct(map[string]any{"expires_at": "tomorrow"})
+						return s
+					}(),
+				},
+			}),
+			want:    nil,
+			wantErr: ErrInvalidPolicyWindow,
+			errCode: connect.CodeInvalidArgument,
+		},
+		{
+			name: "should successfully create a time bound policy",
+			setup: func(ps *mocks.PolicyService) {
+				expiresAt := fixedTime.Add(24 * time.Hour)
+				ps.On("Create", mock.Anything, policy.Policy{
+					RoleID:        "admin",
+					ResourceID:    testResourceID,
+					ResourceType:  "app/project",
+					PrincipalID:   testUserID,
+					PrincipalType: "app/user",
+					Metadata:      metadata.Metadata{"expires_at": "2023-01-02T00:00:00Z"},
+					ExpiresAt:     expiresAt,
+				}).Return(policy.Policy{
+					ID:            testPolicyID,
+					RoleID:        "admin",
+					ResourceID:    testResourceID,
+					ResourceType:  "app/project",
+					PrincipalID:   testUserID,
+					PrincipalType: "app/user",
+					ExpiresAt:     expiresAt,
+					CreatedAt:     fixedTime,
+				}, nil)
+			},
+			request: connect.NewRequest(&frontierv1beta1.CreatePolicyRequest{
+				Body: &frontierv1beta1.PolicyRequestBody{
+					RoleId:    "admin",
+					Resource:  "project:" + testResourceID,
+					Principal: "user:" + testUserID,
+					Metadata: func() *structpb.Struct {
+						s, _ := structpb.NewStruct(map[string]any{"expires_at": "2023-01-02T00:00:00Z"})
+						return s
+					}(),
+				},
+			}),
+			want: connect.NewResponse(&frontierv1beta1.CreatePolicyResponse{
+				Policy: &frontierv1beta1.Policy{
+					Id:        testPolicyID,
+					RoleId:    "admin",
+					Resource:  "app/project:" + testResourceID,
+					Principal: "app/user:" + testUserID,
+					Metadata: func() *structpb.Struct {
+						s, _ := structpb.NewStruct(map[string]any{"expires_at": "2023-01-02T00:00:00Z"})
+						return s
+					}(),
+					CreatedAt: timestamppb.New(fixedTime),
+				},
+			}),
+		},
 		{
 			name: "should return internal error when transformPolicyToPB fails due to metadata error",
 			setup: func(ps *mocks.PolicyService) {
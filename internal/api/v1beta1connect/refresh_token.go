@@ -0,0 +1,97 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/refreshtoken"
+	frontiersession "github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/pkg/server/consts"
+	sessionutils "github.com/raystack/frontier/pkg/session"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/grpc/metadata"
+)
+
+// IssueRefreshToken issues a refresh token bound to the session of the
+// current user
+func (h *ConnectHandler) IssueRefreshToken(ctx context.Context, request *connect.Request[frontierv1beta1.IssueRefreshTokenRequest]) (*connect.Response[frontierv1beta1.IssueRefreshTokenResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	if !h.refreshTokenService.Enabled() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, refreshtoken.ErrDisabled)
+	}
+	// only a session can issue a token renewing it
+	principal, err := h.GetLoggedInPrincipal(ctx, authenticate.SessionClientAssertion)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := h.sessionService.ExtractFromContext(ctx)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "IssueRefreshToken.ExtractFromContext", err, "principal_id", principal.ID)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("IssueRefreshToken: principal_id=%s: %w", principal.ID, err))
+	}
+	refreshToken, err := h.refreshTokenService.Issue(ctx, sess)
+	if err != nil {
+		errorLogger.LogServiceError(ctx, request, "IssueRefreshToken.Issue", err, "session_id", sess.ID.String())
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("IssueRefreshToken: session_id=%s: %w", sess.ID.String(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.IssueRefreshTokenResponse{RefreshToken: refreshToken}), nil
+}
+
+// RefreshAuthToken redeems a refresh token for an access token of the session
+// it is bound to, the session is authenticated like the session cookie. The
+// token is only rotated once the access token is issued, a failed request
+// leaves it usable.
+func (h *ConnectHandler) RefreshAuthToken(ctx context.Context, request *connect.Request[frontierv1beta1.RefreshAuthTokenRequest]) (*connect.Response[frontierv1beta1.RefreshAuthTokenResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	if !h.refreshTokenService.Enabled() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, refreshtoken.ErrDisabled)
+	}
+	sess, err := h.refreshTokenService.Validate(ctx, request.Msg.GetRefreshToken())
+	if err != nil {
+		return nil, refreshTokenError(ctx, request, errorLogger, "RefreshAuthToken.Validate", err)
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(map[string]string{})
+	} else {
+		md = md.Copy()
+	}
+	md.Set(consts.SessionIDGatewayKey, sess.ID.String())
+	ctx = metadata.NewIncomingContext(ctx, md)
+	// the session is checked against the client and its activity tracked
+	ctx = frontiersession.SetSessionMetadataInContext(ctx,
+		sessionutils.ExtractSessionMetadata(ctx, request, h.authConfig.Session.Headers))
+
+	principal, err := h.GetLoggedInPrincipal(ctx, authenticate.SessionClientAssertion)
+	if err != nil {
+		return nil, err
+	}
+	token, err := h.getAccessToken(ctx, principal, request.Header().Values(consts.ProjectRequestKey), request)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("RefreshAuthToken: principal_id=%s: %w", principal.ID, err))
+	}
+
+	_, refreshToken, err := h.refreshTokenService.Rotate(ctx, request.Msg.GetRefreshToken())
+	if err != nil {
+		return nil, refreshTokenError(ctx, request, errorLogger, "RefreshAuthToken.Rotate", err)
+	}
+	return connect.NewResponse(&frontierv1beta1.RefreshAuthTokenResponse{
+		AccessToken:  string(token),
+		TokenType:    "Bearer",
+		RefreshToken: refreshToken,
+	}), nil
+}
+
+func refreshTokenError(ctx context.Context, request connect.AnyRequest, errorLogger *ErrorLogger, operation string, err error) error {
+	if errors.Is(err, refreshtoken.ErrInvalidToken) || errors.Is(err, refreshtoken.ErrReused) {
+		return connect.NewError(connect.CodeUnauthenticated, ErrUnauthenticated)
+	}
+	errorLogger.LogServiceError(ctx, request, operation, err)
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("RefreshAuthToken: %w", err))
+}
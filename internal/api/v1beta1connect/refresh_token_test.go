@@ -0,0 +1,138 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/refreshtoken"
+	frontiersession "github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/pkg/server/consts"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestConnectHandler_RefreshTokens(t *testing.T) {
+	userID := uuid.NewString()
+	sess := &frontiersession.Session{ID: uuid.New(), UserID: userID}
+	userPrincipal := authenticate.Principal{
+		ID:      userID,
+		Type:    schema.UserPrincipal,
+		User:    &user.User{ID: userID},
+		AuthVia: authenticate.SessionClientAssertion,
+	}
+
+	t.Run("should issue a refresh token bound to the session", func(t *testing.T) {
+		mockAuthnSrv := mocks.NewAuthnService(t)
+		mockSessionSrv := mocks.NewSessionService(t)
+		mockRefreshTokenSrv := mocks.NewRefreshTokenService(t)
+		mockRefreshTokenSrv.EXPECT().Enabled().Return(true)
+		mockAuthnSrv.EXPECT().GetPrincipal(mock.Anything, authenticate.SessionClientAssertion).Return(userPrincipal, nil)
+		mockSessionSrv.EXPECT().ExtractFromContext(mock.Anything).Return(sess, nil)
+		mockRefreshTokenSrv.EXPECT().Issue(mock.Anything, sess).Return("refresh-token", nil)
+
+		handler := &ConnectHandler{
+			authnService:        mockAuthnSrv,
+			sessionService:      mockSessionSrv,
+			refreshTokenService: mockRefreshTokenSrv,
+		}
+		resp, err := handler.IssueRefreshToken(context.Background(), connect.NewRequest(&frontierv1beta1.IssueRefreshTokenRequest{}))
+		require.NoError(t, err)
+		assert.Equal(t, "refresh-token", resp.Msg.GetRefreshToken())
+	})
+
+	t.Run("should not issue refresh tokens when disabled", func(t *testing.T) {
+		mockRefreshTokenSrv := mocks.NewRefreshTokenService(t)
+		mockRefreshTokenSrv.EXPECT().Enabled().Return(false)
+
+		handler := &ConnectHandler{refreshTokenService: mockRefreshTokenSrv}
+		_, err := handler.IssueRefreshToken(context.Background(), connect.NewRequest(&frontierv1beta1.IssueRefreshTokenRequest{}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	})
+
+	t.Run("should exchange a refresh token for an access token of its session", func(t *testing.T) {
+		mockAuthnSrv := mocks.NewAuthnService(t)
+		mockRefreshTokenSrv := mocks.NewRefreshTokenService(t)
+		mockRefreshTokenSrv.EXPECT().Enabled().Return(true)
+		mockRefreshTokenSrv.EXPECT().Validate(mock.Anything, "refresh-token").Return(sess, nil)
+		mockAuthnSrv.EXPECT().GetPrincipal(mock.MatchedBy(func(ctx context.Context) bool {
+			md, _ := metadata.FromIncomingContext(ctx)
+			_, tracked := frontiersession.GetSessionMetadataFromContext(ctx)
+			return tracked && slices.Equal(md.Get(consts.SessionIDGatewayKey), []string{sess.ID.String()})
+		}), authenticate.SessionClientAssertion).Return(userPrincipal, nil)
+		mockAuthnSrv.EXPECT().BuildToken(mock.Anything, userPrincipal, mock.Anything).Return([]byte("access-token"), nil)
+		mockRefreshTokenSrv.EXPECT().Rotate(mock.Anything, "refresh-token").Return(sess, "next-refresh-token", nil)
+
+		handler := &ConnectHandler{
+			authnService:        mockAuthnSrv,
+			refreshTokenService: mockRefreshTokenSrv,
+		}
+		resp, err := handler.RefreshAuthToken(context.Background(), connect.NewRequest(&frontierv1beta1.RefreshAuthTokenRequest{
+			RefreshToken: "refresh-token",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "access-token", resp.Msg.GetAccessToken())
+		assert.Equal(t, "Bearer", resp.Msg.GetTokenType())
+		assert.Equal(t, "next-refresh-token", resp.Msg.GetRefreshToken())
+	})
+
+	t.Run("should reject a reused refresh token", func(t *testing.T) {
+		mockRefreshTokenSrv := mocks.NewRefreshTokenService(t)
+		mockRefreshTokenSrv.EXPECT().Enabled().Return(true)
+		mockRefreshTokenSrv.EXPECT().Validate(mock.Anything, "refresh-token").Return(nil, refreshtoken.ErrReused)
+
+		handler := &ConnectHandler{refreshTokenService: mockRefreshTokenSrv}
+		_, err := handler.RefreshAuthToken(context.Background(), connect.NewRequest(&frontierv1beta1.RefreshAuthTokenRequest{
+			RefreshToken: "refresh-token",
+		}))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+	})
+
+	t.Run("should keep the refresh token for a session the authenticator rejects", func(t *testing.T) {
+		mockAuthnSrv := mocks.NewAuthnService(t)
+		mockRefreshTokenSrv := mocks.NewRefreshTokenService(t)
+		mockRefreshTokenSrv.EXPECT().Enabled().Return(true)
+		mockRefreshTokenSrv.EXPECT().Validate(mock.Anything, "refresh-token").Return(sess, nil)
+		mockAuthnSrv.EXPECT().GetPrincipal(mock.Anything, authenticate.SessionClientAssertion).
+			Return(authenticate.Principal{}, authenticate.ErrMFARequired)
+
+		handler := &ConnectHandler{
+			authnService:        mockAuthnSrv,
+			refreshTokenService: mockRefreshTokenSrv,
+		}
+		_, err := handler.RefreshAuthToken(context.Background(), connect.NewRequest(&frontierv1beta1.RefreshAuthTokenRequest{
+			RefreshToken: "refresh-token",
+		}))
+		assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+		mockRefreshTokenSrv.AssertNotCalled(t, "Rotate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should keep the refresh token when the access token isn't issued", func(t *testing.T) {
+		mockAuthnSrv := mocks.NewAuthnService(t)
+		mockRefreshTokenSrv := mocks.NewRefreshTokenService(t)
+		mockRefreshTokenSrv.EXPECT().Enabled().Return(true)
+		mockRefreshTokenSrv.EXPECT().Validate(mock.Anything, "refresh-token").Return(sess, nil)
+		mockAuthnSrv.EXPECT().GetPrincipal(mock.Anything, authenticate.SessionClientAssertion).Return(userPrincipal, nil)
+		mockAuthnSrv.EXPECT().BuildToken(mock.Anything, userPrincipal, mock.Anything).Return(nil, errors.New("no signing key"))
+
+		handler := &ConnectHandler{
+			authnService:        mockAuthnSrv,
+			refreshTokenService: mockRefreshTokenSrv,
+		}
+		_, err := handler.RefreshAuthToken(context.Background(), connect.NewRequest(&frontierv1beta1.RefreshAuthTokenRequest{
+			RefreshToken: "refresh-token",
+		}))
+		assert.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+		mockRefreshTokenSrv.AssertNotCalled(t, "Rotate", mock.Anything, mock.Anything)
+	})
+}
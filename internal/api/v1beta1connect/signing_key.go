@@ -0,0 +1,40 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/authenticate/token"
+	"github.com/raystack/frontier/pkg/db"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RotateSigningKey publishes the key replacing the key signing access tokens
+func (h *ConnectHandler) RotateSigningKey(ctx context.Context, request *connect.Request[frontierv1beta1.RotateSigningKeyRequest]) (*connect.Response[frontierv1beta1.RotateSigningKeyResponse], error) {
+	errorLogger := NewErrorLogger()
+
+	key, err := h.signingKeyService.Replace(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, token.ErrRotationDisabled):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case errors.Is(err, db.ErrLockBusy):
+			// another instance is rotating the keys
+			return nil, connect.NewError(connect.CodeAborted, err)
+		}
+		errorLogger.LogServiceError(ctx, request, "RotateSigningKey.Replace", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("RotateSigningKey: %w", err))
+	}
+
+	response := &frontierv1beta1.RotateSigningKeyResponse{
+		Kid:   key.ID,
+		State: string(key.State),
+	}
+	if key.ActivateAt != nil {
+		response.ActivateAt = timestamppb.New(*key.ActivateAt)
+	}
+	return connect.NewResponse(response), nil
+}
@@ -0,0 +1,96 @@
+package v1beta1connect
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/authenticate/token"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	"github.com/raystack/frontier/pkg/db"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestConnectHandler_RotateSigningKey(t *testing.T) {
+	activateAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		setup    func(sks *mocks.SigningKeyService)
+		want     *frontierv1beta1.RotateSigningKeyResponse
+		wantCode connect.Code
+	}{
+		{
+			name: "should publish the key replacing the active key",
+			setup: func(sks *mocks.SigningKeyService) {
+				sks.EXPECT().Replace(mock.Anything).Return(token.SigningKey{
+					ID:         "kid",
+					State:      token.KeyStatePending,
+					ActivateAt: &activateAt,
+				}, nil)
+			},
+			want: &frontierv1beta1.RotateSigningKeyResponse{
+				Kid:        "kid",
+				State:      "pending",
+				ActivateAt: timestamppb.New(activateAt),
+			},
+		},
+		{
+			name: "should return the key activated at once",
+			setup: func(sks *mocks.SigningKeyService) {
+				sks.EXPECT().Replace(mock.Anything).Return(token.SigningKey{
+					ID:    "kid",
+					State: token.KeyStateActive,
+				}, nil)
+			},
+			want: &frontierv1beta1.RotateSigningKeyResponse{
+				Kid:   "kid",
+				State: "active",
+			},
+		},
+		{
+			name: "should fail when key rotation is disabled",
+			setup: func(sks *mocks.SigningKeyService) {
+				sks.EXPECT().Replace(mock.Anything).Return(token.SigningKey{}, token.ErrRotationDisabled)
+			},
+			wantCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name: "should abort while another instance rotates the keys",
+			setup: func(sks *mocks.SigningKeyService) {
+				sks.EXPECT().Replace(mock.Anything).Return(token.SigningKey{}, db.ErrLockBusy)
+			},
+			wantCode: connect.CodeAborted,
+		},
+		{
+			name: "should fail on a store error",
+			setup: func(sks *mocks.SigningKeyService) {
+				sks.EXPECT().Replace(mock.Anything).Return(token.SigningKey{}, errors.New("db down"))
+			},
+			wantCode: connect.CodeInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSigningKeySrv := mocks.NewSigningKeyService(t)
+			tt.setup(mockSigningKeySrv)
+
+			handler := &ConnectHandler{signingKeyService: mockSigningKeySrv}
+			resp, err := handler.RotateSigningKey(context.Background(), connect.NewRequest(&frontierv1beta1.RotateSigningKeyRequest{}))
+			if tt.wantCode != 0 {
+				assert.Equal(t, tt.wantCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.GetKid(), resp.Msg.GetKid())
+			assert.Equal(t, tt.want.GetState(), resp.Msg.GetState())
+			assert.Equal(t, tt.want.GetActivateAt().AsTime(), resp.Msg.GetActivateAt().AsTime())
+		})
+	}
+}
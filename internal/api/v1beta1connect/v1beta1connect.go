@@ -17,6 +17,18 @@ import (
 type ConnectHandler struct {
 	frontierv1beta1connect.UnimplementedAdminServiceHandler
 	frontierv1beta1connect.UnimplementedFrontierServiceHandler
+	frontierv1beta1connect.UnimplementedWebhookServiceHandler
+	frontierv1beta1connect.UnimplementedAuditRecordServiceHandler
+	frontierv1beta1connect.UnimplementedOAuthConsentServiceHandler
+	frontierv1beta1connect.UnimplementedMFAServiceHandler
+	frontierv1beta1connect.UnimplementedLoginAlertServiceHandler
+	frontierv1beta1connect.UnimplementedAccessRequestServiceHandler
+	frontierv1beta1connect.UnimplementedExplainServiceHandler
+	frontierv1beta1connect.UnimplementedAccessReviewServiceHandler
+	frontierv1beta1connect.UnimplementedCertificationServiceHandler
+	frontierv1beta1connect.UnimplementedAuthTokenServiceHandler
+	frontierv1beta1connect.UnimplementedSigningKeyServiceHandler
+	frontierv1beta1connect.UnimplementedOAuthClientServiceHandler
 
 	authConfig                       authenticate.Config
 	orgService                       OrganizationService
@@ -31,6 +43,7 @@ type ConnectHandler struct {
 	relationService                  RelationService
 	resourceService                  ResourceService
 	sessionService                   SessionService
+	refreshTokenService              RefreshTokenService
 	authnService                     AuthnService
 	deleterService                   CascadeDeleter
 	metaSchemaService                MetaSchemaService
@@ -63,8 +76,17 @@ type ConnectHandler struct {
 	userOrgsService                  UserOrgsService
 	userProjectsService              UserProjectsService
 	auditRecordService               AuditRecordService
+	auditArchiveService              AuditRecordArchiveService
 	userPATService                   UserPATService
 	membershipService                MembershipService
+	oidcProviderService              OIDCProviderService
+	mfaService                       MFAService
+	loginAlertService                LoginAlertService
+	accessRequestService             AccessRequestService
+	explainService                   ExplainService
+	accessReviewService              AccessReviewService
+	certificationService             CertificationService
+	signingKeyService                SigningKeyService
 }
 
 func NewConnectHandler(deps api.Deps, authConf authenticate.Config) *ConnectHandler {
@@ -82,6 +104,7 @@ func NewConnectHandler(deps api.Deps, authConf authenticate.Config) *ConnectHand
 		relationService:                  deps.RelationService,
 		resourceService:                  deps.ResourceService,
 		sessionService:                   deps.SessionService,
+		refreshTokenService:              deps.RefreshTokenService,
 		authnService:                     deps.AuthnService,
 		deleterService:                   deps.DeleterService,
 		metaSchemaService:                deps.MetaSchemaService,
@@ -114,8 +137,17 @@ func NewConnectHandler(deps api.Deps, authConf authenticate.Config) *ConnectHand
 		userOrgsService:                  deps.UserOrgsService,
 		userProjectsService:              deps.UserProjectsService,
 		auditRecordService:               deps.AuditRecordService,
+		auditArchiveService:              deps.AuditArchiveService,
 		userPATService:                   deps.UserPATService,
 		membershipService:                deps.MembershipService,
+		oidcProviderService:              deps.OIDCProviderService,
+		mfaService:                       deps.MFAService,
+		loginAlertService:                deps.LoginAlertService,
+		accessRequestService:             deps.AccessRequestService,
+		explainService:                   deps.ExplainService,
+		accessReviewService:              deps.AccessReviewService,
+		certificationService:             deps.CertificationService,
+		signingKeyService:                deps.TokenKeyStore,
 	}
 }
 
@@ -8,6 +8,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/raystack/frontier/core/webhook"
 	"github.com/raystack/frontier/pkg/metadata"
+	"github.com/raystack/frontier/pkg/pagination"
 	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -21,7 +22,7 @@ func webhookErrCode(err error) connect.Code {
 		return connect.CodeInvalidArgument
 	case errors.Is(err, webhook.ErrConflict):
 		return connect.CodeAlreadyExists
-	case errors.Is(err, webhook.ErrNotFound):
+	case errors.Is(err, webhook.ErrNotFound), errors.Is(err, webhook.ErrSecretNotFound), errors.Is(err, webhook.ErrDeliveryNotFound):
 		return connect.CodeNotFound
 	default:
 		return connect.CodeInternal
@@ -82,7 +83,8 @@ func (h *ConnectHandler) UpdateWebhook(ctx context.Context, req *connect.Request
 }
 
 func (h *ConnectHandler) ListWebhooks(ctx context.Context, req *connect.Request[frontierv1beta1.ListWebhooksRequest]) (*connect.Response[frontierv1beta1.ListWebhooksResponse], error) {
-	filter := webhook.EndpointFilter{}
+	// organization webhooks are managed by the organization, not the platform
+	filter := webhook.EndpointFilter{Platform: true}
 	endpoints, err := h.webhookService.ListEndpoints(ctx, filter)
 	if err != nil {
 		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("ListWebhooks: %w", err))
@@ -110,6 +112,158 @@ func (h *ConnectHandler) DeleteWebhook(ctx context.Context, req *connect.Request
 	return connect.NewResponse(&frontierv1beta1.DeleteWebhookResponse{}), nil
 }
 
+func (h *ConnectHandler) CreateOrganizationWebhook(ctx context.Context, req *connect.Request[frontierv1beta1.CreateOrganizationWebhookRequest]) (*connect.Response[frontierv1beta1.CreateOrganizationWebhookResponse], error) {
+	orgID := req.Msg.GetOrgId()
+
+	endpoint, err := h.webhookService.CreateEndpoint(ctx, webhook.Endpoint{
+		OrgID:            orgID,
+		Description:      req.Msg.GetBody().GetDescription(),
+		SubscribedEvents: req.Msg.GetBody().GetSubscribedEvents(),
+		Headers:          req.Msg.GetBody().GetHeaders(),
+		URL:              req.Msg.GetBody().GetUrl(),
+		State:            webhook.State(req.Msg.GetBody().GetState()),
+	})
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("CreateOrganizationWebhook: org_id=%s url=%s: %w", orgID, req.Msg.GetBody().GetUrl(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.CreateOrganizationWebhookResponse{
+		Webhook: toProtoOrganizationWebhook(endpoint),
+	}), nil
+}
+
+func (h *ConnectHandler) ListOrganizationWebhooks(ctx context.Context, req *connect.Request[frontierv1beta1.ListOrganizationWebhooksRequest]) (*connect.Response[frontierv1beta1.ListOrganizationWebhooksResponse], error) {
+	orgID := req.Msg.GetOrgId()
+
+	endpoints, err := h.webhookService.ListEndpoints(ctx, webhook.EndpointFilter{OrgID: orgID})
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("ListOrganizationWebhooks: org_id=%s: %w", orgID, err))
+	}
+	webhooks := make([]*frontierv1beta1.OrganizationWebhook, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		webhooks = append(webhooks, toProtoOrganizationWebhook(endpoint))
+	}
+	return connect.NewResponse(&frontierv1beta1.ListOrganizationWebhooksResponse{
+		Webhooks: webhooks,
+	}), nil
+}
+
+func (h *ConnectHandler) UpdateOrganizationWebhook(ctx context.Context, req *connect.Request[frontierv1beta1.UpdateOrganizationWebhookRequest]) (*connect.Response[frontierv1beta1.UpdateOrganizationWebhookResponse], error) {
+	orgID := req.Msg.GetOrgId()
+	webhookID := req.Msg.GetId()
+
+	endpoint, err := h.webhookService.UpdateEndpoint(ctx, webhook.Endpoint{
+		ID:               webhookID,
+		OrgID:            orgID,
+		Description:      req.Msg.GetBody().GetDescription(),
+		SubscribedEvents: req.Msg.GetBody().GetSubscribedEvents(),
+		Headers:          req.Msg.GetBody().GetHeaders(),
+		URL:              req.Msg.GetBody().GetUrl(),
+		State:            webhook.State(req.Msg.GetBody().GetState()),
+	})
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("UpdateOrganizationWebhook: org_id=%s webhook_id=%s: %w", orgID, webhookID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.UpdateOrganizationWebhookResponse{
+		Webhook: toProtoOrganizationWebhook(endpoint),
+	}), nil
+}
+
+// DeleteOrganizationWebhook relies on the authorization interceptor to check
+// the webhook belongs to the organization
+func (h *ConnectHandler) DeleteOrganizationWebhook(ctx context.Context, req *connect.Request[frontierv1beta1.DeleteOrganizationWebhookRequest]) (*connect.Response[frontierv1beta1.DeleteOrganizationWebhookResponse], error) {
+	orgID := req.Msg.GetOrgId()
+	webhookID := req.Msg.GetId()
+
+	if err := h.webhookService.DeleteEndpoint(ctx, webhookID); err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("DeleteOrganizationWebhook: org_id=%s webhook_id=%s: %w", orgID, webhookID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.DeleteOrganizationWebhookResponse{}), nil
+}
+
+// GetOrgIDFromWebhookID returns the organization owning the webhook, empty
+// for platform webhooks
+func (h *ConnectHandler) GetOrgIDFromWebhookID(ctx context.Context, webhookID string) (string, error) {
+	endpoint, err := h.webhookService.GetEndpoint(ctx, webhookID)
+	if err != nil {
+		return "", connect.NewError(webhookErrCode(err), fmt.Errorf("GetOrgIDFromWebhookID: webhook_id=%s: %w", webhookID, err))
+	}
+	return endpoint.OrgID, nil
+}
+
+// GetWebhookIDFromDeliveryID returns the webhook the delivery is sent to
+func (h *ConnectHandler) GetWebhookIDFromDeliveryID(ctx context.Context, deliveryID string) (string, error) {
+	delivery, err := h.webhookService.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return "", connect.NewError(webhookErrCode(err), fmt.Errorf("GetWebhookIDFromDeliveryID: delivery_id=%s: %w", deliveryID, err))
+	}
+	return delivery.EndpointID, nil
+}
+
+func (h *ConnectHandler) GetWebhookOptions(ctx context.Context, req *connect.Request[frontierv1beta1.GetWebhookOptionsRequest]) (*connect.Response[frontierv1beta1.GetWebhookOptionsResponse], error) {
+	webhookID := req.Msg.GetWebhookId()
+
+	endpoint, err := h.webhookService.GetEndpoint(ctx, webhookID)
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("GetWebhookOptions: webhook_id=%s: %w", webhookID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.GetWebhookOptionsResponse{
+		Options: toProtoWebhookOptions(endpoint),
+	}), nil
+}
+
+func (h *ConnectHandler) UpdateWebhookFilters(ctx context.Context, req *connect.Request[frontierv1beta1.UpdateWebhookFiltersRequest]) (*connect.Response[frontierv1beta1.UpdateWebhookFiltersResponse], error) {
+	webhookID := req.Msg.GetWebhookId()
+
+	filters := make(map[string][]string, len(req.Msg.GetFilters()))
+	for key, values := range req.Msg.GetFilters() {
+		filters[key] = values.GetValues()
+	}
+	endpoint, err := h.webhookService.UpdateFilters(ctx, webhookID, filters)
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("UpdateWebhookFilters: webhook_id=%s: %w", webhookID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.UpdateWebhookFiltersResponse{
+		Options: toProtoWebhookOptions(endpoint),
+	}), nil
+}
+
+func (h *ConnectHandler) UpdateWebhookPayloadFormat(ctx context.Context, req *connect.Request[frontierv1beta1.UpdateWebhookPayloadFormatRequest]) (*connect.Response[frontierv1beta1.UpdateWebhookPayloadFormatResponse], error) {
+	webhookID := req.Msg.GetWebhookId()
+
+	endpoint, err := h.webhookService.UpdatePayloadFormat(ctx, webhookID, webhook.PayloadFormat(req.Msg.GetPayloadFormat()))
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("UpdateWebhookPayloadFormat: webhook_id=%s: %w", webhookID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.UpdateWebhookPayloadFormatResponse{
+		Options: toProtoWebhookOptions(endpoint),
+	}), nil
+}
+
+func (h *ConnectHandler) RotateWebhookSecret(ctx context.Context, req *connect.Request[frontierv1beta1.RotateWebhookSecretRequest]) (*connect.Response[frontierv1beta1.RotateWebhookSecretResponse], error) {
+	webhookID := req.Msg.GetWebhookId()
+
+	secret, err := h.webhookService.RotateSecret(ctx, webhookID)
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("RotateWebhookSecret: webhook_id=%s: %w", webhookID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.RotateWebhookSecretResponse{
+		Secret: &frontierv1beta1.WebhookSecret{
+			Id:    secret.ID,
+			Value: secret.Value,
+		},
+	}), nil
+}
+
+func (h *ConnectHandler) RevokeWebhookSecret(ctx context.Context, req *connect.Request[frontierv1beta1.RevokeWebhookSecretRequest]) (*connect.Response[frontierv1beta1.RevokeWebhookSecretResponse], error) {
+	webhookID := req.Msg.GetWebhookId()
+	secretID := req.Msg.GetSecretId()
+
+	if err := h.webhookService.RevokeSecret(ctx, webhookID, secretID); err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("RevokeWebhookSecret: webhook_id=%s secret_id=%s: %w", webhookID, secretID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.RevokeWebhookSecretResponse{}), nil
+}
+
 func toProtoWebhookEndpoint(endpoint webhook.Endpoint) (*frontierv1beta1.Webhook, error) {
 	metaData, err := endpoint.Metadata.ToStructPB()
 	if err != nil {
@@ -136,3 +290,159 @@ func toProtoWebhookEndpoint(endpoint webhook.Endpoint) (*frontierv1beta1.Webhook
 		UpdatedAt:        timestamppb.New(endpoint.UpdatedAt),
 	}, nil
 }
+
+func (h *ConnectHandler) SendTestWebhookEvent(ctx context.Context, req *connect.Request[frontierv1beta1.SendTestWebhookEventRequest]) (*connect.Response[frontierv1beta1.SendTestWebhookEventResponse], error) {
+	webhookID := req.Msg.GetWebhookId()
+
+	attempt, err := h.webhookService.SendTestEvent(ctx, webhookID)
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("SendTestWebhookEvent: webhook_id=%s: %w", webhookID, err))
+	}
+	return connect.NewResponse(&frontierv1beta1.SendTestWebhookEventResponse{
+		Attempt: toProtoWebhookDeliveryAttempt(attempt),
+	}), nil
+}
+
+func (h *ConnectHandler) ListWebhookDeliveries(ctx context.Context, req *connect.Request[frontierv1beta1.ListWebhookDeliveriesRequest]) (*connect.Response[frontierv1beta1.ListWebhookDeliveriesResponse], error) {
+	paginate := pagination.NewPagination(req.Msg.GetPageNum(), req.Msg.GetPageSize())
+	filter := webhook.DeliveryFilter{
+		EndpointID: req.Msg.GetWebhookId(),
+		EventID:    req.Msg.GetEventId(),
+		Status:     webhook.DeliveryStatus(req.Msg.GetStatus()),
+		Pagination: paginate,
+	}
+	if req.Msg.GetSince() != nil {
+		filter.Since = req.Msg.GetSince().AsTime()
+	}
+	if req.Msg.GetUntil() != nil {
+		filter.Until = req.Msg.GetUntil().AsTime()
+	}
+	deliveries, err := h.webhookService.ListDeliveries(ctx, filter)
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("ListWebhookDeliveries: webhook_id=%s: %w", req.Msg.GetWebhookId(), err))
+	}
+	var deliveriesPb []*frontierv1beta1.WebhookDelivery
+	for _, delivery := range deliveries {
+		// payloads are only returned one delivery at a time
+		delivery.Payload = nil
+		deliveriesPb = append(deliveriesPb, toProtoWebhookDelivery(delivery))
+	}
+	return connect.NewResponse(&frontierv1beta1.ListWebhookDeliveriesResponse{
+		Deliveries: deliveriesPb,
+		Count:      paginate.Count,
+	}), nil
+}
+
+func (h *ConnectHandler) GetWebhookDelivery(ctx context.Context, req *connect.Request[frontierv1beta1.GetWebhookDeliveryRequest]) (*connect.Response[frontierv1beta1.GetWebhookDeliveryResponse], error) {
+	delivery, err := h.webhookService.GetDelivery(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("GetWebhookDelivery: delivery_id=%s: %w", req.Msg.GetId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.GetWebhookDeliveryResponse{
+		Delivery: toProtoWebhookDelivery(delivery),
+	}), nil
+}
+
+func (h *ConnectHandler) ListWebhookDeliveryAttempts(ctx context.Context, req *connect.Request[frontierv1beta1.ListWebhookDeliveryAttemptsRequest]) (*connect.Response[frontierv1beta1.ListWebhookDeliveryAttemptsResponse], error) {
+	attempts, err := h.webhookService.ListDeliveryAttempts(ctx, req.Msg.GetDeliveryId())
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("ListWebhookDeliveryAttempts: delivery_id=%s: %w", req.Msg.GetDeliveryId(), err))
+	}
+	var attemptsPb []*frontierv1beta1.WebhookDeliveryAttempt
+	for _, attempt := range attempts {
+		attemptsPb = append(attemptsPb, toProtoWebhookDeliveryAttempt(attempt))
+	}
+	return connect.NewResponse(&frontierv1beta1.ListWebhookDeliveryAttemptsResponse{
+		Attempts: attemptsPb,
+	}), nil
+}
+
+func (h *ConnectHandler) RedeliverWebhookDelivery(ctx context.Context, req *connect.Request[frontierv1beta1.RedeliverWebhookDeliveryRequest]) (*connect.Response[frontierv1beta1.RedeliverWebhookDeliveryResponse], error) {
+	if err := h.webhookService.Redeliver(ctx, req.Msg.GetId()); err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("RedeliverWebhookDelivery: delivery_id=%s: %w", req.Msg.GetId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.RedeliverWebhookDeliveryResponse{}), nil
+}
+
+func (h *ConnectHandler) RedeliverWebhookDeliveries(ctx context.Context, req *connect.Request[frontierv1beta1.RedeliverWebhookDeliveriesRequest]) (*connect.Response[frontierv1beta1.RedeliverWebhookDeliveriesResponse], error) {
+	count, err := h.webhookService.RedeliverRange(ctx, webhook.DeliveryFilter{
+		EndpointID: req.Msg.GetWebhookId(),
+		Status:     webhook.DeliveryStatus(req.Msg.GetStatus()),
+		Since:      req.Msg.GetSince().AsTime(),
+		Until:      req.Msg.GetUntil().AsTime(),
+	})
+	if err != nil {
+		return nil, connect.NewError(webhookErrCode(err), fmt.Errorf("RedeliverWebhookDeliveries: webhook_id=%s: %w", req.Msg.GetWebhookId(), err))
+	}
+	return connect.NewResponse(&frontierv1beta1.RedeliverWebhookDeliveriesResponse{
+		Count: int32(count),
+	}), nil
+}
+
+func toProtoWebhookDelivery(delivery webhook.Delivery) *frontierv1beta1.WebhookDelivery {
+	deliveryPb := &frontierv1beta1.WebhookDelivery{
+		Id:             delivery.ID,
+		WebhookId:      delivery.EndpointID,
+		EventId:        delivery.EventID,
+		Action:         delivery.Action,
+		PayloadFormat:  string(delivery.PayloadFormat),
+		Payload:        string(delivery.Payload),
+		RequestId:      delivery.RequestID,
+		Status:         string(delivery.Status),
+		AttemptCount:   int32(delivery.AttemptCount),
+		NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+		LastStatusCode: int32(delivery.LastStatusCode),
+		LastError:      delivery.LastError,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+		UpdatedAt:      timestamppb.New(delivery.UpdatedAt),
+	}
+	if delivery.LastAttemptAt != nil {
+		deliveryPb.LastAttemptAt = timestamppb.New(*delivery.LastAttemptAt)
+	}
+	return deliveryPb
+}
+
+func toProtoOrganizationWebhook(endpoint webhook.Endpoint) *frontierv1beta1.OrganizationWebhook {
+	var secrets []*frontierv1beta1.WebhookSecret
+	for _, secret := range endpoint.Secrets {
+		secrets = append(secrets, &frontierv1beta1.WebhookSecret{
+			Id:    secret.ID,
+			Value: secret.Value,
+		})
+	}
+	return &frontierv1beta1.OrganizationWebhook{
+		Id:               endpoint.ID,
+		OrgId:            endpoint.OrgID,
+		Description:      endpoint.Description,
+		Url:              endpoint.URL,
+		SubscribedEvents: endpoint.SubscribedEvents,
+		Headers:          endpoint.Headers,
+		State:            string(endpoint.State),
+		Secrets:          secrets,
+		CreatedAt:        timestamppb.New(endpoint.CreatedAt),
+		UpdatedAt:        timestamppb.New(endpoint.UpdatedAt),
+	}
+}
+
+func toProtoWebhookOptions(endpoint webhook.Endpoint) *frontierv1beta1.WebhookOptions {
+	filters := make(map[string]*frontierv1beta1.WebhookFilterValues, len(endpoint.Filters))
+	for key, values := range endpoint.Filters {
+		filters[key] = &frontierv1beta1.WebhookFilterValues{Values: values}
+	}
+	return &frontierv1beta1.WebhookOptions{
+		Filters:       filters,
+		PayloadFormat: string(endpoint.PayloadFormat),
+	}
+}
+
+func toProtoWebhookDeliveryAttempt(attempt webhook.Attempt) *frontierv1beta1.WebhookDeliveryAttempt {
+	return &frontierv1beta1.WebhookDeliveryAttempt{
+		Id:         attempt.ID,
+		DeliveryId: attempt.DeliveryID,
+		StatusCode: int32(attempt.StatusCode),
+		LatencyMs:  attempt.Latency.Milliseconds(),
+		Response:   attempt.Response,
+		Error:      attempt.Error,
+		CreatedAt:  timestamppb.New(attempt.CreatedAt),
+	}
+}
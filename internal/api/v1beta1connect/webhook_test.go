@@ -0,0 +1,259 @@
+package v1beta1connect
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/webhook"
+	"github.com/raystack/frontier/internal/api/v1beta1connect/mocks"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHandler_ListWebhookDeliveries(t *testing.T) {
+	webhookID := uuid.NewString()
+	since := time.Now().Add(-time.Hour).UTC()
+	ws := mocks.NewWebhookService(t)
+	ws.EXPECT().ListDeliveries(mock.Anything, mock.MatchedBy(func(f webhook.DeliveryFilter) bool {
+		return f.EndpointID == webhookID && f.Status == webhook.DeliveryFailed && f.Since.Equal(since) &&
+			f.Until.IsZero() && f.Pagination != nil
+	})).Run(func(_ context.Context, f webhook.DeliveryFilter) {
+		f.Pagination.SetCount(1)
+	}).Return([]webhook.Delivery{{
+		ID:         "d1",
+		EndpointID: webhookID,
+		Status:     webhook.DeliveryFailed,
+		Payload:    []byte(`{"action":"app.user.created"}`),
+		LastError:  "unexpected status code: 500",
+	}}, nil)
+	h := &ConnectHandler{webhookService: ws}
+
+	resp, err := h.ListWebhookDeliveries(context.Background(), connect.NewRequest(&frontierv1beta1.ListWebhookDeliveriesRequest{
+		WebhookId: webhookID,
+		Status:    "failed",
+		Since:     timestamppb.New(since),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Msg.GetCount())
+	require.Len(t, resp.Msg.GetDeliveries(), 1)
+	assert.Equal(t, "d1", resp.Msg.GetDeliveries()[0].GetId())
+	assert.Equal(t, "unexpected status code: 500", resp.Msg.GetDeliveries()[0].GetLastError())
+	// payloads are left to GetWebhookDelivery
+	assert.Empty(t, resp.Msg.GetDeliveries()[0].GetPayload())
+}
+
+func TestHandler_GetWebhookDelivery(t *testing.T) {
+	ws := mocks.NewWebhookService(t)
+	attemptedAt := time.Now().UTC()
+	ws.EXPECT().GetDelivery(mock.Anything, "d1").Return(webhook.Delivery{
+		ID:            "d1",
+		Payload:       []byte(`{"action":"app.user.created"}`),
+		LastAttemptAt: &attemptedAt,
+	}, nil)
+	ws.EXPECT().GetDelivery(mock.Anything, "missing").Return(webhook.Delivery{}, webhook.ErrDeliveryNotFound)
+	h := &ConnectHandler{webhookService: ws}
+
+	resp, err := h.GetWebhookDelivery(context.Background(), connect.NewRequest(&frontierv1beta1.GetWebhookDeliveryRequest{Id: "d1"}))
+	require.NoError(t, err)
+	assert.Equal(t, `{"action":"app.user.created"}`, resp.Msg.GetDelivery().GetPayload())
+	assert.True(t, resp.Msg.GetDelivery().GetLastAttemptAt().AsTime().Equal(attemptedAt))
+
+	_, err = h.GetWebhookDelivery(context.Background(), connect.NewRequest(&frontierv1beta1.GetWebhookDeliveryRequest{Id: "missing"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestHandler_RedeliverWebhookDeliveries(t *testing.T) {
+	since := time.Now().Add(-time.Hour).UTC()
+	until := time.Now().UTC()
+	ws := mocks.NewWebhookService(t)
+	ws.EXPECT().RedeliverRange(mock.Anything, webhook.DeliveryFilter{
+		EndpointID: "w1",
+		Status:     webhook.DeliveryFailed,
+		Since:      since,
+		Until:      until,
+	}).Return(3, nil)
+	ws.EXPECT().RedeliverRange(mock.Anything, webhook.DeliveryFilter{
+		Since: until,
+		Until: since,
+	}).Return(0, webhook.ErrInvalidDetail)
+	h := &ConnectHandler{webhookService: ws}
+
+	resp, err := h.RedeliverWebhookDeliveries(context.Background(), connect.NewRequest(&frontierv1beta1.RedeliverWebhookDeliveriesRequest{
+		WebhookId: "w1",
+		Status:    "failed",
+		Since:     timestamppb.New(since),
+		Until:     timestamppb.New(until),
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.Msg.GetCount())
+
+	_, err = h.RedeliverWebhookDeliveries(context.Background(), connect.NewRequest(&frontierv1beta1.RedeliverWebhookDeliveriesRequest{
+		Since: timestamppb.New(until),
+		Until: timestamppb.New(since),
+	}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestHandler_RotateWebhookSecret(t *testing.T) {
+	ws := mocks.NewWebhookService(t)
+	ws.EXPECT().RotateSecret(mock.Anything, "w1").Return(webhook.Secret{ID: "2", Value: "ab12"}, nil)
+	ws.EXPECT().RotateSecret(mock.Anything, "missing").Return(webhook.Secret{}, webhook.ErrNotFound)
+	h := &ConnectHandler{webhookService: ws}
+
+	resp, err := h.RotateWebhookSecret(context.Background(), connect.NewRequest(&frontierv1beta1.RotateWebhookSecretRequest{WebhookId: "w1"}))
+	require.NoError(t, err)
+	assert.Equal(t, "2", resp.Msg.GetSecret().GetId())
+	assert.Equal(t, "ab12", resp.Msg.GetSecret().GetValue())
+
+	_, err = h.RotateWebhookSecret(context.Background(), connect.NewRequest(&frontierv1beta1.RotateWebhookSecretRequest{WebhookId: "missing"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestHandler_RevokeWebhookSecret(t *testing.T) {
+	ws := mocks.NewWebhookService(t)
+	ws.EXPECT().RevokeSecret(mock.Anything, "w1", "1").Return(nil)
+	ws.EXPECT().RevokeSecret(mock.Anything, "w1", "2").Return(fmt.Errorf("%w: the only secret of a webhook can't be revoked", webhook.ErrInvalidDetail))
+	h := &ConnectHandler{webhookService: ws}
+
+	_, err := h.RevokeWebhookSecret(context.Background(), connect.NewRequest(&frontierv1beta1.RevokeWebhookSecretRequest{WebhookId: "w1", SecretId: "1"}))
+	require.NoError(t, err)
+
+	_, err = h.RevokeWebhookSecret(context.Background(), connect.NewRequest(&frontierv1beta1.RevokeWebhookSecretRequest{WebhookId: "w1", SecretId: "2"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestHandler_SendTestWebhookEvent(t *testing.T) {
+	ws := mocks.NewWebhookService(t)
+	ws.EXPECT().SendTestEvent(mock.Anything, "w1").Return(webhook.Attempt{
+		StatusCode: 503,
+		Latency:    40 * time.Millisecond,
+		Response:   "unavailable",
+	}, nil)
+	ws.EXPECT().SendTestEvent(mock.Anything, "missing").Return(webhook.Attempt{}, webhook.ErrNotFound)
+	h := &ConnectHandler{webhookService: ws}
+
+	resp, err := h.SendTestWebhookEvent(context.Background(), connect.NewRequest(&frontierv1beta1.SendTestWebhookEventRequest{WebhookId: "w1"}))
+	require.NoError(t, err)
+	assert.Equal(t, int32(503), resp.Msg.GetAttempt().GetStatusCode())
+	assert.Equal(t, int64(40), resp.Msg.GetAttempt().GetLatencyMs())
+	assert.Equal(t, "unavailable", resp.Msg.GetAttempt().GetResponse())
+
+	_, err = h.SendTestWebhookEvent(context.Background(), connect.NewRequest(&frontierv1beta1.SendTestWebhookEventRequest{WebhookId: "missing"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestHandler_UpdateWebhookFilters(t *testing.T) {
+	ws := mocks.NewWebhookService(t)
+	filters := map[string][]string{"org_id": {"org-1", "org-2"}}
+	ws.EXPECT().UpdateFilters(mock.Anything, "w1", filters).Return(webhook.Endpoint{ID: "w1", Filters: filters}, nil)
+	ws.EXPECT().UpdateFilters(mock.Anything, "w1", map[string][]string{}).
+		Return(webhook.Endpoint{}, fmt.Errorf("%w: invalid filter key", webhook.ErrInvalidDetail))
+	h := &ConnectHandler{webhookService: ws}
+
+	resp, err := h.UpdateWebhookFilters(context.Background(), connect.NewRequest(&frontierv1beta1.UpdateWebhookFiltersRequest{
+		WebhookId: "w1",
+		Filters: map[string]*frontierv1beta1.WebhookFilterValues{
+			"org_id": {Values: []string{"org-1", "org-2"}},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"org-1", "org-2"}, resp.Msg.GetOptions().GetFilters()["org_id"].GetValues())
+
+	_, err = h.UpdateWebhookFilters(context.Background(), connect.NewRequest(&frontierv1beta1.UpdateWebhookFiltersRequest{WebhookId: "w1"}))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestHandler_UpdateWebhookPayloadFormat(t *testing.T) {
+	ws := mocks.NewWebhookService(t)
+	ws.EXPECT().UpdatePayloadFormat(mock.Anything, "w1", webhook.PayloadFormatCloudEventsBinary).
+		Return(webhook.Endpoint{ID: "w1", PayloadFormat: webhook.PayloadFormatCloudEventsBinary}, nil)
+	ws.EXPECT().UpdatePayloadFormat(mock.Anything, "w2", webhook.PayloadFormatCloudEvents).
+		Return(webhook.Endpoint{}, webhook.ErrNotFound)
+	h := &ConnectHandler{webhookService: ws}
+
+	resp, err := h.UpdateWebhookPayloadFormat(context.Background(), connect.NewRequest(&frontierv1beta1.UpdateWebhookPayloadFormatRequest{
+		WebhookId:     "w1",
+		PayloadFormat: "cloudevents_binary",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "cloudevents_binary", resp.Msg.GetOptions().GetPayloadFormat())
+
+	_, err = h.UpdateWebhookPayloadFormat(context.Background(), connect.NewRequest(&frontierv1beta1.UpdateWebhookPayloadFormatRequest{
+		WebhookId:     "w2",
+		PayloadFormat: "cloudevents",
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestHandler_ListWebhooks(t *testing.T) {
+	ws := mocks.NewWebhookService(t)
+	// organization webhooks are left out of the admin list
+	ws.EXPECT().ListEndpoints(mock.Anything, webhook.EndpointFilter{Platform: true}).Return([]webhook.Endpoint{
+		{ID: "w1", URL: "https://a.example/hook", State: webhook.Enabled},
+	}, nil)
+	h := &ConnectHandler{webhookService: ws}
+
+	resp, err := h.ListWebhooks(context.Background(), connect.NewRequest(&frontierv1beta1.ListWebhooksRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetWebhooks(), 1)
+	assert.Equal(t, "w1", resp.Msg.GetWebhooks()[0].GetId())
+}
+
+func TestHandler_CreateOrganizationWebhook(t *testing.T) {
+	orgID := uuid.NewString()
+	ws := mocks.NewWebhookService(t)
+	ws.EXPECT().CreateEndpoint(mock.Anything, webhook.Endpoint{
+		OrgID:            orgID,
+		URL:              "https://a.example/hook",
+		SubscribedEvents: []string{"app.organization.*"},
+	}).Return(webhook.Endpoint{
+		ID:               "w1",
+		OrgID:            orgID,
+		URL:              "https://a.example/hook",
+		SubscribedEvents: []string{"app.organization.*"},
+		State:            webhook.Enabled,
+		Secrets:          []webhook.Secret{{ID: webhook.DefaultSecretID, Value: "ab12"}},
+	}, nil)
+	ws.EXPECT().CreateEndpoint(mock.Anything, webhook.Endpoint{OrgID: orgID, URL: "https://b.example/hook"}).
+		Return(webhook.Endpoint{}, fmt.Errorf("%w: url is already used by another webhook", webhook.ErrConflict))
+	h := &ConnectHandler{webhookService: ws}
+
+	resp, err := h.CreateOrganizationWebhook(context.Background(), connect.NewRequest(&frontierv1beta1.CreateOrganizationWebhookRequest{
+		OrgId: orgID,
+		Body: &frontierv1beta1.OrganizationWebhookRequestBody{
+			Url:              "https://a.example/hook",
+			SubscribedEvents: []string{"app.organization.*"},
+		},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, orgID, resp.Msg.GetWebhook().GetOrgId())
+	require.Len(t, resp.Msg.GetWebhook().GetSecrets(), 1)
+	assert.Equal(t, "ab12", resp.Msg.GetWebhook().GetSecrets()[0].GetValue())
+
+	_, err = h.CreateOrganizationWebhook(context.Background(), connect.NewRequest(&frontierv1beta1.CreateOrganizationWebhookRequest{
+		OrgId: orgID,
+		Body:  &frontierv1beta1.OrganizationWebhookRequestBody{Url: "https://b.example/hook"},
+	}))
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+}
+
+func TestHandler_ListOrganizationWebhooks(t *testing.T) {
+	ws := mocks.NewWebhookService(t)
+	ws.EXPECT().ListEndpoints(mock.Anything, webhook.EndpointFilter{OrgID: "org-1"}).Return([]webhook.Endpoint{
+		{ID: "w1", OrgID: "org-1", URL: "https://a.example/hook"},
+	}, nil)
+	h := &ConnectHandler{webhookService: ws}
+
+	resp, err := h.ListOrganizationWebhooks(context.Background(), connect.NewRequest(&frontierv1beta1.ListOrganizationWebhooksRequest{OrgId: "org-1"}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetWebhooks(), 1)
+	assert.Equal(t, "w1", resp.Msg.GetWebhooks()[0].GetId())
+	assert.Empty(t, resp.Msg.GetWebhooks()[0].GetSecrets())
+}
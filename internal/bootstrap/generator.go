@@ -32,8 +32,15 @@ func ValidatePreparedAZSchema(ctx context.Context, azSchemaSource string) error
 	return nil
 }
 
-func PrepareSchemaAsAZSource(authzedDefinitions []*azcore.NamespaceDefinition) (string, error) {
+func PrepareSchemaAsAZSource(authzedDefinitions []*azcore.NamespaceDefinition, caveats ...*azcore.CaveatDefinition) (string, error) {
 	preparedSchemaString := ""
+	for _, caveat := range caveats {
+		generatedCaveatString, _, err := generator.GenerateCaveatSource(caveat)
+		if err != nil {
+			return "", fmt.Errorf("generateCaveatSource: failed to compile authz schema: %w", err)
+		}
+		preparedSchemaString = fmt.Sprintf("%s\n\n%s", preparedSchemaString, generatedCaveatString)
+	}
 	for _, def := range authzedDefinitions {
 		generatedDefString, _, err := generator.GenerateSource(def)
 		if err != nil {
@@ -45,6 +52,16 @@ func PrepareSchemaAsAZSource(authzedDefinitions []*azcore.NamespaceDefinition) (
 }
 
 func GetBaseAZSchema() []*azcore.NamespaceDefinition {
+	return compileBaseAZSchema().ObjectDefinitions
+}
+
+// GetBaseAZCaveats returns the caveats relations of the base schema are
+// conditioned with
+func GetBaseAZCaveats() []*azcore.CaveatDefinition {
+	return compileBaseAZSchema().CaveatDefinitions
+}
+
+func compileBaseAZSchema() *compiler.CompiledSchema {
 	tenantName := "frontier"
 	compiledSchema, err := compiler.Compile(compiler.InputSchema{
 		Source:       "base_schema.zed",
@@ -54,7 +71,7 @@ func GetBaseAZSchema() []*azcore.NamespaceDefinition {
 		// this should not happen
 		panic(err)
 	}
-	return compiledSchema.ObjectDefinitions
+	return compiledSchema
 }
 
 // BuildServiceDefinitionFromAZSchema converts authzed schema to frontier service definition.
@@ -30,7 +30,7 @@ func TestCompileSchema(t *testing.T) {
 
 	appService, err := bootstrap.BuildServiceDefinitionFromAZSchema(compiledSchema.ObjectDefinitions, "app")
 	assert.NoError(t, err)
-	assert.Len(t, appService.Permissions, 25)
+	assert.Len(t, appService.Permissions, 26)
 }
 
 func TestAddServiceToSchema(t *testing.T) {
@@ -59,7 +59,7 @@ func TestAddServiceToSchema(t *testing.T) {
 		return spiceDBDefinitions[i].GetName() < spiceDBDefinitions[j].GetName()
 	})
 
-	authzedSchemaSource, err := bootstrap.PrepareSchemaAsAZSource(spiceDBDefinitions)
+	authzedSchemaSource, err := bootstrap.PrepareSchemaAsAZSource(spiceDBDefinitions, existingSchema.CaveatDefinitions...)
 	assert.NoError(t, err)
 
 	// compile and validate generated schema
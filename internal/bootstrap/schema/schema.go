@@ -45,6 +45,11 @@ const (
 	RoleGrantRelationName    = "granted"
 	RoleBearerRelationName   = "bearer"
 
+	// RoleBindingWindowCaveat limits a role binding to its validity window
+	RoleBindingWindowCaveat = "app/rolebinding_window"
+	// CaveatNowParam is the current time checks pass to the caveats
+	CaveatNowParam = "now"
+
 	// permissions
 	ListPermission              = "list"
 	GetPermission               = "get"
@@ -65,6 +70,7 @@ const (
 	ManagePermission            = "manage"
 	BillingViewPermission       = "billingview"
 	BillingManagePermission     = "billingmanage"
+	WebhookManagePermission     = "webhookmanage"
 
 	// platform permissions
 	PlatformSudoPermission  = "superuser"
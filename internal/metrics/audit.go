@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var AuditSinkLogs *prometheus.CounterVec
+var AuditSinkQueueLength *prometheus.GaugeVec
+var AuditSinkWriteLatency HistogramFunc
+
+func initAudit() {
+	AuditSinkLogs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "audit_sink_logs_total",
+		Help: "Audit logs handled by a sink, by result: written, failed or dropped",
+	}, []string{"sink", "result"})
+	AuditSinkQueueLength = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "audit_sink_queue_length",
+		Help: "Audit logs buffered for a sink",
+	}, []string{"sink"})
+	AuditSinkWriteLatency = createMeasureTime(promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "audit_sink_write_latency",
+		Help:    "Time took to write a batch of audit logs to a sink",
+		Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"sink"}))
+}
@@ -10,6 +10,7 @@ func Init() {
 	initStripe()
 	initDB()
 	initService()
+	initAudit()
 }
 
 type HistogramFunc func(labelValue ...string) func()
@@ -0,0 +1,39 @@
+package blob
+
+import (
+	"context"
+
+	"gocloud.dev/blob"
+)
+
+const auditRecordArchiveContentType = "application/gzip"
+
+// AuditRecordArchiveStore keeps the archives of expired audit records in a
+// bucket
+type AuditRecordArchiveStore struct {
+	bucket Bucket
+}
+
+func NewAuditRecordArchiveStore(bucket Bucket) *AuditRecordArchiveStore {
+	return &AuditRecordArchiveStore{
+		bucket: bucket,
+	}
+}
+
+func (s *AuditRecordArchiveStore) Write(ctx context.Context, key string, data []byte) error {
+	return s.bucket.WriteAll(ctx, key, data, &blob.WriterOptions{
+		ContentType: auditRecordArchiveContentType,
+	})
+}
+
+func (s *AuditRecordArchiveStore) Read(ctx context.Context, key string) ([]byte, error) {
+	return s.bucket.ReadAll(ctx, key)
+}
+
+func (s *AuditRecordArchiveStore) Delete(ctx context.Context, key string) error {
+	return s.bucket.Delete(ctx, key)
+}
+
+func (s *AuditRecordArchiveStore) Close() error {
+	return s.bucket.Close()
+}
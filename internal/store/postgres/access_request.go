@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/raystack/frontier/core/accessrequest"
+)
+
+type AccessRequest struct {
+	ID              string         `db:"id"`
+	OrgID           string         `db:"org_id"`
+	ResourceID      string         `db:"resource_id"`
+	ResourceType    string         `db:"resource_type"`
+	RoleID          string         `db:"role_id"`
+	RequesterID     string         `db:"requester_id"`
+	DurationSeconds int64          `db:"duration_seconds"`
+	Justification   string         `db:"justification"`
+	State           string         `db:"state"`
+	ReviewerID      sql.NullString `db:"reviewer_id"`
+	ReviewComment   sql.NullString `db:"review_comment"`
+	ReviewedAt      sql.NullTime   `db:"reviewed_at"`
+	PolicyID        sql.NullString `db:"policy_id"`
+	ExpiresAt       sql.NullTime   `db:"expires_at"`
+	CreatedAt       time.Time      `db:"created_at"`
+	UpdatedAt       time.Time      `db:"updated_at"`
+}
+
+func (r AccessRequest) transform() accessrequest.AccessRequest {
+	return accessrequest.AccessRequest{
+		ID:            r.ID,
+		OrgID:         r.OrgID,
+		ResourceID:    r.ResourceID,
+		ResourceType:  r.ResourceType,
+		RoleID:        r.RoleID,
+		RequesterID:   r.RequesterID,
+		Duration:      time.Duration(r.DurationSeconds) * time.Second,
+		Justification: r.Justification,
+		State:         accessrequest.State(r.State),
+		ReviewerID:    nullStringToString(r.ReviewerID),
+		ReviewComment: nullStringToString(r.ReviewComment),
+		ReviewedAt:    r.ReviewedAt.Time,
+		PolicyID:      nullStringToString(r.PolicyID),
+		ExpiresAt:     r.ExpiresAt.Time,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
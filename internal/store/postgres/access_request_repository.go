@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/raystack/frontier/core/accessrequest"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type AccessRequestRepository struct {
+	dbc *db.Client
+}
+
+func NewAccessRequestRepository(dbc *db.Client) *AccessRequestRepository {
+	return &AccessRequestRepository{
+		dbc: dbc,
+	}
+}
+
+func (r AccessRequestRepository) Create(ctx context.Context, request accessrequest.AccessRequest) (accessrequest.AccessRequest, error) {
+	query, params, err := dialect.Insert(TABLE_ACCESS_REQUESTS).Rows(
+		goqu.Record{
+			"org_id":           request.OrgID,
+			"resource_id":      request.ResourceID,
+			"resource_type":    request.ResourceType,
+			"role_id":          request.RoleID,
+			"requester_id":     request.RequesterID,
+			"duration_seconds": int64(request.Duration.Seconds()),
+			"justification":    request.Justification,
+			"state":            request.State.String(),
+		}).Returning(&AccessRequest{}).ToSQL()
+	if err != nil {
+		return accessrequest.AccessRequest{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model AccessRequest
+	if err = r.dbc.WithTimeout(ctx, TABLE_ACCESS_REQUESTS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, ErrDuplicateKey):
+			return accessrequest.AccessRequest{}, accessrequest.ErrConflict
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return accessrequest.AccessRequest{}, accessrequest.ErrInvalidID
+		}
+		return accessrequest.AccessRequest{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r AccessRequestRepository) Get(ctx context.Context, id string) (accessrequest.AccessRequest, error) {
+	query, params, err := dialect.From(TABLE_ACCESS_REQUESTS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return accessrequest.AccessRequest{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model AccessRequest
+	if err = r.dbc.WithTimeout(ctx, TABLE_ACCESS_REQUESTS, "Get", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return accessrequest.AccessRequest{}, accessrequest.ErrNotExist
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return accessrequest.AccessRequest{}, accessrequest.ErrInvalidID
+		}
+		return accessrequest.AccessRequest{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r AccessRequestRepository) List(ctx context.Context, flt accessrequest.Filter) ([]accessrequest.AccessRequest, error) {
+	ex := goqu.Ex{}
+	if flt.OrgID != "" {
+		ex["org_id"] = flt.OrgID
+	}
+	if flt.ResourceID != "" {
+		ex["resource_id"] = flt.ResourceID
+	}
+	if flt.ResourceType != "" {
+		ex["resource_type"] = flt.ResourceType
+	}
+	if flt.RequesterID != "" {
+		ex["requester_id"] = flt.RequesterID
+	}
+	if flt.State != "" {
+		ex["state"] = flt.State.String()
+	}
+	query, params, err := dialect.From(TABLE_ACCESS_REQUESTS).Where(ex).
+		Order(goqu.C("created_at").Desc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var models []AccessRequest
+	if err = r.dbc.WithTimeout(ctx, TABLE_ACCESS_REQUESTS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &models, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return nil, accessrequest.ErrInvalidID
+		}
+		return nil, fmt.Errorf("%w: %w", errDB, err)
+	}
+
+	requests := make([]accessrequest.AccessRequest, 0, len(models))
+	for _, model := range models {
+		requests = append(requests, model.transform())
+	}
+	return requests, nil
+}
+
+func (r AccessRequestRepository) Review(ctx context.Context, request accessrequest.AccessRequest) (accessrequest.AccessRequest, error) {
+	query, params, err := dialect.Update(TABLE_ACCESS_REQUESTS).Set(
+		goqu.Record{
+			"state":          request.State.String(),
+			"reviewer_id":    toNullString(request.ReviewerID),
+			"review_comment": toNullString(request.ReviewComment),
+			"reviewed_at":    toNullTime(request.ReviewedAt),
+			"policy_id":      toNullString(request.PolicyID),
+			"expires_at":     toNullTime(request.ExpiresAt),
+			"updated_at":     goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"id":    request.ID,
+		"state": accessrequest.Pending.String(),
+	}).Returning(&AccessRequest{}).ToSQL()
+	if err != nil {
+		return accessrequest.AccessRequest{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model AccessRequest
+	if err = r.dbc.WithTimeout(ctx, TABLE_ACCESS_REQUESTS, "Review", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return accessrequest.AccessRequest{}, accessrequest.ErrNotPending
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return accessrequest.AccessRequest{}, accessrequest.ErrInvalidID
+		}
+		return accessrequest.AccessRequest{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
@@ -0,0 +1,164 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ory/dockertest"
+	"github.com/raystack/frontier/core/accessrequest"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/internal/store/postgres"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/stretchr/testify/suite"
+)
+
+type AccessRequestRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *db.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.AccessRequestRepository
+	orgs       []organization.Organization
+	projects   []project.Project
+	roles      []role.Role
+	users      []user.User
+}
+
+func (s *AccessRequestRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewAccessRequestRepository(s.client)
+
+	if _, err = bootstrapNamespace(s.client); err != nil {
+		s.T().Fatal(err)
+	}
+	if _, err = bootstrapPermissions(s.client); err != nil {
+		s.T().Fatal(err)
+	}
+	s.orgs, err = bootstrapOrganization(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.projects, err = bootstrapProject(s.client, s.orgs)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.roles, err = bootstrapRole(s.client, s.orgs[0].ID)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.users, err = bootstrapUser(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *AccessRequestRepositoryTestSuite) TearDownSuite() {
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *AccessRequestRepositoryTestSuite) TearDownTest() {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_ACCESS_REQUESTS),
+	}
+	if err := execQueries(context.TODO(), s.client, queries); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *AccessRequestRepositoryTestSuite) newRequest() accessrequest.AccessRequest {
+	return accessrequest.AccessRequest{
+		OrgID:         s.orgs[0].ID,
+		ResourceID:    s.projects[0].ID,
+		ResourceType:  schema.ProjectNamespace,
+		RoleID:        s.roles[0].ID,
+		RequesterID:   s.users[0].ID,
+		Duration:      4 * time.Hour,
+		Justification: "incident",
+		State:         accessrequest.Pending,
+	}
+}
+
+func (s *AccessRequestRepositoryTestSuite) TestCreateAndReview() {
+	created, err := s.repository.Create(s.ctx, s.newRequest())
+	s.Require().NoError(err)
+	s.Equal(4*time.Hour, created.Duration)
+	s.Equal(accessrequest.Pending, created.State)
+
+	_, err = s.repository.Create(s.ctx, s.newRequest())
+	s.ErrorIs(err, accessrequest.ErrConflict)
+
+	_, err = s.repository.Get(s.ctx, uuid.NewString())
+	s.ErrorIs(err, accessrequest.ErrNotExist)
+	_, err = s.repository.Get(s.ctx, "invalid")
+	s.ErrorIs(err, accessrequest.ErrInvalidID)
+
+	reviewedAt := time.Now().UTC().Truncate(time.Second)
+	policyID := uuid.NewString()
+	created.State = accessrequest.Approved
+	created.ReviewerID = s.users[1].ID
+	created.ReviewComment = "ok"
+	created.ReviewedAt = reviewedAt
+	created.PolicyID = policyID
+	created.ExpiresAt = reviewedAt.Add(created.Duration)
+	reviewed, err := s.repository.Review(s.ctx, created)
+	s.Require().NoError(err)
+	s.Equal(accessrequest.Approved, reviewed.State)
+	s.Equal(policyID, reviewed.PolicyID)
+	s.True(reviewed.ExpiresAt.Equal(reviewedAt.Add(4 * time.Hour)))
+
+	created.State = accessrequest.Denied
+	_, err = s.repository.Review(s.ctx, created)
+	s.ErrorIs(err, accessrequest.ErrNotPending)
+
+	// the requester can ask again once the request is decided
+	_, err = s.repository.Create(s.ctx, s.newRequest())
+	s.NoError(err)
+}
+
+func (s *AccessRequestRepositoryTestSuite) TestList() {
+	_, err := s.repository.Create(s.ctx, s.newRequest())
+	s.Require().NoError(err)
+	other := s.newRequest()
+	other.RequesterID = s.users[1].ID
+	other.ResourceID = s.orgs[0].ID
+	other.ResourceType = schema.OrganizationNamespace
+	_, err = s.repository.Create(s.ctx, other)
+	s.Require().NoError(err)
+
+	requests, err := s.repository.List(s.ctx, accessrequest.Filter{OrgID: s.orgs[0].ID, State: accessrequest.Pending})
+	s.Require().NoError(err)
+	s.Len(requests, 2)
+
+	requests, err = s.repository.List(s.ctx, accessrequest.Filter{ResourceID: s.projects[0].ID})
+	s.Require().NoError(err)
+	s.Require().Len(requests, 1)
+	s.Equal(s.users[0].ID, requests[0].RequesterID)
+
+	requests, err = s.repository.List(s.ctx, accessrequest.Filter{RequesterID: s.users[1].ID, State: accessrequest.Approved})
+	s.Require().NoError(err)
+	s.Empty(requests)
+}
+
+func TestAccessRequestRepository(t *testing.T) {
+	suite.Run(t, new(AccessRequestRepositoryTestSuite))
+}
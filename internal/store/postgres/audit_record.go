@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/doug-martin/goqu/v9"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/jmoiron/sqlx/types"
@@ -17,7 +18,7 @@ import (
 )
 
 type AuditRecord struct {
-	ID               uuid.UUID          `db:"id" goqu:"skipinsert"`
+	ID               uuid.UUID          `db:"id"`
 	IdempotencyKey   uuid.NullUUID      `db:"idempotency_key"`
 	Event            string             `db:"event"`
 	ActorID          uuid.UUID          `db:"actor_id"`
@@ -37,9 +38,12 @@ type AuditRecord struct {
 	OrganizationName string             `db:"org_name"`
 	RequestID        sql.NullString     `db:"request_id"`
 	OccurredAt       time.Time          `db:"occurred_at"`
-	CreatedAt        time.Time          `db:"created_at" goqu:"skipinsert"`
+	CreatedAt        time.Time          `db:"created_at"`
 	DeletedAt        sql.NullTime       `db:"deleted_at"`
 	Metadata         types.NullJSONText `db:"metadata"`
+	ChainSeq         sql.NullInt64      `db:"chain_seq"`
+	PrevHash         sql.NullString     `db:"prev_hash"`
+	Hash             sql.NullString     `db:"hash"`
 }
 
 func nullStringToTargetPtr(targetID, targetType, targetName sql.NullString, targetMetadata types.NullJSONText) *auditrecord.Target {
@@ -87,6 +91,9 @@ func (ar *AuditRecord) transformToDomain() (auditrecord.AuditRecord, error) {
 		RequestID:  nullStringToPtr(ar.RequestID),
 		CreatedAt:  ar.CreatedAt,
 		Metadata:   nullJSONTextToMetadata(ar.Metadata),
+		ChainSeq:   ar.ChainSeq.Int64,
+		PrevHash:   ar.PrevHash.String,
+		Hash:       ar.Hash.String,
 	}, nil
 }
 
@@ -275,6 +282,71 @@ func BuildAuditRecord(ctx context.Context, event pkgAuditRecord.Event, resource
 	return record
 }
 
+// chainAuditRecord links the record to the last record of its organization
+// and sets its hash. Inserts of an organization are serialized by a
+// transaction scoped advisory lock, so the sequence has no gaps and the lock
+// is released when the transaction inserting the record ends.
+func chainAuditRecord(ctx context.Context, tx *sqlx.Tx, record *AuditRecord) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))",
+		"audit_records:"+record.OrganizationID.String()); err != nil {
+		return errors.Wrap(err, "failed to lock audit chain")
+	}
+
+	// the last record may have been archived, leaving only its tombstone
+	lastRecord := dialect.Select("chain_seq", "hash").
+		From(TABLE_AUDITRECORDS).
+		Where(goqu.Ex{"org_id": record.OrganizationID}, goqu.C("chain_seq").IsNotNull()).
+		Order(goqu.C("chain_seq").Desc()).
+		Limit(1)
+	lastTombstone := dialect.Select("chain_seq", "hash").
+		From(TABLE_AUDITRECORD_TOMBSTONES).
+		Where(goqu.Ex{"org_id": record.OrganizationID}).
+		Order(goqu.C("chain_seq").Desc()).
+		Limit(1)
+	query, params, err := dialect.From(lastRecord.UnionAll(lastTombstone).As("last")).
+		Select("chain_seq", "hash").
+		Order(goqu.C("chain_seq").Desc()).
+		Limit(1).
+		ToSQL()
+	if err != nil {
+		return errors.Wrap(err, "failed to build audit chain query")
+	}
+	var last struct {
+		Seq  int64          `db:"chain_seq"`
+		Hash sql.NullString `db:"hash"`
+	}
+	if err := tx.QueryRowxContext(ctx, query, params...).StructScan(&last); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrap(err, "failed to get last audit record")
+	}
+
+	// id and timestamps are set here instead of by the database since they
+	// are part of the hash, postgres keeps microseconds
+	if record.ID == uuid.Nil {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return errors.Wrap(err, "failed to generate audit record id")
+		}
+		record.ID = id
+	}
+	record.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	record.OccurredAt = record.OccurredAt.Truncate(time.Microsecond)
+	record.ChainSeq = sql.NullInt64{Int64: last.Seq + 1, Valid: true}
+	record.PrevHash = last.Hash
+
+	// hash the record as it will be read back, e.g. with metadata decoded
+	// from its JSON
+	stored, err := record.transformToDomain()
+	if err != nil {
+		return err
+	}
+	hash, err := auditrecord.ChainHash(stored)
+	if err != nil {
+		return err
+	}
+	record.Hash = toNullString(hash)
+	return nil
+}
+
 // InsertAuditRecordInTx inserts an audit record within a transaction
 func InsertAuditRecordInTx(ctx context.Context, tx *sqlx.Tx, record AuditRecord) error {
 	// Enrich the organization name from DB only if not already set
@@ -287,6 +359,10 @@ func InsertAuditRecordInTx(ctx context.Context, tx *sqlx.Tx, record AuditRecord)
 		}
 	}
 
+	if err := chainAuditRecord(ctx, tx, &record); err != nil {
+		return err
+	}
+
 	query, params, err := dialect.Insert(TABLE_AUDITRECORDS).
 		Rows(record).
 		ToSQL()
@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/raystack/frontier/core/auditrecord"
+)
+
+type AuditRecordArchive struct {
+	ID             uuid.UUID `db:"id"`
+	OrganizationID uuid.UUID `db:"org_id"`
+	Key            string    `db:"key"`
+	FirstCreatedAt time.Time `db:"first_created_at"`
+	LastCreatedAt  time.Time `db:"last_created_at"`
+	RecordCount    int64     `db:"record_count"`
+	CreatedAt      time.Time `db:"created_at" goqu:"skipinsert"`
+}
+
+func (a AuditRecordArchive) transformToDomain() auditrecord.Archive {
+	return auditrecord.Archive{
+		ID:             a.ID.String(),
+		OrgID:          a.OrganizationID.String(),
+		Key:            a.Key,
+		FirstCreatedAt: a.FirstCreatedAt,
+		LastCreatedAt:  a.LastCreatedAt,
+		RecordCount:    a.RecordCount,
+		CreatedAt:      a.CreatedAt,
+	}
+}
+
+// AuditRecordTombstone keeps the chain fields of an archived audit record
+type AuditRecordTombstone struct {
+	OrganizationID uuid.UUID      `db:"org_id"`
+	ChainSeq       int64          `db:"chain_seq"`
+	RecordID       uuid.UUID      `db:"record_id"`
+	PrevHash       sql.NullString `db:"prev_hash"`
+	Hash           string         `db:"hash"`
+	CreatedAt      time.Time      `db:"created_at"`
+	ArchiveID      uuid.UUID      `db:"archive_id"`
+}
+
+func (t AuditRecordTombstone) transformToDomain() auditrecord.AuditRecord {
+	return auditrecord.AuditRecord{
+		ID:        t.RecordID.String(),
+		OrgID:     t.OrganizationID.String(),
+		CreatedAt: t.CreatedAt,
+		ChainSeq:  t.ChainSeq,
+		PrevHash:  t.PrevHash.String,
+		Hash:      t.Hash,
+		ArchiveID: t.ArchiveID.String(),
+	}
+}
@@ -0,0 +1,320 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/raystack/frontier/core/auditrecord"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+// auditRecordInsertBatchSize keeps multi row inserts below the limit of
+// parameters in a statement
+const auditRecordInsertBatchSize = 1000
+
+type AuditRecordArchiveRepository struct {
+	dbc *db.Client
+}
+
+func NewAuditRecordArchiveRepository(dbc *db.Client) *AuditRecordArchiveRepository {
+	return &AuditRecordArchiveRepository{
+		dbc: dbc,
+	}
+}
+
+// retentionCutoff is the creation time before which a record is expired,
+// the first cutoff matching the event and organization of the record applies
+func retentionCutoff(cutoffs []auditrecord.RetentionCutoff) exp.Expression {
+	caseExp := goqu.Case()
+	var elseValue exp.Expression = goqu.L("NULL")
+	hasWhen := false
+	for _, cutoff := range cutoffs {
+		var value exp.Expression = goqu.L("NULL")
+		if !cutoff.Before.IsZero() {
+			value = goqu.Cast(goqu.V(cutoff.Before), "TIMESTAMPTZ")
+		}
+
+		cond := goqu.Ex{}
+		if cutoff.OrgID != "" {
+			cond["org_id"] = cutoff.OrgID
+		}
+		if cutoff.Event != "" {
+			cond["event"] = cutoff.Event
+		}
+		if len(cond) == 0 {
+			elseValue = value
+			break
+		}
+		caseExp = caseExp.When(cond, value)
+		hasWhen = true
+	}
+	if !hasWhen {
+		return elseValue
+	}
+	return caseExp.Else(elseValue)
+}
+
+func (r AuditRecordArchiveRepository) ListExpiredPartitions(ctx context.Context, cutoffs []auditrecord.RetentionCutoff, limit int) ([]auditrecord.Partition, error) {
+	day := goqu.L("date_trunc('day', created_at AT TIME ZONE 'UTC')")
+	query, params, err := dialect.From(TABLE_AUDITRECORDS).
+		Select(goqu.C("org_id"), day.As("day"), goqu.COUNT("*").As("records")).
+		Where(goqu.C("created_at").Lt(retentionCutoff(cutoffs))).
+		GroupBy(goqu.C("org_id"), goqu.C("day")).
+		Order(goqu.C("day").Asc(), goqu.C("org_id").Asc()).
+		Limit(uint(limit)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var rows []struct {
+		OrgID   uuid.UUID `db:"org_id"`
+		Day     time.Time `db:"day"`
+		Records int64     `db:"records"`
+	}
+	if err = r.dbc.WithTimeout(ctx, TABLE_AUDITRECORDS, "ListExpiredPartitions", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &rows, query, params...)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %w", errDB, err)
+	}
+
+	partitions := make([]auditrecord.Partition, 0, len(rows))
+	for _, row := range rows {
+		y, m, d := row.Day.Date()
+		partitions = append(partitions, auditrecord.Partition{
+			OrgID:   row.OrgID.String(),
+			Day:     time.Date(y, m, d, 0, 0, 0, 0, time.UTC),
+			Records: row.Records,
+		})
+	}
+	return partitions, nil
+}
+
+func (r AuditRecordArchiveRepository) ListExpired(ctx context.Context, partition auditrecord.Partition, cutoffs []auditrecord.RetentionCutoff, limit int) ([]auditrecord.AuditRecord, error) {
+	query, params, err := dialect.From(TABLE_AUDITRECORDS).
+		Where(
+			goqu.Ex{"org_id": partition.OrgID},
+			goqu.C("created_at").Gte(partition.Day),
+			goqu.C("created_at").Lt(partition.Day.Add(24*time.Hour)),
+			goqu.C("created_at").Lt(retentionCutoff(cutoffs)),
+		).
+		Order(goqu.C("chain_seq").Asc().NullsFirst(), goqu.C("created_at").Asc()).
+		Limit(uint(limit)).
+		ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var models []AuditRecord
+	if err = r.dbc.WithTimeout(ctx, TABLE_AUDITRECORDS, "ListExpired", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &models, query, params...)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %w", errDB, err)
+	}
+
+	records := make([]auditrecord.AuditRecord, 0, len(models))
+	for _, model := range models {
+		record, err := model.transformToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errParse, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (r AuditRecordArchiveRepository) Archive(ctx context.Context, archive auditrecord.Archive, records []auditrecord.AuditRecord) (auditrecord.Archive, error) {
+	archiveID, err := uuid.Parse(archive.ID)
+	if err != nil {
+		return auditrecord.Archive{}, auditrecord.ErrInvalidUUID
+	}
+	orgID, err := uuid.Parse(archive.OrgID)
+	if err != nil {
+		return auditrecord.Archive{}, auditrecord.ErrInvalidUUID
+	}
+
+	ids := make([]string, 0, len(records))
+	var tombstones []AuditRecordTombstone
+	for _, record := range records {
+		ids = append(ids, record.ID)
+		if record.ChainSeq == 0 {
+			continue
+		}
+		recordID, err := uuid.Parse(record.ID)
+		if err != nil {
+			return auditrecord.Archive{}, auditrecord.ErrInvalidUUID
+		}
+		tombstones = append(tombstones, AuditRecordTombstone{
+			OrganizationID: orgID,
+			ChainSeq:       record.ChainSeq,
+			RecordID:       recordID,
+			PrevHash:       toNullString(record.PrevHash),
+			Hash:           record.Hash,
+			CreatedAt:      record.CreatedAt,
+			ArchiveID:      archiveID,
+		})
+	}
+
+	var model AuditRecordArchive
+	if err = r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+		return r.dbc.WithTimeout(ctx, TABLE_AUDITRECORD_ARCHIVES, "Archive", func(ctx context.Context) error {
+			query, params, err := dialect.Insert(TABLE_AUDITRECORD_ARCHIVES).Rows(AuditRecordArchive{
+				ID:             archiveID,
+				OrganizationID: orgID,
+				Key:            archive.Key,
+				FirstCreatedAt: archive.FirstCreatedAt,
+				LastCreatedAt:  archive.LastCreatedAt,
+				RecordCount:    archive.RecordCount,
+			}).Returning(&AuditRecordArchive{}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			if err := tx.QueryRowxContext(ctx, query, params...).StructScan(&model); err != nil {
+				return err
+			}
+
+			for start := 0; start < len(tombstones); start += auditRecordInsertBatchSize {
+				end := min(start+auditRecordInsertBatchSize, len(tombstones))
+				query, params, err := dialect.Insert(TABLE_AUDITRECORD_TOMBSTONES).Rows(tombstones[start:end]).ToSQL()
+				if err != nil {
+					return fmt.Errorf("%w: %w", errQuery, err)
+				}
+				if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+					return err
+				}
+			}
+
+			query, params, err = dialect.Delete(TABLE_AUDITRECORDS).Where(goqu.Ex{"id": ids}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			_, err = tx.ExecContext(ctx, query, params...)
+			return err
+		})
+	}); err != nil {
+		return auditrecord.Archive{}, fmt.Errorf("%w: %w", errDB, checkPostgresError(err))
+	}
+	return model.transformToDomain(), nil
+}
+
+func (r AuditRecordArchiveRepository) Restore(ctx context.Context, archive auditrecord.Archive, records []auditrecord.AuditRecord) error {
+	models := make([]AuditRecord, 0, len(records))
+	for _, record := range records {
+		model, err := restoredAuditRecord(record)
+		if err != nil {
+			return err
+		}
+		models = append(models, model)
+	}
+
+	if err := r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+		return r.dbc.WithTimeout(ctx, TABLE_AUDITRECORD_ARCHIVES, "Restore", func(ctx context.Context) error {
+			// tombstones are removed with the archive
+			query, params, err := dialect.Delete(TABLE_AUDITRECORD_ARCHIVES).Where(goqu.Ex{"id": archive.ID}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+				return err
+			}
+
+			for start := 0; start < len(models); start += auditRecordInsertBatchSize {
+				end := min(start+auditRecordInsertBatchSize, len(models))
+				query, params, err := dialect.Insert(TABLE_AUDITRECORDS).Rows(models[start:end]).ToSQL()
+				if err != nil {
+					return fmt.Errorf("%w: %w", errQuery, err)
+				}
+				if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return fmt.Errorf("%w: %w", errDB, checkPostgresError(err))
+	}
+	return nil
+}
+
+// restoredAuditRecord converts an archived record back to its row, keeping
+// the fields set when it was first inserted
+func restoredAuditRecord(record auditrecord.AuditRecord) (AuditRecord, error) {
+	model, err := transformFromDomain(record)
+	if err != nil {
+		return AuditRecord{}, fmt.Errorf("%w: %w", errParse, err)
+	}
+	if model.ID, err = uuid.Parse(record.ID); err != nil {
+		return AuditRecord{}, auditrecord.ErrInvalidUUID
+	}
+	model.OrganizationName = record.OrgName
+	if record.ChainSeq > 0 {
+		model.ChainSeq = sql.NullInt64{Int64: record.ChainSeq, Valid: true}
+		model.PrevHash = toNullString(record.PrevHash)
+		model.Hash = toNullString(record.Hash)
+	}
+	return model, nil
+}
+
+func (r AuditRecordArchiveRepository) GetArchive(ctx context.Context, id string) (auditrecord.Archive, error) {
+	query, params, err := dialect.From(TABLE_AUDITRECORD_ARCHIVES).Where(goqu.Ex{"id": id}).ToSQL()
+	if err != nil {
+		return auditrecord.Archive{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model AuditRecordArchive
+	if err = r.dbc.WithTimeout(ctx, TABLE_AUDITRECORD_ARCHIVES, "GetArchive", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return auditrecord.Archive{}, auditrecord.ErrArchiveNotFound
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return auditrecord.Archive{}, auditrecord.ErrInvalidUUID
+		}
+		return auditrecord.Archive{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transformToDomain(), nil
+}
+
+func (r AuditRecordArchiveRepository) ListArchives(ctx context.Context, filter auditrecord.ArchiveFilter) ([]auditrecord.Archive, error) {
+	stmt := dialect.From(TABLE_AUDITRECORD_ARCHIVES)
+	if filter.OrgID != "" {
+		stmt = stmt.Where(goqu.Ex{"org_id": filter.OrgID})
+	}
+	if !filter.Since.IsZero() {
+		stmt = stmt.Where(goqu.C("last_created_at").Gte(filter.Since))
+	}
+	if !filter.Until.IsZero() {
+		stmt = stmt.Where(goqu.C("first_created_at").Lt(filter.Until))
+	}
+	query, params, err := stmt.Order(goqu.C("first_created_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var models []AuditRecordArchive
+	if err = r.dbc.WithTimeout(ctx, TABLE_AUDITRECORD_ARCHIVES, "ListArchives", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &models, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return nil, auditrecord.ErrInvalidUUID
+		}
+		return nil, fmt.Errorf("%w: %w", errDB, err)
+	}
+
+	archives := make([]auditrecord.Archive, 0, len(models))
+	for _, model := range models {
+		archives = append(archives, model.transformToDomain())
+	}
+	return archives, nil
+}
@@ -13,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
 	"github.com/raystack/frontier/core/auditrecord"
 	"github.com/raystack/frontier/pkg/db"
 	"github.com/raystack/frontier/pkg/utils"
@@ -92,12 +93,17 @@ func (r AuditRecordRepository) Create(ctx context.Context, auditRecord auditreco
 	}
 
 	var auditRecordModel AuditRecord
-	query, params, err := dialect.Insert(TABLE_AUDITRECORDS).Rows(dbRecord).Returning(&AuditRecord{}).ToSQL()
-	if err != nil {
-		return auditrecord.AuditRecord{}, err
-	}
-	if err = r.dbc.WithTimeout(ctx, TABLE_AUDITRECORDS, "Create", func(ctx context.Context) error {
-		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&auditRecordModel)
+	if err = r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+		return r.dbc.WithTimeout(ctx, TABLE_AUDITRECORDS, "Create", func(ctx context.Context) error {
+			if err := chainAuditRecord(ctx, tx, &dbRecord); err != nil {
+				return err
+			}
+			query, params, err := dialect.Insert(TABLE_AUDITRECORDS).Rows(dbRecord).Returning(&AuditRecord{}).ToSQL()
+			if err != nil {
+				return err
+			}
+			return tx.QueryRowxContext(ctx, query, params...).StructScan(&auditRecordModel)
+		})
 	}); err != nil {
 		err = checkPostgresError(err)
 		switch {
@@ -156,6 +162,73 @@ func (r AuditRecordRepository) getByField(ctx context.Context, field string, val
 	return transformedAuditRecord, nil
 }
 
+// ListChain returns the chained records of an organization in sequence order.
+// Deleted records are included since they are still part of the chain, and
+// archived records are returned as their tombstones.
+func (r AuditRecordRepository) ListChain(ctx context.Context, filter auditrecord.ChainFilter) ([]auditrecord.AuditRecord, error) {
+	var auditRecordModels []AuditRecord
+	if err := r.selectChain(ctx, TABLE_AUDITRECORDS, filter, &auditRecordModels); err != nil {
+		return nil, err
+	}
+	var tombstones []AuditRecordTombstone
+	if err := r.selectChain(ctx, TABLE_AUDITRECORD_TOMBSTONES, filter, &tombstones); err != nil {
+		return nil, err
+	}
+
+	// merge both sequences, keeping at most the limit
+	records := make([]auditrecord.AuditRecord, 0, len(auditRecordModels)+len(tombstones))
+	i, j := 0, 0
+	for i < len(auditRecordModels) || j < len(tombstones) {
+		if filter.Limit > 0 && len(records) == filter.Limit {
+			break
+		}
+		if j == len(tombstones) || (i < len(auditRecordModels) && auditRecordModels[i].ChainSeq.Int64 < tombstones[j].ChainSeq) {
+			record, err := auditRecordModels[i].transformToDomain()
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", errParse, err)
+			}
+			records = append(records, record)
+			i++
+			continue
+		}
+		records = append(records, tombstones[j].transformToDomain())
+		j++
+	}
+	return records, nil
+}
+
+func (r AuditRecordRepository) selectChain(ctx context.Context, table string, filter auditrecord.ChainFilter, dest any) error {
+	stmt := dialect.From(table).Where(
+		goqu.Ex{"org_id": filter.OrgID},
+		goqu.C("chain_seq").Gt(filter.AfterSeq),
+	)
+	if !filter.Since.IsZero() {
+		stmt = stmt.Where(goqu.C("created_at").Gte(filter.Since))
+	}
+	if !filter.Until.IsZero() {
+		stmt = stmt.Where(goqu.C("created_at").Lt(filter.Until))
+	}
+	stmt = stmt.Order(goqu.C("chain_seq").Asc())
+	if filter.Limit > 0 {
+		stmt = stmt.Limit(uint(filter.Limit))
+	}
+
+	query, params, err := stmt.ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+	if err = r.dbc.WithTimeout(ctx, table, "ListChain", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, dest, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return auditrecord.ErrInvalidUUID
+		}
+		return fmt.Errorf("%w: %w", errDB, err)
+	}
+	return nil
+}
+
 func (r AuditRecordRepository) List(ctx context.Context, rqlQuery *rql.Query) (auditrecord.AuditRecordsList, error) {
 	baseStmt, err := r.buildFilteredQuery(rqlQuery)
 	if err != nil {
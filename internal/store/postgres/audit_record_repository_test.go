@@ -2,6 +2,7 @@ package postgres_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 	"log/slog"
 
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"github.com/ory/dockertest"
 	"github.com/raystack/frontier/core/auditrecord"
 	"github.com/raystack/frontier/internal/store/postgres"
@@ -1136,6 +1138,224 @@ func (s *AuditRecordRepositoryTestSuite) TestList_EdgeCases() {
 	})
 }
 
+// TEST 14: Hash chain
+func (s *AuditRecordRepositoryTestSuite) TestChain() {
+	orgID := uuid.New().String()
+
+	s.Run("concurrent creates are chained in sequence", func() {
+		const numGoroutines = 10
+		errChan := make(chan error, numGoroutines)
+		for range numGoroutines {
+			go func() {
+				record := s.createValidAuditRecord()
+				record.OrgID = orgID
+				_, err := s.repository.Create(s.ctx, record)
+				errChan <- err
+			}()
+		}
+		for range numGoroutines {
+			s.NoError(<-errChan)
+		}
+
+		records, err := s.repository.ListChain(s.ctx, auditrecord.ChainFilter{OrgID: orgID})
+		s.NoError(err)
+		s.Len(records, numGoroutines)
+		prevHash := ""
+		for i, record := range records {
+			s.Equal(int64(i+1), record.ChainSeq)
+			s.Equal(prevHash, record.PrevHash)
+			hash, err := auditrecord.ChainHash(record)
+			s.NoError(err)
+			s.Equal(hash, record.Hash, "stored hash should match the record read back")
+			prevHash = record.Hash
+		}
+	})
+
+	s.Run("records created in a transaction are chained", func() {
+		record := postgres.BuildAuditRecord(s.ctx, "user.updated", postgres.AuditResource{
+			ID:   "resource-" + uuid.New().String(),
+			Type: "project",
+		}, nil, orgID, metadata.Metadata{"count": 1}, time.Now())
+		err := s.client.WithTxn(s.ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+			return postgres.InsertAuditRecordInTx(s.ctx, tx, record)
+		})
+		s.NoError(err)
+
+		service := auditrecord.NewService(s.repository, nil, nil, nil, nil)
+		result, err := service.VerifyChain(s.ctx, orgID, time.Time{}, time.Time{})
+		s.NoError(err)
+		s.True(result.Valid(), "chain should verify: %+v", result.Break)
+		s.Equal(11, result.Checked)
+		s.Equal(int64(11), result.LastSeq)
+	})
+
+	s.Run("removed record breaks the chain", func() {
+		_, err := s.client.ExecContext(s.ctx, fmt.Sprintf("DELETE FROM %s WHERE org_id = $1 AND chain_seq = 5", postgres.TABLE_AUDITRECORDS), orgID)
+		s.NoError(err)
+
+		service := auditrecord.NewService(s.repository, nil, nil, nil, nil)
+		result, err := service.VerifyChain(s.ctx, orgID, time.Time{}, time.Time{})
+		s.NoError(err)
+		s.False(result.Valid())
+		s.Equal(int64(5), result.Break.Seq)
+		s.Equal(4, result.Checked)
+	})
+}
+
+func (s *AuditRecordRepositoryTestSuite) TestChainLock() {
+	orgID, otherOrgID := uuid.New().String(), uuid.New().String()
+	newRecord := func(org string) postgres.AuditRecord {
+		return postgres.BuildAuditRecord(s.ctx, "user.updated", postgres.AuditResource{
+			ID:   "resource-" + uuid.New().String(),
+			Type: "project",
+		}, nil, org, nil, time.Now())
+	}
+
+	s.Run("an open insert holds back inserts of its organization only", func() {
+		tx, err := s.client.DB.BeginTxx(s.ctx, nil)
+		s.Require().NoError(err)
+		s.Require().NoError(postgres.InsertAuditRecordInTx(s.ctx, tx, newRecord(orgID)))
+
+		blocked := make(chan error, 1)
+		go func() {
+			record := s.createValidAuditRecord()
+			record.OrgID = orgID
+			_, err := s.repository.Create(s.ctx, record)
+			blocked <- err
+		}()
+
+		other := s.createValidAuditRecord()
+		other.OrgID = otherOrgID
+		_, err = s.repository.Create(s.ctx, other)
+		s.NoError(err, "other organizations are not locked")
+
+		select {
+		case err := <-blocked:
+			s.Failf("insert wasn't held back by the open transaction", "error: %v", err)
+		case <-time.After(500 * time.Millisecond):
+		}
+		s.Require().NoError(tx.Commit())
+		s.NoError(<-blocked)
+	})
+
+	s.Run("concurrent inserts of both paths are chained in sequence", func() {
+		const numGoroutines = 20
+		errChan := make(chan error, numGoroutines)
+		for i := range numGoroutines {
+			org := orgID
+			if i%4 >= 2 {
+				org = otherOrgID
+			}
+			go func() {
+				if i%2 == 0 {
+					errChan <- s.client.WithTxn(s.ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+						return postgres.InsertAuditRecordInTx(s.ctx, tx, newRecord(org))
+					})
+					return
+				}
+				record := s.createValidAuditRecord()
+				record.OrgID = org
+				_, err := s.repository.Create(s.ctx, record)
+				errChan <- err
+			}()
+		}
+		for range numGoroutines {
+			s.NoError(<-errChan)
+		}
+
+		service := auditrecord.NewService(s.repository, nil, nil, nil, nil)
+		// including the records created by the previous subtest
+		for org, count := range map[string]int{orgID: numGoroutines/2 + 2, otherOrgID: numGoroutines/2 + 1} {
+			result, err := service.VerifyChain(s.ctx, org, time.Time{}, time.Time{})
+			s.NoError(err)
+			s.True(result.Valid(), "chain should verify: %+v", result.Break)
+			s.Equal(count, result.Checked)
+			s.Equal(int64(count), result.LastSeq)
+		}
+	})
+}
+
+// TEST 15: Archival
+func (s *AuditRecordRepositoryTestSuite) TestArchive() {
+	archiveRepository := postgres.NewAuditRecordArchiveRepository(s.client)
+	orgID := uuid.New().String()
+	for range 3 {
+		record := s.createValidAuditRecord()
+		record.OrgID = orgID
+		_, err := s.repository.Create(s.ctx, record)
+		s.Require().NoError(err)
+	}
+	cutoffs := []auditrecord.RetentionCutoff{{OrgID: orgID, Before: time.Now().Add(time.Minute)}}
+
+	partitions, err := archiveRepository.ListExpiredPartitions(s.ctx, cutoffs, 10)
+	s.Require().NoError(err)
+	s.Require().Len(partitions, 1)
+	s.Equal(orgID, partitions[0].OrgID)
+	s.EqualValues(3, partitions[0].Records)
+
+	expired, err := archiveRepository.ListExpired(s.ctx, partitions[0], cutoffs, 2)
+	s.Require().NoError(err)
+	s.Require().Len(expired, 2)
+	s.EqualValues(1, expired[0].ChainSeq)
+
+	archive, err := archiveRepository.Archive(s.ctx, auditrecord.Archive{
+		ID:             uuid.New().String(),
+		OrgID:          orgID,
+		Key:            "audit_records/" + orgID + "/archive.ndjson.gz",
+		FirstCreatedAt: expired[0].CreatedAt,
+		LastCreatedAt:  expired[1].CreatedAt,
+		RecordCount:    2,
+	}, expired)
+	s.Require().NoError(err)
+
+	s.Run("archived records are replaced by tombstones in the chain", func() {
+		chain, err := s.repository.ListChain(s.ctx, auditrecord.ChainFilter{OrgID: orgID})
+		s.Require().NoError(err)
+		s.Require().Len(chain, 3)
+		s.Equal(archive.ID, chain[0].ArchiveID)
+		s.Equal(archive.ID, chain[1].ArchiveID)
+		s.Empty(chain[2].ArchiveID)
+
+		service := auditrecord.NewService(s.repository, nil, nil, nil, nil)
+		result, err := service.VerifyChain(s.ctx, orgID, time.Time{}, time.Time{})
+		s.NoError(err)
+		s.True(result.Valid(), "chain should verify: %+v", result.Break)
+	})
+
+	s.Run("new records continue the chain", func() {
+		_, err := archiveRepository.Archive(s.ctx, auditrecord.Archive{
+			ID:          uuid.New().String(),
+			OrgID:       orgID,
+			Key:         "audit_records/" + orgID + "/last.ndjson.gz",
+			RecordCount: 1,
+		}, []auditrecord.AuditRecord{expiredLast(s, archiveRepository, partitions[0], cutoffs)})
+		s.Require().NoError(err)
+
+		record := s.createValidAuditRecord()
+		record.OrgID = orgID
+		created, err := s.repository.Create(s.ctx, record)
+		s.Require().NoError(err)
+		s.EqualValues(4, created.ChainSeq)
+	})
+
+	s.Run("restore inserts the records back", func() {
+		s.Require().NoError(archiveRepository.Restore(s.ctx, archive, expired))
+		_, err := archiveRepository.GetArchive(s.ctx, archive.ID)
+		s.ErrorIs(err, auditrecord.ErrArchiveNotFound)
+
+		restored, err := s.repository.GetByID(s.ctx, expired[0].ID)
+		s.Require().NoError(err)
+		s.Equal(expired[0].Hash, restored.Hash)
+	})
+}
+
+func expiredLast(s *AuditRecordRepositoryTestSuite, repo *postgres.AuditRecordArchiveRepository, partition auditrecord.Partition, cutoffs []auditrecord.RetentionCutoff) auditrecord.AuditRecord {
+	expired, err := repo.ListExpired(s.ctx, partition, cutoffs, 10)
+	s.Require().NoError(err)
+	s.Require().Len(expired, 1)
+	return expired[0]
+}
+
 // TestAuditRecordRepositoryTestSuite is the entry point for the test suite
 func TestAuditRecordRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(AuditRecordRepositoryTestSuite))
@@ -10,8 +10,10 @@ import (
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"github.com/raystack/frontier/core/audit"
 	"github.com/raystack/frontier/core/group"
+	"github.com/raystack/frontier/core/webhook"
 	"github.com/raystack/frontier/pkg/db"
 )
 
@@ -26,8 +28,54 @@ func NewAuditRepository(dbc *db.Client) *AuditRepository {
 }
 
 func (a AuditRepository) Create(ctx context.Context, l *audit.Log) error {
+	query, params, err := auditInsertQuery(l)
+	if err != nil {
+		return err
+	}
+
+	var auditModel Audit
+	if err = a.dbc.WithTimeout(ctx, TABLE_AUDITLOGS, "Create", func(ctx context.Context) error {
+		return a.dbc.QueryRowxContext(ctx, query, params...).StructScan(&auditModel)
+	}); err != nil {
+		return fmt.Errorf("failed to insert audit in pg repo: %w", err)
+	}
+	return nil
+}
+
+// CreateWithDeliveries inserts the log and the webhook deliveries of its event
+// in one transaction, the deliveries work as the outbox of the log
+func (a AuditRepository) CreateWithDeliveries(ctx context.Context, l *audit.Log, deliveries []webhook.Delivery) error {
+	query, params, err := auditInsertQuery(l)
+	if err != nil {
+		return err
+	}
+	var deliveryQuery string
+	var deliveryParams []any
+	if len(deliveries) > 0 {
+		if deliveryQuery, deliveryParams, err = deliveryInsertQuery(deliveries); err != nil {
+			return err
+		}
+	}
+
+	return a.dbc.WithTimeout(ctx, TABLE_AUDITLOGS, "CreateWithDeliveries", func(ctx context.Context) error {
+		return a.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+			if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+				return fmt.Errorf("failed to insert audit in pg repo: %w", err)
+			}
+			if deliveryQuery == "" {
+				return nil
+			}
+			if _, err := tx.ExecContext(ctx, deliveryQuery, deliveryParams...); err != nil {
+				return fmt.Errorf("%w: %s", errDB, checkPostgresError(err))
+			}
+			return nil
+		})
+	})
+}
+
+func auditInsertQuery(l *audit.Log) (string, []any, error) {
 	if strings.TrimSpace(l.Source) == "" || strings.TrimSpace(l.Action) == "" {
-		return audit.ErrInvalidDetail
+		return "", nil, audit.ErrInvalidDetail
 	}
 	if l.ID == "" {
 		l.ID = uuid.NewString()
@@ -38,15 +86,15 @@ func (a AuditRepository) Create(ctx context.Context, l *audit.Log) error {
 
 	marshaledActor, err := json.Marshal(l.Actor)
 	if err != nil {
-		return fmt.Errorf("%w: %w", err, errParse)
+		return "", nil, fmt.Errorf("%w: %w", err, errParse)
 	}
 	marshaledTarget, err := json.Marshal(l.Target)
 	if err != nil {
-		return fmt.Errorf("%w: %w", err, errParse)
+		return "", nil, fmt.Errorf("%w: %w", err, errParse)
 	}
 	marshaledMetadata, err := json.Marshal(l.Metadata)
 	if err != nil {
-		return fmt.Errorf("%w: %w", err, errParse)
+		return "", nil, fmt.Errorf("%w: %w", err, errParse)
 	}
 
 	query, params, err := dialect.Insert(TABLE_AUDITLOGS).Rows(
@@ -60,16 +108,9 @@ func (a AuditRepository) Create(ctx context.Context, l *audit.Log) error {
 			"metadata": marshaledMetadata,
 		}).Returning(&Audit{}).ToSQL()
 	if err != nil {
-		return fmt.Errorf("%w: %w", err, errQuery)
+		return "", nil, fmt.Errorf("%w: %w", err, errQuery)
 	}
-
-	var auditModel Audit
-	if err = a.dbc.WithTimeout(ctx, TABLE_AUDITLOGS, "Create", func(ctx context.Context) error {
-		return a.dbc.QueryRowxContext(ctx, query, params...).StructScan(&auditModel)
-	}); err != nil {
-		return fmt.Errorf("failed to insert audit in pg repo: %w", err)
-	}
-	return nil
+	return query, params, nil
 }
 
 func (a AuditRepository) List(ctx context.Context, flt audit.Filter) ([]audit.Log, error) {
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/raystack/frontier/core/certification"
+)
+
+type CertificationCampaign struct {
+	ID          string         `db:"id"`
+	OrgID       string         `db:"org_id"`
+	Name        string         `db:"name"`
+	ProjectIDs  pq.StringArray `db:"project_ids"`
+	RoleIDs     pq.StringArray `db:"role_ids"`
+	Deadline    time.Time      `db:"deadline"`
+	AutoRevoke  bool           `db:"auto_revoke"`
+	State       string         `db:"state"`
+	CreatedBy   sql.NullString `db:"created_by"`
+	RemindedAt  sql.NullTime   `db:"reminded_at"`
+	CompletedAt sql.NullTime   `db:"completed_at"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
+
+func (c CertificationCampaign) transform() certification.Campaign {
+	return certification.Campaign{
+		ID:          c.ID,
+		OrgID:       c.OrgID,
+		Name:        c.Name,
+		ProjectIDs:  c.ProjectIDs,
+		RoleIDs:     c.RoleIDs,
+		Deadline:    c.Deadline,
+		AutoRevoke:  c.AutoRevoke,
+		State:       certification.State(c.State),
+		CreatedBy:   nullStringToString(c.CreatedBy),
+		RemindedAt:  c.RemindedAt.Time,
+		CompletedAt: c.CompletedAt.Time,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+}
+
+type CertificationItem struct {
+	ID            string         `db:"id"`
+	CampaignID    string         `db:"campaign_id"`
+	PolicyID      string         `db:"policy_id"`
+	ResourceID    string         `db:"resource_id"`
+	ResourceType  string         `db:"resource_type"`
+	RoleID        string         `db:"role_id"`
+	PrincipalID   string         `db:"principal_id"`
+	PrincipalType string         `db:"principal_type"`
+	Decision      string         `db:"decision"`
+	ReviewerID    sql.NullString `db:"reviewer_id"`
+	Comment       sql.NullString `db:"comment"`
+	DecidedAt     sql.NullTime   `db:"decided_at"`
+	CreatedAt     time.Time      `db:"created_at"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+}
+
+func (i CertificationItem) transform() certification.Item {
+	return certification.Item{
+		ID:            i.ID,
+		CampaignID:    i.CampaignID,
+		PolicyID:      i.PolicyID,
+		ResourceID:    i.ResourceID,
+		ResourceType:  i.ResourceType,
+		RoleID:        i.RoleID,
+		PrincipalID:   i.PrincipalID,
+		PrincipalType: i.PrincipalType,
+		Decision:      certification.Decision(i.Decision),
+		ReviewerID:    nullStringToString(i.ReviewerID),
+		Comment:       nullStringToString(i.Comment),
+		DecidedAt:     i.DecidedAt.Time,
+		CreatedAt:     i.CreatedAt,
+		UpdatedAt:     i.UpdatedAt,
+	}
+}
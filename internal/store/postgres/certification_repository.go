@@ -0,0 +1,258 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raystack/frontier/core/certification"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+const certificationItemInsertBatchSize = 1000
+
+type CertificationRepository struct {
+	dbc *db.Client
+}
+
+func NewCertificationRepository(dbc *db.Client) *CertificationRepository {
+	return &CertificationRepository{
+		dbc: dbc,
+	}
+}
+
+func (r CertificationRepository) CreateCampaign(ctx context.Context, campaign certification.Campaign,
+	items []certification.Item) (certification.Campaign, error) {
+	var model CertificationCampaign
+	if err := r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+		return r.dbc.WithTimeout(ctx, TABLE_CERTIFICATION_CAMPAIGNS, "CreateCampaign", func(ctx context.Context) error {
+			query, params, err := dialect.Insert(TABLE_CERTIFICATION_CAMPAIGNS).Rows(
+				goqu.Record{
+					"org_id":      campaign.OrgID,
+					"name":        campaign.Name,
+					"project_ids": pq.StringArray(campaign.ProjectIDs),
+					"role_ids":    pq.StringArray(campaign.RoleIDs),
+					"deadline":    campaign.Deadline,
+					"auto_revoke": campaign.AutoRevoke,
+					"state":       campaign.State.String(),
+					"created_by":  toNullString(campaign.CreatedBy),
+				}).Returning(&CertificationCampaign{}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			if err := tx.QueryRowxContext(ctx, query, params...).StructScan(&model); err != nil {
+				return err
+			}
+
+			for start := 0; start < len(items); start += certificationItemInsertBatchSize {
+				end := min(start+certificationItemInsertBatchSize, len(items))
+				rows := make([]any, 0, end-start)
+				for _, item := range items[start:end] {
+					rows = append(rows, goqu.Record{
+						"campaign_id":    model.ID,
+						"policy_id":      item.PolicyID,
+						"resource_id":    item.ResourceID,
+						"resource_type":  item.ResourceType,
+						"role_id":        item.RoleID,
+						"principal_id":   item.PrincipalID,
+						"principal_type": item.PrincipalType,
+						"decision":       item.Decision.String(),
+					})
+				}
+				query, params, err := dialect.Insert(TABLE_CERTIFICATION_ITEMS).Rows(rows...).ToSQL()
+				if err != nil {
+					return fmt.Errorf("%w: %w", errQuery, err)
+				}
+				if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return certification.Campaign{}, certification.ErrInvalidID
+		}
+		return certification.Campaign{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r CertificationRepository) GetCampaign(ctx context.Context, id string) (certification.Campaign, error) {
+	query, params, err := dialect.From(TABLE_CERTIFICATION_CAMPAIGNS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return certification.Campaign{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model CertificationCampaign
+	if err = r.dbc.WithTimeout(ctx, TABLE_CERTIFICATION_CAMPAIGNS, "GetCampaign", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return certification.Campaign{}, certification.ErrNotExist
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return certification.Campaign{}, certification.ErrInvalidID
+		}
+		return certification.Campaign{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r CertificationRepository) ListCampaigns(ctx context.Context, flt certification.Filter) ([]certification.Campaign, error) {
+	ex := goqu.Ex{}
+	if flt.OrgID != "" {
+		ex["org_id"] = flt.OrgID
+	}
+	if flt.State != "" {
+		ex["state"] = flt.State.String()
+	}
+	query, params, err := dialect.From(TABLE_CERTIFICATION_CAMPAIGNS).Where(ex).
+		Order(goqu.C("created_at").Desc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var models []CertificationCampaign
+	if err = r.dbc.WithTimeout(ctx, TABLE_CERTIFICATION_CAMPAIGNS, "ListCampaigns", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &models, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return nil, certification.ErrInvalidID
+		}
+		return nil, fmt.Errorf("%w: %w", errDB, err)
+	}
+
+	campaigns := make([]certification.Campaign, 0, len(models))
+	for _, model := range models {
+		campaigns = append(campaigns, model.transform())
+	}
+	return campaigns, nil
+}
+
+func (r CertificationRepository) UpdateCampaign(ctx context.Context, campaign certification.Campaign) (certification.Campaign, error) {
+	query, params, err := dialect.Update(TABLE_CERTIFICATION_CAMPAIGNS).Set(
+		goqu.Record{
+			"state":        campaign.State.String(),
+			"reminded_at":  toNullTime(campaign.RemindedAt),
+			"completed_at": toNullTime(campaign.CompletedAt),
+			"updated_at":   goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"id":    campaign.ID,
+		"state": certification.Active.String(),
+	}).Returning(&CertificationCampaign{}).ToSQL()
+	if err != nil {
+		return certification.Campaign{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model CertificationCampaign
+	if err = r.dbc.WithTimeout(ctx, TABLE_CERTIFICATION_CAMPAIGNS, "UpdateCampaign", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return certification.Campaign{}, certification.ErrNotActive
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return certification.Campaign{}, certification.ErrInvalidID
+		}
+		return certification.Campaign{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r CertificationRepository) GetItem(ctx context.Context, id string) (certification.Item, error) {
+	query, params, err := dialect.From(TABLE_CERTIFICATION_ITEMS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return certification.Item{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model CertificationItem
+	if err = r.dbc.WithTimeout(ctx, TABLE_CERTIFICATION_ITEMS, "GetItem", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return certification.Item{}, certification.ErrNotExist
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return certification.Item{}, certification.ErrInvalidID
+		}
+		return certification.Item{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r CertificationRepository) ListItems(ctx context.Context, flt certification.ItemFilter) ([]certification.Item, error) {
+	ex := goqu.Ex{
+		"campaign_id": flt.CampaignID,
+	}
+	if flt.Decision != "" {
+		ex["decision"] = flt.Decision.String()
+	}
+	query, params, err := dialect.From(TABLE_CERTIFICATION_ITEMS).Where(ex).
+		Order(goqu.C("resource_type").Asc(), goqu.C("resource_id").Asc(), goqu.C("created_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var models []CertificationItem
+	if err = r.dbc.WithTimeout(ctx, TABLE_CERTIFICATION_ITEMS, "ListItems", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &models, query, params...)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return nil, certification.ErrInvalidID
+		}
+		return nil, fmt.Errorf("%w: %w", errDB, err)
+	}
+
+	items := make([]certification.Item, 0, len(models))
+	for _, model := range models {
+		items = append(items, model.transform())
+	}
+	return items, nil
+}
+
+func (r CertificationRepository) DecideItem(ctx context.Context, item certification.Item) (certification.Item, error) {
+	query, params, err := dialect.Update(TABLE_CERTIFICATION_ITEMS).Set(
+		goqu.Record{
+			"decision":    item.Decision.String(),
+			"reviewer_id": toNullString(item.ReviewerID),
+			"comment":     toNullString(item.Comment),
+			"decided_at":  toNullTime(item.DecidedAt),
+			"updated_at":  goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"id":       item.ID,
+		"decision": certification.Pending.String(),
+	}).Returning(&CertificationItem{}).ToSQL()
+	if err != nil {
+		return certification.Item{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model CertificationItem
+	if err = r.dbc.WithTimeout(ctx, TABLE_CERTIFICATION_ITEMS, "DecideItem", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return certification.Item{}, certification.ErrAlreadyDecided
+		case errors.Is(err, ErrInvalidTextRepresentation):
+			return certification.Item{}, certification.ErrInvalidID
+		}
+		return certification.Item{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
@@ -0,0 +1,192 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ory/dockertest"
+	"github.com/raystack/frontier/core/certification"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/project"
+	"github.com/raystack/frontier/core/role"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"github.com/raystack/frontier/internal/store/postgres"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/stretchr/testify/suite"
+)
+
+type CertificationRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *db.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.CertificationRepository
+	orgs       []organization.Organization
+	projects   []project.Project
+	roles      []role.Role
+	users      []user.User
+}
+
+func (s *CertificationRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewCertificationRepository(s.client)
+
+	if _, err = bootstrapNamespace(s.client); err != nil {
+		s.T().Fatal(err)
+	}
+	if _, err = bootstrapPermissions(s.client); err != nil {
+		s.T().Fatal(err)
+	}
+	s.orgs, err = bootstrapOrganization(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.projects, err = bootstrapProject(s.client, s.orgs)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.roles, err = bootstrapRole(s.client, s.orgs[0].ID)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.users, err = bootstrapUser(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *CertificationRepositoryTestSuite) TearDownSuite() {
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *CertificationRepositoryTestSuite) TearDownTest() {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_CERTIFICATION_CAMPAIGNS),
+	}
+	if err := execQueries(context.TODO(), s.client, queries); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *CertificationRepositoryTestSuite) createCampaign() (certification.Campaign, []certification.Item) {
+	campaign, err := s.repository.CreateCampaign(s.ctx, certification.Campaign{
+		OrgID:      s.orgs[0].ID,
+		Name:       "Q4 review",
+		ProjectIDs: []string{s.projects[0].ID},
+		Deadline:   time.Now().UTC().Add(14 * 24 * time.Hour).Truncate(time.Second),
+		AutoRevoke: true,
+		State:      certification.Active,
+		CreatedBy:  s.users[0].ID,
+	}, []certification.Item{
+		{
+			PolicyID:      uuid.NewString(),
+			ResourceID:    s.projects[0].ID,
+			ResourceType:  schema.ProjectNamespace,
+			RoleID:        s.roles[0].ID,
+			PrincipalID:   s.users[1].ID,
+			PrincipalType: schema.UserPrincipal,
+			Decision:      certification.Pending,
+		},
+		{
+			PolicyID:      uuid.NewString(),
+			ResourceID:    s.projects[0].ID,
+			ResourceType:  schema.ProjectNamespace,
+			RoleID:        s.roles[0].ID,
+			PrincipalID:   s.users[0].ID,
+			PrincipalType: schema.UserPrincipal,
+			Decision:      certification.Pending,
+		},
+	})
+	s.Require().NoError(err)
+	items, err := s.repository.ListItems(s.ctx, certification.ItemFilter{CampaignID: campaign.ID})
+	s.Require().NoError(err)
+	return campaign, items
+}
+
+func (s *CertificationRepositoryTestSuite) TestCampaign() {
+	campaign, items := s.createCampaign()
+	s.Equal(certification.Active, campaign.State)
+	s.Equal([]string{s.projects[0].ID}, []string(campaign.ProjectIDs))
+	s.Empty(campaign.RoleIDs)
+	s.True(campaign.AutoRevoke)
+	s.Len(items, 2)
+
+	_, err := s.repository.GetCampaign(s.ctx, uuid.NewString())
+	s.ErrorIs(err, certification.ErrNotExist)
+	_, err = s.repository.GetCampaign(s.ctx, "invalid")
+	s.ErrorIs(err, certification.ErrInvalidID)
+
+	remindedAt := time.Now().UTC().Truncate(time.Second)
+	campaign.RemindedAt = remindedAt
+	updated, err := s.repository.UpdateCampaign(s.ctx, campaign)
+	s.Require().NoError(err)
+	s.True(updated.RemindedAt.Equal(remindedAt))
+
+	campaigns, err := s.repository.ListCampaigns(s.ctx, certification.Filter{State: certification.Active})
+	s.Require().NoError(err)
+	s.Len(campaigns, 1)
+
+	updated.State = certification.Completed
+	updated.CompletedAt = remindedAt
+	_, err = s.repository.UpdateCampaign(s.ctx, updated)
+	s.Require().NoError(err)
+	_, err = s.repository.UpdateCampaign(s.ctx, updated)
+	s.ErrorIs(err, certification.ErrNotActive)
+
+	campaigns, err = s.repository.ListCampaigns(s.ctx, certification.Filter{OrgID: s.orgs[0].ID, State: certification.Active})
+	s.Require().NoError(err)
+	s.Empty(campaigns)
+}
+
+func (s *CertificationRepositoryTestSuite) TestDecideItem() {
+	_, items := s.createCampaign()
+
+	decidedAt := time.Now().UTC().Truncate(time.Second)
+	item := items[0]
+	item.Decision = certification.Revoked
+	item.ReviewerID = s.users[2].ID
+	item.Comment = "left the team"
+	item.DecidedAt = decidedAt
+	decided, err := s.repository.DecideItem(s.ctx, item)
+	s.Require().NoError(err)
+	s.Equal(certification.Revoked, decided.Decision)
+	s.Equal("left the team", decided.Comment)
+	s.True(decided.DecidedAt.Equal(decidedAt))
+
+	item.Decision = certification.Certified
+	_, err = s.repository.DecideItem(s.ctx, item)
+	s.ErrorIs(err, certification.ErrAlreadyDecided)
+
+	got, err := s.repository.GetItem(s.ctx, item.ID)
+	s.Require().NoError(err)
+	s.Equal(certification.Revoked, got.Decision)
+
+	pending, err := s.repository.ListItems(s.ctx, certification.ItemFilter{
+		CampaignID: item.CampaignID,
+		Decision:   certification.Pending,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(pending, 1)
+	s.Equal(items[1].ID, pending[0].ID)
+}
+
+func TestCertificationRepository(t *testing.T) {
+	suite.Run(t, new(CertificationRepositoryTestSuite))
+}
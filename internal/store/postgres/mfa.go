@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/raystack/frontier/core/authenticate/mfa"
+	"github.com/raystack/frontier/pkg/crypt"
+)
+
+type MFATOTP struct {
+	UserID       string       `db:"user_id"`
+	Secret       string       `db:"secret"`
+	LastUsedStep int64        `db:"last_used_step"`
+	ConfirmedAt  sql.NullTime `db:"confirmed_at"`
+	CreatedAt    time.Time    `db:"created_at"`
+	UpdatedAt    time.Time    `db:"updated_at"`
+}
+
+func toDBMFASecret(secret []byte, encryptionKey []byte) (string, error) {
+	encryptedSecret, err := crypt.Encrypt(secret, encryptionKey)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawStdEncoding.EncodeToString(encryptedSecret), nil
+}
+
+func (t MFATOTP) transform(encryptionKey []byte) (mfa.TOTP, error) {
+	encryptedSecret, err := base64.RawStdEncoding.DecodeString(t.Secret)
+	if err != nil {
+		return mfa.TOTP{}, fmt.Errorf("failed to decode totp secret of user %s: %w", t.UserID, err)
+	}
+	secret, err := crypt.Decrypt(encryptedSecret, encryptionKey)
+	if err != nil {
+		return mfa.TOTP{}, fmt.Errorf("failed to decrypt totp secret of user %s: %w", t.UserID, err)
+	}
+	totp := mfa.TOTP{
+		UserID:       t.UserID,
+		Secret:       secret,
+		LastUsedStep: t.LastUsedStep,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+	}
+	if t.ConfirmedAt.Valid {
+		totp.ConfirmedAt = &t.ConfirmedAt.Time
+	}
+	return totp, nil
+}
@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/raystack/frontier/core/authenticate/mfa"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type MFARepository struct {
+	dbc           *db.Client
+	encryptionKey []byte
+}
+
+func NewMFARepository(dbc *db.Client, encryptionKey []byte) *MFARepository {
+	return &MFARepository{
+		dbc:           dbc,
+		encryptionKey: encryptionKey,
+	}
+}
+
+func (r MFARepository) SetTOTP(ctx context.Context, totp mfa.TOTP) (mfa.TOTP, error) {
+	secret, err := toDBMFASecret(totp.Secret, r.encryptionKey)
+	if err != nil {
+		return mfa.TOTP{}, fmt.Errorf("failed to encrypt totp secret: %w", err)
+	}
+	// a confirmed totp is only replaced after it's deleted
+	query, params, err := dialect.Insert(TABLE_MFA_TOTPS).Rows(
+		goqu.Record{
+			"user_id": totp.UserID,
+			"secret":  secret,
+		}).OnConflict(goqu.DoUpdate("user_id", goqu.Record{
+		"secret":         secret,
+		"last_used_step": 0,
+		"confirmed_at":   nil,
+		"created_at":     goqu.L("now()"),
+		"updated_at":     goqu.L("now()"),
+	}).Where(goqu.Ex{TABLE_MFA_TOTPS + ".confirmed_at": nil})).Returning(&MFATOTP{}).ToSQL()
+	if err != nil {
+		return mfa.TOTP{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model MFATOTP
+	if err = r.dbc.WithTimeout(ctx, TABLE_MFA_TOTPS, "SetTOTP", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return mfa.TOTP{}, mfa.ErrAlreadyEnrolled
+		}
+		return mfa.TOTP{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(r.encryptionKey)
+}
+
+func (r MFARepository) GetTOTP(ctx context.Context, userID string) (mfa.TOTP, error) {
+	query, params, err := dialect.From(TABLE_MFA_TOTPS).Where(goqu.Ex{
+		"user_id": userID,
+	}).ToSQL()
+	if err != nil {
+		return mfa.TOTP{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model MFATOTP
+	if err = r.dbc.WithTimeout(ctx, TABLE_MFA_TOTPS, "GetTOTP", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return mfa.TOTP{}, mfa.ErrNotEnrolled
+		}
+		return mfa.TOTP{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(r.encryptionKey)
+}
+
+func (r MFARepository) ConfirmTOTP(ctx context.Context, userID string, step int64, codeHashes []string) error {
+	return r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+		return r.dbc.WithTimeout(ctx, TABLE_MFA_TOTPS, "ConfirmTOTP", func(ctx context.Context) error {
+			query, params, err := dialect.Update(TABLE_MFA_TOTPS).Set(
+				goqu.Record{
+					"last_used_step": step,
+					"confirmed_at":   goqu.L("now()"),
+					"updated_at":     goqu.L("now()"),
+				}).Where(goqu.Ex{
+				"user_id":      userID,
+				"confirmed_at": nil,
+			}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			result, err := tx.ExecContext(ctx, query, params...)
+			if err != nil {
+				return fmt.Errorf("%w: %w", errDB, err)
+			}
+			if count, _ := result.RowsAffected(); count == 0 {
+				return mfa.ErrAlreadyEnrolled
+			}
+			return replaceRecoveryCodes(ctx, tx, userID, codeHashes)
+		})
+	})
+}
+
+func (r MFARepository) UseTOTPStep(ctx context.Context, userID string, step int64) error {
+	query, params, err := dialect.Update(TABLE_MFA_TOTPS).Set(
+		goqu.Record{
+			"last_used_step": step,
+			"updated_at":     goqu.L("now()"),
+		}).Where(
+		goqu.Ex{"user_id": userID},
+		goqu.C("last_used_step").Lt(step),
+	).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_MFA_TOTPS, "UseTOTPStep", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return mfa.ErrInvalidCode
+		}
+		return nil
+	})
+}
+
+func (r MFARepository) DeleteTOTP(ctx context.Context, userID string) error {
+	return r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+		return r.dbc.WithTimeout(ctx, TABLE_MFA_TOTPS, "DeleteTOTP", func(ctx context.Context) error {
+			for _, table := range []string{TABLE_MFA_RECOVERY_CODES, TABLE_MFA_TOTPS} {
+				query, params, err := dialect.Delete(table).Where(goqu.Ex{
+					"user_id": userID,
+				}).ToSQL()
+				if err != nil {
+					return fmt.Errorf("%w: %w", errQuery, err)
+				}
+				if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+					return fmt.Errorf("%w: %w", errDB, err)
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func (r MFARepository) UseRecoveryCode(ctx context.Context, userID string, codeHash string) error {
+	query, params, err := dialect.Update(TABLE_MFA_RECOVERY_CODES).Set(
+		goqu.Record{
+			"used_at": goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"user_id":   userID,
+		"code_hash": codeHash,
+		"used_at":   nil,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_MFA_RECOVERY_CODES, "UseRecoveryCode", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return mfa.ErrInvalidCode
+		}
+		return nil
+	})
+}
+
+func (r MFARepository) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	return r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+		return r.dbc.WithTimeout(ctx, TABLE_MFA_RECOVERY_CODES, "ReplaceRecoveryCodes", func(ctx context.Context) error {
+			return replaceRecoveryCodes(ctx, tx, userID, codeHashes)
+		})
+	})
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx *sqlx.Tx, userID string, codeHashes []string) error {
+	query, params, err := dialect.Delete(TABLE_MFA_RECOVERY_CODES).Where(goqu.Ex{
+		"user_id": userID,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+	if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+		return fmt.Errorf("%w: %w", errDB, err)
+	}
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	rows := make([]any, 0, len(codeHashes))
+	for _, codeHash := range codeHashes {
+		rows = append(rows, goqu.Record{
+			"user_id":   userID,
+			"code_hash": codeHash,
+		})
+	}
+	query, params, err = dialect.Insert(TABLE_MFA_RECOVERY_CODES).Rows(rows...).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+	if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+		return fmt.Errorf("%w: %w", errDB, err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/ory/dockertest"
+	"github.com/raystack/frontier/core/authenticate/mfa"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/store/postgres"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/stretchr/testify/suite"
+)
+
+type MFARepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *db.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.MFARepository
+	users      []user.User
+}
+
+func (s *MFARepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewMFARepository(s.client, []byte("hash-secret-should-be-32-chars--"))
+	s.users, err = bootstrapUser(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *MFARepositoryTestSuite) TearDownSuite() {
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *MFARepositoryTestSuite) TearDownTest() {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_MFA_RECOVERY_CODES),
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_MFA_TOTPS),
+	}
+	if err := execQueries(context.TODO(), s.client, queries); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *MFARepositoryTestSuite) TestTOTP() {
+	userID := s.users[0].ID
+
+	_, err := s.repository.GetTOTP(s.ctx, userID)
+	s.ErrorIs(err, mfa.ErrNotEnrolled)
+
+	_, err = s.repository.SetTOTP(s.ctx, mfa.TOTP{UserID: userID, Secret: []byte("first")})
+	s.Require().NoError(err)
+	created, err := s.repository.SetTOTP(s.ctx, mfa.TOTP{UserID: userID, Secret: []byte("second")})
+	s.Require().NoError(err)
+	s.Equal([]byte("second"), created.Secret)
+	s.False(created.IsConfirmed())
+
+	var secret string
+	s.Require().NoError(s.client.GetContext(s.ctx, &secret,
+		fmt.Sprintf("SELECT secret FROM %s", postgres.TABLE_MFA_TOTPS)))
+	s.NotContains(secret, "second", "secret is stored encrypted")
+
+	s.Require().NoError(s.repository.ConfirmTOTP(s.ctx, userID, 10, []string{"a", "b"}))
+	s.ErrorIs(s.repository.ConfirmTOTP(s.ctx, userID, 11, nil), mfa.ErrAlreadyEnrolled)
+	_, err = s.repository.SetTOTP(s.ctx, mfa.TOTP{UserID: userID, Secret: []byte("third")})
+	s.ErrorIs(err, mfa.ErrAlreadyEnrolled)
+
+	confirmed, err := s.repository.GetTOTP(s.ctx, userID)
+	s.Require().NoError(err)
+	s.True(confirmed.IsConfirmed())
+	s.Equal([]byte("second"), confirmed.Secret)
+	s.EqualValues(10, confirmed.LastUsedStep)
+
+	s.ErrorIs(s.repository.UseTOTPStep(s.ctx, userID, 10), mfa.ErrInvalidCode)
+	s.NoError(s.repository.UseTOTPStep(s.ctx, userID, 11))
+
+	s.Require().NoError(s.repository.DeleteTOTP(s.ctx, userID))
+	_, err = s.repository.GetTOTP(s.ctx, userID)
+	s.ErrorIs(err, mfa.ErrNotEnrolled)
+	s.ErrorIs(s.repository.UseRecoveryCode(s.ctx, userID, "a"), mfa.ErrInvalidCode)
+}
+
+func (s *MFARepositoryTestSuite) TestRecoveryCodes() {
+	userID := s.users[0].ID
+
+	s.Require().NoError(s.repository.ReplaceRecoveryCodes(s.ctx, userID, []string{"a", "b"}))
+	s.NoError(s.repository.UseRecoveryCode(s.ctx, userID, "a"))
+	s.ErrorIs(s.repository.UseRecoveryCode(s.ctx, userID, "a"), mfa.ErrInvalidCode)
+	s.ErrorIs(s.repository.UseRecoveryCode(s.ctx, s.users[1].ID, "b"), mfa.ErrInvalidCode)
+
+	s.Require().NoError(s.repository.ReplaceRecoveryCodes(s.ctx, userID, []string{"c"}))
+	s.ErrorIs(s.repository.UseRecoveryCode(s.ctx, userID, "b"), mfa.ErrInvalidCode)
+	s.NoError(s.repository.UseRecoveryCode(s.ctx, userID, "c"))
+}
+
+func TestMFARepository(t *testing.T) {
+	suite.Run(t, new(MFARepositoryTestSuite))
+}
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    endpoint_id uuid NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    event_id text NOT NULL,
+    action text NOT NULL,
+    payload bytea NOT NULL,
+    request_id text,
+    status text NOT NULL DEFAULT 'pending',
+    attempt_count integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL DEFAULT NOW(),
+    last_attempt_at timestamptz,
+    last_status_code integer,
+    last_error text,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_idx ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_endpoint_created_idx ON webhook_deliveries(endpoint_id, created_at);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_event_idx ON webhook_deliveries(event_id);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    delivery_id uuid NOT NULL REFERENCES webhook_deliveries(id) ON DELETE CASCADE,
+    status_code integer,
+    latency_ms bigint NOT NULL DEFAULT 0,
+    response text,
+    error text,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS webhook_delivery_attempts_delivery_idx ON webhook_delivery_attempts(delivery_id, created_at);
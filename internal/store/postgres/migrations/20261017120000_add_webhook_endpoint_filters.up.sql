@@ -0,0 +1 @@
+ALTER TABLE webhook_endpoints ADD COLUMN IF NOT EXISTS filters jsonb NOT NULL DEFAULT '{}'::jsonb;
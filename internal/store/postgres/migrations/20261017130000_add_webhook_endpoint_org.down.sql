@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS webhook_endpoints_org_id_idx;
+ALTER TABLE webhook_endpoints DROP COLUMN IF EXISTS org_id;
@@ -0,0 +1,2 @@
+ALTER TABLE webhook_endpoints ADD COLUMN IF NOT EXISTS org_id uuid REFERENCES organizations(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS webhook_endpoints_org_id_idx ON webhook_endpoints(org_id);
@@ -0,0 +1,2 @@
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS payload_format;
+ALTER TABLE webhook_endpoints DROP COLUMN IF EXISTS payload_format;
@@ -0,0 +1,2 @@
+ALTER TABLE webhook_endpoints ADD COLUMN IF NOT EXISTS payload_format text NOT NULL DEFAULT 'frontier';
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS payload_format text NOT NULL DEFAULT 'frontier';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_audit_records_org_chain_seq;
+
+ALTER TABLE audit_records DROP COLUMN IF EXISTS hash;
+ALTER TABLE audit_records DROP COLUMN IF EXISTS prev_hash;
+ALTER TABLE audit_records DROP COLUMN IF EXISTS chain_seq;
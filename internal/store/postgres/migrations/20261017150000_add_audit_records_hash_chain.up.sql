@@ -0,0 +1,9 @@
+-- Link audit records of an organization in a hash chain. Records created
+-- before the chain have no sequence and are not verified.
+ALTER TABLE audit_records ADD COLUMN chain_seq BIGINT;
+ALTER TABLE audit_records ADD COLUMN prev_hash TEXT;
+ALTER TABLE audit_records ADD COLUMN hash TEXT;
+
+CREATE UNIQUE INDEX idx_audit_records_org_chain_seq
+    ON audit_records(org_id, chain_seq)
+    WHERE chain_seq IS NOT NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS audit_record_tombstones;
+DROP TABLE IF EXISTS audit_record_archives;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS audit_record_archives (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    org_id uuid NOT NULL,
+    key text NOT NULL UNIQUE,
+    first_created_at timestamptz NOT NULL,
+    last_created_at timestamptz NOT NULL,
+    record_count bigint NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_audit_record_archives_org_created
+    ON audit_record_archives(org_id, first_created_at);
+
+-- Archived audit records keep their chain fields, so the chain of an
+-- organization can be verified after its records are archived
+CREATE TABLE IF NOT EXISTS audit_record_tombstones (
+    org_id uuid NOT NULL,
+    chain_seq bigint NOT NULL,
+    record_id uuid NOT NULL,
+    prev_hash text,
+    hash text NOT NULL,
+    created_at timestamptz NOT NULL,
+    archive_id uuid NOT NULL REFERENCES audit_record_archives(id) ON DELETE CASCADE,
+    PRIMARY KEY (org_id, chain_seq)
+);
+CREATE INDEX IF NOT EXISTS idx_audit_record_tombstones_archive_id
+    ON audit_record_tombstones(archive_id);
@@ -0,0 +1,57 @@
+CREATE TABLE IF NOT EXISTS oauth_clients (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    org_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    redirect_uris text[] NOT NULL,
+    scopes text[] NOT NULL,
+    public boolean NOT NULL DEFAULT false,
+    secret_hash text,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS oauth_clients_org_id_idx ON oauth_clients (org_id);
+
+-- authorization requests waiting for consent and the codes issued for them
+CREATE TABLE IF NOT EXISTS oauth_authorizations (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    client_id uuid NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    redirect_uri text NOT NULL,
+    scopes text[] NOT NULL,
+    state text,
+    nonce text,
+    code_challenge text,
+    auth_time timestamptz,
+    code_hash text UNIQUE,
+    expires_at timestamptz NOT NULL,
+    consumed_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS oauth_authorizations_expires_at_idx ON oauth_authorizations (expires_at);
+
+CREATE TABLE IF NOT EXISTS oauth_consents (
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    client_id uuid NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+    scopes text[] NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, client_id)
+);
+
+-- authorization_id groups the rotations of a refresh token, it outlives
+-- the authorization so a reused code can still revoke them
+CREATE TABLE IF NOT EXISTS oauth_refresh_tokens (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    token_hash text NOT NULL UNIQUE,
+    authorization_id uuid NOT NULL,
+    client_id uuid NOT NULL REFERENCES oauth_clients (id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    scopes text[] NOT NULL,
+    auth_time timestamptz,
+    expires_at timestamptz NOT NULL,
+    rotated_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS oauth_refresh_tokens_authorization_id_idx ON oauth_refresh_tokens (authorization_id);
+CREATE INDEX IF NOT EXISTS oauth_refresh_tokens_user_id_idx ON oauth_refresh_tokens (user_id, client_id);
@@ -0,0 +1,25 @@
+-- family_id groups the rotations of a refresh token, a rotated token used
+-- again revokes its family
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    token_hash text NOT NULL UNIQUE,
+    session_id uuid NOT NULL REFERENCES sessions (id) ON DELETE CASCADE,
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    family_id uuid NOT NULL,
+    expires_at timestamptz NOT NULL,
+    rotated_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS refresh_tokens_family_id_idx ON refresh_tokens (family_id);
+CREATE INDEX IF NOT EXISTS refresh_tokens_session_id_idx ON refresh_tokens (session_id);
+
+-- access tokens revoked before their expiry, by jti, session or subject
+CREATE TABLE IF NOT EXISTS token_revocations (
+    kind text NOT NULL,
+    value text NOT NULL,
+    revoked_at timestamptz NOT NULL,
+    expires_at timestamptz NOT NULL,
+    PRIMARY KEY (kind, value)
+);
+CREATE INDEX IF NOT EXISTS token_revocations_revoked_at_idx ON token_revocations (revoked_at);
@@ -0,0 +1,15 @@
+-- keys signing the access tokens, key_material is the private jwk encrypted
+-- with app.authentication.token.key_rotation.encryption_key. A pending key
+-- is published ahead of signing, till activate_at when it is set, a retiring
+-- one till its tokens expire.
+CREATE TABLE IF NOT EXISTS signing_keys (
+    id text PRIMARY KEY,
+    algorithm text NOT NULL,
+    key_material text NOT NULL,
+    state text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    activate_at timestamptz,
+    activated_at timestamptz,
+    retired_at timestamptz
+);
+CREATE UNIQUE INDEX IF NOT EXISTS signing_keys_active_idx ON signing_keys (state) WHERE state = 'active';
@@ -0,0 +1,5 @@
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS mfa_required,
+    DROP COLUMN IF EXISTS assurance_level;
+DROP TABLE IF EXISTS mfa_recovery_codes;
+DROP TABLE IF EXISTS mfa_totps;
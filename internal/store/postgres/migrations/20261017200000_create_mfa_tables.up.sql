@@ -0,0 +1,27 @@
+-- time based one time password of a user, secret is encrypted with
+-- app.authentication.mfa.encryption_key. The enrollment is pending till
+-- confirmed_at is set by the first code of the authenticator app.
+CREATE TABLE IF NOT EXISTS mfa_totps (
+    user_id uuid PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    secret text NOT NULL,
+    last_used_step bigint NOT NULL DEFAULT 0,
+    confirmed_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- one time codes of users who lost their authenticator, only hashes are kept
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    code_hash text NOT NULL,
+    used_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, code_hash)
+);
+
+-- a session requiring mfa is held at aal1 till the user verifies the second
+-- factor
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS assurance_level text NOT NULL DEFAULT 'aal1',
+    ADD COLUMN IF NOT EXISTS mfa_required boolean NOT NULL DEFAULT false;
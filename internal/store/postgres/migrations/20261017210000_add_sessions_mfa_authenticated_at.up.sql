@@ -0,0 +1,4 @@
+-- authenticated_at is the last time the user proved the login strategy in
+-- the session, mfa_authenticated_at the last time of the second factor
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS mfa_authenticated_at timestamptz;
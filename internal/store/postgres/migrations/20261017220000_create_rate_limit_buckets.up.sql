@@ -0,0 +1,10 @@
+-- token buckets limiting the login flows per email, client ip and
+-- organization across the instances
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key text PRIMARY KEY,
+    tokens double precision NOT NULL,
+    locked_until timestamptz,
+    limited boolean NOT NULL DEFAULT false,
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS rate_limit_buckets_updated_at_idx ON rate_limit_buckets (updated_at);
@@ -0,0 +1,3 @@
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS policy,
+    DROP COLUMN IF EXISTS last_active_at;
@@ -0,0 +1,7 @@
+-- last_active_at is the last time the session authenticated a request, the
+-- policy is the session policy in effect for the user on login
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS last_active_at timestamptz,
+    ADD COLUMN IF NOT EXISTS policy jsonb NOT NULL DEFAULT '{}'::jsonb;
+
+UPDATE sessions SET last_active_at = updated_at WHERE last_active_at IS NULL;
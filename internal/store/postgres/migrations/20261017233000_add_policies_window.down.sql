@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS policies_not_before_idx;
+DROP INDEX IF EXISTS policies_expires_at_idx;
+ALTER TABLE policies
+    DROP COLUMN IF EXISTS activated,
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS not_before;
@@ -0,0 +1,9 @@
+-- not_before and expires_at bound the validity of a time bound policy, the
+-- relations of a policy are written to spicedb once it's activated
+ALTER TABLE policies
+    ADD COLUMN IF NOT EXISTS not_before timestamptz,
+    ADD COLUMN IF NOT EXISTS expires_at timestamptz,
+    ADD COLUMN IF NOT EXISTS activated boolean NOT NULL DEFAULT true;
+
+CREATE INDEX IF NOT EXISTS policies_expires_at_idx ON policies (expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS policies_not_before_idx ON policies (not_before) WHERE NOT activated;
@@ -0,0 +1,26 @@
+-- requests of members for a role on an organization, project or group for
+-- a limited time, an approval grants the role with an expiring policy
+CREATE TABLE IF NOT EXISTS access_requests (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    org_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    resource_id uuid NOT NULL,
+    resource_type text NOT NULL,
+    role_id uuid NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    requester_id uuid NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    duration_seconds bigint NOT NULL,
+    justification text NOT NULL,
+    state text NOT NULL DEFAULT 'pending',
+    reviewer_id uuid REFERENCES users (id) ON DELETE SET NULL,
+    review_comment text,
+    reviewed_at timestamptz,
+    policy_id uuid,
+    expires_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS access_requests_org_id_state_idx ON access_requests (org_id, state);
+CREATE INDEX IF NOT EXISTS access_requests_resource_id_idx ON access_requests (resource_id);
+CREATE INDEX IF NOT EXISTS access_requests_requester_id_idx ON access_requests (requester_id);
+-- a user has one pending request per role and resource
+CREATE UNIQUE INDEX IF NOT EXISTS access_requests_pending_idx ON access_requests (requester_id, resource_id, role_id)
+    WHERE state = 'pending';
@@ -0,0 +1,40 @@
+-- campaigns reviewing the policies of an organization until a deadline,
+-- project_ids and role_ids narrow the scope when set
+CREATE TABLE IF NOT EXISTS certification_campaigns (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    org_id uuid NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    project_ids text[] NOT NULL DEFAULT '{}',
+    role_ids text[] NOT NULL DEFAULT '{}',
+    deadline timestamptz NOT NULL,
+    auto_revoke boolean NOT NULL DEFAULT false,
+    state text NOT NULL DEFAULT 'active',
+    created_by uuid REFERENCES users (id) ON DELETE SET NULL,
+    reminded_at timestamptz,
+    completed_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS certification_campaigns_org_id_state_idx ON certification_campaigns (org_id, state);
+CREATE INDEX IF NOT EXISTS certification_campaigns_state_idx ON certification_campaigns (state);
+
+-- the policies in the scope of a campaign when it started and the decisions
+-- on them, policy_id is kept as evidence after the policy is deleted
+CREATE TABLE IF NOT EXISTS certification_items (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    campaign_id uuid NOT NULL REFERENCES certification_campaigns (id) ON DELETE CASCADE,
+    policy_id uuid NOT NULL,
+    resource_id uuid NOT NULL,
+    resource_type text NOT NULL,
+    role_id uuid NOT NULL,
+    principal_id uuid NOT NULL,
+    principal_type text NOT NULL,
+    decision text NOT NULL DEFAULT 'pending',
+    reviewer_id uuid REFERENCES users (id) ON DELETE SET NULL,
+    comment text,
+    decided_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS certification_items_campaign_id_decision_idx ON certification_items (campaign_id, decision);
+CREATE UNIQUE INDEX IF NOT EXISTS certification_items_campaign_id_policy_id_idx ON certification_items (campaign_id, policy_id);
@@ -49,6 +49,24 @@ func toNullString(s string) sql.NullString {
 	}
 }
 
+// toNullInt32 converts an int to sql.NullInt32.
+// Zero will be stored as NULL in the database.
+func toNullInt32(i int) sql.NullInt32 {
+	return sql.NullInt32{
+		Int32: int32(i),
+		Valid: i != 0,
+	}
+}
+
+// toNullTime converts a time.Time to sql.NullTime.
+// Zero time will be stored as NULL in the database.
+func toNullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{
+		Time:  t,
+		Valid: !t.IsZero(),
+	}
+}
+
 // nullStringToPtr converts a sql.NullString to *string.
 // invalid strings will be converted to nil.
 func nullStringToPtr(ns sql.NullString) *string {
@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+)
+
+type OAuthClient struct {
+	ID           string         `db:"id"`
+	OrgID        string         `db:"org_id"`
+	Name         string         `db:"name"`
+	RedirectURIs pq.StringArray `db:"redirect_uris"`
+	Scopes       pq.StringArray `db:"scopes"`
+	Public       bool           `db:"public"`
+	SecretHash   sql.NullString `db:"secret_hash"`
+	CreatedAt    time.Time      `db:"created_at"`
+	UpdatedAt    time.Time      `db:"updated_at"`
+}
+
+func (c OAuthClient) transform() oidcprovider.Client {
+	return oidcprovider.Client{
+		ID:           c.ID,
+		OrgID:        c.OrgID,
+		Name:         c.Name,
+		RedirectURIs: c.RedirectURIs,
+		Scopes:       c.Scopes,
+		Public:       c.Public,
+		SecretHash:   c.SecretHash.String,
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+	}
+}
+
+type OAuthAuthorization struct {
+	ID            string         `db:"id"`
+	ClientID      string         `db:"client_id"`
+	UserID        string         `db:"user_id"`
+	RedirectURI   string         `db:"redirect_uri"`
+	Scopes        pq.StringArray `db:"scopes"`
+	State         sql.NullString `db:"state"`
+	Nonce         sql.NullString `db:"nonce"`
+	CodeChallenge sql.NullString `db:"code_challenge"`
+	AuthTime      sql.NullTime   `db:"auth_time"`
+	CodeHash      sql.NullString `db:"code_hash"`
+	ExpiresAt     time.Time      `db:"expires_at"`
+	ConsumedAt    sql.NullTime   `db:"consumed_at"`
+	CreatedAt     time.Time      `db:"created_at"`
+}
+
+func (a OAuthAuthorization) transform() oidcprovider.Authorization {
+	authorization := oidcprovider.Authorization{
+		ID:            a.ID,
+		ClientID:      a.ClientID,
+		UserID:        a.UserID,
+		RedirectURI:   a.RedirectURI,
+		Scopes:        a.Scopes,
+		State:         a.State.String,
+		Nonce:         a.Nonce.String,
+		CodeChallenge: a.CodeChallenge.String,
+		AuthTime:      a.AuthTime.Time,
+		CodeHash:      a.CodeHash.String,
+		ExpiresAt:     a.ExpiresAt,
+		CreatedAt:     a.CreatedAt,
+	}
+	if a.ConsumedAt.Valid {
+		authorization.ConsumedAt = &a.ConsumedAt.Time
+	}
+	return authorization
+}
+
+type OAuthConsent struct {
+	UserID    string         `db:"user_id"`
+	ClientID  string         `db:"client_id"`
+	Scopes    pq.StringArray `db:"scopes"`
+	CreatedAt time.Time      `db:"created_at"`
+	UpdatedAt time.Time      `db:"updated_at"`
+}
+
+func (c OAuthConsent) transform() oidcprovider.Consent {
+	return oidcprovider.Consent{
+		UserID:    c.UserID,
+		ClientID:  c.ClientID,
+		Scopes:    c.Scopes,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+type OAuthRefreshToken struct {
+	ID              string         `db:"id"`
+	TokenHash       string         `db:"token_hash"`
+	AuthorizationID string         `db:"authorization_id"`
+	ClientID        string         `db:"client_id"`
+	UserID          string         `db:"user_id"`
+	Scopes          pq.StringArray `db:"scopes"`
+	AuthTime        sql.NullTime   `db:"auth_time"`
+	ExpiresAt       time.Time      `db:"expires_at"`
+	RotatedAt       sql.NullTime   `db:"rotated_at"`
+	RevokedAt       sql.NullTime   `db:"revoked_at"`
+	CreatedAt       time.Time      `db:"created_at"`
+}
+
+func (t OAuthRefreshToken) transform() oidcprovider.RefreshToken {
+	token := oidcprovider.RefreshToken{
+		ID:              t.ID,
+		TokenHash:       t.TokenHash,
+		AuthorizationID: t.AuthorizationID,
+		ClientID:        t.ClientID,
+		UserID:          t.UserID,
+		Scopes:          t.Scopes,
+		AuthTime:        t.AuthTime.Time,
+		ExpiresAt:       t.ExpiresAt,
+		CreatedAt:       t.CreatedAt,
+	}
+	if t.RotatedAt.Valid {
+		token.RotatedAt = &t.RotatedAt.Time
+	}
+	if t.RevokedAt.Valid {
+		token.RevokedAt = &t.RevokedAt.Time
+	}
+	return token
+}
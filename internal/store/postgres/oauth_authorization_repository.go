@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type OAuthAuthorizationRepository struct {
+	dbc *db.Client
+}
+
+func NewOAuthAuthorizationRepository(dbc *db.Client) *OAuthAuthorizationRepository {
+	return &OAuthAuthorizationRepository{
+		dbc: dbc,
+	}
+}
+
+func (r OAuthAuthorizationRepository) Create(ctx context.Context, authorization oidcprovider.Authorization) (oidcprovider.Authorization, error) {
+	query, params, err := dialect.Insert(TABLE_OAUTH_AUTHORIZATIONS).Rows(
+		goqu.Record{
+			"client_id":      authorization.ClientID,
+			"user_id":        authorization.UserID,
+			"redirect_uri":   authorization.RedirectURI,
+			"scopes":         pq.StringArray(authorization.Scopes),
+			"state":          toNullString(authorization.State),
+			"nonce":          toNullString(authorization.Nonce),
+			"code_challenge": toNullString(authorization.CodeChallenge),
+			"auth_time":      toNullTime(authorization.AuthTime),
+			"expires_at":     authorization.ExpiresAt,
+		}).Returning(&OAuthAuthorization{}).ToSQL()
+	if err != nil {
+		return oidcprovider.Authorization{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model OAuthAuthorization
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_AUTHORIZATIONS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		return oidcprovider.Authorization{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r OAuthAuthorizationRepository) Get(ctx context.Context, id string) (oidcprovider.Authorization, error) {
+	return r.get(ctx, "Get", goqu.Ex{"id": id})
+}
+
+func (r OAuthAuthorizationRepository) get(ctx context.Context, operation string, where goqu.Ex) (oidcprovider.Authorization, error) {
+	query, params, err := dialect.From(TABLE_OAUTH_AUTHORIZATIONS).Where(where).ToSQL()
+	if err != nil {
+		return oidcprovider.Authorization{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model OAuthAuthorization
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_AUTHORIZATIONS, operation, func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, ErrInvalidTextRepresentation) {
+			return oidcprovider.Authorization{}, oidcprovider.ErrAuthorizationNotFound
+		}
+		return oidcprovider.Authorization{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r OAuthAuthorizationRepository) SetCode(ctx context.Context, id, codeHash string, expiresAt time.Time) error {
+	query, params, err := dialect.Update(TABLE_OAUTH_AUTHORIZATIONS).Set(
+		goqu.Record{
+			"code_hash":  codeHash,
+			"expires_at": expiresAt,
+		}).Where(goqu.Ex{
+		"id":        id,
+		"code_hash": nil,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_OAUTH_AUTHORIZATIONS, "SetCode", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return oidcprovider.ErrAuthorizationNotFound
+		}
+		return nil
+	})
+}
+
+func (r OAuthAuthorizationRepository) Consume(ctx context.Context, codeHash string) (oidcprovider.Authorization, error) {
+	query, params, err := dialect.Update(TABLE_OAUTH_AUTHORIZATIONS).Set(
+		goqu.Record{
+			"consumed_at": goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"code_hash":   codeHash,
+		"consumed_at": nil,
+	}).Returning(&OAuthAuthorization{}).ToSQL()
+	if err != nil {
+		return oidcprovider.Authorization{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model OAuthAuthorization
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_AUTHORIZATIONS, "Consume", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return oidcprovider.Authorization{}, fmt.Errorf("%w: %w", errDB, err)
+		}
+		// either the code doesn't exist or it was consumed before
+		authorization, err := r.get(ctx, "Consume", goqu.Ex{"code_hash": codeHash})
+		if err != nil {
+			return oidcprovider.Authorization{}, err
+		}
+		return authorization, oidcprovider.ErrCodeReused
+	}
+	return model.transform(), nil
+}
+
+func (r OAuthAuthorizationRepository) Delete(ctx context.Context, id string) error {
+	query, params, err := dialect.Delete(TABLE_OAUTH_AUTHORIZATIONS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_OAUTH_AUTHORIZATIONS, "Delete", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		return nil
+	})
+}
+
+func (r OAuthAuthorizationRepository) DeleteExpired(ctx context.Context) error {
+	query, params, err := dialect.Delete(TABLE_OAUTH_AUTHORIZATIONS).Where(
+		goqu.C("expires_at").Lt(goqu.L("now()")),
+	).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_OAUTH_AUTHORIZATIONS, "DeleteExpired", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type OAuthClientRepository struct {
+	dbc *db.Client
+}
+
+func NewOAuthClientRepository(dbc *db.Client) *OAuthClientRepository {
+	return &OAuthClientRepository{
+		dbc: dbc,
+	}
+}
+
+func (r OAuthClientRepository) Create(ctx context.Context, client oidcprovider.Client) (oidcprovider.Client, error) {
+	query, params, err := dialect.Insert(TABLE_OAUTH_CLIENTS).Rows(
+		goqu.Record{
+			"org_id":        client.OrgID,
+			"name":          client.Name,
+			"redirect_uris": pq.StringArray(client.RedirectURIs),
+			"scopes":        pq.StringArray(client.Scopes),
+			"public":        client.Public,
+			"secret_hash":   toNullString(client.SecretHash),
+		}).Returning(&OAuthClient{}).ToSQL()
+	if err != nil {
+		return oidcprovider.Client{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model OAuthClient
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_CLIENTS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, ErrInvalidTextRepresentation) {
+			return oidcprovider.Client{}, oidcprovider.ErrInvalidClient
+		}
+		return oidcprovider.Client{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r OAuthClientRepository) Get(ctx context.Context, id string) (oidcprovider.Client, error) {
+	query, params, err := dialect.From(TABLE_OAUTH_CLIENTS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return oidcprovider.Client{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model OAuthClient
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_CLIENTS, "Get", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, ErrInvalidTextRepresentation) {
+			return oidcprovider.Client{}, oidcprovider.ErrClientNotFound
+		}
+		return oidcprovider.Client{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r OAuthClientRepository) List(ctx context.Context, orgID string) ([]oidcprovider.Client, error) {
+	query, params, err := dialect.From(TABLE_OAUTH_CLIENTS).Where(goqu.Ex{
+		"org_id": orgID,
+	}).Order(goqu.I("created_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var models []OAuthClient
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_CLIENTS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &models, query, params...)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %w", errDB, err)
+	}
+
+	clients := make([]oidcprovider.Client, 0, len(models))
+	for _, model := range models {
+		clients = append(clients, model.transform())
+	}
+	return clients, nil
+}
+
+func (r OAuthClientRepository) Update(ctx context.Context, client oidcprovider.Client) (oidcprovider.Client, error) {
+	query, params, err := dialect.Update(TABLE_OAUTH_CLIENTS).Set(
+		goqu.Record{
+			"name":          client.Name,
+			"redirect_uris": pq.StringArray(client.RedirectURIs),
+			"scopes":        pq.StringArray(client.Scopes),
+			"updated_at":    goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"id": client.ID,
+	}).Returning(&OAuthClient{}).ToSQL()
+	if err != nil {
+		return oidcprovider.Client{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model OAuthClient
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_CLIENTS, "Update", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, ErrInvalidTextRepresentation) {
+			return oidcprovider.Client{}, oidcprovider.ErrClientNotFound
+		}
+		return oidcprovider.Client{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r OAuthClientRepository) UpdateSecret(ctx context.Context, id, secretHash string) error {
+	query, params, err := dialect.Update(TABLE_OAUTH_CLIENTS).Set(
+		goqu.Record{
+			"secret_hash": secretHash,
+			"updated_at":  goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_OAUTH_CLIENTS, "UpdateSecret", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return oidcprovider.ErrClientNotFound
+		}
+		return nil
+	})
+}
+
+// Delete removes the client, its authorizations, consents and refresh tokens
+// are removed with it
+func (r OAuthClientRepository) Delete(ctx context.Context, id string) error {
+	query, params, err := dialect.Delete(TABLE_OAUTH_CLIENTS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_OAUTH_CLIENTS, "Delete", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			if errors.Is(checkPostgresError(err), ErrInvalidTextRepresentation) {
+				return oidcprovider.ErrClientNotFound
+			}
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return oidcprovider.ErrClientNotFound
+		}
+		return nil
+	})
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/lib/pq"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type OAuthConsentRepository struct {
+	dbc *db.Client
+}
+
+func NewOAuthConsentRepository(dbc *db.Client) *OAuthConsentRepository {
+	return &OAuthConsentRepository{
+		dbc: dbc,
+	}
+}
+
+func (r OAuthConsentRepository) Get(ctx context.Context, userID, clientID string) (oidcprovider.Consent, error) {
+	query, params, err := dialect.From(TABLE_OAUTH_CONSENTS).Where(goqu.Ex{
+		"user_id":   userID,
+		"client_id": clientID,
+	}).ToSQL()
+	if err != nil {
+		return oidcprovider.Consent{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model OAuthConsent
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_CONSENTS, "Get", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		err = checkPostgresError(err)
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, ErrInvalidTextRepresentation) {
+			return oidcprovider.Consent{}, oidcprovider.ErrConsentNotFound
+		}
+		return oidcprovider.Consent{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r OAuthConsentRepository) List(ctx context.Context, userID string) ([]oidcprovider.Consent, error) {
+	query, params, err := dialect.From(TABLE_OAUTH_CONSENTS).Where(goqu.Ex{
+		"user_id": userID,
+	}).Order(goqu.I("updated_at").Desc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var models []OAuthConsent
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_CONSENTS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &models, query, params...)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %w", errDB, err)
+	}
+
+	consents := make([]oidcprovider.Consent, 0, len(models))
+	for _, model := range models {
+		consents = append(consents, model.transform())
+	}
+	return consents, nil
+}
+
+func (r OAuthConsentRepository) Upsert(ctx context.Context, consent oidcprovider.Consent) (oidcprovider.Consent, error) {
+	query, params, err := dialect.Insert(TABLE_OAUTH_CONSENTS).Rows(
+		goqu.Record{
+			"user_id":   consent.UserID,
+			"client_id": consent.ClientID,
+			"scopes":    pq.StringArray(consent.Scopes),
+		}).OnConflict(goqu.DoUpdate("user_id, client_id", goqu.Record{
+		"scopes":     pq.StringArray(consent.Scopes),
+		"updated_at": goqu.L("now()"),
+	})).Returning(&OAuthConsent{}).ToSQL()
+	if err != nil {
+		return oidcprovider.Consent{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model OAuthConsent
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_CONSENTS, "Upsert", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		return oidcprovider.Consent{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r OAuthConsentRepository) Delete(ctx context.Context, userID, clientID string) error {
+	query, params, err := dialect.Delete(TABLE_OAUTH_CONSENTS).Where(goqu.Ex{
+		"user_id":   userID,
+		"client_id": clientID,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_OAUTH_CONSENTS, "Delete", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			if errors.Is(checkPostgresError(err), ErrInvalidTextRepresentation) {
+				return oidcprovider.ErrConsentNotFound
+			}
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return oidcprovider.ErrConsentNotFound
+		}
+		return nil
+	})
+}
@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type OAuthRefreshTokenRepository struct {
+	dbc *db.Client
+}
+
+func NewOAuthRefreshTokenRepository(dbc *db.Client) *OAuthRefreshTokenRepository {
+	return &OAuthRefreshTokenRepository{
+		dbc: dbc,
+	}
+}
+
+func refreshTokenRecord(token oidcprovider.RefreshToken) goqu.Record {
+	return goqu.Record{
+		"token_hash":       token.TokenHash,
+		"authorization_id": token.AuthorizationID,
+		"client_id":        token.ClientID,
+		"user_id":          token.UserID,
+		"scopes":           pq.StringArray(token.Scopes),
+		"auth_time":        toNullTime(token.AuthTime),
+		"expires_at":       token.ExpiresAt,
+	}
+}
+
+func (r OAuthRefreshTokenRepository) Create(ctx context.Context, token oidcprovider.RefreshToken) (oidcprovider.RefreshToken, error) {
+	query, params, err := dialect.Insert(TABLE_OAUTH_REFRESH_TOKENS).Rows(
+		refreshTokenRecord(token),
+	).Returning(&OAuthRefreshToken{}).ToSQL()
+	if err != nil {
+		return oidcprovider.RefreshToken{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model OAuthRefreshToken
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_REFRESH_TOKENS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		return oidcprovider.RefreshToken{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r OAuthRefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (oidcprovider.RefreshToken, error) {
+	query, params, err := dialect.From(TABLE_OAUTH_REFRESH_TOKENS).Where(goqu.Ex{
+		"token_hash": tokenHash,
+	}).ToSQL()
+	if err != nil {
+		return oidcprovider.RefreshToken{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model OAuthRefreshToken
+	if err = r.dbc.WithTimeout(ctx, TABLE_OAUTH_REFRESH_TOKENS, "GetByHash", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return oidcprovider.RefreshToken{}, oidcprovider.ErrRefreshTokenNotFound
+		}
+		return oidcprovider.RefreshToken{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r OAuthRefreshTokenRepository) Rotate(ctx context.Context, id string, next oidcprovider.RefreshToken) (oidcprovider.RefreshToken, error) {
+	var model OAuthRefreshToken
+	err := r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+		return r.dbc.WithTimeout(ctx, TABLE_OAUTH_REFRESH_TOKENS, "Rotate", func(ctx context.Context) error {
+			// only one of concurrent refreshes with the same token wins
+			query, params, err := dialect.Update(TABLE_OAUTH_REFRESH_TOKENS).Set(
+				goqu.Record{
+					"rotated_at": goqu.L("now()"),
+				}).Where(goqu.Ex{
+				"id":         id,
+				"rotated_at": nil,
+				"revoked_at": nil,
+			}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			result, err := tx.ExecContext(ctx, query, params...)
+			if err != nil {
+				return fmt.Errorf("%w: %w", errDB, err)
+			}
+			if count, _ := result.RowsAffected(); count == 0 {
+				return oidcprovider.ErrRefreshTokenReused
+			}
+
+			query, params, err = dialect.Insert(TABLE_OAUTH_REFRESH_TOKENS).Rows(
+				refreshTokenRecord(next),
+			).Returning(&OAuthRefreshToken{}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			if err := tx.QueryRowxContext(ctx, query, params...).StructScan(&model); err != nil {
+				return fmt.Errorf("%w: %w", errDB, err)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return oidcprovider.RefreshToken{}, err
+	}
+	return model.transform(), nil
+}
+
+func (r OAuthRefreshTokenRepository) RevokeFamily(ctx context.Context, authorizationID string) error {
+	return r.revoke(ctx, "RevokeFamily", goqu.Ex{
+		"authorization_id": authorizationID,
+	})
+}
+
+func (r OAuthRefreshTokenRepository) RevokeByUser(ctx context.Context, userID, clientID string) error {
+	where := goqu.Ex{
+		"user_id": userID,
+	}
+	if clientID != "" {
+		where["client_id"] = clientID
+	}
+	return r.revoke(ctx, "RevokeByUser", where)
+}
+
+func (r OAuthRefreshTokenRepository) revoke(ctx context.Context, operation string, where goqu.Ex) error {
+	where["revoked_at"] = nil
+	query, params, err := dialect.Update(TABLE_OAUTH_REFRESH_TOKENS).Set(
+		goqu.Record{
+			"revoked_at": goqu.L("now()"),
+		}).Where(where).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_OAUTH_REFRESH_TOKENS, operation, func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		return nil
+	})
+}
+
+func (r OAuthRefreshTokenRepository) DeleteExpired(ctx context.Context) error {
+	query, params, err := dialect.Delete(TABLE_OAUTH_REFRESH_TOKENS).Where(
+		goqu.C("expires_at").Lt(goqu.L("now()")),
+	).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_OAUTH_REFRESH_TOKENS, "DeleteExpired", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		return nil
+	})
+}
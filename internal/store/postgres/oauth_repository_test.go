@@ -0,0 +1,215 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ory/dockertest"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	"github.com/raystack/frontier/core/organization"
+	"github.com/raystack/frontier/core/user"
+	"github.com/raystack/frontier/internal/store/postgres"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/stretchr/testify/suite"
+)
+
+type OAuthRepositoryTestSuite struct {
+	suite.Suite
+	ctx               context.Context
+	client            *db.Client
+	pool              *dockertest.Pool
+	resource          *dockertest.Resource
+	clientRepo        *postgres.OAuthClientRepository
+	authorizationRepo *postgres.OAuthAuthorizationRepository
+	consentRepo       *postgres.OAuthConsentRepository
+	refreshTokenRepo  *postgres.OAuthRefreshTokenRepository
+	orgs              []organization.Organization
+	users             []user.User
+}
+
+func (s *OAuthRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.clientRepo = postgres.NewOAuthClientRepository(s.client)
+	s.authorizationRepo = postgres.NewOAuthAuthorizationRepository(s.client)
+	s.consentRepo = postgres.NewOAuthConsentRepository(s.client)
+	s.refreshTokenRepo = postgres.NewOAuthRefreshTokenRepository(s.client)
+
+	s.orgs, err = bootstrapOrganization(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+	s.users, err = bootstrapUser(s.client)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *OAuthRepositoryTestSuite) TearDownSuite() {
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *OAuthRepositoryTestSuite) TearDownTest() {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_OAUTH_CLIENTS),
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_OAUTH_REFRESH_TOKENS),
+	}
+	if err := execQueries(context.TODO(), s.client, queries); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *OAuthRepositoryTestSuite) createClient() oidcprovider.Client {
+	client, err := s.clientRepo.Create(s.ctx, oidcprovider.Client{
+		OrgID:        s.orgs[0].ID,
+		Name:         "wiki",
+		RedirectURIs: []string{"https://wiki.example.com/callback"},
+		Scopes:       []string{oidcprovider.ScopeOpenID, oidcprovider.ScopeOfflineAccess},
+		SecretHash:   "hash",
+	})
+	s.Require().NoError(err)
+	return client
+}
+
+func (s *OAuthRepositoryTestSuite) TestClient() {
+	client := s.createClient()
+	s.NotEmpty(client.ID)
+	s.Equal([]string{"https://wiki.example.com/callback"}, client.RedirectURIs)
+
+	got, err := s.clientRepo.Get(s.ctx, client.ID)
+	s.Require().NoError(err)
+	s.Equal(client, got)
+
+	client.Name = "docs"
+	client.RedirectURIs = append(client.RedirectURIs, "https://docs.example.com/callback")
+	updated, err := s.clientRepo.Update(s.ctx, client)
+	s.Require().NoError(err)
+	s.Equal("docs", updated.Name)
+	s.Len(updated.RedirectURIs, 2)
+
+	s.Require().NoError(s.clientRepo.UpdateSecret(s.ctx, client.ID, "rotated"))
+	got, err = s.clientRepo.Get(s.ctx, client.ID)
+	s.Require().NoError(err)
+	s.Equal("rotated", got.SecretHash)
+
+	clients, err := s.clientRepo.List(s.ctx, s.orgs[0].ID)
+	s.Require().NoError(err)
+	s.Len(clients, 1)
+
+	s.Require().NoError(s.clientRepo.Delete(s.ctx, client.ID))
+	_, err = s.clientRepo.Get(s.ctx, client.ID)
+	s.ErrorIs(err, oidcprovider.ErrClientNotFound)
+	s.ErrorIs(s.clientRepo.Delete(s.ctx, client.ID), oidcprovider.ErrClientNotFound)
+	_, err = s.clientRepo.Get(s.ctx, "not-a-uuid")
+	s.ErrorIs(err, oidcprovider.ErrClientNotFound)
+}
+
+func (s *OAuthRepositoryTestSuite) TestAuthorization() {
+	client := s.createClient()
+	authorization, err := s.authorizationRepo.Create(s.ctx, oidcprovider.Authorization{
+		ClientID:    client.ID,
+		UserID:      s.users[0].ID,
+		RedirectURI: client.RedirectURIs[0],
+		Scopes:      []string{oidcprovider.ScopeOpenID},
+		State:       "xyz",
+		AuthTime:    time.Now().UTC().Truncate(time.Second),
+		ExpiresAt:   time.Now().Add(time.Minute),
+	})
+	s.Require().NoError(err)
+	s.Empty(authorization.CodeHash)
+
+	_, err = s.authorizationRepo.Consume(s.ctx, "code-hash")
+	s.ErrorIs(err, oidcprovider.ErrAuthorizationNotFound)
+
+	s.Require().NoError(s.authorizationRepo.SetCode(s.ctx, authorization.ID, "code-hash", time.Now().Add(time.Minute)))
+	s.ErrorIs(s.authorizationRepo.SetCode(s.ctx, authorization.ID, "other-hash", time.Now().Add(time.Minute)),
+		oidcprovider.ErrAuthorizationNotFound, "code is issued once")
+
+	consumed, err := s.authorizationRepo.Consume(s.ctx, "code-hash")
+	s.Require().NoError(err)
+	s.Equal(authorization.ID, consumed.ID)
+	s.Equal("xyz", consumed.State)
+	s.NotNil(consumed.ConsumedAt)
+
+	reused, err := s.authorizationRepo.Consume(s.ctx, "code-hash")
+	s.ErrorIs(err, oidcprovider.ErrCodeReused)
+	s.Equal(authorization.ID, reused.ID)
+}
+
+func (s *OAuthRepositoryTestSuite) TestConsent() {
+	client := s.createClient()
+	_, err := s.consentRepo.Get(s.ctx, s.users[0].ID, client.ID)
+	s.ErrorIs(err, oidcprovider.ErrConsentNotFound)
+
+	_, err = s.consentRepo.Upsert(s.ctx, oidcprovider.Consent{
+		UserID: s.users[0].ID, ClientID: client.ID, Scopes: []string{oidcprovider.ScopeOpenID},
+	})
+	s.Require().NoError(err)
+	consent, err := s.consentRepo.Upsert(s.ctx, oidcprovider.Consent{
+		UserID: s.users[0].ID, ClientID: client.ID, Scopes: []string{oidcprovider.ScopeOpenID, oidcprovider.ScopeOfflineAccess},
+	})
+	s.Require().NoError(err)
+	s.Len(consent.Scopes, 2)
+
+	consents, err := s.consentRepo.List(s.ctx, s.users[0].ID)
+	s.Require().NoError(err)
+	s.Len(consents, 1)
+
+	s.Require().NoError(s.consentRepo.Delete(s.ctx, s.users[0].ID, client.ID))
+	s.ErrorIs(s.consentRepo.Delete(s.ctx, s.users[0].ID, client.ID), oidcprovider.ErrConsentNotFound)
+}
+
+func (s *OAuthRepositoryTestSuite) TestRefreshToken() {
+	client := s.createClient()
+	authorizationID := uuid.NewString()
+	newToken := func(hash string) oidcprovider.RefreshToken {
+		return oidcprovider.RefreshToken{
+			TokenHash:       hash,
+			AuthorizationID: authorizationID,
+			ClientID:        client.ID,
+			UserID:          s.users[0].ID,
+			Scopes:          []string{oidcprovider.ScopeOpenID},
+			ExpiresAt:       time.Now().Add(time.Hour),
+		}
+	}
+
+	first, err := s.refreshTokenRepo.Create(s.ctx, newToken("first"))
+	s.Require().NoError(err)
+	second, err := s.refreshTokenRepo.Rotate(s.ctx, first.ID, newToken("second"))
+	s.Require().NoError(err)
+	s.Equal(authorizationID, second.AuthorizationID)
+
+	_, err = s.refreshTokenRepo.Rotate(s.ctx, first.ID, newToken("third"))
+	s.ErrorIs(err, oidcprovider.ErrRefreshTokenReused)
+	_, err = s.refreshTokenRepo.GetByHash(s.ctx, "third")
+	s.ErrorIs(err, oidcprovider.ErrRefreshTokenNotFound, "failed rotation doesn't leave a token behind")
+
+	got, err := s.refreshTokenRepo.GetByHash(s.ctx, "first")
+	s.Require().NoError(err)
+	s.NotNil(got.RotatedAt)
+
+	s.Require().NoError(s.refreshTokenRepo.RevokeFamily(s.ctx, authorizationID))
+	got, err = s.refreshTokenRepo.GetByHash(s.ctx, "second")
+	s.Require().NoError(err)
+	s.NotNil(got.RevokedAt)
+	_, err = s.refreshTokenRepo.Rotate(s.ctx, second.ID, newToken("fourth"))
+	s.ErrorIs(err, oidcprovider.ErrRefreshTokenReused)
+}
+
+func TestOAuthRepository(t *testing.T) {
+	suite.Run(t, new(OAuthRepositoryTestSuite))
+}
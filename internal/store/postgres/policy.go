@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"database/sql"
 	"encoding/json"
 	"time"
 
@@ -10,28 +11,32 @@ import (
 type Policy struct {
 	ID            string `db:"id"`
 	Role          Role
-	RoleID        string    `db:"role_id"`
-	ResourceID    string    `db:"resource_id"`
-	ResourceType  string    `db:"resource_type"`
-	PrincipalID   string    `db:"principal_id"`
-	PrincipalType string    `db:"principal_type"`
-	GrantRelation string    `db:"grant_relation"`
-	Metadata      []byte    `db:"metadata"`
-	CreatedAt     time.Time `db:"created_at"`
-	UpdatedAt     time.Time `db:"updated_at"`
+	RoleID        string       `db:"role_id"`
+	ResourceID    string       `db:"resource_id"`
+	ResourceType  string       `db:"resource_type"`
+	PrincipalID   string       `db:"principal_id"`
+	PrincipalType string       `db:"principal_type"`
+	GrantRelation string       `db:"grant_relation"`
+	Metadata      []byte       `db:"metadata"`
+	NotBefore     sql.NullTime `db:"not_before"`
+	ExpiresAt     sql.NullTime `db:"expires_at"`
+	CreatedAt     time.Time    `db:"created_at"`
+	UpdatedAt     time.Time    `db:"updated_at"`
 }
 
 type PolicyCols struct {
-	ID            string    `db:"id"`
-	RoleID        string    `db:"role_id"`
-	ResourceType  string    `db:"resource_type"`
-	ResourceID    string    `db:"resource_id"`
-	PrincipalID   string    `db:"principal_id"`
-	PrincipalType string    `db:"principal_type"`
-	GrantRelation string    `db:"grant_relation"`
-	Metadata      []byte    `db:"metadata"`
-	CreatedAt     time.Time `db:"created_at"`
-	UpdatedAt     time.Time `db:"updated_at"`
+	ID            string       `db:"id"`
+	RoleID        string       `db:"role_id"`
+	ResourceType  string       `db:"resource_type"`
+	ResourceID    string       `db:"resource_id"`
+	PrincipalID   string       `db:"principal_id"`
+	PrincipalType string       `db:"principal_type"`
+	GrantRelation string       `db:"grant_relation"`
+	Metadata      []byte       `db:"metadata"`
+	NotBefore     sql.NullTime `db:"not_before"`
+	ExpiresAt     sql.NullTime `db:"expires_at"`
+	CreatedAt     time.Time    `db:"created_at"`
+	UpdatedAt     time.Time    `db:"updated_at"`
 }
 
 func (from Policy) transformToPolicy() (policy.Policy, error) {
@@ -51,6 +56,8 @@ func (from Policy) transformToPolicy() (policy.Policy, error) {
 		PrincipalType: from.PrincipalType,
 		GrantRelation: from.GrantRelation,
 		Metadata:      unmarshalledMetadata,
+		NotBefore:     from.NotBefore.Time,
+		ExpiresAt:     from.ExpiresAt.Time,
 		CreatedAt:     from.CreatedAt,
 		UpdatedAt:     from.UpdatedAt,
 	}, nil
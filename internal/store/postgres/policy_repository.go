@@ -39,6 +39,8 @@ func (r PolicyRepository) buildListQuery() *goqu.SelectDataset {
 		"p.principal_type",
 		"p.role_id",
 		"p.grant_relation",
+		"p.not_before",
+		"p.expires_at",
 	).From(goqu.T(TABLE_POLICIES).As("p"))
 }
 
@@ -208,6 +210,8 @@ func (r PolicyRepository) Upsert(ctx context.Context, pol policy.Policy) (policy
 	if err != nil {
 		return policy.Policy{}, fmt.Errorf("%w: %w", errParse, err)
 	}
+	// a pending policy is activated by the reaper once its window starts
+	activated := !pol.Pending(time.Now())
 
 	query, params, err := dialect.Insert(TABLE_POLICIES).Rows(
 		goqu.Record{
@@ -218,9 +222,15 @@ func (r PolicyRepository) Upsert(ctx context.Context, pol policy.Policy) (policy
 			"principal_type": pol.PrincipalType,
 			"grant_relation": pol.GrantRelation,
 			"metadata":       marshaledMetadata,
+			"not_before":     toNullTime(pol.NotBefore),
+			"expires_at":     toNullTime(pol.ExpiresAt),
+			"activated":      activated,
 		}).OnConflict(goqu.DoUpdate("role_id, resource_id, resource_type, principal_id, principal_type", goqu.Record{
 		"grant_relation": pol.GrantRelation,
 		"metadata":       marshaledMetadata,
+		"not_before":     toNullTime(pol.NotBefore),
+		"expires_at":     toNullTime(pol.ExpiresAt),
+		"activated":      activated,
 		"updated_at":     goqu.L("now()"),
 	})).Returning(&PolicyCols{}).ToSQL()
 	if err != nil {
@@ -457,6 +467,54 @@ func (r PolicyRepository) DeleteWithMinRoleGuard(ctx context.Context, id string,
 	return nil
 }
 
+func (r PolicyRepository) ListExpired(ctx context.Context, now time.Time) ([]policy.Policy, error) {
+	return r.listByWindow(ctx, "ListExpired", goqu.C("expires_at").Lte(now))
+}
+
+func (r PolicyRepository) ListDue(ctx context.Context, now time.Time) ([]policy.Policy, error) {
+	return r.listByWindow(ctx, "ListDue", goqu.Ex{"activated": false}, goqu.C("not_before").Lte(now))
+}
+
+func (r PolicyRepository) listByWindow(ctx context.Context, operation string, conditions ...goqu.Expression) ([]policy.Policy, error) {
+	query, params, err := r.buildListQuery().Where(conditions...).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var fetchedPolicies []Policy
+	if err = r.dbc.WithTimeout(ctx, TABLE_POLICIES, operation, func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &fetchedPolicies, query, params...)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %s", errDB, checkPostgresError(err))
+	}
+
+	policies := make([]policy.Policy, 0, len(fetchedPolicies))
+	for _, p := range fetchedPolicies {
+		transformedPolicy, err := p.transformToPolicy()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errParse, err)
+		}
+		policies = append(policies, transformedPolicy)
+	}
+	return policies, nil
+}
+
+func (r PolicyRepository) MarkActivated(ctx context.Context, id string) error {
+	query, params, err := dialect.Update(TABLE_POLICIES).Set(goqu.Record{
+		"activated": true,
+	}).Where(goqu.Ex{"id": id}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_POLICIES, "MarkActivated", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %s", errDB, checkPostgresError(err))
+		}
+		return nil
+	})
+}
+
 func (r PolicyRepository) GroupMemberCount(ctx context.Context, groupIDs []string) ([]policy.MemberCount, error) {
 	if len(groupIDs) == 0 {
 		return nil, policy.ErrInvalidID
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/raystack/frontier/core/role"
 
@@ -351,6 +352,46 @@ func (s *PolicyRepositoryTestSuite) TestDelete() {
 	}
 }
 
+func (s *PolicyRepositoryTestSuite) TestListByWindow() {
+	now := time.Now().UTC().Truncate(time.Second)
+	create := func(notBefore, expiresAt time.Time) policy.Policy {
+		created, err := s.repository.Upsert(s.ctx, policy.Policy{
+			RoleID:        s.roles[0].ID,
+			ResourceID:    uuid.NewString(),
+			ResourceType:  "ns1",
+			PrincipalID:   s.userID,
+			PrincipalType: schema.UserPrincipal,
+			NotBefore:     notBefore,
+			ExpiresAt:     expiresAt,
+		})
+		s.Require().NoError(err)
+		return created
+	}
+	expired := create(time.Time{}, now.Add(time.Hour))
+	pending := create(now.Add(time.Hour), now.Add(2*time.Hour))
+	s.Assert().True(expired.ExpiresAt.Equal(now.Add(time.Hour)))
+
+	later := now.Add(90 * time.Minute)
+	got, err := s.repository.ListExpired(s.ctx, later)
+	s.Require().NoError(err)
+	s.Require().Len(got, 1)
+	s.Assert().Equal(expired.ID, got[0].ID)
+
+	got, err = s.repository.ListDue(s.ctx, now)
+	s.Require().NoError(err)
+	s.Assert().Empty(got)
+
+	got, err = s.repository.ListDue(s.ctx, later)
+	s.Require().NoError(err)
+	s.Require().Len(got, 1)
+	s.Assert().Equal(pending.ID, got[0].ID)
+
+	s.Require().NoError(s.repository.MarkActivated(s.ctx, pending.ID))
+	got, err = s.repository.ListDue(s.ctx, later)
+	s.Require().NoError(err)
+	s.Assert().Empty(got)
+}
+
 func TestPolicyRepository(t *testing.T) {
 	suite.Run(t, new(PolicyRepositoryTestSuite))
 }
@@ -42,6 +42,8 @@ const (
 	TABLE_SERVICEUSERCREDENTIALS = "serviceuser_credentials"
 	TABLE_AUDITLOGS              = "auditlogs"
 	TABLE_AUDITRECORDS           = "audit_records"
+	TABLE_AUDITRECORD_ARCHIVES   = "audit_record_archives"
+	TABLE_AUDITRECORD_TOMBSTONES = "audit_record_tombstones"
 	TABLE_DOMAINS                = "domains"
 	TABLE_PREFERENCES            = "preferences"
 	TABLE_BILLING_CUSTOMERS      = "billing_customers"
@@ -54,8 +56,24 @@ const (
 	TABLE_BILLING_TRANSACTIONS   = "billing_transactions"
 	TABLE_BILLING_INVOICES       = "billing_invoices"
 	TABLE_WEBHOOK_ENDPOINTS      = "webhook_endpoints"
+	TABLE_WEBHOOK_DELIVERIES     = "webhook_deliveries"
+	TABLE_WEBHOOK_ATTEMPTS       = "webhook_delivery_attempts"
 	TABLE_PROSPECTS              = "prospects"
 	TABLE_USER_PATS              = "user_pats"
+	TABLE_OAUTH_CLIENTS          = "oauth_clients"
+	TABLE_OAUTH_AUTHORIZATIONS   = "oauth_authorizations"
+	TABLE_OAUTH_CONSENTS         = "oauth_consents"
+	TABLE_OAUTH_REFRESH_TOKENS   = "oauth_refresh_tokens"
+	TABLE_REFRESH_TOKENS         = "refresh_tokens"
+	TABLE_TOKEN_REVOCATIONS      = "token_revocations"
+	TABLE_SIGNING_KEYS           = "signing_keys"
+	TABLE_MFA_TOTPS              = "mfa_totps"
+	TABLE_MFA_RECOVERY_CODES     = "mfa_recovery_codes"
+	TABLE_RATE_LIMIT_BUCKETS     = "rate_limit_buckets"
+	TABLE_ACCESS_REQUESTS        = "access_requests"
+
+	TABLE_CERTIFICATION_CAMPAIGNS = "certification_campaigns"
+	TABLE_CERTIFICATION_ITEMS     = "certification_items"
 )
 
 func checkPostgresError(err error) error {
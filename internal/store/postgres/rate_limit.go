@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/raystack/frontier/core/authenticate/ratelimit"
+)
+
+type RateLimitBucket struct {
+	Key         string       `db:"key"`
+	Tokens      float64      `db:"tokens"`
+	LockedUntil sql.NullTime `db:"locked_until"`
+	Limited     bool         `db:"limited"`
+	UpdatedAt   time.Time    `db:"updated_at"`
+}
+
+func (b RateLimitBucket) transform() ratelimit.Bucket {
+	bucket := ratelimit.Bucket{
+		Key:       b.Key,
+		Tokens:    b.Tokens,
+		Limited:   b.Limited,
+		UpdatedAt: b.UpdatedAt,
+	}
+	if b.LockedUntil.Valid {
+		bucket.LockedUntil = &b.LockedUntil.Time
+	}
+	return bucket
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/raystack/frontier/core/authenticate/ratelimit"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type RateLimitRepository struct {
+	dbc *db.Client
+}
+
+func NewRateLimitRepository(dbc *db.Client) *RateLimitRepository {
+	return &RateLimitRepository{
+		dbc: dbc,
+	}
+}
+
+// Update serializes the updates of a key with a transaction scoped advisory
+// lock, the lock also covers the key before its bucket is inserted
+func (r RateLimitRepository) Update(ctx context.Context, key string, fn func(ratelimit.Bucket) ratelimit.Bucket) (ratelimit.Bucket, error) {
+	var bucket ratelimit.Bucket
+	err := r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+		return r.dbc.WithTimeout(ctx, TABLE_RATE_LIMIT_BUCKETS, "Update", func(ctx context.Context) error {
+			if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))",
+				TABLE_RATE_LIMIT_BUCKETS+":"+key); err != nil {
+				return fmt.Errorf("%w: %w", errDB, err)
+			}
+
+			query, params, err := dialect.From(TABLE_RATE_LIMIT_BUCKETS).Where(goqu.Ex{
+				"key": key,
+			}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			var model RateLimitBucket
+			if err := tx.QueryRowxContext(ctx, query, params...).StructScan(&model); err != nil {
+				if !errors.Is(err, sql.ErrNoRows) {
+					return fmt.Errorf("%w: %w", errDB, err)
+				}
+				model.Key = key
+			}
+
+			bucket = fn(model.transform())
+			record := goqu.Record{
+				"key":          key,
+				"tokens":       bucket.Tokens,
+				"locked_until": bucket.LockedUntil,
+				"limited":      bucket.Limited,
+				"updated_at":   bucket.UpdatedAt,
+			}
+			query, params, err = dialect.Insert(TABLE_RATE_LIMIT_BUCKETS).Rows(record).
+				OnConflict(goqu.DoUpdate("key", record)).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+				return fmt.Errorf("%w: %w", errDB, err)
+			}
+			return nil
+		})
+	})
+	return bucket, err
+}
+
+func (r RateLimitRepository) DeleteStale(ctx context.Context, updatedBefore time.Time) error {
+	query, params, err := dialect.Delete(TABLE_RATE_LIMIT_BUCKETS).Where(
+		goqu.C("updated_at").Lt(updatedBefore),
+	).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+	return r.dbc.WithTimeout(ctx, TABLE_RATE_LIMIT_BUCKETS, "DeleteStale", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,129 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ory/dockertest"
+	"github.com/raystack/frontier/core/authenticate/ratelimit"
+	"github.com/raystack/frontier/internal/store/postgres"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *db.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.RateLimitRepository
+}
+
+func (s *RateLimitRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewRateLimitRepository(s.client)
+}
+
+func (s *RateLimitRepositoryTestSuite) TearDownSuite() {
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *RateLimitRepositoryTestSuite) TearDownTest() {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_RATE_LIMIT_BUCKETS),
+	}
+	if err := execQueries(context.TODO(), s.client, queries); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *RateLimitRepositoryTestSuite) TestUpdate() {
+	now := time.Now().UTC().Truncate(time.Second)
+	lockedUntil := now.Add(time.Hour)
+
+	created, err := s.repository.Update(s.ctx, "email:alice@acme.org", func(bucket ratelimit.Bucket) ratelimit.Bucket {
+		s.Equal("email:alice@acme.org", bucket.Key)
+		s.True(bucket.UpdatedAt.IsZero())
+		bucket.Tokens = 0.5
+		bucket.LockedUntil = &lockedUntil
+		bucket.Limited = true
+		bucket.UpdatedAt = now
+		return bucket
+	})
+	s.Require().NoError(err)
+	s.Equal(0.5, created.Tokens)
+
+	_, err = s.repository.Update(s.ctx, "email:alice@acme.org", func(bucket ratelimit.Bucket) ratelimit.Bucket {
+		s.Equal(0.5, bucket.Tokens)
+		s.True(bucket.Limited)
+		s.Require().NotNil(bucket.LockedUntil)
+		s.True(lockedUntil.Equal(*bucket.LockedUntil))
+		s.True(now.Equal(bucket.UpdatedAt))
+		return bucket
+	})
+	s.Require().NoError(err)
+}
+
+func (s *RateLimitRepositoryTestSuite) TestConcurrentUpdates() {
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.repository.Update(s.ctx, "ip:10.0.0.1", func(bucket ratelimit.Bucket) ratelimit.Bucket {
+				bucket.Tokens++
+				bucket.UpdatedAt = time.Now().UTC()
+				return bucket
+			})
+			s.NoError(err)
+		}()
+	}
+	wg.Wait()
+
+	bucket, err := s.repository.Update(s.ctx, "ip:10.0.0.1", func(bucket ratelimit.Bucket) ratelimit.Bucket {
+		return bucket
+	})
+	s.Require().NoError(err)
+	s.Equal(float64(10), bucket.Tokens)
+}
+
+func (s *RateLimitRepositoryTestSuite) TestDeleteStale() {
+	now := time.Now().UTC()
+	for key, updatedAt := range map[string]time.Time{
+		"email:stale@acme.org": now.Add(-2 * time.Hour),
+		"email:fresh@acme.org": now,
+	} {
+		_, err := s.repository.Update(s.ctx, key, func(bucket ratelimit.Bucket) ratelimit.Bucket {
+			bucket.Tokens = 1
+			bucket.UpdatedAt = updatedAt
+			return bucket
+		})
+		s.Require().NoError(err)
+	}
+
+	s.Require().NoError(s.repository.DeleteStale(s.ctx, now.Add(-time.Hour)))
+	var keys []string
+	s.Require().NoError(s.client.SelectContext(s.ctx, &keys,
+		fmt.Sprintf("SELECT key FROM %s", postgres.TABLE_RATE_LIMIT_BUCKETS)))
+	s.Equal([]string{"email:fresh@acme.org"}, keys)
+}
+
+func TestRateLimitRepository(t *testing.T) {
+	suite.Run(t, new(RateLimitRepositoryTestSuite))
+}
@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/raystack/frontier/core/authenticate/refreshtoken"
+)
+
+type RefreshToken struct {
+	ID        string       `db:"id"`
+	TokenHash string       `db:"token_hash"`
+	SessionID uuid.UUID    `db:"session_id"`
+	UserID    string       `db:"user_id"`
+	FamilyID  string       `db:"family_id"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	RotatedAt sql.NullTime `db:"rotated_at"`
+	RevokedAt sql.NullTime `db:"revoked_at"`
+	CreatedAt time.Time    `db:"created_at"`
+}
+
+func (t RefreshToken) transform() refreshtoken.RefreshToken {
+	token := refreshtoken.RefreshToken{
+		ID:        t.ID,
+		TokenHash: t.TokenHash,
+		SessionID: t.SessionID,
+		UserID:    t.UserID,
+		FamilyID:  t.FamilyID,
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}
+	if t.RotatedAt.Valid {
+		token.RotatedAt = &t.RotatedAt.Time
+	}
+	if t.RevokedAt.Valid {
+		token.RevokedAt = &t.RevokedAt.Time
+	}
+	return token
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/raystack/frontier/core/authenticate/refreshtoken"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type RefreshTokenRepository struct {
+	dbc *db.Client
+}
+
+func NewRefreshTokenRepository(dbc *db.Client) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		dbc: dbc,
+	}
+}
+
+func sessionRefreshTokenRecord(token refreshtoken.RefreshToken) goqu.Record {
+	return goqu.Record{
+		"token_hash": token.TokenHash,
+		"session_id": token.SessionID,
+		"user_id":    token.UserID,
+		"family_id":  token.FamilyID,
+		"expires_at": token.ExpiresAt,
+	}
+}
+
+func (r RefreshTokenRepository) Create(ctx context.Context, token refreshtoken.RefreshToken) (refreshtoken.RefreshToken, error) {
+	query, params, err := dialect.Insert(TABLE_REFRESH_TOKENS).Rows(
+		sessionRefreshTokenRecord(token),
+	).Returning(&RefreshToken{}).ToSQL()
+	if err != nil {
+		return refreshtoken.RefreshToken{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model RefreshToken
+	if err = r.dbc.WithTimeout(ctx, TABLE_REFRESH_TOKENS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		return refreshtoken.RefreshToken{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r RefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (refreshtoken.RefreshToken, error) {
+	query, params, err := dialect.From(TABLE_REFRESH_TOKENS).Where(goqu.Ex{
+		"token_hash": tokenHash,
+	}).ToSQL()
+	if err != nil {
+		return refreshtoken.RefreshToken{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model RefreshToken
+	if err = r.dbc.WithTimeout(ctx, TABLE_REFRESH_TOKENS, "GetByHash", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return refreshtoken.RefreshToken{}, refreshtoken.ErrNotFound
+		}
+		return refreshtoken.RefreshToken{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(), nil
+}
+
+func (r RefreshTokenRepository) Rotate(ctx context.Context, id string, next refreshtoken.RefreshToken) (refreshtoken.RefreshToken, error) {
+	var model RefreshToken
+	err := r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+		return r.dbc.WithTimeout(ctx, TABLE_REFRESH_TOKENS, "Rotate", func(ctx context.Context) error {
+			// only one of concurrent refreshes with the same token wins
+			query, params, err := dialect.Update(TABLE_REFRESH_TOKENS).Set(
+				goqu.Record{
+					"rotated_at": goqu.L("now()"),
+				}).Where(goqu.Ex{
+				"id":         id,
+				"rotated_at": nil,
+				"revoked_at": nil,
+			}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			result, err := tx.ExecContext(ctx, query, params...)
+			if err != nil {
+				return fmt.Errorf("%w: %w", errDB, err)
+			}
+			if count, _ := result.RowsAffected(); count == 0 {
+				return refreshtoken.ErrReused
+			}
+
+			query, params, err = dialect.Insert(TABLE_REFRESH_TOKENS).Rows(
+				sessionRefreshTokenRecord(next),
+			).Returning(&RefreshToken{}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			if err := tx.QueryRowxContext(ctx, query, params...).StructScan(&model); err != nil {
+				return fmt.Errorf("%w: %w", errDB, err)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return refreshtoken.RefreshToken{}, err
+	}
+	return model.transform(), nil
+}
+
+func (r RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	query, params, err := dialect.Update(TABLE_REFRESH_TOKENS).Set(
+		goqu.Record{
+			"revoked_at": goqu.L("now()"),
+		}).Where(goqu.Ex{
+		"family_id":  familyID,
+		"revoked_at": nil,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_REFRESH_TOKENS, "RevokeFamily", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		return nil
+	})
+}
+
+func (r RefreshTokenRepository) DeleteExpired(ctx context.Context) error {
+	query, params, err := dialect.Delete(TABLE_REFRESH_TOKENS).Where(
+		goqu.C("expires_at").Lt(goqu.L("now()")),
+	).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_REFRESH_TOKENS, "DeleteExpired", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		return nil
+	})
+}
@@ -19,6 +19,12 @@ type Session struct {
 	CreatedAt       time.Time  `db:"created_at"`
 	UpdatedAt       time.Time  `db:"updated_at"`
 	DeletedAt       *time.Time `db:"deleted_at"`
+	AssuranceLevel  string     `db:"assurance_level"`
+	MFARequired     bool       `db:"mfa_required"`
+
+	MFAAuthenticatedAt *time.Time `db:"mfa_authenticated_at"`
+	LastActiveAt       *time.Time `db:"last_active_at"`
+	Policy             []byte     `db:"policy"`
 }
 
 func (s *Session) transformToSession() (*session.Session, error) {
@@ -26,6 +32,16 @@ func (s *Session) transformToSession() (*session.Session, error) {
 	if err := json.Unmarshal(s.Metadata, &unmarshalledMetadata); err != nil {
 		return nil, fmt.Errorf("error marshaling session: %w", err)
 	}
+	var policy session.Policy
+	if len(s.Policy) > 0 {
+		if err := json.Unmarshal(s.Policy, &policy); err != nil {
+			return nil, fmt.Errorf("error unmarshaling session policy: %w", err)
+		}
+	}
+	var lastActiveAt time.Time
+	if s.LastActiveAt != nil {
+		lastActiveAt = *s.LastActiveAt
+	}
 
 	return &session.Session{
 		ID:              s.ID,
@@ -36,5 +52,11 @@ func (s *Session) transformToSession() (*session.Session, error) {
 		CreatedAt:       s.CreatedAt,
 		UpdatedAt:       s.UpdatedAt,
 		DeletedAt:       s.DeletedAt,
+		AssuranceLevel:  session.AssuranceLevel(s.AssuranceLevel),
+		MFARequired:     s.MFARequired,
+
+		MFAAuthenticatedAt: s.MFAAuthenticatedAt,
+		LastActiveAt:       lastActiveAt,
+		Policy:             policy,
 	}, nil
 }
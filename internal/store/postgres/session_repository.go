@@ -45,6 +45,11 @@ func (s *SessionRepository) Set(ctx context.Context, session *frontiersession.Se
 		return fmt.Errorf("%w: %s", errParse, err)
 	}
 
+	marshaledPolicy, err := json.Marshal(session.Policy)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errParse, err)
+	}
+
 	query, params, err := dialect.Insert(TABLE_SESSIONS).Rows(
 		goqu.Record{
 			"id":               session.ID,
@@ -54,6 +59,10 @@ func (s *SessionRepository) Set(ctx context.Context, session *frontiersession.Se
 			"created_at":       session.CreatedAt,
 			"updated_at":       session.CreatedAt,
 			"metadata":         marshaledMetadata,
+			"assurance_level":  session.AssuranceLevel,
+			"mfa_required":     session.MFARequired,
+			"last_active_at":   session.CreatedAt,
+			"policy":           marshaledPolicy,
 		}).Returning(&Session{}).ToSQL()
 	if err != nil {
 		return fmt.Errorf("%w: %s", errQuery, err)
@@ -259,6 +268,72 @@ func (s *SessionRepository) UpdateSessionMetadata(ctx context.Context, id uuid.U
 	})
 }
 
+func (s *SessionRepository) UpdateAssuranceLevel(ctx context.Context, id uuid.UUID, level frontiersession.AssuranceLevel, authenticatedAt time.Time) error {
+	query, params, err := dialect.Update(TABLE_SESSIONS).Set(
+		goqu.Record{
+			"assurance_level":      level,
+			"mfa_authenticated_at": authenticatedAt,
+			"updated_at":           s.Now(),
+		},
+	).Where(goqu.Ex{"id": id}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errQuery, err)
+	}
+	return s.dbc.WithTimeout(ctx, TABLE_SESSIONS, "UpdateAssuranceLevel", func(ctx context.Context) error {
+		result, err := s.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errDB, err)
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return frontiersession.ErrNoSession
+		}
+		return nil
+	})
+}
+
+func (s *SessionRepository) UpdateAuthenticatedAt(ctx context.Context, id uuid.UUID, authenticatedAt time.Time) error {
+	query, params, err := dialect.Update(TABLE_SESSIONS).Set(
+		goqu.Record{
+			"authenticated_at": authenticatedAt,
+			"updated_at":       s.Now(),
+		},
+	).Where(goqu.Ex{"id": id}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errQuery, err)
+	}
+	return s.dbc.WithTimeout(ctx, TABLE_SESSIONS, "UpdateAuthenticatedAt", func(ctx context.Context) error {
+		result, err := s.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errDB, err)
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return frontiersession.ErrNoSession
+		}
+		return nil
+	})
+}
+
+func (s *SessionRepository) UpdateLastActiveAt(ctx context.Context, id uuid.UUID, lastActiveAt time.Time) error {
+	query, params, err := dialect.Update(TABLE_SESSIONS).Set(
+		goqu.Record{
+			"last_active_at": lastActiveAt,
+		},
+	).Where(goqu.Ex{"id": id}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errQuery, err)
+	}
+	return s.dbc.WithTimeout(ctx, TABLE_SESSIONS, "UpdateLastActiveAt", func(ctx context.Context) error {
+		result, err := s.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errDB, err)
+		}
+		if count, _ := result.RowsAffected(); count == 0 {
+			return frontiersession.ErrNoSession
+		}
+		return nil
+	})
+}
+
 func (s *SessionRepository) List(ctx context.Context, userID string) ([]*frontiersession.Session, error) {
 	uid, err := uuid.Parse(userID)
 	if err != nil {
@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/raystack/frontier/core/authenticate/token"
+	"github.com/raystack/frontier/pkg/crypt"
+)
+
+type SigningKey struct {
+	ID          string       `db:"id"`
+	Algorithm   string       `db:"algorithm"`
+	KeyMaterial string       `db:"key_material"`
+	State       string       `db:"state"`
+	CreatedAt   time.Time    `db:"created_at"`
+	ActivateAt  sql.NullTime `db:"activate_at"`
+	ActivatedAt sql.NullTime `db:"activated_at"`
+	RetiredAt   sql.NullTime `db:"retired_at"`
+}
+
+// toDBSigningKeyMaterial encrypts the private key in its jwk form
+func toDBSigningKeyMaterial(key jwk.Key, encryptionKey []byte) (string, error) {
+	rawKey, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	encryptedKey, err := crypt.Encrypt(rawKey, encryptionKey)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawStdEncoding.EncodeToString(encryptedKey), nil
+}
+
+func fromDBSigningKeyMaterial(keyMaterial string, encryptionKey []byte) (jwk.Key, error) {
+	encryptedKey, err := base64.RawStdEncoding.DecodeString(keyMaterial)
+	if err != nil {
+		return nil, err
+	}
+	rawKey, err := crypt.Decrypt(encryptedKey, encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return jwk.ParseKey(rawKey)
+}
+
+func (k SigningKey) transform(encryptionKey []byte) (token.SigningKey, error) {
+	key, err := fromDBSigningKeyMaterial(k.KeyMaterial, encryptionKey)
+	if err != nil {
+		return token.SigningKey{}, fmt.Errorf("failed to decrypt signing key %s: %w", k.ID, err)
+	}
+	signingKey := token.SigningKey{
+		ID:        k.ID,
+		Key:       key,
+		State:     token.KeyState(k.State),
+		CreatedAt: k.CreatedAt,
+	}
+	if k.ActivateAt.Valid {
+		signingKey.ActivateAt = &k.ActivateAt.Time
+	}
+	if k.ActivatedAt.Valid {
+		signingKey.ActivatedAt = &k.ActivatedAt.Time
+	}
+	if k.RetiredAt.Valid {
+		signingKey.RetiredAt = &k.RetiredAt.Time
+	}
+	return signingKey, nil
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jmoiron/sqlx"
+	"github.com/raystack/frontier/core/authenticate/token"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/raystack/frontier/pkg/utils"
+)
+
+type SigningKeyRepository struct {
+	dbc           *db.Client
+	encryptionKey []byte
+}
+
+func NewSigningKeyRepository(dbc *db.Client, encryptionKey []byte) *SigningKeyRepository {
+	return &SigningKeyRepository{
+		dbc:           dbc,
+		encryptionKey: encryptionKey,
+	}
+}
+
+func (r SigningKeyRepository) Create(ctx context.Context, key token.SigningKey) (token.SigningKey, error) {
+	keyMaterial, err := toDBSigningKeyMaterial(key.Key, r.encryptionKey)
+	if err != nil {
+		return token.SigningKey{}, fmt.Errorf("failed to encrypt signing key: %w", err)
+	}
+	query, params, err := dialect.Insert(TABLE_SIGNING_KEYS).Rows(
+		goqu.Record{
+			"id":           key.ID,
+			"algorithm":    utils.SigningAlgorithm(key.Key).String(),
+			"key_material": keyMaterial,
+			"state":        string(key.State),
+			"activate_at":  key.ActivateAt,
+		}).Returning(&SigningKey{}).ToSQL()
+	if err != nil {
+		return token.SigningKey{}, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var model SigningKey
+	if err = r.dbc.WithTimeout(ctx, TABLE_SIGNING_KEYS, "Create", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&model)
+	}); err != nil {
+		return token.SigningKey{}, fmt.Errorf("%w: %w", errDB, err)
+	}
+	return model.transform(r.encryptionKey)
+}
+
+func (r SigningKeyRepository) List(ctx context.Context) ([]token.SigningKey, error) {
+	query, params, err := dialect.From(TABLE_SIGNING_KEYS).
+		Order(goqu.C("created_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var models []SigningKey
+	if err = r.dbc.WithTimeout(ctx, TABLE_SIGNING_KEYS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &models, query, params...)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %w", errDB, err)
+	}
+
+	keys := make([]token.SigningKey, 0, len(models))
+	for _, model := range models {
+		key, err := model.transform(r.encryptionKey)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (r SigningKeyRepository) Promote(ctx context.Context, id string) error {
+	return r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+		return r.dbc.WithTimeout(ctx, TABLE_SIGNING_KEYS, "Promote", func(ctx context.Context) error {
+			query, params, err := dialect.Update(TABLE_SIGNING_KEYS).Set(
+				goqu.Record{
+					"state":      string(token.KeyStateRetiring),
+					"retired_at": goqu.L("now()"),
+				}).Where(goqu.Ex{
+				"state": string(token.KeyStateActive),
+			}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			if _, err := tx.ExecContext(ctx, query, params...); err != nil {
+				return fmt.Errorf("%w: %w", errDB, err)
+			}
+
+			query, params, err = dialect.Update(TABLE_SIGNING_KEYS).Set(
+				goqu.Record{
+					"state":        string(token.KeyStateActive),
+					"activated_at": goqu.L("now()"),
+				}).Where(goqu.Ex{
+				"id": id,
+			}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %w", errQuery, err)
+			}
+			result, err := tx.ExecContext(ctx, query, params...)
+			if err != nil {
+				return fmt.Errorf("%w: %w", errDB, err)
+			}
+			if count, _ := result.RowsAffected(); count == 0 {
+				return token.ErrKeyNotFound
+			}
+			return nil
+		})
+	})
+}
+
+func (r SigningKeyRepository) Delete(ctx context.Context, id string) error {
+	query, params, err := dialect.Delete(TABLE_SIGNING_KEYS).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_SIGNING_KEYS, "Delete", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,114 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/ory/dockertest"
+	"github.com/raystack/frontier/core/authenticate/token"
+	"github.com/raystack/frontier/internal/store/postgres"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/raystack/frontier/pkg/utils"
+	"github.com/stretchr/testify/suite"
+)
+
+type SigningKeyRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *db.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.SigningKeyRepository
+}
+
+func (s *SigningKeyRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewSigningKeyRepository(s.client, []byte("hash-secret-should-be-32-chars--"))
+}
+
+func (s *SigningKeyRepositoryTestSuite) TearDownSuite() {
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *SigningKeyRepositoryTestSuite) TearDownTest() {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_SIGNING_KEYS),
+	}
+	if err := execQueries(context.TODO(), s.client, queries); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *SigningKeyRepositoryTestSuite) createKey(alg jwa.SignatureAlgorithm) token.SigningKey {
+	key, err := utils.CreateJWK(alg)
+	s.Require().NoError(err)
+	created, err := s.repository.Create(s.ctx, token.SigningKey{
+		ID:    key.KeyID(),
+		Key:   key,
+		State: token.KeyStatePending,
+	})
+	s.Require().NoError(err)
+	return created
+}
+
+func (s *SigningKeyRepositoryTestSuite) TestCreate() {
+	created := s.createKey(jwa.ES256)
+
+	keys, err := s.repository.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(keys, 1)
+	s.Equal(created.ID, keys[0].ID)
+	s.Equal(token.KeyStatePending, keys[0].State)
+	s.Equal(jwa.ES256, utils.SigningAlgorithm(keys[0].Key))
+	s.Nil(keys[0].ActivatedAt)
+
+	var keyMaterial string
+	s.Require().NoError(s.client.GetContext(s.ctx, &keyMaterial,
+		fmt.Sprintf("SELECT key_material FROM %s", postgres.TABLE_SIGNING_KEYS)))
+	s.NotContains(keyMaterial, `"d"`, "private key is stored encrypted")
+
+	other := postgres.NewSigningKeyRepository(s.client, []byte("other-secret-should-be-32-chars-"))
+	_, err = other.List(s.ctx)
+	s.Error(err)
+}
+
+func (s *SigningKeyRepositoryTestSuite) TestPromote() {
+	first := s.createKey(jwa.RS256)
+	second := s.createKey(jwa.EdDSA)
+
+	s.Require().NoError(s.repository.Promote(s.ctx, first.ID))
+	s.Require().NoError(s.repository.Promote(s.ctx, second.ID))
+
+	keys, err := s.repository.List(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(keys, 2)
+	s.Equal(token.KeyStateRetiring, keys[0].State)
+	s.NotNil(keys[0].RetiredAt)
+	s.Equal(token.KeyStateActive, keys[1].State)
+	s.NotNil(keys[1].ActivatedAt)
+
+	s.ErrorIs(s.repository.Promote(s.ctx, "missing"), token.ErrKeyNotFound)
+
+	s.Require().NoError(s.repository.Delete(s.ctx, first.ID))
+	keys, err = s.repository.List(s.ctx)
+	s.Require().NoError(err)
+	s.Len(keys, 1)
+}
+
+func TestSigningKeyRepository(t *testing.T) {
+	suite.Run(t, new(SigningKeyRepositoryTestSuite))
+}
@@ -0,0 +1,23 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/raystack/frontier/core/authenticate/token"
+)
+
+type TokenRevocation struct {
+	Kind      string    `db:"kind"`
+	Value     string    `db:"value"`
+	RevokedAt time.Time `db:"revoked_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+func (r TokenRevocation) transform() token.Revocation {
+	return token.Revocation{
+		Kind:      r.Kind,
+		Value:     r.Value,
+		RevokedAt: r.RevokedAt,
+		ExpiresAt: r.ExpiresAt,
+	}
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/raystack/frontier/core/authenticate/token"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type TokenRevocationRepository struct {
+	dbc *db.Client
+}
+
+func NewTokenRevocationRepository(dbc *db.Client) *TokenRevocationRepository {
+	return &TokenRevocationRepository{
+		dbc: dbc,
+	}
+}
+
+func (r TokenRevocationRepository) Upsert(ctx context.Context, revocation token.Revocation) error {
+	query, params, err := dialect.Insert(TABLE_TOKEN_REVOCATIONS).Rows(
+		goqu.Record{
+			"kind":       revocation.Kind,
+			"value":      revocation.Value,
+			"revoked_at": revocation.RevokedAt,
+			"expires_at": revocation.ExpiresAt,
+		}).OnConflict(goqu.DoUpdate("kind, value", goqu.Record{
+		"revoked_at": goqu.L("EXCLUDED.revoked_at"),
+		"expires_at": goqu.L("EXCLUDED.expires_at"),
+	})).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_TOKEN_REVOCATIONS, "Upsert", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		return nil
+	})
+}
+
+func (r TokenRevocationRepository) List(ctx context.Context, since time.Time) ([]token.Revocation, error) {
+	query, params, err := dialect.From(TABLE_TOKEN_REVOCATIONS).Where(
+		goqu.C("expires_at").Gt(goqu.L("now()")),
+		goqu.C("revoked_at").Gte(since),
+	).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	var models []TokenRevocation
+	if err = r.dbc.WithTimeout(ctx, TABLE_TOKEN_REVOCATIONS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &models, query, params...)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %w", errDB, err)
+	}
+
+	revocations := make([]token.Revocation, 0, len(models))
+	for _, model := range models {
+		revocations = append(revocations, model.transform())
+	}
+	return revocations, nil
+}
+
+func (r TokenRevocationRepository) DeleteExpired(ctx context.Context) error {
+	query, params, err := dialect.Delete(TABLE_TOKEN_REVOCATIONS).Where(
+		goqu.C("expires_at").Lt(goqu.L("now()")),
+	).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_TOKEN_REVOCATIONS, "DeleteExpired", func(ctx context.Context) error {
+		if _, err := r.dbc.ExecContext(ctx, query, params...); err != nil {
+			return fmt.Errorf("%w: %w", errDB, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/raystack/frontier/core/webhook"
+)
+
+type WebhookDelivery struct {
+	ID             string         `db:"id"`
+	EndpointID     string         `db:"endpoint_id"`
+	EventID        string         `db:"event_id"`
+	Action         string         `db:"action"`
+	PayloadFormat  string         `db:"payload_format"`
+	Payload        []byte         `db:"payload"`
+	RequestID      sql.NullString `db:"request_id"`
+	Status         string         `db:"status"`
+	AttemptCount   int            `db:"attempt_count"`
+	NextAttemptAt  time.Time      `db:"next_attempt_at"`
+	LastAttemptAt  *time.Time     `db:"last_attempt_at"`
+	LastStatusCode sql.NullInt32  `db:"last_status_code"`
+	LastError      sql.NullString `db:"last_error"`
+
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (d WebhookDelivery) transform() webhook.Delivery {
+	return webhook.Delivery{
+		ID:             d.ID,
+		EndpointID:     d.EndpointID,
+		EventID:        d.EventID,
+		Action:         d.Action,
+		PayloadFormat:  webhook.PayloadFormat(d.PayloadFormat),
+		Payload:        d.Payload,
+		RequestID:      nullStringToString(d.RequestID),
+		Status:         webhook.DeliveryStatus(d.Status),
+		AttemptCount:   d.AttemptCount,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastAttemptAt:  d.LastAttemptAt,
+		LastStatusCode: int(d.LastStatusCode.Int32),
+		LastError:      nullStringToString(d.LastError),
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
+
+type WebhookDeliveryAttempt struct {
+	ID         string         `db:"id"`
+	DeliveryID string         `db:"delivery_id"`
+	StatusCode sql.NullInt32  `db:"status_code"`
+	LatencyMS  int64          `db:"latency_ms"`
+	Response   sql.NullString `db:"response"`
+	Error      sql.NullString `db:"error"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
+
+func (a WebhookDeliveryAttempt) transform() webhook.Attempt {
+	return webhook.Attempt{
+		ID:         a.ID,
+		DeliveryID: a.DeliveryID,
+		StatusCode: int(a.StatusCode.Int32),
+		Latency:    time.Duration(a.LatencyMS) * time.Millisecond,
+		Response:   nullStringToString(a.Response),
+		Error:      nullStringToString(a.Error),
+		CreatedAt:  a.CreatedAt,
+	}
+}
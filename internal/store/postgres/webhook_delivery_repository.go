@@ -0,0 +1,281 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/jmoiron/sqlx"
+	"github.com/raystack/frontier/core/webhook"
+	"github.com/raystack/frontier/pkg/db"
+)
+
+type WebhookDeliveryRepository struct {
+	dbc *db.Client
+}
+
+func NewWebhookDeliveryRepository(dbc *db.Client) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{
+		dbc: dbc,
+	}
+}
+
+func (r WebhookDeliveryRepository) Create(ctx context.Context, deliveries []webhook.Delivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	query, params, err := deliveryInsertQuery(deliveries)
+	if err != nil {
+		return err
+	}
+
+	if err = r.dbc.WithTimeout(ctx, TABLE_WEBHOOK_DELIVERIES, "Create", func(ctx context.Context) error {
+		_, err := r.dbc.ExecContext(ctx, query, params...)
+		return err
+	}); err != nil {
+		return fmt.Errorf("%w: %s", errDB, checkPostgresError(err))
+	}
+	return nil
+}
+
+// deliveryInsertQuery builds the insert of the deliveries, it is shared with
+// the audit repository which queues deliveries along with the audit log
+func deliveryInsertQuery(deliveries []webhook.Delivery) (string, []any, error) {
+	rows := make([]any, 0, len(deliveries))
+	for _, d := range deliveries {
+		status := d.Status
+		if status == "" {
+			status = webhook.DeliveryPending
+		}
+		nextAttemptAt := any(goqu.L("now()"))
+		if !d.NextAttemptAt.IsZero() {
+			nextAttemptAt = d.NextAttemptAt
+		}
+		rows = append(rows, goqu.Record{
+			"id":              d.ID,
+			"endpoint_id":     d.EndpointID,
+			"event_id":        d.EventID,
+			"action":          d.Action,
+			"payload_format":  d.PayloadFormat,
+			"payload":         d.Payload,
+			"request_id":      toNullString(d.RequestID),
+			"status":          status,
+			"next_attempt_at": nextAttemptAt,
+			"created_at":      goqu.L("now()"),
+			"updated_at":      goqu.L("now()"),
+		})
+	}
+	query, params, err := dialect.Insert(TABLE_WEBHOOK_DELIVERIES).Rows(rows...).ToSQL()
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %s", errParse, err)
+	}
+	return query, params, nil
+}
+
+func (r WebhookDeliveryRepository) GetByID(ctx context.Context, id string) (webhook.Delivery, error) {
+	query, params, err := dialect.From(TABLE_WEBHOOK_DELIVERIES).Where(goqu.Ex{
+		"id": id,
+	}).ToSQL()
+	if err != nil {
+		return webhook.Delivery{}, fmt.Errorf("%w: %s", errParse, err)
+	}
+
+	var deliveryModel WebhookDelivery
+	if err = r.dbc.WithTimeout(ctx, TABLE_WEBHOOK_DELIVERIES, "GetByID", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&deliveryModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows), errors.Is(err, ErrInvalidTextRepresentation):
+			return webhook.Delivery{}, webhook.ErrDeliveryNotFound
+		}
+		return webhook.Delivery{}, fmt.Errorf("%w: %s", errDB, err)
+	}
+	return deliveryModel.transform(), nil
+}
+
+func (r WebhookDeliveryRepository) List(ctx context.Context, flt webhook.DeliveryFilter) ([]webhook.Delivery, error) {
+	stmt := dialect.From(TABLE_WEBHOOK_DELIVERIES).Where(deliveryFilterExpressions(flt)...)
+	if flt.Pagination != nil {
+		// always make this call after all the filters have been applied
+		totalCountQuery, totalCountParams, err := stmt.Select(goqu.COUNT("*")).ToSQL()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errQuery, err)
+		}
+		var totalCount int32
+		if err = r.dbc.WithTimeout(ctx, TABLE_WEBHOOK_DELIVERIES, "Count", func(ctx context.Context) error {
+			return r.dbc.GetContext(ctx, &totalCount, totalCountQuery, totalCountParams...)
+		}); err != nil {
+			return nil, fmt.Errorf("%w: %w", errDB, err)
+		}
+		flt.Pagination.SetCount(totalCount)
+		stmt = stmt.Limit(uint(flt.Pagination.PageSize)).Offset(uint(flt.Pagination.Offset()))
+	}
+
+	query, params, err := stmt.Order(goqu.I("created_at").Desc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errParse, err)
+	}
+
+	var deliveryModels []WebhookDelivery
+	if err = r.dbc.WithTimeout(ctx, TABLE_WEBHOOK_DELIVERIES, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &deliveryModels, query, params...)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %s", errDB, err)
+	}
+
+	deliveries := make([]webhook.Delivery, 0, len(deliveryModels))
+	for _, deliveryModel := range deliveryModels {
+		deliveries = append(deliveries, deliveryModel.transform())
+	}
+	return deliveries, nil
+}
+
+func (r WebhookDeliveryRepository) ListAttempts(ctx context.Context, deliveryID string) ([]webhook.Attempt, error) {
+	query, params, err := dialect.From(TABLE_WEBHOOK_ATTEMPTS).Where(goqu.Ex{
+		"delivery_id": deliveryID,
+	}).Order(goqu.I("created_at").Asc()).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errParse, err)
+	}
+
+	var attemptModels []WebhookDeliveryAttempt
+	if err = r.dbc.WithTimeout(ctx, TABLE_WEBHOOK_ATTEMPTS, "List", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &attemptModels, query, params...)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %s", errDB, err)
+	}
+
+	attempts := make([]webhook.Attempt, 0, len(attemptModels))
+	for _, attemptModel := range attemptModels {
+		attempts = append(attempts, attemptModel.transform())
+	}
+	return attempts, nil
+}
+
+func (r WebhookDeliveryRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]webhook.Delivery, error) {
+	due := dialect.From(TABLE_WEBHOOK_DELIVERIES).Select("id").Where(
+		goqu.Ex{"status": webhook.DeliveryPending},
+		goqu.C("next_attempt_at").Lte(goqu.L("now()")),
+	).Order(goqu.I("next_attempt_at").Asc()).Limit(uint(limit)).ForUpdate(exp.SkipLocked)
+
+	query, params, err := dialect.Update(TABLE_WEBHOOK_DELIVERIES).Set(goqu.Record{
+		"next_attempt_at": goqu.L("now() + make_interval(secs => ?)", lease.Seconds()),
+		"updated_at":      goqu.L("now()"),
+	}).Where(goqu.C("id").In(due)).Returning(&WebhookDelivery{}).ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errQuery, err)
+	}
+
+	var deliveryModels []WebhookDelivery
+	if err = r.dbc.WithTimeout(ctx, TABLE_WEBHOOK_DELIVERIES, "ClaimDue", func(ctx context.Context) error {
+		return r.dbc.SelectContext(ctx, &deliveryModels, query, params...)
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %s", errDB, err)
+	}
+
+	deliveries := make([]webhook.Delivery, 0, len(deliveryModels))
+	for _, deliveryModel := range deliveryModels {
+		deliveries = append(deliveries, deliveryModel.transform())
+	}
+	return deliveries, nil
+}
+
+func (r WebhookDeliveryRepository) RecordAttempt(ctx context.Context, delivery webhook.Delivery, attempt webhook.Attempt) error {
+	attemptQuery, attemptParams, err := dialect.Insert(TABLE_WEBHOOK_ATTEMPTS).Rows(goqu.Record{
+		"id":          attempt.ID,
+		"delivery_id": delivery.ID,
+		"status_code": toNullInt32(attempt.StatusCode),
+		"latency_ms":  attempt.Latency.Milliseconds(),
+		"response":    toNullString(attempt.Response),
+		"error":       toNullString(attempt.Error),
+		"created_at":  attempt.CreatedAt,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errParse, err)
+	}
+	deliveryQuery, deliveryParams, err := dialect.Update(TABLE_WEBHOOK_DELIVERIES).Set(goqu.Record{
+		"status":           delivery.Status,
+		"attempt_count":    delivery.AttemptCount,
+		"next_attempt_at":  delivery.NextAttemptAt,
+		"last_attempt_at":  delivery.LastAttemptAt,
+		"last_status_code": toNullInt32(delivery.LastStatusCode),
+		"last_error":       toNullString(delivery.LastError),
+		"updated_at":       goqu.L("now()"),
+	}).Where(goqu.Ex{
+		"id": delivery.ID,
+	}).ToSQL()
+	if err != nil {
+		return fmt.Errorf("%w: %s", errQuery, err)
+	}
+
+	return r.dbc.WithTimeout(ctx, TABLE_WEBHOOK_DELIVERIES, "RecordAttempt", func(ctx context.Context) error {
+		return r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+			if _, err := tx.ExecContext(ctx, attemptQuery, attemptParams...); err != nil {
+				return fmt.Errorf("%w: %s", errDB, checkPostgresError(err))
+			}
+			if _, err := tx.ExecContext(ctx, deliveryQuery, deliveryParams...); err != nil {
+				return fmt.Errorf("%w: %s", errDB, checkPostgresError(err))
+			}
+			return nil
+		})
+	})
+}
+
+func (r WebhookDeliveryRepository) Requeue(ctx context.Context, flt webhook.DeliveryFilter) (int, error) {
+	query, params, err := dialect.Update(TABLE_WEBHOOK_DELIVERIES).Set(goqu.Record{
+		"status":          webhook.DeliveryPending,
+		"attempt_count":   0,
+		"next_attempt_at": goqu.L("now()"),
+		"updated_at":      goqu.L("now()"),
+	}).Where(deliveryFilterExpressions(flt)...).ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errQuery, err)
+	}
+
+	var affected int64
+	if err = r.dbc.WithTimeout(ctx, TABLE_WEBHOOK_DELIVERIES, "Requeue", func(ctx context.Context) error {
+		result, err := r.dbc.ExecContext(ctx, query, params...)
+		if err != nil {
+			return err
+		}
+		affected, err = result.RowsAffected()
+		return err
+	}); err != nil {
+		return 0, fmt.Errorf("%w: %s", errDB, checkPostgresError(err))
+	}
+	if flt.ID != "" && affected == 0 {
+		return 0, webhook.ErrDeliveryNotFound
+	}
+	return int(affected), nil
+}
+
+func deliveryFilterExpressions(flt webhook.DeliveryFilter) []exp.Expression {
+	var conditions []exp.Expression
+	if flt.ID != "" {
+		conditions = append(conditions, goqu.Ex{"id": flt.ID})
+	}
+	if flt.EndpointID != "" {
+		conditions = append(conditions, goqu.Ex{"endpoint_id": flt.EndpointID})
+	}
+	if flt.EventID != "" {
+		conditions = append(conditions, goqu.Ex{"event_id": flt.EventID})
+	}
+	if flt.Status != "" {
+		conditions = append(conditions, goqu.Ex{"status": flt.Status})
+	}
+	if flt.LastError != "" {
+		conditions = append(conditions, goqu.Ex{"last_error": flt.LastError})
+	}
+	if !flt.Since.IsZero() {
+		conditions = append(conditions, goqu.C("created_at").Gte(flt.Since))
+	}
+	if !flt.Until.IsZero() {
+		conditions = append(conditions, goqu.C("created_at").Lt(flt.Until))
+	}
+	return conditions
+}
@@ -0,0 +1,285 @@
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ory/dockertest"
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/webhook"
+	"github.com/raystack/frontier/internal/store/postgres"
+	"github.com/raystack/frontier/pkg/db"
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookDeliveryRepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	client     *db.Client
+	pool       *dockertest.Pool
+	resource   *dockertest.Resource
+	repository *postgres.WebhookDeliveryRepository
+	endpoints  []webhook.Endpoint
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) SetupSuite() {
+	var err error
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	s.client, s.pool, s.resource, err = newTestClient(logger)
+	if err != nil {
+		s.T().Fatal(err)
+	}
+
+	s.ctx = context.TODO()
+	s.repository = postgres.NewWebhookDeliveryRepository(s.client)
+
+	endpointRepository := postgres.NewWebhookEndpointRepository(s.client, []byte("kmm4ECoWU21K2ZoyTcYLd6w7DfhoUoap"))
+	for _, url := range []string{"http://localhost:8080/a", "http://localhost:8080/b"} {
+		endpoint, err := endpointRepository.Create(s.ctx, webhook.Endpoint{
+			ID:      uuid.NewString(),
+			URL:     url,
+			State:   webhook.Enabled,
+			Secrets: []webhook.Secret{{ID: webhook.DefaultSecretID, Value: "secret"}},
+		})
+		if err != nil {
+			s.T().Fatal(err)
+		}
+		s.endpoints = append(s.endpoints, endpoint)
+	}
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) TearDownSuite() {
+	if err := purgeDocker(s.pool, s.resource); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) TearDownTest() {
+	queries := []string{
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_WEBHOOK_DELIVERIES),
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_AUDITLOGS),
+	}
+	if err := execQueries(context.TODO(), s.client, queries); err != nil {
+		s.T().Fatal(err)
+	}
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) createDeliveries(endpointID string, nextAttemptAt ...time.Time) []webhook.Delivery {
+	var deliveries []webhook.Delivery
+	for _, at := range nextAttemptAt {
+		deliveries = append(deliveries, webhook.Delivery{
+			ID:            uuid.NewString(),
+			EndpointID:    endpointID,
+			EventID:       uuid.NewString(),
+			Action:        "app.organization.created",
+			PayloadFormat: webhook.PayloadFormatFrontier,
+			Payload:       []byte(`{}`),
+			Status:        webhook.DeliveryPending,
+			NextAttemptAt: at,
+		})
+	}
+	s.Require().NoError(s.repository.Create(s.ctx, deliveries))
+	return deliveries
+}
+
+// setCreatedAt moves the creation time of a delivery, it is always set by
+// the database on insert
+func (s *WebhookDeliveryRepositoryTestSuite) setCreatedAt(id string, createdAt time.Time) {
+	_, err := s.client.DB.ExecContext(s.ctx,
+		fmt.Sprintf("UPDATE %s SET created_at = $1 WHERE id = $2", postgres.TABLE_WEBHOOK_DELIVERIES), createdAt, id)
+	s.Require().NoError(err)
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) TestClaimDue() {
+	now := time.Now().UTC()
+	due := s.createDeliveries(s.endpoints[0].ID, now.Add(-time.Minute), now.Add(-time.Second))
+	s.createDeliveries(s.endpoints[0].ID, now.Add(time.Hour))
+
+	claimed, err := s.repository.ClaimDue(s.ctx, 10, time.Minute)
+	s.Require().NoError(err)
+	s.ElementsMatch([]string{due[0].ID, due[1].ID}, deliveryIDs(claimed))
+	for _, d := range claimed {
+		// the lease pushes the next attempt out of reach of other workers
+		s.True(d.NextAttemptAt.After(now.Add(30*time.Second)), d.ID)
+	}
+
+	claimed, err = s.repository.ClaimDue(s.ctx, 10, time.Minute)
+	s.Require().NoError(err)
+	s.Empty(claimed)
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) TestClaimDueConcurrently() {
+	now := time.Now().UTC()
+	var nextAttempts []time.Time
+	for i := 0; i < 20; i++ {
+		nextAttempts = append(nextAttempts, now.Add(-time.Duration(i)*time.Second))
+	}
+	s.createDeliveries(s.endpoints[0].ID, nextAttempts...)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	claimedBy := map[string]int{}
+	for worker := 0; worker < 5; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				claimed, err := s.repository.ClaimDue(s.ctx, 3, time.Minute)
+				s.NoError(err)
+				if len(claimed) == 0 {
+					return
+				}
+				mu.Lock()
+				for _, d := range claimed {
+					claimedBy[d.ID]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// every delivery is claimed by exactly one worker
+	s.Len(claimedBy, len(nextAttempts))
+	for id, count := range claimedBy {
+		s.Equal(1, count, id)
+	}
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) TestRecordAttempt() {
+	delivery := s.createDeliveries(s.endpoints[0].ID, time.Now().UTC())[0]
+
+	attemptedAt := time.Now().UTC().Truncate(time.Millisecond)
+	delivery.Status = webhook.DeliveryPending
+	delivery.AttemptCount = 1
+	delivery.LastAttemptAt = &attemptedAt
+	delivery.LastStatusCode = 500
+	delivery.LastError = "unexpected status code: 500"
+	delivery.NextAttemptAt = attemptedAt.Add(time.Minute)
+	s.Require().NoError(s.repository.RecordAttempt(s.ctx, delivery, webhook.Attempt{
+		ID:         uuid.NewString(),
+		StatusCode: 500,
+		Latency:    120 * time.Millisecond,
+		Response:   "oops",
+		CreatedAt:  attemptedAt,
+	}))
+
+	delivery.Status = webhook.DeliverySucceeded
+	delivery.AttemptCount = 2
+	delivery.LastStatusCode = 200
+	delivery.LastError = ""
+	s.Require().NoError(s.repository.RecordAttempt(s.ctx, delivery, webhook.Attempt{
+		ID:         uuid.NewString(),
+		StatusCode: 200,
+		CreatedAt:  attemptedAt.Add(time.Minute),
+	}))
+
+	got, err := s.repository.GetByID(s.ctx, delivery.ID)
+	s.Require().NoError(err)
+	s.Equal(webhook.DeliverySucceeded, got.Status)
+	s.Equal(2, got.AttemptCount)
+	s.Equal(200, got.LastStatusCode)
+	s.Empty(got.LastError)
+
+	attempts, err := s.repository.ListAttempts(s.ctx, delivery.ID)
+	s.Require().NoError(err)
+	s.Require().Len(attempts, 2)
+	s.Equal(500, attempts[0].StatusCode)
+	s.Equal(120*time.Millisecond, attempts[0].Latency)
+	s.Equal("oops", attempts[0].Response)
+	s.Equal(200, attempts[1].StatusCode)
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) TestRequeue() {
+	now := time.Now().UTC()
+	deliveries := s.createDeliveries(s.endpoints[0].ID, now, now, now)
+	other := s.createDeliveries(s.endpoints[1].ID, now)[0]
+	s.setCreatedAt(deliveries[0].ID, now.Add(-3*time.Hour))
+	s.setCreatedAt(deliveries[1].ID, now.Add(-90*time.Minute))
+	s.setCreatedAt(deliveries[2].ID, now.Add(-30*time.Minute))
+	s.setCreatedAt(other.ID, now.Add(-90*time.Minute))
+	for _, d := range append(deliveries, other) {
+		d.Status = webhook.DeliveryFailed
+		d.AttemptCount = 10
+		s.Require().NoError(s.repository.RecordAttempt(s.ctx, d, webhook.Attempt{ID: uuid.NewString(), CreatedAt: now}))
+	}
+
+	count, err := s.repository.Requeue(s.ctx, webhook.DeliveryFilter{
+		EndpointID: s.endpoints[0].ID,
+		Since:      now.Add(-2 * time.Hour),
+		Until:      now.Add(-time.Hour),
+	})
+	s.Require().NoError(err)
+	s.Equal(1, count)
+
+	for _, d := range append(deliveries, other) {
+		got, err := s.repository.GetByID(s.ctx, d.ID)
+		s.Require().NoError(err)
+		if d.ID == deliveries[1].ID {
+			s.Equal(webhook.DeliveryPending, got.Status)
+			s.Zero(got.AttemptCount)
+			continue
+		}
+		s.Equal(webhook.DeliveryFailed, got.Status, d.ID)
+	}
+
+	count, err = s.repository.Requeue(s.ctx, webhook.DeliveryFilter{ID: other.ID})
+	s.Require().NoError(err)
+	s.Equal(1, count)
+
+	_, err = s.repository.Requeue(s.ctx, webhook.DeliveryFilter{ID: uuid.NewString()})
+	s.ErrorIs(err, webhook.ErrDeliveryNotFound)
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) TestAuditLogWithDeliveries() {
+	auditRepository := postgres.NewAuditRepository(s.client)
+	delivery := webhook.Delivery{
+		ID:            uuid.NewString(),
+		EndpointID:    s.endpoints[0].ID,
+		Action:        "app.organization.created",
+		PayloadFormat: webhook.PayloadFormatFrontier,
+		Payload:       []byte(`{}`),
+	}
+
+	log := &audit.Log{ID: uuid.NewString(), Source: "frontier", Action: "app.organization.created"}
+	delivery.EventID = log.ID
+	s.Require().NoError(auditRepository.CreateWithDeliveries(s.ctx, log, []webhook.Delivery{delivery}))
+	s.Equal(1, s.countAuditLogs(log.ID))
+	got, err := s.repository.GetByID(s.ctx, delivery.ID)
+	s.Require().NoError(err)
+	s.Equal(webhook.DeliveryPending, got.Status)
+
+	// a delivery failing to insert rolls the log back
+	log = &audit.Log{ID: uuid.NewString(), Source: "frontier", Action: "app.organization.created"}
+	delivery.ID = uuid.NewString()
+	delivery.EventID = log.ID
+	delivery.EndpointID = uuid.NewString()
+	s.Error(auditRepository.CreateWithDeliveries(s.ctx, log, []webhook.Delivery{delivery}))
+	s.Zero(s.countAuditLogs(log.ID))
+}
+
+func (s *WebhookDeliveryRepositoryTestSuite) countAuditLogs(id string) int {
+	var count int
+	s.Require().NoError(s.client.DB.GetContext(s.ctx, &count,
+		fmt.Sprintf("SELECT count(*) FROM %s WHERE id = $1", postgres.TABLE_AUDITLOGS), id))
+	return count
+}
+
+func deliveryIDs(deliveries []webhook.Delivery) []string {
+	ids := make([]string, 0, len(deliveries))
+	for _, d := range deliveries {
+		ids = append(ids, d.ID)
+	}
+	return ids
+}
+
+func TestWebhookDeliveryRepository(t *testing.T) {
+	suite.Run(t, new(WebhookDeliveryRepositoryTestSuite))
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/base64"
 	"encoding/json"
@@ -38,6 +39,28 @@ func toDBWebHookHeaders(headers map[string]string) WebhookHeaders {
 	return WebhookHeaders{KVs: headers}
 }
 
+// WebhookFilters maps a path in the event data to the accepted values
+type WebhookFilters map[string][]string
+
+func (s *WebhookFilters) Scan(src any) error {
+	switch src := src.(type) {
+	case []byte:
+		return json.Unmarshal(src, s)
+	case string:
+		return json.Unmarshal([]byte(src), s)
+	case nil:
+		return nil
+	}
+	return fmt.Errorf("cannot convert %T to JsonB", src)
+}
+
+func (s WebhookFilters) Value() (driver.Value, error) {
+	if s == nil {
+		return json.Marshal(map[string][]string{})
+	}
+	return json.Marshal(map[string][]string(s))
+}
+
 type WebhookSecret struct {
 	ID    string `json:"id"`
 	Value string `json:"value"`
@@ -95,12 +118,16 @@ type WebhookEndpoint struct {
 	ID               string         `db:"id"`
 	Description      *string        `db:"description"`
 	SubscribedEvents pq.StringArray `db:"subscribed_events"`
+	Filters          WebhookFilters `db:"filters"`
+	PayloadFormat    string         `db:"payload_format"`
 	Headers          WebhookHeaders `db:"headers"`
 	Url              string         `db:"url"`
+	OrgID            sql.NullString `db:"org_id"`
 	Secrets          string         `db:"secrets"`
 
-	State    string             `db:"state"`
-	Metadata types.NullJSONText `db:"metadata"`
+	State               string             `db:"state"`
+	ConsecutiveFailures int                `db:"consecutive_failures"`
+	Metadata            types.NullJSONText `db:"metadata"`
 
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
@@ -125,12 +152,16 @@ func (i WebhookEndpoint) transform(encryptionKey []byte) (webhook.Endpoint, erro
 		ID:               i.ID,
 		Description:      description,
 		SubscribedEvents: i.SubscribedEvents,
+		Filters:          i.Filters,
+		PayloadFormat:    webhook.PayloadFormat(i.PayloadFormat),
 		Secrets:          secrets,
 		URL:              i.Url,
+		OrgID:            i.OrgID.String,
 		Headers:          i.Headers.KVs,
 
-		State:    webhook.State(i.State),
-		Metadata: unmarshalledMetadata,
+		State:               webhook.State(i.State),
+		ConsecutiveFailures: i.ConsecutiveFailures,
+		Metadata:            unmarshalledMetadata,
 
 		CreatedAt: i.CreatedAt,
 		UpdatedAt: i.UpdatedAt,
@@ -9,7 +9,9 @@ import (
 	"strings"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"github.com/raystack/frontier/core/webhook"
 	"github.com/raystack/frontier/pkg/db"
@@ -51,9 +53,12 @@ func (r WebhookEndpointRepository) Create(ctx context.Context, toCreate webhook.
 			"id":                toCreate.ID,
 			"description":       toCreate.Description,
 			"subscribed_events": pq.StringArray(toCreate.SubscribedEvents),
+			"filters":           WebhookFilters(toCreate.Filters),
+			"payload_format":    toCreate.PayloadFormat,
 			"secrets":           secretString,
 			"headers":           toDBWebHookHeaders(toCreate.Headers),
 			"url":               toCreate.URL,
+			"org_id":            toNullString(toCreate.OrgID),
 			"state":             toCreate.State,
 			"metadata":          marshaledMetadata,
 			"created_at":        goqu.L("now()"),
@@ -104,6 +109,14 @@ func (r WebhookEndpointRepository) List(ctx context.Context, flt webhook.Endpoin
 			"state": flt.State,
 		})
 	}
+	if flt.OrgID != "" {
+		stmt = stmt.Where(goqu.Ex{
+			"org_id": flt.OrgID,
+		})
+	}
+	if flt.Platform {
+		stmt = stmt.Where(goqu.C("org_id").IsNull())
+	}
 	query, params, err := stmt.ToSQL()
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", errParse, err)
@@ -139,6 +152,12 @@ func (r WebhookEndpointRepository) UpdateByID(ctx context.Context, toUpdate webh
 		"headers":           toDBWebHookHeaders(toUpdate.Headers),
 		"updated_at":        goqu.L("now()"),
 	}
+	if toUpdate.PayloadFormat != "" {
+		updateRecord["payload_format"] = toUpdate.PayloadFormat
+	}
+	if toUpdate.Filters != nil {
+		updateRecord["filters"] = WebhookFilters(toUpdate.Filters)
+	}
 	if toUpdate.Metadata != nil {
 		marshaledMetadata, err := json.Marshal(toUpdate.Metadata)
 		if err != nil {
@@ -149,6 +168,10 @@ func (r WebhookEndpointRepository) UpdateByID(ctx context.Context, toUpdate webh
 	if toUpdate.State != "" {
 		updateRecord["state"] = toUpdate.State
 	}
+	if toUpdate.State == webhook.Enabled {
+		// an endpoint enabled again starts with a clean slate
+		updateRecord["consecutive_failures"] = 0
+	}
 
 	query, params, err := dialect.Update(TABLE_WEBHOOK_ENDPOINTS).Set(updateRecord).Where(goqu.Ex{
 		"id": toUpdate.ID,
@@ -173,6 +196,101 @@ func (r WebhookEndpointRepository) UpdateByID(ctx context.Context, toUpdate webh
 	return endpointModel.transform(r.encryptionKey)
 }
 
+func (r WebhookEndpointRepository) UpdateSecrets(ctx context.Context, id string, update func([]webhook.Secret) ([]webhook.Secret, error)) (webhook.Endpoint, error) {
+	if strings.TrimSpace(id) == "" {
+		return webhook.Endpoint{}, webhook.ErrInvalidDetail
+	}
+	selectQuery, selectParams, err := dialect.Select("secrets").From(TABLE_WEBHOOK_ENDPOINTS).Where(goqu.Ex{
+		"id": id,
+	}).ForUpdate(exp.Wait).ToSQL()
+	if err != nil {
+		return webhook.Endpoint{}, fmt.Errorf("%w: %s", errQuery, err)
+	}
+
+	// updateErr is returned as is, it is the caller's own error
+	var endpointModel WebhookEndpoint
+	var updateErr error
+	if err = r.dbc.WithTimeout(ctx, TABLE_WEBHOOK_ENDPOINTS, "UpdateSecrets", func(ctx context.Context) error {
+		return r.dbc.WithTxn(ctx, sql.TxOptions{}, func(tx *sqlx.Tx) error {
+			var currentSecrets string
+			if err := tx.QueryRowxContext(ctx, selectQuery, selectParams...).Scan(&currentSecrets); err != nil {
+				return err
+			}
+			secrets, err := fromDBWebHookSecrets(currentSecrets, r.encryptionKey)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt webhook secrets: %w", err)
+			}
+			if secrets, updateErr = update(secrets); updateErr != nil {
+				return updateErr
+			}
+			secretString, err := toDBWebHookSecrets(secrets, r.encryptionKey)
+			if err != nil {
+				return fmt.Errorf("failed to encrypt webhook secrets: %w", err)
+			}
+
+			query, params, err := dialect.Update(TABLE_WEBHOOK_ENDPOINTS).Set(goqu.Record{
+				"secrets":    secretString,
+				"updated_at": goqu.L("now()"),
+			}).Where(goqu.Ex{
+				"id": id,
+			}).Returning(&WebhookEndpoint{}).ToSQL()
+			if err != nil {
+				return fmt.Errorf("%w: %s", errQuery, err)
+			}
+			return tx.QueryRowxContext(ctx, query, params...).StructScan(&endpointModel)
+		})
+	}); err != nil {
+		if updateErr != nil {
+			return webhook.Endpoint{}, updateErr
+		}
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return webhook.Endpoint{}, webhook.ErrNotFound
+		default:
+			return webhook.Endpoint{}, fmt.Errorf("%w: %w", errTxn, err)
+		}
+	}
+
+	return endpointModel.transform(r.encryptionKey)
+}
+
+func (r WebhookEndpointRepository) UpdateHealth(ctx context.Context, id string, succeeded bool, disableAfter int) (webhook.Endpoint, error) {
+	updateRecord := goqu.Record{
+		"consecutive_failures": 0,
+	}
+	if !succeeded {
+		updateRecord["consecutive_failures"] = goqu.L("consecutive_failures + 1")
+		if disableAfter > 0 {
+			updateRecord["state"] = goqu.Case().
+				When(goqu.L("consecutive_failures + 1 >= ?", disableAfter), string(webhook.Disabled)).
+				Else(goqu.I("state"))
+		}
+	}
+
+	query, params, err := dialect.Update(TABLE_WEBHOOK_ENDPOINTS).Set(updateRecord).Where(goqu.Ex{
+		"id": id,
+	}).Returning(&WebhookEndpoint{}).ToSQL()
+	if err != nil {
+		return webhook.Endpoint{}, fmt.Errorf("%w: %s", errQuery, err)
+	}
+
+	var endpointModel WebhookEndpoint
+	if err = r.dbc.WithTimeout(ctx, TABLE_WEBHOOK_ENDPOINTS, "UpdateHealth", func(ctx context.Context) error {
+		return r.dbc.QueryRowxContext(ctx, query, params...).StructScan(&endpointModel)
+	}); err != nil {
+		err = checkPostgresError(err)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return webhook.Endpoint{}, webhook.ErrNotFound
+		default:
+			return webhook.Endpoint{}, fmt.Errorf("%w: %w", errTxn, err)
+		}
+	}
+
+	return endpointModel.transform(r.encryptionKey)
+}
+
 func (r WebhookEndpointRepository) Delete(ctx context.Context, id string) error {
 	query, params, err := dialect.Delete(TABLE_WEBHOOK_ENDPOINTS).Where(goqu.Ex{
 		"id": id,
@@ -3,6 +3,8 @@ package postgres_test
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/raystack/frontier/core/webhook"
@@ -62,6 +64,7 @@ func (s *WebhookEndpointRepositoryTestSuite) TearDownTest() {
 func (s *WebhookEndpointRepositoryTestSuite) cleanup() error {
 	queries := []string{
 		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_WEBHOOK_ENDPOINTS),
+		fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", postgres.TABLE_ORGANIZATIONS),
 	}
 	return execQueries(context.TODO(), s.client, queries)
 }
@@ -179,6 +182,42 @@ func (s *WebhookEndpointRepositoryTestSuite) TestList() {
 	}
 }
 
+func (s *WebhookEndpointRepositoryTestSuite) TestListByOwner() {
+	orgs, err := bootstrapOrganization(s.client)
+	s.Require().NoError(err)
+	platform, err := s.repository.Create(s.ctx, webhook.Endpoint{
+		ID:  uuid.NewString(),
+		URL: "http://localhost:8080/platform",
+	})
+	s.Require().NoError(err)
+	owned, err := s.repository.Create(s.ctx, webhook.Endpoint{
+		ID:    uuid.NewString(),
+		URL:   "http://localhost:8080/org",
+		OrgID: orgs[0].ID,
+	})
+	s.Require().NoError(err)
+
+	got, err := s.repository.List(s.ctx, webhook.EndpointFilter{Platform: true})
+	s.Require().NoError(err)
+	s.Equal([]string{platform.ID}, endpointIDs(got))
+
+	got, err = s.repository.List(s.ctx, webhook.EndpointFilter{OrgID: orgs[0].ID})
+	s.Require().NoError(err)
+	s.Equal([]string{owned.ID}, endpointIDs(got))
+
+	got, err = s.repository.List(s.ctx, webhook.EndpointFilter{OrgID: orgs[1].ID})
+	s.Require().NoError(err)
+	s.Empty(got)
+}
+
+func endpointIDs(endpoints []webhook.Endpoint) []string {
+	ids := make([]string, 0, len(endpoints))
+	for _, e := range endpoints {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
+
 func (s *WebhookEndpointRepositoryTestSuite) TestUpdateByID() {
 	type testCase struct {
 		Description string
@@ -243,6 +282,45 @@ func (s *WebhookEndpointRepositoryTestSuite) TestUpdateByID() {
 	}
 }
 
+func (s *WebhookEndpointRepositoryTestSuite) TestUpdateSecretsConcurrently() {
+	created, err := s.repository.Create(s.ctx, webhook.Endpoint{
+		ID:      uuid.NewString(),
+		URL:     "http://localhost:8080",
+		Secrets: []webhook.Secret{{ID: "1", Value: "secret-1"}},
+	})
+	s.Require().NoError(err)
+
+	// every update appends a secret, none of them may be lost to a
+	// concurrent read of the same secrets
+	var wg sync.WaitGroup
+	for i := 2; i <= 11; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			_, err := s.repository.UpdateSecrets(s.ctx, created.ID, func(secrets []webhook.Secret) ([]webhook.Secret, error) {
+				return append(secrets, webhook.Secret{ID: id, Value: "secret-" + id}), nil
+			})
+			s.NoError(err)
+		}(strconv.Itoa(i))
+	}
+	wg.Wait()
+
+	got, err := s.repository.GetByID(s.ctx, created.ID)
+	s.Require().NoError(err)
+	s.Len(got.Secrets, 11)
+
+	// the error of the update is returned as is and nothing is written
+	_, err = s.repository.UpdateSecrets(s.ctx, created.ID, func([]webhook.Secret) ([]webhook.Secret, error) {
+		return nil, webhook.ErrSecretNotFound
+	})
+	s.ErrorIs(err, webhook.ErrSecretNotFound)
+
+	_, err = s.repository.UpdateSecrets(s.ctx, uuid.NewString(), func(secrets []webhook.Secret) ([]webhook.Secret, error) {
+		return secrets, nil
+	})
+	s.ErrorIs(err, webhook.ErrNotFound)
+}
+
 func TestWebhookEndpointRepository(t *testing.T) {
 	suite.Run(t, new(WebhookEndpointRepositoryTestSuite))
 }
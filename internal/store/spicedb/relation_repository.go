@@ -7,9 +7,12 @@ import (
 	"io"
 	"log/slog"
 	"sync/atomic"
+	"time"
 
 	authzedpb "github.com/authzed/authzed-go/proto/authzed/api/v1"
 	"github.com/raystack/frontier/core/relation"
+	"github.com/raystack/frontier/internal/bootstrap/schema"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type RelationRepository struct {
@@ -69,6 +72,16 @@ func (r *RelationRepository) Add(ctx context.Context, rel relation.Relation) err
 			OptionalRelation: rel.Subject.SubRelationName,
 		},
 	}
+	if rel.Caveat != nil {
+		caveatContext, err := structpb.NewStruct(rel.Caveat.Context)
+		if err != nil {
+			return err
+		}
+		relationship.OptionalCaveat = &authzedpb.ContextualizedCaveat{
+			CaveatName: rel.Caveat.Name,
+			Context:    caveatContext,
+		}
+	}
 	request := &authzedpb.WriteRelationshipsRequest{
 		Updates: []*authzedpb.RelationshipUpdate{
 			{
@@ -88,8 +101,43 @@ func (r *RelationRepository) Add(ctx context.Context, rel relation.Relation) err
 }
 
 func (r *RelationRepository) Check(ctx context.Context, rel relation.Relation) (bool, error) {
-	request := &authzedpb.CheckPermissionRequest{
-		Consistency: r.getConsistencyForCheck(),
+	request := checkRequest(rel)
+	request.Consistency = r.getConsistencyForCheck()
+	request.WithTracing = r.tracing
+
+	response, err := r.spiceDB.client.CheckPermission(ctx, request)
+	if err != nil {
+		return false, err
+	}
+	if response.GetDebugTrace() != nil {
+		str, _ := json.Marshal(response.GetDebugTrace())
+		slog.InfoContext(ctx, "CheckPermission", "trace", string(str))
+	}
+
+	r.lastToken.Store(response.GetCheckedAt())
+	return response.GetPermissionship() == authzedpb.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION, nil
+}
+
+// Explain runs a fully consistent check with tracing regardless of the
+// configured tracing, an explanation of a stale check would mislead
+func (r *RelationRepository) Explain(ctx context.Context, rel relation.Relation) (relation.Trace, error) {
+	request := checkRequest(rel)
+	request.Consistency = &authzedpb.Consistency{Requirement: &authzedpb.Consistency_FullyConsistent{FullyConsistent: true}}
+	request.WithTracing = true
+
+	response, err := r.spiceDB.client.CheckPermission(ctx, request)
+	if err != nil {
+		return relation.Trace{}, err
+	}
+	r.lastToken.Store(response.GetCheckedAt())
+	if response.GetDebugTrace().GetCheck() == nil {
+		return relation.Trace{}, errors.New("authz engine returned no trace for the check")
+	}
+	return toTrace(response.GetDebugTrace().GetCheck()), nil
+}
+
+func checkRequest(rel relation.Relation) *authzedpb.CheckPermissionRequest {
+	return &authzedpb.CheckPermissionRequest{
 		Resource: &authzedpb.ObjectReference{
 			ObjectId:   rel.Object.ID,
 			ObjectType: rel.Object.Namespace,
@@ -101,21 +149,25 @@ func (r *RelationRepository) Check(ctx context.Context, rel relation.Relation) (
 			},
 			OptionalRelation: rel.Subject.SubRelationName,
 		},
-		Permission:  rel.RelationName,
-		WithTracing: r.tracing,
+		Permission: rel.RelationName,
+		Context:    checkContext(),
 	}
+}
 
-	response, err := r.spiceDB.client.CheckPermission(ctx, request)
-	if err != nil {
-		return false, err
+func toTrace(check *authzedpb.CheckDebugTrace) relation.Trace {
+	trace := relation.Trace{
+		Object: relation.Object{
+			ID:        check.GetResource().GetObjectId(),
+			Namespace: check.GetResource().GetObjectType(),
+		},
+		Name:       check.GetPermission(),
+		Permission: check.GetPermissionType() == authzedpb.CheckDebugTrace_PERMISSION_TYPE_PERMISSION,
+		Allowed:    check.GetResult() == authzedpb.CheckDebugTrace_PERMISSIONSHIP_HAS_PERMISSION,
 	}
-	if response.GetDebugTrace() != nil {
-		str, _ := json.Marshal(response.GetDebugTrace())
-		slog.InfoContext(ctx, "CheckPermission", "trace", string(str))
+	for _, sub := range check.GetSubProblems().GetTraces() {
+		trace.Children = append(trace.Children, toTrace(sub))
 	}
-
-	r.lastToken.Store(response.GetCheckedAt())
-	return response.GetPermissionship() == authzedpb.CheckPermissionResponse_PERMISSIONSHIP_HAS_PERMISSION, nil
+	return trace
 }
 
 func (r *RelationRepository) Delete(ctx context.Context, rel relation.Relation) error {
@@ -155,6 +207,7 @@ func (r *RelationRepository) LookupSubjects(ctx context.Context, rel relation.Re
 		},
 		Permission:        rel.RelationName,
 		SubjectObjectType: rel.Subject.Namespace,
+		Context:           checkContext(),
 	})
 	if err != nil {
 		return nil, err
@@ -168,6 +221,9 @@ func (r *RelationRepository) LookupSubjects(ctx context.Context, rel relation.Re
 		if err != nil {
 			return nil, err
 		}
+		if item.GetSubject().GetPermissionship() != authzedpb.LookupPermissionship_LOOKUP_PERMISSIONSHIP_HAS_PERMISSION {
+			continue
+		}
 		subjects = append(subjects, item.GetSubject().GetSubjectObjectId())
 	}
 	return subjects, nil
@@ -185,6 +241,7 @@ func (r *RelationRepository) LookupResources(ctx context.Context, rel relation.R
 			},
 			OptionalRelation: rel.Subject.SubRelationName,
 		},
+		Context: checkContext(),
 	})
 	if err != nil {
 		return nil, err
@@ -198,6 +255,9 @@ func (r *RelationRepository) LookupResources(ctx context.Context, rel relation.R
 		if err != nil {
 			return nil, err
 		}
+		if item.GetPermissionship() != authzedpb.LookupPermissionship_LOOKUP_PERMISSIONSHIP_HAS_PERMISSION {
+			continue
+		}
 		subjects = append(subjects, item.GetResourceObjectId())
 	}
 	return subjects, nil
@@ -253,6 +313,7 @@ func (r *RelationRepository) ListRelations(ctx context.Context, rel relation.Rel
 func (r *RelationRepository) BatchCheck(ctx context.Context, relations []relation.Relation) ([]relation.CheckPair, error) {
 	result := make([]relation.CheckPair, len(relations))
 	items := make([]*authzedpb.CheckBulkPermissionsRequestItem, 0, len(relations))
+	caveatContext := checkContext()
 	for _, rel := range relations {
 		items = append(items, &authzedpb.CheckBulkPermissionsRequestItem{
 			Resource: &authzedpb.ObjectReference{
@@ -267,6 +328,7 @@ func (r *RelationRepository) BatchCheck(ctx context.Context, relations []relatio
 				OptionalRelation: rel.Subject.SubRelationName,
 			},
 			Permission: rel.RelationName,
+			Context:    caveatContext,
 		})
 	}
 	request := &authzedpb.CheckBulkPermissionsRequest{
@@ -309,6 +371,14 @@ func (r *RelationRepository) BatchCheck(ctx context.Context, relations []relatio
 	return result, respErr
 }
 
+// checkContext passes the current time to the caveats of the relations, a
+// role binding outside of its validity window doesn't grant the role
+func checkContext() *structpb.Struct {
+	return &structpb.Struct{Fields: map[string]*structpb.Value{
+		schema.CaveatNowParam: structpb.NewStringValue(time.Now().UTC().Format(time.RFC3339)),
+	}}
+}
+
 func (r *RelationRepository) getConsistency() *authzedpb.Consistency {
 	switch r.consistency {
 	case ConsistencyLevelMinimizeLatency:
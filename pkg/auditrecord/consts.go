@@ -64,8 +64,24 @@ const (
 	PolicyUpdatedEvent Event = "policy.updated"
 	PolicyDeletedEvent Event = "policy.deleted"
 
+	// Access Request Events
+	AccessRequestCreatedEvent   Event = "access_request.created"
+	AccessRequestApprovedEvent  Event = "access_request.approved"
+	AccessRequestDeniedEvent    Event = "access_request.denied"
+	AccessRequestCancelledEvent Event = "access_request.cancelled"
+
+	// Certification Events
+	CertificationStartedEvent   Event = "certification.started"
+	CertificationCancelledEvent Event = "certification.cancelled"
+	CertificationCompletedEvent Event = "certification.completed"
+	CertificationCertifiedEvent Event = "certification.binding_certified"
+	CertificationRevokedEvent   Event = "certification.binding_revoked"
+	CertificationReminderEvent  Event = "certification.reminder"
+
 	// Session Events
-	SessionRevokedEvent Event = "session.revoked"
+	SessionRevokedEvent    Event = "session.revoked"
+	SessionAnomalyEvent    Event = "session.anomaly"
+	SessionSuspiciousEvent Event = "session.suspicious"
 
 	// Platform Events
 	PlatformAdminAddedEvent    Event = "platform.admin_added"
@@ -84,6 +100,12 @@ const (
 	PATExpiryReminderEvent Event = "pat.expiry_reminder"
 	PATExpiredNoticeEvent  Event = "pat.expired_notice"
 
+	// Webhook Events
+	WebhookDisabledEvent Event = "webhook.disabled"
+
+	// Rate Limit Events
+	RateLimitExceededEvent Event = "ratelimit.exceeded"
+
 	SystemActor = "system"
 
 	// Entity Types (used in Resource.Type and Target.Type)
@@ -103,6 +125,10 @@ const (
 	SessionType             EntityType = "session"
 	PATType                 EntityType = "pat"
 	PlatformType            EntityType = "platform"
+	WebhookType             EntityType = "webhook"
+	RateLimitType           EntityType = "ratelimit"
+	AccessRequestType       EntityType = "access_request"
+	CertificationType       EntityType = "certification"
 )
 
 // String returns the string representation of the event
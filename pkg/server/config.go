@@ -3,7 +3,12 @@ package server
 import (
 	"time"
 
+	"github.com/raystack/frontier/core/accessrequest"
+	"github.com/raystack/frontier/core/audit"
+	"github.com/raystack/frontier/core/auditrecord"
+	"github.com/raystack/frontier/core/certification"
 	"github.com/raystack/frontier/core/metaschema"
+	"github.com/raystack/frontier/core/policy"
 	"github.com/raystack/frontier/core/userpat"
 	"github.com/raystack/frontier/core/webhook"
 
@@ -92,11 +97,29 @@ type Config struct {
 	Mailer mailer.Config `yaml:"mailer" mapstructure:"mailer"`
 
 	Webhook webhook.Config `yaml:"webhook" mapstructure:"webhook"`
+	Audit   audit.Config   `yaml:"audit" mapstructure:"audit"`
 	PAT     userpat.Config `yaml:"pat" mapstructure:"pat"`
 
+	// Policy configures time bound policies
+	Policy policy.Config `yaml:"policy" mapstructure:"policy"`
+
+	// AccessRequest configures just in time access requests of members
+	AccessRequest accessrequest.Config `yaml:"access_request" mapstructure:"access_request"`
+
+	// Certification configures the reminders and deadlines of access
+	// certification campaigns
+	Certification certification.Config `yaml:"certification" mapstructure:"certification"`
+
+	AuditRecords auditrecord.Config `yaml:"audit_records" mapstructure:"audit_records"`
+
 	Metaschema metaschema.Config `yaml:"metaschema" mapstructure:"metaschema"`
 
 	// AdditionalTraitsPath is a file path to a YAML file containing additional preference traits
 	// These traits are merged with DefaultTraits at startup
 	AdditionalTraitsPath string `yaml:"additional_traits_path" mapstructure:"additional_traits_path"`
+
+	// PreferencesEncryptionKey encrypts the values of sensitive preference
+	// traits like the sso client secret of an organization, it must be 32
+	// bytes long
+	PreferencesEncryptionKey string `yaml:"preferences_encryption_key" mapstructure:"preferences_encryption_key" default:"hash-secret-should-be-32-chars--"`
 }
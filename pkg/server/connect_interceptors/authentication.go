@@ -11,6 +11,7 @@ import (
 	"github.com/raystack/frontier/internal/api/v1beta1connect"
 	"github.com/raystack/frontier/internal/bootstrap/schema"
 	sessionutils "github.com/raystack/frontier/pkg/session"
+	"github.com/raystack/frontier/proto/v1beta1/frontierv1beta1connect"
 )
 
 type AuthenticationInterceptor struct {
@@ -31,7 +32,15 @@ func (i *AuthenticationInterceptor) WrapUnary(next connect.UnaryFunc) connect.Un
 			return next(ctx, req)
 		}
 
-		principal, err := i.h.GetLoggedInPrincipal(ctx)
+		// the session of the principal is checked against the client
+		sessionMetadata := sessionutils.ExtractSessionMetadata(ctx, req, i.sessionHeaderConfig)
+		ctx = frontiersession.SetSessionMetadataInContext(ctx, sessionMetadata)
+
+		var assertions []authenticate.ClientAssertion
+		if mfaPendingEndpoints[req.Spec().Procedure] {
+			assertions = []authenticate.ClientAssertion{authenticate.MFAPendingSessionClientAssertion}
+		}
+		principal, err := i.h.GetLoggedInPrincipal(ctx, assertions...)
 		if err != nil {
 			return nil, err
 		}
@@ -48,9 +57,6 @@ func (i *AuthenticationInterceptor) WrapUnary(next connect.UnaryFunc) connect.Un
 		}
 		ctx = authenticate.SetSuperUserInContext(ctx, isSuperUser)
 
-		sessionMetadata := sessionutils.ExtractSessionMetadata(ctx, req, i.sessionHeaderConfig)
-		ctx = frontiersession.SetSessionMetadataInContext(ctx, sessionMetadata)
-
 		// Set audit record actor context - for repositories and audit consumers
 		actorName, actorTitle := authenticate.GetPrincipalNameAndTitle(&principal)
 		actorMetadata := map[string]any{}
@@ -107,4 +113,20 @@ var authenticationSkipList = map[string]bool{
 	"/raystack.frontier.v1beta1.FrontierService/ListMetaSchemas":        true,
 	"/raystack.frontier.v1beta1.FrontierService/GetMetaSchema":          true,
 	"/raystack.frontier.v1beta1.FrontierService/BillingWebhookCallback": true,
+	// the signed token of the request identifies the session
+	frontierv1beta1connect.LoginAlertServiceRevokeLoginAlertSessionProcedure: true,
+	// the refresh token of the request identifies the session, the handler
+	// authenticates the session it is bound to
+	frontierv1beta1connect.AuthTokenServiceRefreshAuthTokenProcedure: true,
+}
+
+// mfaPendingEndpoints authenticate the user by the session cookie only and
+// accept a session waiting for its second factor, they complete the login
+var mfaPendingEndpoints = map[string]bool{
+	frontierv1beta1connect.MFAServiceGetMFAStatusProcedure:               true,
+	frontierv1beta1connect.MFAServiceEnrollTOTPProcedure:                 true,
+	frontierv1beta1connect.MFAServiceConfirmTOTPProcedure:                true,
+	frontierv1beta1connect.MFAServiceVerifyMFAProcedure:                  true,
+	frontierv1beta1connect.MFAServiceRegenerateMFARecoveryCodesProcedure: true,
+	frontierv1beta1connect.MFAServiceDisableTOTPProcedure:                true,
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/raystack/frontier/core/authenticate"
 	"github.com/raystack/frontier/proto/v1beta1/frontierv1beta1connect"
 
 	"github.com/raystack/frontier/internal/api/v1beta1connect"
@@ -24,6 +26,10 @@ var (
 
 type AuthorizationInterceptor struct {
 	h *v1beta1connect.ConnectHandler
+
+	// stepUp lists the procedures demanding a recent authentication
+	stepUp       map[string]bool
+	stepUpMaxAge time.Duration
 }
 
 func (a *AuthorizationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
@@ -35,6 +41,10 @@ func (a *AuthorizationInterceptor) WrapStreamingClient(next connect.StreamingCli
 
 func (a *AuthorizationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return connect.StreamingHandlerFunc(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := a.requireRecentAuthentication(ctx, conn.Spec().Procedure); err != nil {
+			return err
+		}
+
 		// check if authorization needs to be skipped
 		if authorizationSkipEndpoints[conn.Spec().Procedure] {
 			return next(ctx, conn)
@@ -66,6 +76,10 @@ func (a *AuthorizationInterceptor) WrapUnary(next connect.UnaryFunc) connect.Una
 			}
 		}
 
+		if err := a.requireRecentAuthentication(ctx, req.Spec().Procedure); err != nil {
+			return nil, err
+		}
+
 		// check if authorization needs to be skipped
 		if authorizationSkipEndpoints[req.Spec().Procedure] {
 			return next(ctx, req)
@@ -86,8 +100,28 @@ func (a *AuthorizationInterceptor) WrapUnary(next connect.UnaryFunc) connect.Una
 	})
 }
 
-func NewAuthorizationInterceptor(h *v1beta1connect.ConnectHandler) *AuthorizationInterceptor {
-	return &AuthorizationInterceptor{h}
+// requireRecentAuthentication rejects sessions not authenticated within the
+// step up max age from calling sensitive procedures
+func (a *AuthorizationInterceptor) requireRecentAuthentication(ctx context.Context, procedure string) error {
+	if a.stepUpMaxAge <= 0 || !a.stepUp[procedure] {
+		return nil
+	}
+	return a.h.RequireRecentAuthentication(ctx, a.stepUpMaxAge)
+}
+
+func NewAuthorizationInterceptor(h *v1beta1connect.ConnectHandler, stepUp authenticate.StepUpConfig) *AuthorizationInterceptor {
+	stepUpProcedures := stepUpEndpoints
+	if len(stepUp.Procedures) > 0 {
+		stepUpProcedures = map[string]bool{}
+		for _, procedure := range stepUp.Procedures {
+			stepUpProcedures[procedure] = true
+		}
+	}
+	return &AuthorizationInterceptor{
+		h:            h,
+		stepUp:       stepUpProcedures,
+		stepUpMaxAge: stepUp.MaxAge,
+	}
 }
 
 // authorizationSkipEndpoints stores path to skip authorization, by default its enabled for all requests
@@ -145,6 +179,50 @@ var authorizationSkipEndpoints = map[string]bool{
 	"/raystack.frontier.v1beta1.FrontierService/RevokeSession":   true,
 
 	"/raystack.frontier.v1beta1.FrontierService/ListRolesForPAT": true,
+
+	// the handlers only act on authorizations of the current user
+	frontierv1beta1connect.OAuthConsentServiceGetOAuthConsentRequestProcedure: true,
+	frontierv1beta1connect.OAuthConsentServiceDecideOAuthConsentProcedure:     true,
+
+	// the second factor of the current user
+	frontierv1beta1connect.MFAServiceGetMFAStatusProcedure:               true,
+	frontierv1beta1connect.MFAServiceEnrollTOTPProcedure:                 true,
+	frontierv1beta1connect.MFAServiceConfirmTOTPProcedure:                true,
+	frontierv1beta1connect.MFAServiceVerifyMFAProcedure:                  true,
+	frontierv1beta1connect.MFAServiceRegenerateMFARecoveryCodesProcedure: true,
+	frontierv1beta1connect.MFAServiceDisableTOTPProcedure:                true,
+
+	frontierv1beta1connect.LoginAlertServiceRevokeLoginAlertSessionProcedure: true,
+
+	// the service checks the requester and the reviewers of the resource
+	frontierv1beta1connect.AccessRequestServiceCreateAccessRequestProcedure:  true,
+	frontierv1beta1connect.AccessRequestServiceListAccessRequestsProcedure:   true,
+	frontierv1beta1connect.AccessRequestServiceApproveAccessRequestProcedure: true,
+	frontierv1beta1connect.AccessRequestServiceDenyAccessRequestProcedure:    true,
+	frontierv1beta1connect.AccessRequestServiceCancelAccessRequestProcedure:  true,
+
+	// the service checks who can explain the access of other principals
+	frontierv1beta1connect.ExplainServiceExplainPermissionProcedure: true,
+
+	// the service checks who can review the access of principals and resources
+	frontierv1beta1connect.AccessReviewServiceReviewPrincipalAccessProcedure: true,
+	frontierv1beta1connect.AccessReviewServiceReviewResourceAccessProcedure:  true,
+	frontierv1beta1connect.AccessReviewServiceExportPrincipalAccessProcedure: true,
+	frontierv1beta1connect.AccessReviewServiceExportResourceAccessProcedure:  true,
+
+	// the service checks the managers of the organization and the reviewers
+	// of the policies
+	frontierv1beta1connect.CertificationServiceCreateCertificationCampaignProcedure: true,
+	frontierv1beta1connect.CertificationServiceListCertificationCampaignsProcedure:  true,
+	frontierv1beta1connect.CertificationServiceCancelCertificationCampaignProcedure: true,
+	frontierv1beta1connect.CertificationServiceListCertificationItemsProcedure:      true,
+	frontierv1beta1connect.CertificationServiceCertifyCertificationItemProcedure:    true,
+	frontierv1beta1connect.CertificationServiceRevokeCertificationItemProcedure:     true,
+	frontierv1beta1connect.CertificationServiceExportCertificationCampaignProcedure: true,
+
+	// refresh tokens are bound to the session of the user
+	frontierv1beta1connect.AuthTokenServiceIssueRefreshTokenProcedure: true,
+	frontierv1beta1connect.AuthTokenServiceRefreshAuthTokenProcedure:  true,
 }
 
 // patDeniedEndpoints lists endpoints that (org scoped) PATs cannot call. Will be called by SDK(UI)
@@ -158,6 +236,20 @@ var patDeniedEndpoints = map[string]bool{
 	"/raystack.frontier.v1beta1.FrontierService/CreateCurrentUserPAT":         true,
 }
 
+// stepUpEndpoints lists the sensitive endpoints demanding a recent
+// authentication of user sessions when step up is enabled without procedures
+var stepUpEndpoints = map[string]bool{
+	"/raystack.frontier.v1beta1.FrontierService/DeleteOrganization":          true,
+	"/raystack.frontier.v1beta1.FrontierService/DeleteProject":               true,
+	"/raystack.frontier.v1beta1.FrontierService/DeleteUser":                  true,
+	"/raystack.frontier.v1beta1.FrontierService/DeleteServiceUser":           true,
+	"/raystack.frontier.v1beta1.FrontierService/CreateServiceUserJWK":        true,
+	"/raystack.frontier.v1beta1.FrontierService/CreateServiceUserCredential": true,
+	"/raystack.frontier.v1beta1.FrontierService/CreateServiceUserToken":      true,
+	"/raystack.frontier.v1beta1.FrontierService/CreateCurrentUserPAT":        true,
+	"/raystack.frontier.v1beta1.FrontierService/RegenerateCurrentUserPAT":    true,
+}
+
 // authorizationValidationMap stores path to validation function
 var authorizationValidationMap = map[string]func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error{
 	// user
@@ -819,6 +911,21 @@ var authorizationValidationMap = map[string]func(ctx context.Context, handler *v
 	frontierv1beta1connect.AdminServiceExportAuditRecordsProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
 		return handler.IsSuperUser(ctx, req)
 	},
+	frontierv1beta1connect.AuditRecordServiceVerifyAuditRecordChainProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		return handler.IsSuperUser(ctx, req)
+	},
+	frontierv1beta1connect.AuditRecordServiceListAuditRecordArchivesProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		return handler.IsSuperUser(ctx, req)
+	},
+	frontierv1beta1connect.AuditRecordServiceGetAuditRecordArchiveProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		return handler.IsSuperUser(ctx, req)
+	},
+	frontierv1beta1connect.AuditRecordServiceRestoreAuditRecordArchiveProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		return handler.IsSuperUser(ctx, req)
+	},
+	frontierv1beta1connect.SigningKeyServiceRotateSigningKeyProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		return handler.IsSuperUser(ctx, req)
+	},
 
 	// preferences
 	"/raystack.frontier.v1beta1.FrontierService/CreateOrganizationPreferences": func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
@@ -1233,6 +1340,103 @@ var authorizationValidationMap = map[string]func(ctx context.Context, handler *v
 	"/raystack.frontier.v1beta1.AdminService/DeleteWebhook": func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
 		return handler.IsSuperUser(ctx, req)
 	},
+
+	// oauth clients
+	frontierv1beta1connect.OAuthClientServiceCreateOrganizationOAuthClientProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.CreateOrganizationOAuthClientRequest])
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.Msg.GetOrgId()}, schema.UpdatePermission, req)
+	},
+	frontierv1beta1connect.OAuthClientServiceListOrganizationOAuthClientsProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.ListOrganizationOAuthClientsRequest])
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.Msg.GetOrgId()}, schema.UpdatePermission, req)
+	},
+	frontierv1beta1connect.OAuthClientServiceRotateOrganizationOAuthClientSecretProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.RotateOrganizationOAuthClientSecretRequest])
+		if err := ensureOAuthClientBelongToOrg(ctx, handler, pbreq.Msg.GetOrgId(), pbreq.Msg.GetId()); err != nil {
+			return err
+		}
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.Msg.GetOrgId()}, schema.UpdatePermission, req)
+	},
+	frontierv1beta1connect.OAuthClientServiceDeleteOrganizationOAuthClientProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.DeleteOrganizationOAuthClientRequest])
+		if err := ensureOAuthClientBelongToOrg(ctx, handler, pbreq.Msg.GetOrgId(), pbreq.Msg.GetId()); err != nil {
+			return err
+		}
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.Msg.GetOrgId()}, schema.UpdatePermission, req)
+	},
+
+	frontierv1beta1connect.WebhookServiceCreateOrganizationWebhookProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.CreateOrganizationWebhookRequest])
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.Msg.GetOrgId()}, schema.WebhookManagePermission, req)
+	},
+	frontierv1beta1connect.WebhookServiceListOrganizationWebhooksProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.ListOrganizationWebhooksRequest])
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.Msg.GetOrgId()}, schema.WebhookManagePermission, req)
+	},
+	frontierv1beta1connect.WebhookServiceUpdateOrganizationWebhookProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.UpdateOrganizationWebhookRequest])
+		if err := ensureWebhookBelongToOrg(ctx, handler, pbreq.Msg.GetOrgId(), pbreq.Msg.GetId()); err != nil {
+			return err
+		}
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.Msg.GetOrgId()}, schema.WebhookManagePermission, req)
+	},
+	frontierv1beta1connect.WebhookServiceDeleteOrganizationWebhookProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.DeleteOrganizationWebhookRequest])
+		if err := ensureWebhookBelongToOrg(ctx, handler, pbreq.Msg.GetOrgId(), pbreq.Msg.GetId()); err != nil {
+			return err
+		}
+		return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: pbreq.Msg.GetOrgId()}, schema.WebhookManagePermission, req)
+	},
+	frontierv1beta1connect.WebhookServiceGetWebhookOptionsProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.GetWebhookOptionsRequest])
+		return authorizeWebhook(ctx, handler, pbreq.Msg.GetWebhookId(), req)
+	},
+	frontierv1beta1connect.WebhookServiceUpdateWebhookFiltersProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.UpdateWebhookFiltersRequest])
+		return authorizeWebhook(ctx, handler, pbreq.Msg.GetWebhookId(), req)
+	},
+	frontierv1beta1connect.WebhookServiceUpdateWebhookPayloadFormatProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.UpdateWebhookPayloadFormatRequest])
+		return authorizeWebhook(ctx, handler, pbreq.Msg.GetWebhookId(), req)
+	},
+	frontierv1beta1connect.WebhookServiceRotateWebhookSecretProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.RotateWebhookSecretRequest])
+		return authorizeWebhook(ctx, handler, pbreq.Msg.GetWebhookId(), req)
+	},
+	frontierv1beta1connect.WebhookServiceRevokeWebhookSecretProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.RevokeWebhookSecretRequest])
+		return authorizeWebhook(ctx, handler, pbreq.Msg.GetWebhookId(), req)
+	},
+	frontierv1beta1connect.WebhookServiceSendTestWebhookEventProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.SendTestWebhookEventRequest])
+		return authorizeWebhook(ctx, handler, pbreq.Msg.GetWebhookId(), req)
+	},
+	frontierv1beta1connect.WebhookServiceListWebhookDeliveriesProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.ListWebhookDeliveriesRequest])
+		if pbreq.Msg.GetWebhookId() == "" {
+			return handler.IsSuperUser(ctx, req)
+		}
+		return authorizeWebhook(ctx, handler, pbreq.Msg.GetWebhookId(), req)
+	},
+	frontierv1beta1connect.WebhookServiceGetWebhookDeliveryProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.GetWebhookDeliveryRequest])
+		return authorizeWebhookDelivery(ctx, handler, pbreq.Msg.GetId(), req)
+	},
+	frontierv1beta1connect.WebhookServiceListWebhookDeliveryAttemptsProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.ListWebhookDeliveryAttemptsRequest])
+		return authorizeWebhookDelivery(ctx, handler, pbreq.Msg.GetDeliveryId(), req)
+	},
+	frontierv1beta1connect.WebhookServiceRedeliverWebhookDeliveryProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.RedeliverWebhookDeliveryRequest])
+		return authorizeWebhookDelivery(ctx, handler, pbreq.Msg.GetId(), req)
+	},
+	frontierv1beta1connect.WebhookServiceRedeliverWebhookDeliveriesProcedure: func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
+		pbreq := req.(*connect.Request[frontierv1beta1.RedeliverWebhookDeliveriesRequest])
+		if pbreq.Msg.GetWebhookId() == "" {
+			return handler.IsSuperUser(ctx, req)
+		}
+		return authorizeWebhook(ctx, handler, pbreq.Msg.GetWebhookId(), req)
+	},
 	"/raystack.frontier.v1beta1.AdminService/CreateProspect": func(ctx context.Context, handler *v1beta1connect.ConnectHandler, req connect.AnyRequest) error {
 		return handler.IsSuperUser(ctx, req)
 	},
@@ -1296,3 +1500,46 @@ func ensureSubscriptionBelongToOrg(ctx context.Context, handler *v1beta1connect.
 	// Return the inferred org_id for authorization check
 	return orgID, nil
 }
+
+func ensureWebhookBelongToOrg(ctx context.Context, handler *v1beta1connect.ConnectHandler, orgID, webhookID string) error {
+	webhookOrgID, err := handler.GetOrgIDFromWebhookID(ctx, webhookID)
+	if err != nil {
+		return err
+	}
+	if webhookOrgID != orgID {
+		return ErrDeniedInvalidArgs
+	}
+	return nil
+}
+
+func ensureOAuthClientBelongToOrg(ctx context.Context, handler *v1beta1connect.ConnectHandler, orgID, clientID string) error {
+	clientOrgID, err := handler.GetOrgIDFromOAuthClientID(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	if clientOrgID != orgID {
+		return ErrDeniedInvalidArgs
+	}
+	return nil
+}
+
+// authorizeWebhook lets superusers manage platform webhooks, and members with
+// webhookmanage on an organization manage the webhooks of the organization
+func authorizeWebhook(ctx context.Context, handler *v1beta1connect.ConnectHandler, webhookID string, req connect.AnyRequest) error {
+	orgID, err := handler.GetOrgIDFromWebhookID(ctx, webhookID)
+	if err != nil {
+		return err
+	}
+	if orgID == "" {
+		return handler.IsSuperUser(ctx, req)
+	}
+	return handler.IsAuthorized(ctx, relation.Object{Namespace: schema.OrganizationNamespace, ID: orgID}, schema.WebhookManagePermission, req)
+}
+
+func authorizeWebhookDelivery(ctx context.Context, handler *v1beta1connect.ConnectHandler, deliveryID string, req connect.AnyRequest) error {
+	webhookID, err := handler.GetWebhookIDFromDeliveryID(ctx, deliveryID)
+	if err != nil {
+		return err
+	}
+	return authorizeWebhook(ctx, handler, webhookID, req)
+}
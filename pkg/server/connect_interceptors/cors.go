@@ -25,8 +25,9 @@ func WithConnectCORS(connectHandler http.Handler, conf CorsConfig) http.Handler
 		AllowedMethods: connectcors.AllowedMethods(),
 		// Use wildcard for headers to support all Connect RPC headers
 		// Connect can send various headers depending on the request type
-		AllowedHeaders:   []string{"*"},
-		ExposedHeaders:   connectcors.ExposedHeaders(),
+		AllowedHeaders: []string{"*"},
+		// WWW-Authenticate carries the step up challenge of sensitive procedures
+		ExposedHeaders:   append(connectcors.ExposedHeaders(), "WWW-Authenticate"),
 		AllowCredentials: true,
 		MaxAge:           conf.MaxAge,
 		Debug:            false,
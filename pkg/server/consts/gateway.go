@@ -48,6 +48,11 @@ const (
 	// response headers
 	UserTokenRequestKey = "x-user-token"
 
+	// ReauthenticateRequestKey set to true makes authenticate start a login
+	// flow for a logged in user, the callback marks the current session as
+	// authenticated again
+	ReauthenticateRequestKey = "x-reauthenticate"
+
 	// LocationRequestKey is used to set location response header for redirecting browser
 	LocationRequestKey = "location"
 
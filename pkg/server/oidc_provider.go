@@ -0,0 +1,236 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/securecookie"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/oidcprovider"
+	"github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/pkg/server/consts"
+	sessionutils "github.com/raystack/frontier/pkg/session"
+	"google.golang.org/grpc/metadata"
+)
+
+type SessionExtractor interface {
+	ExtractFromContext(ctx context.Context) (*session.Session, error)
+}
+
+type PrincipalService interface {
+	GetPrincipal(ctx context.Context, assertions ...authenticate.ClientAssertion) (authenticate.Principal, error)
+}
+
+// OIDCProviderHandler serves the endpoints of frontier acting as an OpenID
+// provider. They are plain http endpoints as OAuth clients don't speak
+// connect, the user is identified by the frontier session cookie. The consent
+// screen uses the OAuthConsentService rpcs instead.
+type OIDCProviderHandler struct {
+	provider       *oidcprovider.Service
+	principals     PrincipalService
+	sessions       SessionExtractor
+	cookieCodec    securecookie.Codec
+	sessionHeaders authenticate.SessionMetadataHeaders
+	logger         *slog.Logger
+}
+
+func NewOIDCProviderHandler(provider *oidcprovider.Service, principals PrincipalService, sessions SessionExtractor,
+	cookieCodec securecookie.Codec, sessionHeaders authenticate.SessionMetadataHeaders, logger *slog.Logger) *OIDCProviderHandler {
+	return &OIDCProviderHandler{
+		provider:       provider,
+		principals:     principals,
+		sessions:       sessions,
+		cookieCodec:    cookieCodec,
+		sessionHeaders: sessionHeaders,
+		logger:         logger,
+	}
+}
+
+// Register mounts the endpoints on the mux
+func (h *OIDCProviderHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc(oidcprovider.DiscoveryPath, h.Discovery)
+	mux.HandleFunc(oidcprovider.JWKSPath, h.JWKS)
+	mux.HandleFunc(oidcprovider.AuthorizationPath, h.Authorize)
+	mux.HandleFunc(oidcprovider.TokenPath, h.Token)
+	mux.HandleFunc(oidcprovider.UserInfoPath, h.UserInfo)
+}
+
+func (h *OIDCProviderHandler) Discovery(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, http.StatusOK, h.provider.Discovery())
+}
+
+func (h *OIDCProviderHandler) JWKS(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, http.StatusOK, h.provider.JWKs())
+}
+
+func (h *OIDCProviderHandler) Authorize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		h.writeError(w, r, &oidcprovider.Error{Code: oidcprovider.ErrorInvalidRequest, Description: "malformed request"})
+		return
+	}
+
+	redirectTo, err := h.provider.Authorize(r.Context(), oidcprovider.AuthorizeRequest{
+		ResponseType:        r.Form.Get("response_type"),
+		ClientID:            r.Form.Get("client_id"),
+		RedirectURI:         r.Form.Get("redirect_uri"),
+		Scope:               r.Form.Get("scope"),
+		State:               r.Form.Get("state"),
+		Nonce:               r.Form.Get("nonce"),
+		CodeChallenge:       r.Form.Get("code_challenge"),
+		CodeChallengeMethod: r.Form.Get("code_challenge_method"),
+		Prompt:              r.Form.Get("prompt"),
+	}, h.subject(r))
+	if errors.Is(err, oidcprovider.ErrLoginRequired) {
+		requestURI := r.URL.RequestURI()
+		if r.Method == http.MethodPost {
+			requestURI = r.URL.Path + "?" + r.Form.Encode()
+		}
+		http.Redirect(w, r, h.provider.LoginURL(requestURI), http.StatusFound)
+		return
+	}
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	http.Redirect(w, r, redirectTo, http.StatusFound)
+}
+
+func (h *OIDCProviderHandler) Token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		h.writeError(w, r, &oidcprovider.Error{Code: oidcprovider.ErrorInvalidRequest, Description: "malformed request"})
+		return
+	}
+
+	request := oidcprovider.TokenRequest{
+		GrantType:    r.PostForm.Get("grant_type"),
+		ClientID:     r.PostForm.Get("client_id"),
+		ClientSecret: r.PostForm.Get("client_secret"),
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		RefreshToken: r.PostForm.Get("refresh_token"),
+		Scope:        r.PostForm.Get("scope"),
+	}
+	// basic credentials are form encoded before being joined, RFC 6749 2.3.1
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		request.ClientID, _ = url.QueryUnescape(clientID)
+		request.ClientSecret, _ = url.QueryUnescape(clientSecret)
+	}
+	response, err := h.provider.Token(r.Context(), request)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *OIDCProviderHandler) UserInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	accessToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || accessToken == "" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="frontier"`)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	claims, err := h.provider.UserInfo(r.Context(), strings.TrimSpace(accessToken))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, claims)
+}
+
+// subject returns the user signed in by the session cookie of the request.
+// The session is authenticated like the connect interceptors do, its
+// activity is tracked and a session waiting for its second factor doesn't
+// sign in the user yet.
+func (h *OIDCProviderHandler) subject(r *http.Request) oidcprovider.Subject {
+	if h.cookieCodec == nil {
+		return oidcprovider.Subject{}
+	}
+	cookie, err := r.Cookie(consts.SessionRequestKey)
+	if err != nil {
+		return oidcprovider.Subject{}
+	}
+	var sessionID string
+	if err := h.cookieCodec.Decode(cookie.Name, cookie.Value, &sessionID); err != nil {
+		return oidcprovider.Subject{}
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs(consts.SessionIDGatewayKey, strings.TrimSpace(sessionID)))
+	ctx = session.SetSessionMetadataInContext(ctx, sessionutils.SessionMetadataFromHeader(r.Header, h.sessionHeaders))
+
+	principal, err := h.principals.GetPrincipal(ctx, authenticate.SessionClientAssertion)
+	if err != nil {
+		return oidcprovider.Subject{}
+	}
+	// auth_time of the id tokens is the time the session signed in
+	sess, err := h.sessions.ExtractFromContext(ctx)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "reading the authenticated session failed", "err", err)
+		return oidcprovider.Subject{}
+	}
+	return oidcprovider.Subject{UserID: principal.ID, AuthTime: sess.AuthenticatedAt}
+}
+
+// writeError writes oauth errors as is, other errors are logged and hidden
+// from the client
+func (h *OIDCProviderHandler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var oauthErr *oidcprovider.Error
+	switch {
+	case errors.As(err, &oauthErr):
+		status := http.StatusBadRequest
+		switch oauthErr.Code {
+		case oidcprovider.ErrorInvalidClient:
+			status = http.StatusUnauthorized
+			if _, _, ok := r.BasicAuth(); ok {
+				w.Header().Set("WWW-Authenticate", `Basic realm="frontier"`)
+			}
+		case oidcprovider.ErrorInvalidToken:
+			status = http.StatusUnauthorized
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		}
+		writeJSON(w, status, oauthErr)
+	case errors.Is(err, oidcprovider.ErrDisabled):
+		http.NotFound(w, r)
+	case errors.Is(err, oidcprovider.ErrAuthorizationNotFound):
+		writeJSON(w, http.StatusNotFound, oidcprovider.Error{
+			Code:        oidcprovider.ErrorInvalidRequest,
+			Description: "authorization doesn't exist or has expired",
+		})
+	default:
+		h.logger.ErrorContext(r.Context(), "openid provider request failed", "path", r.URL.Path, "err", err)
+		writeJSON(w, http.StatusInternalServerError, oidcprovider.Error{Code: oidcprovider.ErrorServerError})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/securecookie"
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/session"
+	"github.com/raystack/frontier/pkg/server/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+type fakePrincipalService struct {
+	principal  authenticate.Principal
+	err        error
+	assertions []authenticate.ClientAssertion
+	sessionID  string
+	clientIP   string
+}
+
+func (f *fakePrincipalService) GetPrincipal(ctx context.Context, assertions ...authenticate.ClientAssertion) (authenticate.Principal, error) {
+	f.assertions = assertions
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(consts.SessionIDGatewayKey); len(values) > 0 {
+			f.sessionID = values[0]
+		}
+	}
+	if sessionMetadata, ok := session.GetSessionMetadataFromContext(ctx); ok {
+		f.clientIP = sessionMetadata.IpAddress
+	}
+	return f.principal, f.err
+}
+
+type fakeSessionExtractor struct {
+	session *session.Session
+}
+
+func (f fakeSessionExtractor) ExtractFromContext(ctx context.Context) (*session.Session, error) {
+	return f.session, nil
+}
+
+func TestOIDCProviderHandler_Subject(t *testing.T) {
+	codec := securecookie.New(securecookie.GenerateRandomKey(32), securecookie.GenerateRandomKey(32))
+	userID := uuid.New().String()
+	sessionID := uuid.New()
+	authenticatedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	headers := authenticate.SessionMetadataHeaders{ClientIP: "x-forwarded-for"}
+
+	request := func(t *testing.T) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/oauth2/authorize", nil)
+		value, err := codec.Encode(consts.SessionRequestKey, sessionID.String())
+		require.NoError(t, err)
+		r.AddCookie(&http.Cookie{Name: consts.SessionRequestKey, Value: value})
+		r.Header.Set("x-forwarded-for", "203.0.113.7, 10.0.0.1")
+		return r
+	}
+
+	t.Run("authenticates the session through the session authenticator", func(t *testing.T) {
+		principals := &fakePrincipalService{principal: authenticate.Principal{ID: userID}}
+		h := NewOIDCProviderHandler(nil, principals, fakeSessionExtractor{session: &session.Session{
+			ID:              sessionID,
+			UserID:          userID,
+			AuthenticatedAt: authenticatedAt,
+		}}, codec, headers, slog.Default())
+
+		subject := h.subject(request(t))
+		assert.Equal(t, userID, subject.UserID)
+		assert.Equal(t, authenticatedAt, subject.AuthTime)
+		assert.Equal(t, []authenticate.ClientAssertion{authenticate.SessionClientAssertion}, principals.assertions)
+		assert.Equal(t, sessionID.String(), principals.sessionID)
+		// the activity of the session is tracked with the client of the request
+		assert.Equal(t, "203.0.113.7", principals.clientIP)
+	})
+
+	t.Run("doesn't sign in sessions the authenticator rejects", func(t *testing.T) {
+		principals := &fakePrincipalService{err: errors.New("second factor required")}
+		h := NewOIDCProviderHandler(nil, principals, fakeSessionExtractor{}, codec, headers, slog.Default())
+
+		assert.Empty(t, h.subject(request(t)).UserID)
+	})
+
+	t.Run("doesn't authenticate requests without a session cookie", func(t *testing.T) {
+		principals := &fakePrincipalService{principal: authenticate.Principal{ID: userID}}
+		h := NewOIDCProviderHandler(nil, principals, fakeSessionExtractor{}, codec, headers, slog.Default())
+
+		assert.Empty(t, h.subject(httptest.NewRequest(http.MethodGet, "/oauth2/authorize", nil)).UserID)
+		assert.Nil(t, principals.assertions)
+	})
+}
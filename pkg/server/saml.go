@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/raystack/frontier/core/authenticate"
+	"github.com/raystack/frontier/core/authenticate/strategy"
+)
+
+type SAMLService interface {
+	SAMLMetadata(ctx context.Context, name string) ([]byte, error)
+	ConsumeSAMLResponse(ctx context.Context, name, samlResponse, relayState string) (string, error)
+}
+
+// SAMLHandler serves the service provider endpoints of the saml strategies.
+// They are plain http endpoints as identity providers post their response
+// from the browser as a form.
+type SAMLHandler struct {
+	service SAMLService
+	logger  *slog.Logger
+}
+
+func NewSAMLHandler(service SAMLService, logger *slog.Logger) *SAMLHandler {
+	return &SAMLHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Register mounts the endpoints on the mux
+func (h *SAMLHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc(authenticate.SAMLMetadataPath, h.Metadata)
+	mux.HandleFunc(authenticate.SAMLACSPath, h.ACS)
+}
+
+func (h *SAMLHandler) Metadata(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	metadata, err := h.service.SAMLMetadata(r.Context(), r.PathValue("name"))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(metadata)
+}
+
+func (h *SAMLHandler) ACS(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "malformed request", http.StatusBadRequest)
+		return
+	}
+	redirectURL, err := h.service.ConsumeSAMLResponse(r.Context(), r.PathValue("name"),
+		r.PostForm.Get("SAMLResponse"), r.PostForm.Get("RelayState"))
+	if err != nil {
+		h.writeError(w, r, err)
+		return
+	}
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
+
+func (h *SAMLHandler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, authenticate.ErrUnsupportedMethod):
+		http.NotFound(w, r)
+	case errors.Is(err, strategy.ErrInvalidSAMLResponse),
+		errors.Is(err, authenticate.ErrInvalidOIDCState),
+		errors.Is(err, authenticate.ErrFlowInvalid):
+		h.logger.WarnContext(r.Context(), "rejected saml response", "path", r.URL.Path, "err", err)
+		http.Error(w, "invalid saml response or expired login", http.StatusBadRequest)
+	case errors.Is(err, authenticate.ErrIDPUserNotAllowed),
+		errors.Is(err, authenticate.ErrSSORequired):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	default:
+		h.logger.ErrorContext(r.Context(), "saml request failed", "path", r.URL.Path, "err", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
@@ -160,7 +160,7 @@ func ServeConnect(ctx context.Context, logger *slog.Logger, cfg Config, deps api
 	}
 
 	authNInterceptor := connectinterceptors.NewAuthenticationInterceptor(frontierService, cfg.Authentication.Session.Headers)
-	authZInterceptor := connectinterceptors.NewAuthorizationInterceptor(frontierService)
+	authZInterceptor := connectinterceptors.NewAuthorizationInterceptor(frontierService, cfg.Authentication.StepUp)
 	sessionInterceptor := connectinterceptors.NewSessionInterceptor(sessionCookieCutter, cfg.Authentication.Session, frontierService, cfg.PAT)
 	auditInterceptor := connectinterceptors.NewAuditInterceptor(deps.AuditService)
 
@@ -182,20 +182,68 @@ func ServeConnect(ctx context.Context, logger *slog.Logger, cfg Config, deps api
 	// the other interceptors as well, not just the handlers.
 	frontierPath, frontierHandler := frontierv1beta1connect.NewFrontierServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
 	adminPath, adminHandler := frontierv1beta1connect.NewAdminServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	webhookPath, webhookHandler := frontierv1beta1connect.NewWebhookServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	auditRecordPath, auditRecordHandler := frontierv1beta1connect.NewAuditRecordServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	oauthConsentPath, oauthConsentHandler := frontierv1beta1connect.NewOAuthConsentServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	mfaPath, mfaHandler := frontierv1beta1connect.NewMFAServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	loginAlertPath, loginAlertHandler := frontierv1beta1connect.NewLoginAlertServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	accessRequestPath, accessRequestHandler := frontierv1beta1connect.NewAccessRequestServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	explainPath, explainHandler := frontierv1beta1connect.NewExplainServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	accessReviewPath, accessReviewHandler := frontierv1beta1connect.NewAccessReviewServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	certificationPath, certificationHandler := frontierv1beta1connect.NewCertificationServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	authTokenPath, authTokenHandler := frontierv1beta1connect.NewAuthTokenServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	signingKeyPath, signingKeyHandler := frontierv1beta1connect.NewSigningKeyServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
+	oauthClientPath, oauthClientHandler := frontierv1beta1connect.NewOAuthClientServiceHandler(frontierService, connectPanicRecovery(logger), interceptors, connect.WithCodec(connectCodec{}))
 
 	// Create mux and register handlers
 	mux := http.NewServeMux()
 	mux.Handle(frontierPath, frontierHandler)
 	mux.Handle(adminPath, adminHandler)
+	mux.Handle(webhookPath, webhookHandler)
+	mux.Handle(auditRecordPath, auditRecordHandler)
+	mux.Handle(oauthConsentPath, oauthConsentHandler)
+	mux.Handle(mfaPath, mfaHandler)
+	mux.Handle(loginAlertPath, loginAlertHandler)
+	mux.Handle(accessRequestPath, accessRequestHandler)
+	mux.Handle(explainPath, explainHandler)
+	mux.Handle(accessReviewPath, accessReviewHandler)
+	mux.Handle(certificationPath, certificationHandler)
+	mux.Handle(authTokenPath, authTokenHandler)
+	mux.Handle(signingKeyPath, signingKeyHandler)
+	mux.Handle(oauthClientPath, oauthClientHandler)
 
 	// Register webhook bridge handler to allow Stripe to call with provider in path
 	// This uses frontierHandler which has all interceptors (auth, logging, audit, etc.) applied
 	mux.HandleFunc("/billing/webhooks/callback/", WebhookBridgeHandler(frontierHandler))
+
+	// endpoints of frontier acting as an openid provider for third party apps
+	if deps.OIDCProviderService != nil && deps.OIDCProviderService.Enabled() {
+		NewOIDCProviderHandler(deps.OIDCProviderService, deps.AuthnService, deps.SessionService, sessionCookieCutter,
+			cfg.Authentication.Session.Headers, logger).Register(mux)
+	}
+
+	// service provider endpoints of the saml login strategies
+	if len(cfg.Authentication.SAMLConfig) > 0 {
+		NewSAMLHandler(deps.AuthnService, logger).Register(mux)
+	}
+	// protoc-gen-connect-go generates package-level constants for these
+	// fully-qualified protobuf service names, such as
+	// frontierv1beta1connect.WebhookServiceName
 	reflector := grpcreflect.NewStaticReflector(
 		"raystack.frontier.v1beta1.FrontierService",
-		"raystack.frontier.v1beta1.AdminService") // protoc-gen-connect-go generates package-level constants
-	// for these fully-qualified protobuf service names, such as
-	// frontierv1beta1.FrontierServiceName and frontierv1beta1.AdminServiceName
+		"raystack.frontier.v1beta1.AdminService",
+		frontierv1beta1connect.WebhookServiceName,
+		frontierv1beta1connect.AuditRecordServiceName,
+		frontierv1beta1connect.OAuthConsentServiceName,
+		frontierv1beta1connect.MFAServiceName,
+		frontierv1beta1connect.LoginAlertServiceName,
+		frontierv1beta1connect.AccessRequestServiceName,
+		frontierv1beta1connect.ExplainServiceName,
+		frontierv1beta1connect.AccessReviewServiceName,
+		frontierv1beta1connect.CertificationServiceName,
+		frontierv1beta1connect.AuthTokenServiceName,
+		frontierv1beta1connect.SigningKeyServiceName,
+		frontierv1beta1connect.OAuthClientServiceName)
 
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	// Many tools still expect the older version of the server reflection API, so
@@ -209,6 +257,18 @@ func ServeConnect(ctx context.Context, logger *slog.Logger, cfg Config, deps api
 	checker := connecthealth.NewStaticChecker(
 		"raystack.frontier.v1beta1.FrontierService",
 		"raystack.frontier.v1beta1.AdminService",
+		frontierv1beta1connect.WebhookServiceName,
+		frontierv1beta1connect.AuditRecordServiceName,
+		frontierv1beta1connect.OAuthConsentServiceName,
+		frontierv1beta1connect.MFAServiceName,
+		frontierv1beta1connect.LoginAlertServiceName,
+		frontierv1beta1connect.AccessRequestServiceName,
+		frontierv1beta1connect.ExplainServiceName,
+		frontierv1beta1connect.AccessReviewServiceName,
+		frontierv1beta1connect.CertificationServiceName,
+		frontierv1beta1connect.AuthTokenServiceName,
+		frontierv1beta1connect.SigningKeyServiceName,
+		frontierv1beta1connect.OAuthClientServiceName,
 	)
 
 	mux.Handle(connecthealth.NewHandler(checker))
@@ -2,6 +2,7 @@ package session
 
 import (
 	"context"
+	"net/http"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -12,30 +13,31 @@ import (
 
 // ExtractSessionMetadata extracts session metadata from HTTP headers
 func ExtractSessionMetadata(ctx context.Context, req connect.AnyRequest, headers authenticate.SessionMetadataHeaders) session.SessionMetadata {
+	return SessionMetadataFromHeader(req.Header(), headers)
+}
+
+// SessionMetadataFromHeader extracts session metadata from the headers of
+// plain http requests
+func SessionMetadataFromHeader(header http.Header, headers authenticate.SessionMetadataHeaders) session.SessionMetadata {
 	metadata := session.SessionMetadata{}
 
-	// IP Address
-	if clientIP := req.Header().Get(headers.ClientIP); clientIP != "" {
-		if parts := strings.Split(clientIP, ","); len(parts) > 0 {
-			metadata.IpAddress = parts[0]
-		}
-	}
+	metadata.IpAddress = clientIPFromHeader(header, headers)
 
-	if country := req.Header().Get(headers.ClientCountry); country != "" {
+	if country := header.Get(headers.ClientCountry); country != "" {
 		metadata.Location.Country = country
 	}
-	if city := req.Header().Get(headers.ClientCity); city != "" {
+	if city := header.Get(headers.ClientCity); city != "" {
 		metadata.Location.City = city
 	}
-	if latitude := req.Header().Get(headers.ClientLatitude); latitude != "" {
+	if latitude := header.Get(headers.ClientLatitude); latitude != "" {
 		metadata.Location.Latitude = latitude
 	}
-	if longitude := req.Header().Get(headers.ClientLongitude); longitude != "" {
+	if longitude := header.Get(headers.ClientLongitude); longitude != "" {
 		metadata.Location.Longitude = longitude
 	}
 
 	// OS and Browser (from User-Agent) using uap-go library
-	userAgent := req.Header().Get(headers.ClientUserAgent)
+	userAgent := header.Get(headers.ClientUserAgent)
 	if userAgent != "" {
 		parser := uaparser.NewFromSaved()
 		client := parser.Parse(userAgent)
@@ -46,3 +48,16 @@ func ExtractSessionMetadata(ctx context.Context, req connect.AnyRequest, headers
 
 	return metadata
 }
+
+// ExtractClientIP extracts the ip address of the client from HTTP headers,
+// the first address of a forwarded list is the client
+func ExtractClientIP(req connect.AnyRequest, headers authenticate.SessionMetadataHeaders) string {
+	return clientIPFromHeader(req.Header(), headers)
+}
+
+func clientIPFromHeader(header http.Header, headers authenticate.SessionMetadataHeaders) string {
+	if clientIP := header.Get(headers.ClientIP); clientIP != "" {
+		return strings.TrimSpace(strings.Split(clientIP, ",")[0])
+	}
+	return ""
+}
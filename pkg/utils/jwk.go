@@ -3,6 +3,9 @@ package utils
 import (
 	"context"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/base64"
@@ -23,39 +26,69 @@ const (
 func CreateJWKs(numOfKeys int) (jwk.Set, error) {
 	keySet := jwk.NewSet()
 	for ; numOfKeys > 0; numOfKeys-- {
-		// generate keys
-		keyRaw, err := rsa.GenerateKey(rand.Reader, RSAKeySize)
+		key, err := CreateJWK(jwa.RS256)
 		if err != nil {
 			return nil, err
 		}
-		rsaKey, err := jwk.FromRaw(keyRaw)
-		if err != nil {
-			return nil, err
-		}
-		pubKey, err := rsaKey.PublicKey()
-		if err != nil {
-			return nil, err
-		}
-		thumb, err := pubKey.Thumbprint(crypto.SHA256)
-		if err != nil {
-			return nil, err
-		}
-		if err := rsaKey.Set(jwk.AlgorithmKey, "RS256"); err != nil {
-			return nil, err
-		}
-		if err := rsaKey.Set(jwk.KeyUsageKey, "sig"); err != nil {
-			return nil, err
-		}
-		if err := rsaKey.Set(jwk.KeyIDKey, base64.RawURLEncoding.EncodeToString(thumb)); err != nil {
-			return nil, err
-		}
-		if err := keySet.AddKey(rsaKey); err != nil {
+		if err := keySet.AddKey(key); err != nil {
 			return nil, err
 		}
 	}
 	return keySet, nil
 }
 
+// CreateJWK generates a private signing key for one of RS256, ES256 or
+// EdDSA, the key id is the thumbprint of its public key
+func CreateJWK(alg jwa.SignatureAlgorithm) (jwk.Key, error) {
+	var keyRaw any
+	var err error
+	switch alg {
+	case jwa.RS256:
+		keyRaw, err = rsa.GenerateKey(rand.Reader, RSAKeySize)
+	case jwa.ES256:
+		keyRaw, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case jwa.EdDSA:
+		_, keyRaw, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", alg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	key, err := jwk.FromRaw(keyRaw)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := key.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	thumb, err := pubKey.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+	if err := key.Set(jwk.AlgorithmKey, alg); err != nil {
+		return nil, err
+	}
+	if err := key.Set(jwk.KeyUsageKey, "sig"); err != nil {
+		return nil, err
+	}
+	if err := key.Set(jwk.KeyIDKey, base64.RawURLEncoding.EncodeToString(thumb)); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// SigningAlgorithm returns the algorithm a key signs with, keys without
+// one are taken as RS256 keys
+func SigningAlgorithm(key jwk.Key) jwa.SignatureAlgorithm {
+	var alg jwa.SignatureAlgorithm
+	if err := alg.Accept(key.Algorithm().String()); err == nil && alg != "" {
+		return alg
+	}
+	return jwa.RS256
+}
+
 func CreateJWKWithKID(id string) (jwk.Key, error) {
 	// generate key
 	keyRaw, err := rsa.GenerateKey(rand.Reader, RSAKeySize)
@@ -87,7 +120,7 @@ func GetPublicKeySet(ctx context.Context, privateKeySet jwk.Set) (jwk.Set, error
 
 		pubKey, err := key.PublicKey()
 		if err != nil {
-			return nil, fmt.Errorf("failed to generate public key from private rsa: %w", err)
+			return nil, fmt.Errorf("failed to generate public key from private key: %w", err)
 		}
 		if err := publicKeySet.AddKey(pubKey); err != nil {
 			return nil, err
@@ -98,9 +131,9 @@ func GetPublicKeySet(ctx context.Context, privateKeySet jwk.Set) (jwk.Set, error
 
 // BuildToken creates a signed jwt using provided private key
 // Ensure the key contains kid else the operation fails
-func BuildToken(rsaKey jwk.Key, issuer, sub string,
+func BuildToken(key jwk.Key, issuer, sub string,
 	validity time.Duration, customClaims map[string]string) ([]byte, error) {
-	if rsaKey.KeyID() == "" {
+	if key.KeyID() == "" {
 		return nil, fmt.Errorf("key id is empty")
 	}
 	body := jwt.NewBuilder().
@@ -110,7 +143,7 @@ func BuildToken(rsaKey jwk.Key, issuer, sub string,
 		Expiration(time.Now().UTC().Add(validity)).
 		JwtID(uuid.New().String()).
 		Subject(sub)
-	body.Claim(jwk.KeyIDKey, rsaKey.KeyID())
+	body.Claim(jwk.KeyIDKey, key.KeyID())
 	for claimKey, claimVal := range customClaims {
 		body = body.Claim(claimKey, claimVal)
 	}
@@ -120,5 +153,5 @@ func BuildToken(rsaKey jwk.Key, issuer, sub string,
 		return nil, err
 	}
 
-	return jwt.Sign(tok, jwt.WithKey(jwa.RS256, rsaKey))
+	return jwt.Sign(tok, jwt.WithKey(SigningAlgorithm(key), key))
 }
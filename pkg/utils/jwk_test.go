@@ -1,10 +1,12 @@
 package utils
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v2/jwa"
 	"github.com/lestrrat-go/jwx/v2/jwk"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 	"github.com/stretchr/testify/assert"
@@ -28,3 +30,30 @@ func TestBuildToken(t *testing.T) {
 		assert.Equal(t, kid, gotKid)
 	})
 }
+
+func TestCreateJWK(t *testing.T) {
+	for _, alg := range []jwa.SignatureAlgorithm{jwa.RS256, jwa.ES256, jwa.EdDSA} {
+		t.Run(alg.String(), func(t *testing.T) {
+			key, err := CreateJWK(alg)
+			assert.NoError(t, err)
+			assert.Equal(t, alg, SigningAlgorithm(key))
+			assert.NotEmpty(t, key.KeyID())
+
+			got, err := BuildToken(key, "test", "subject", time.Minute, nil)
+			assert.NoError(t, err)
+
+			keySet := jwk.NewSet()
+			assert.NoError(t, keySet.AddKey(key))
+			publicKeySet, err := GetPublicKeySet(context.Background(), keySet)
+			assert.NoError(t, err)
+			parsedToken, err := jwt.Parse(got, jwt.WithKeySet(publicKeySet))
+			assert.NoError(t, err)
+			assert.Equal(t, "subject", parsedToken.Subject())
+		})
+	}
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := CreateJWK(jwa.HS256)
+		assert.Error(t, err)
+	})
+}
@@ -2,6 +2,7 @@ package webhook
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/raystack/frontier/pkg/crypt"
@@ -18,6 +19,10 @@ var (
 	EventExpiryDuration = time.Minute * 10
 )
 
+// ParseAndValidateEvent verifies a payload signed with a single secret and
+// parses the event. suppliedHexMAC is the signature of the secret, i.e. the
+// part after "<id>=" in the X-Signature header. Use ParseAndValidateEventWithKeys
+// to accept any of the secrets of a webhook during rotation.
 func ParseAndValidateEvent(data []byte, hexKey string, suppliedHexMAC string) (*frontierv1beta1.WebhookEvent, error) {
 	validHmac, err := crypt.VerifyHMACFromHex(data, hexKey, suppliedHexMAC)
 	if err != nil {
@@ -26,6 +31,50 @@ func ParseAndValidateEvent(data []byte, hexKey string, suppliedHexMAC string) (*
 	if !validHmac {
 		return nil, ErrInvalidSignature
 	}
+	return parseEvent(data)
+}
+
+// ParseAndValidateEventWithKeys verifies the X-Signature header sent with a
+// webhook event and parses the event. hexKeys maps a secret id to its value,
+// the event is accepted if any signature in the header matches the key with
+// the same id. Passing every active secret of the webhook lets the secrets be
+// rotated without rejecting events signed by only the old or new secret.
+//
+// The header signs only the payload, so freshness is checked against the
+// event's created_at, which also rejects retries of old events. Prefer
+// Verifier, which checks the signed delivery timestamp instead.
+func ParseAndValidateEventWithKeys(data []byte, hexKeys map[string]string, signatureHeader string) (*frontierv1beta1.WebhookEvent, error) {
+	signatures := ParseSignatureHeader(signatureHeader)
+	if len(signatures) == 0 {
+		return nil, ErrInvalidSignature
+	}
+	var verifyErr error
+	validHmac := false
+	for id, suppliedHexMAC := range signatures {
+		hexKey, ok := hexKeys[id]
+		if !ok {
+			continue
+		}
+		valid, err := crypt.VerifyHMACFromHex(data, hexKey, suppliedHexMAC)
+		if err != nil {
+			verifyErr = err
+			continue
+		}
+		if valid {
+			validHmac = true
+			break
+		}
+	}
+	if !validHmac {
+		if verifyErr != nil {
+			return nil, fmt.Errorf("%s: %w", verifyErr.Error(), ErrVerificationFailed)
+		}
+		return nil, ErrInvalidSignature
+	}
+	return parseEvent(data)
+}
+
+func parseEvent(data []byte) (*frontierv1beta1.WebhookEvent, error) {
 	var event frontierv1beta1.WebhookEvent
 	if err := protojson.Unmarshal(data, &event); err != nil {
 		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidEvent)
@@ -37,3 +86,17 @@ func ParseAndValidateEvent(data []byte, hexKey string, suppliedHexMAC string) (*
 	}
 	return &event, nil
 }
+
+// ParseSignatureHeader splits a signature header of the form
+// "id=signature,id2=signature2" into signatures keyed by secret id
+func ParseSignatureHeader(header string) map[string]string {
+	signatures := make(map[string]string)
+	for _, part := range strings.Split(header, ",") {
+		id, signature, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || id == "" || signature == "" {
+			continue
+		}
+		signatures[id] = signature
+	}
+	return signatures
+}
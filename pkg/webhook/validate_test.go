@@ -0,0 +1,87 @@
+package webhook_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/pkg/crypt"
+	"github.com/raystack/frontier/pkg/webhook"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParseSignatureHeader(t *testing.T) {
+	assert.Equal(t, map[string]string{"1": "ab", "2": "cd"}, webhook.ParseSignatureHeader("1=ab, 2=cd"))
+	assert.Equal(t, map[string]string{"1": "ab"}, webhook.ParseSignatureHeader("1=ab,=cd,2=,junk"))
+	assert.Empty(t, webhook.ParseSignatureHeader(""))
+}
+
+func TestParseAndValidateEventWithKeys(t *testing.T) {
+	key, err := crypt.NewEncryptionKeyInHex()
+	require.NoError(t, err)
+	otherKey, err := crypt.NewEncryptionKeyInHex()
+	require.NoError(t, err)
+
+	sign := func(t *testing.T, createdAt time.Time) ([]byte, string) {
+		t.Helper()
+		payload, err := protojson.Marshal(&frontierv1beta1.WebhookEvent{
+			Id:        "evt-1",
+			Action:    "app.user.created",
+			CreatedAt: timestamppb.New(createdAt),
+		})
+		require.NoError(t, err)
+		signature, err := crypt.GenerateHMACFromHex(payload, key)
+		require.NoError(t, err)
+		return payload, "1=" + signature
+	}
+
+	t.Run("accepts a signature made with any of the keys", func(t *testing.T) {
+		payload, header := sign(t, time.Now())
+		event, err := webhook.ParseAndValidateEventWithKeys(payload, map[string]string{"1": key, "2": otherKey}, header)
+		assert.NoError(t, err)
+		assert.Equal(t, "evt-1", event.GetId())
+	})
+
+	t.Run("rejects a signature with an unknown key id", func(t *testing.T) {
+		payload, header := sign(t, time.Now())
+		_, err := webhook.ParseAndValidateEventWithKeys(payload, map[string]string{"2": key}, header)
+		assert.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	})
+
+	t.Run("rejects a signature made with another key", func(t *testing.T) {
+		payload, header := sign(t, time.Now())
+		_, err := webhook.ParseAndValidateEventWithKeys(payload, map[string]string{"1": otherKey}, header)
+		assert.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	})
+
+	t.Run("rejects an old event", func(t *testing.T) {
+		payload, header := sign(t, time.Now().Add(-time.Hour))
+		_, err := webhook.ParseAndValidateEventWithKeys(payload, map[string]string{"1": key}, header)
+		assert.ErrorIs(t, err, webhook.ErrTimestampExpired)
+	})
+}
+
+func TestParseAndValidateEvent(t *testing.T) {
+	key, err := crypt.NewEncryptionKeyInHex()
+	require.NoError(t, err)
+	otherKey, err := crypt.NewEncryptionKeyInHex()
+	require.NoError(t, err)
+	payload, err := protojson.Marshal(&frontierv1beta1.WebhookEvent{
+		Id:        "evt-1",
+		Action:    "app.user.created",
+		CreatedAt: timestamppb.Now(),
+	})
+	require.NoError(t, err)
+	signature, err := crypt.GenerateHMACFromHex(payload, key)
+	require.NoError(t, err)
+
+	event, err := webhook.ParseAndValidateEvent(payload, key, signature)
+	assert.NoError(t, err)
+	assert.Equal(t, "evt-1", event.GetId())
+
+	_, err = webhook.ParseAndValidateEvent(payload, otherKey, signature)
+	assert.ErrorIs(t, err, webhook.ErrInvalidSignature)
+}
@@ -0,0 +1,253 @@
+package webhook
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/raystack/frontier/pkg/crypt"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// TimestampedSignatureHeader carries the delivery timestamp and the
+	// signatures of it together with the payload, e.g. "t=1700000000,v1=ab12..,v1=cd34.."
+	TimestampedSignatureHeader = "X-Frontier-Signature"
+	// DeliveryIDHeader identifies the delivery of an event to an endpoint. It
+	// stays the same across retries of the delivery.
+	DeliveryIDHeader = "X-Frontier-Delivery-Id"
+
+	signatureTimestampKey = "t"
+	signatureV1Key        = "v1"
+
+	DefaultTolerance = 5 * time.Minute
+)
+
+var ErrReplayed = errors.New("event already received")
+
+// SignedCloudEventsHeaders are the CloudEvents attributes covered by the
+// signatures in binary content mode, where they are sent as headers
+var SignedCloudEventsHeaders = []string{"ce-id", "ce-source", "ce-type", "ce-time"}
+
+// CloudEventsBinaryContent returns the content signed for a CloudEvent sent in
+// binary content mode: every signed attribute as "<header>:<value>" on its own
+// line, followed by the body. Signing the body alone would let the event be
+// sent again with other attributes.
+func CloudEventsBinaryContent(header http.Header, body []byte) []byte {
+	var content []byte
+	for _, key := range SignedCloudEventsHeaders {
+		content = append(content, key...)
+		content = append(content, ':')
+		content = append(content, header.Get(key)...)
+		content = append(content, '\n')
+	}
+	return append(content, body...)
+}
+
+// SignTimestamped signs the payload sent at t with each key and returns the
+// value of the TimestampedSignatureHeader. The signed content is the unix
+// timestamp and the payload joined by a dot.
+func SignTimestamped(payload []byte, t time.Time, hexKeys []string) (string, error) {
+	timestamp := strconv.FormatInt(t.Unix(), 10)
+	content := signedContent(timestamp, payload)
+	parts := []string{signatureTimestampKey + "=" + timestamp}
+	for _, hexKey := range hexKeys {
+		signature, err := crypt.GenerateHMACFromHex(content, hexKey)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, signatureV1Key+"="+signature)
+	}
+	return strings.Join(parts, ","), nil
+}
+
+func signedContent(timestamp string, payload []byte) []byte {
+	content := make([]byte, 0, len(timestamp)+1+len(payload))
+	content = append(content, timestamp...)
+	content = append(content, '.')
+	return append(content, payload...)
+}
+
+// SeenCache remembers received events so a captured request can't be sent
+// again while its timestamp is still within the tolerance
+type SeenCache interface {
+	// Seen records the key and reports whether it was already recorded.
+	// Keys only need to be remembered for ttl.
+	Seen(key string, ttl time.Duration) (bool, error)
+}
+
+type VerifierOption func(*Verifier)
+
+// WithTolerance sets how far the signed timestamp may be from the current time
+func WithTolerance(d time.Duration) VerifierOption {
+	return func(v *Verifier) {
+		v.tolerance = d
+	}
+}
+
+// WithSeenCache rejects a request whose signature was already received.
+// Retries of a delivery are signed with a new timestamp and pass.
+func WithSeenCache(c SeenCache) VerifierOption {
+	return func(v *Verifier) {
+		v.seen = c
+	}
+}
+
+// Verifier validates the TimestampedSignatureHeader of received events
+type Verifier struct {
+	hexKeys   []string
+	tolerance time.Duration
+	seen      SeenCache
+	now       func() time.Time
+}
+
+// NewVerifier returns a verifier accepting events signed with any of the
+// keys, so the keys can be rotated without downtime
+func NewVerifier(hexKeys []string, opts ...VerifierOption) *Verifier {
+	v := &Verifier{
+		hexKeys:   hexKeys,
+		tolerance: DefaultTolerance,
+		now:       time.Now,
+	}
+	for _, o := range opts {
+		o(v)
+	}
+	return v
+}
+
+// Verify checks the signature and timestamp of the header against the
+// payload and parses the event
+func (v *Verifier) Verify(payload []byte, header string) (*frontierv1beta1.WebhookEvent, error) {
+	if err := v.VerifySignature(payload, header); err != nil {
+		return nil, err
+	}
+
+	var event frontierv1beta1.WebhookEvent
+	if err := protojson.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidEvent)
+	}
+	return &event, nil
+}
+
+// VerifySignature checks the signature and timestamp of the header against
+// the payload without parsing it, e.g. for webhooks receiving CloudEvents in
+// structured content mode. With a SeenCache, a request is rejected if the
+// same signature was already received.
+func (v *Verifier) VerifySignature(payload []byte, header string) error {
+	timestamp, signature, err := v.verifySignature(payload, header)
+	if err != nil {
+		return err
+	}
+	if v.seen == nil {
+		return nil
+	}
+	// the signature covers the payload and the timestamp, so retries, which
+	// are signed again, are not mistaken for replays
+	seen, err := v.seen.Seen(timestamp+"."+signature, 2*v.tolerance)
+	if err != nil {
+		return fmt.Errorf("%s: %w", err.Error(), ErrVerificationFailed)
+	}
+	if seen {
+		return ErrReplayed
+	}
+	return nil
+}
+
+// VerifyCloudEventsBinary checks the signature of a CloudEvent received in
+// binary content mode, which covers the SignedCloudEventsHeaders along with
+// the body
+func (v *Verifier) VerifyCloudEventsBinary(header http.Header, body []byte) error {
+	return v.VerifySignature(CloudEventsBinaryContent(header, body), header.Get(TimestampedSignatureHeader))
+}
+
+// verifySignature returns the timestamp and the signature that matched
+func (v *Verifier) verifySignature(payload []byte, header string) (string, string, error) {
+	timestamp, signatures := parseTimestampedHeader(header)
+	if timestamp == "" || len(signatures) == 0 {
+		return "", "", ErrInvalidSignature
+	}
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return "", "", fmt.Errorf("%s: %w", err.Error(), ErrInvalidSignature)
+	}
+	if age := v.now().Sub(time.Unix(unix, 0)); age > v.tolerance || age < -v.tolerance {
+		return "", "", ErrTimestampExpired
+	}
+
+	// a signature that isn't valid hex can't match any key, skip it so a
+	// malformed entry doesn't hide a valid one
+	macs := make([][]byte, 0, len(signatures))
+	for _, signature := range signatures {
+		if mac, err := hex.DecodeString(signature); err == nil {
+			macs = append(macs, mac)
+		}
+	}
+
+	content := signedContent(timestamp, payload)
+	for _, hexKey := range v.hexKeys {
+		key, err := hex.DecodeString(hexKey)
+		if err != nil {
+			return "", "", fmt.Errorf("%s: %w", err.Error(), ErrVerificationFailed)
+		}
+		for _, mac := range macs {
+			if crypt.VerifyHMAC(content, key, mac) {
+				return timestamp, hex.EncodeToString(mac), nil
+			}
+		}
+	}
+	return "", "", ErrInvalidSignature
+}
+
+func parseTimestampedHeader(header string) (string, []string) {
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || value == "" {
+			continue
+		}
+		switch key {
+		case signatureTimestampKey:
+			timestamp = value
+		case signatureV1Key:
+			signatures = append(signatures, value)
+		}
+	}
+	return timestamp, signatures
+}
+
+// MemorySeenCache is a SeenCache for a single process
+type MemorySeenCache struct {
+	mu      sync.Mutex
+	entries map[string]time.Time
+	now     func() time.Time
+}
+
+func NewMemorySeenCache() *MemorySeenCache {
+	return &MemorySeenCache{
+		entries: make(map[string]time.Time),
+		now:     time.Now,
+	}
+}
+
+func (c *MemorySeenCache) Seen(key string, ttl time.Duration) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for k, expiry := range c.entries {
+		if now.After(expiry) {
+			delete(c.entries, k)
+		}
+	}
+	if _, ok := c.entries[key]; ok {
+		return true, nil
+	}
+	c.entries[key] = now.Add(ttl)
+	return false, nil
+}
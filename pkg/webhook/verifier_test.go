@@ -0,0 +1,105 @@
+package webhook_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raystack/frontier/pkg/crypt"
+	"github.com/raystack/frontier/pkg/webhook"
+	frontierv1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestVerifier(t *testing.T) {
+	key, err := crypt.NewEncryptionKeyInHex()
+	require.NoError(t, err)
+	otherKey, err := crypt.NewEncryptionKeyInHex()
+	require.NoError(t, err)
+
+	// an old event, e.g. one delivered after several retries
+	payload, err := protojson.Marshal(&frontierv1beta1.WebhookEvent{
+		Id:        "evt-1",
+		Action:    "app.user.created",
+		CreatedAt: timestamppb.New(time.Now().Add(-24 * time.Hour)),
+	})
+	require.NoError(t, err)
+
+	t.Run("accepts a fresh signature from any key", func(t *testing.T) {
+		header, err := webhook.SignTimestamped(payload, time.Now(), []string{otherKey, key})
+		require.NoError(t, err)
+		event, err := webhook.NewVerifier([]string{key}).Verify(payload, header)
+		assert.NoError(t, err)
+		assert.Equal(t, "evt-1", event.GetId())
+	})
+
+	t.Run("rejects a timestamp outside the tolerance", func(t *testing.T) {
+		header, err := webhook.SignTimestamped(payload, time.Now().Add(-10*time.Minute), []string{key})
+		require.NoError(t, err)
+		_, err = webhook.NewVerifier([]string{key}).Verify(payload, header)
+		assert.ErrorIs(t, err, webhook.ErrTimestampExpired)
+
+		_, err = webhook.NewVerifier([]string{key}, webhook.WithTolerance(time.Hour)).Verify(payload, header)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects a timestamp swapped for a fresh one", func(t *testing.T) {
+		header, err := webhook.SignTimestamped(payload, time.Now().Add(-10*time.Minute), []string{key})
+		require.NoError(t, err)
+		_, signature, _ := strings.Cut(header, ",")
+		forged := "t=" + strconv.FormatInt(time.Now().Unix(), 10) + "," + signature
+		_, err = webhook.NewVerifier([]string{key}).Verify(payload, forged)
+		assert.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	})
+
+	t.Run("rejects an unknown key and a missing header", func(t *testing.T) {
+		header, err := webhook.SignTimestamped(payload, time.Now(), []string{otherKey})
+		require.NoError(t, err)
+		_, err = webhook.NewVerifier([]string{key}).Verify(payload, header)
+		assert.ErrorIs(t, err, webhook.ErrInvalidSignature)
+
+		_, err = webhook.NewVerifier([]string{key}).Verify(payload, "")
+		assert.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	})
+
+	t.Run("skips a signature that is not hex", func(t *testing.T) {
+		header, err := webhook.SignTimestamped(payload, time.Now(), []string{key})
+		require.NoError(t, err)
+		timestamp, signature, _ := strings.Cut(header, ",")
+		withMalformed := timestamp + ",v1=not-hex," + signature
+		_, err = webhook.NewVerifier([]string{key}).Verify(payload, withMalformed)
+		assert.NoError(t, err)
+
+		_, err = webhook.NewVerifier([]string{key}).Verify(payload, timestamp+",v1=not-hex")
+		assert.ErrorIs(t, err, webhook.ErrInvalidSignature)
+	})
+
+	t.Run("rejects a replay but accepts a retry", func(t *testing.T) {
+		verifier := webhook.NewVerifier([]string{key}, webhook.WithSeenCache(webhook.NewMemorySeenCache()))
+		header, err := webhook.SignTimestamped(payload, time.Now(), []string{key})
+		require.NoError(t, err)
+
+		_, err = verifier.Verify(payload, header)
+		assert.NoError(t, err)
+		_, err = verifier.Verify(payload, header)
+		assert.ErrorIs(t, err, webhook.ErrReplayed)
+
+		retry, err := webhook.SignTimestamped(payload, time.Now().Add(time.Second), []string{key})
+		require.NoError(t, err)
+		_, err = verifier.Verify(payload, retry)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects a replay of an unparsed payload", func(t *testing.T) {
+		verifier := webhook.NewVerifier([]string{key}, webhook.WithSeenCache(webhook.NewMemorySeenCache()))
+		header, err := webhook.SignTimestamped([]byte(`{"specversion":"1.0"}`), time.Now(), []string{key})
+		require.NoError(t, err)
+
+		assert.NoError(t, verifier.VerifySignature([]byte(`{"specversion":"1.0"}`), header))
+		assert.ErrorIs(t, verifier.VerifySignature([]byte(`{"specversion":"1.0"}`), header), webhook.ErrReplayed)
+	})
+}
@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/access_request.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AccessRequestServiceName is the fully-qualified name of the AccessRequestService service.
+	AccessRequestServiceName = "raystack.frontier.v1beta1.AccessRequestService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AccessRequestServiceCreateAccessRequestProcedure is the fully-qualified name of the
+	// AccessRequestService's CreateAccessRequest RPC.
+	AccessRequestServiceCreateAccessRequestProcedure = "/raystack.frontier.v1beta1.AccessRequestService/CreateAccessRequest"
+	// AccessRequestServiceListAccessRequestsProcedure is the fully-qualified name of the
+	// AccessRequestService's ListAccessRequests RPC.
+	AccessRequestServiceListAccessRequestsProcedure = "/raystack.frontier.v1beta1.AccessRequestService/ListAccessRequests"
+	// AccessRequestServiceApproveAccessRequestProcedure is the fully-qualified name of the
+	// AccessRequestService's ApproveAccessRequest RPC.
+	AccessRequestServiceApproveAccessRequestProcedure = "/raystack.frontier.v1beta1.AccessRequestService/ApproveAccessRequest"
+	// AccessRequestServiceDenyAccessRequestProcedure is the fully-qualified name of the
+	// AccessRequestService's DenyAccessRequest RPC.
+	AccessRequestServiceDenyAccessRequestProcedure = "/raystack.frontier.v1beta1.AccessRequestService/DenyAccessRequest"
+	// AccessRequestServiceCancelAccessRequestProcedure is the fully-qualified name of the
+	// AccessRequestService's CancelAccessRequest RPC.
+	AccessRequestServiceCancelAccessRequestProcedure = "/raystack.frontier.v1beta1.AccessRequestService/CancelAccessRequest"
+)
+
+// AccessRequestServiceClient is a client for the raystack.frontier.v1beta1.AccessRequestService
+// service.
+type AccessRequestServiceClient interface {
+	// CreateAccessRequest requests a role on a resource for the current user
+	CreateAccessRequest(context.Context, *connect.Request[v1beta1.CreateAccessRequestRequest]) (*connect.Response[v1beta1.CreateAccessRequestResponse], error)
+	// ListAccessRequests lists the requests of the current user. With org_id
+	// or resource it lists the requests of the organization or resource to its
+	// reviewers.
+	ListAccessRequests(context.Context, *connect.Request[v1beta1.ListAccessRequestsRequest]) (*connect.Response[v1beta1.ListAccessRequestsResponse], error)
+	// ApproveAccessRequest approves a pending request, the role is granted to
+	// the requester for the requested duration
+	ApproveAccessRequest(context.Context, *connect.Request[v1beta1.ApproveAccessRequestRequest]) (*connect.Response[v1beta1.ApproveAccessRequestResponse], error)
+	// DenyAccessRequest denies a pending request
+	DenyAccessRequest(context.Context, *connect.Request[v1beta1.DenyAccessRequestRequest]) (*connect.Response[v1beta1.DenyAccessRequestResponse], error)
+	// CancelAccessRequest withdraws a pending request of the current user
+	CancelAccessRequest(context.Context, *connect.Request[v1beta1.CancelAccessRequestRequest]) (*connect.Response[v1beta1.CancelAccessRequestResponse], error)
+}
+
+// NewAccessRequestServiceClient constructs a client for the
+// raystack.frontier.v1beta1.AccessRequestService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAccessRequestServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AccessRequestServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	accessRequestServiceMethods := v1beta1.File_raystack_frontier_v1beta1_access_request_proto.Services().ByName("AccessRequestService").Methods()
+	return &accessRequestServiceClient{
+		createAccessRequest: connect.NewClient[v1beta1.CreateAccessRequestRequest, v1beta1.CreateAccessRequestResponse](
+			httpClient,
+			baseURL+AccessRequestServiceCreateAccessRequestProcedure,
+			connect.WithSchema(accessRequestServiceMethods.ByName("CreateAccessRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		listAccessRequests: connect.NewClient[v1beta1.ListAccessRequestsRequest, v1beta1.ListAccessRequestsResponse](
+			httpClient,
+			baseURL+AccessRequestServiceListAccessRequestsProcedure,
+			connect.WithSchema(accessRequestServiceMethods.ByName("ListAccessRequests")),
+			connect.WithClientOptions(opts...),
+		),
+		approveAccessRequest: connect.NewClient[v1beta1.ApproveAccessRequestRequest, v1beta1.ApproveAccessRequestResponse](
+			httpClient,
+			baseURL+AccessRequestServiceApproveAccessRequestProcedure,
+			connect.WithSchema(accessRequestServiceMethods.ByName("ApproveAccessRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		denyAccessRequest: connect.NewClient[v1beta1.DenyAccessRequestRequest, v1beta1.DenyAccessRequestResponse](
+			httpClient,
+			baseURL+AccessRequestServiceDenyAccessRequestProcedure,
+			connect.WithSchema(accessRequestServiceMethods.ByName("DenyAccessRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelAccessRequest: connect.NewClient[v1beta1.CancelAccessRequestRequest, v1beta1.CancelAccessRequestResponse](
+			httpClient,
+			baseURL+AccessRequestServiceCancelAccessRequestProcedure,
+			connect.WithSchema(accessRequestServiceMethods.ByName("CancelAccessRequest")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// accessRequestServiceClient implements AccessRequestServiceClient.
+type accessRequestServiceClient struct {
+	createAccessRequest  *connect.Client[v1beta1.CreateAccessRequestRequest, v1beta1.CreateAccessRequestResponse]
+	listAccessRequests   *connect.Client[v1beta1.ListAccessRequestsRequest, v1beta1.ListAccessRequestsResponse]
+	approveAccessRequest *connect.Client[v1beta1.ApproveAccessRequestRequest, v1beta1.ApproveAccessRequestResponse]
+	denyAccessRequest    *connect.Client[v1beta1.DenyAccessRequestRequest, v1beta1.DenyAccessRequestResponse]
+	cancelAccessRequest  *connect.Client[v1beta1.CancelAccessRequestRequest, v1beta1.CancelAccessRequestResponse]
+}
+
+// CreateAccessRequest calls raystack.frontier.v1beta1.AccessRequestService.CreateAccessRequest.
+func (c *accessRequestServiceClient) CreateAccessRequest(ctx context.Context, req *connect.Request[v1beta1.CreateAccessRequestRequest]) (*connect.Response[v1beta1.CreateAccessRequestResponse], error) {
+	return c.createAccessRequest.CallUnary(ctx, req)
+}
+
+// ListAccessRequests calls raystack.frontier.v1beta1.AccessRequestService.ListAccessRequests.
+func (c *accessRequestServiceClient) ListAccessRequests(ctx context.Context, req *connect.Request[v1beta1.ListAccessRequestsRequest]) (*connect.Response[v1beta1.ListAccessRequestsResponse], error) {
+	return c.listAccessRequests.CallUnary(ctx, req)
+}
+
+// ApproveAccessRequest calls raystack.frontier.v1beta1.AccessRequestService.ApproveAccessRequest.
+func (c *accessRequestServiceClient) ApproveAccessRequest(ctx context.Context, req *connect.Request[v1beta1.ApproveAccessRequestRequest]) (*connect.Response[v1beta1.ApproveAccessRequestResponse], error) {
+	return c.approveAccessRequest.CallUnary(ctx, req)
+}
+
+// DenyAccessRequest calls raystack.frontier.v1beta1.AccessRequestService.DenyAccessRequest.
+func (c *accessRequestServiceClient) DenyAccessRequest(ctx context.Context, req *connect.Request[v1beta1.DenyAccessRequestRequest]) (*connect.Response[v1beta1.DenyAccessRequestResponse], error) {
+	return c.denyAccessRequest.CallUnary(ctx, req)
+}
+
+// CancelAccessRequest calls raystack.frontier.v1beta1.AccessRequestService.CancelAccessRequest.
+func (c *accessRequestServiceClient) CancelAccessRequest(ctx context.Context, req *connect.Request[v1beta1.CancelAccessRequestRequest]) (*connect.Response[v1beta1.CancelAccessRequestResponse], error) {
+	return c.cancelAccessRequest.CallUnary(ctx, req)
+}
+
+// AccessRequestServiceHandler is an implementation of the
+// raystack.frontier.v1beta1.AccessRequestService service.
+type AccessRequestServiceHandler interface {
+	// CreateAccessRequest requests a role on a resource for the current user
+	CreateAccessRequest(context.Context, *connect.Request[v1beta1.CreateAccessRequestRequest]) (*connect.Response[v1beta1.CreateAccessRequestResponse], error)
+	// ListAccessRequests lists the requests of the current user. With org_id
+	// or resource it lists the requests of the organization or resource to its
+	// reviewers.
+	ListAccessRequests(context.Context, *connect.Request[v1beta1.ListAccessRequestsRequest]) (*connect.Response[v1beta1.ListAccessRequestsResponse], error)
+	// ApproveAccessRequest approves a pending request, the role is granted to
+	// the requester for the requested duration
+	ApproveAccessRequest(context.Context, *connect.Request[v1beta1.ApproveAccessRequestRequest]) (*connect.Response[v1beta1.ApproveAccessRequestResponse], error)
+	// DenyAccessRequest denies a pending request
+	DenyAccessRequest(context.Context, *connect.Request[v1beta1.DenyAccessRequestRequest]) (*connect.Response[v1beta1.DenyAccessRequestResponse], error)
+	// CancelAccessRequest withdraws a pending request of the current user
+	CancelAccessRequest(context.Context, *connect.Request[v1beta1.CancelAccessRequestRequest]) (*connect.Response[v1beta1.CancelAccessRequestResponse], error)
+}
+
+// NewAccessRequestServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAccessRequestServiceHandler(svc AccessRequestServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	accessRequestServiceMethods := v1beta1.File_raystack_frontier_v1beta1_access_request_proto.Services().ByName("AccessRequestService").Methods()
+	accessRequestServiceCreateAccessRequestHandler := connect.NewUnaryHandler(
+		AccessRequestServiceCreateAccessRequestProcedure,
+		svc.CreateAccessRequest,
+		connect.WithSchema(accessRequestServiceMethods.ByName("CreateAccessRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessRequestServiceListAccessRequestsHandler := connect.NewUnaryHandler(
+		AccessRequestServiceListAccessRequestsProcedure,
+		svc.ListAccessRequests,
+		connect.WithSchema(accessRequestServiceMethods.ByName("ListAccessRequests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessRequestServiceApproveAccessRequestHandler := connect.NewUnaryHandler(
+		AccessRequestServiceApproveAccessRequestProcedure,
+		svc.ApproveAccessRequest,
+		connect.WithSchema(accessRequestServiceMethods.ByName("ApproveAccessRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessRequestServiceDenyAccessRequestHandler := connect.NewUnaryHandler(
+		AccessRequestServiceDenyAccessRequestProcedure,
+		svc.DenyAccessRequest,
+		connect.WithSchema(accessRequestServiceMethods.ByName("DenyAccessRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessRequestServiceCancelAccessRequestHandler := connect.NewUnaryHandler(
+		AccessRequestServiceCancelAccessRequestProcedure,
+		svc.CancelAccessRequest,
+		connect.WithSchema(accessRequestServiceMethods.ByName("CancelAccessRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.AccessRequestService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AccessRequestServiceCreateAccessRequestProcedure:
+			accessRequestServiceCreateAccessRequestHandler.ServeHTTP(w, r)
+		case AccessRequestServiceListAccessRequestsProcedure:
+			accessRequestServiceListAccessRequestsHandler.ServeHTTP(w, r)
+		case AccessRequestServiceApproveAccessRequestProcedure:
+			accessRequestServiceApproveAccessRequestHandler.ServeHTTP(w, r)
+		case AccessRequestServiceDenyAccessRequestProcedure:
+			accessRequestServiceDenyAccessRequestHandler.ServeHTTP(w, r)
+		case AccessRequestServiceCancelAccessRequestProcedure:
+			accessRequestServiceCancelAccessRequestHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAccessRequestServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAccessRequestServiceHandler struct{}
+
+func (UnimplementedAccessRequestServiceHandler) CreateAccessRequest(context.Context, *connect.Request[v1beta1.CreateAccessRequestRequest]) (*connect.Response[v1beta1.CreateAccessRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AccessRequestService.CreateAccessRequest is not implemented"))
+}
+
+func (UnimplementedAccessRequestServiceHandler) ListAccessRequests(context.Context, *connect.Request[v1beta1.ListAccessRequestsRequest]) (*connect.Response[v1beta1.ListAccessRequestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AccessRequestService.ListAccessRequests is not implemented"))
+}
+
+func (UnimplementedAccessRequestServiceHandler) ApproveAccessRequest(context.Context, *connect.Request[v1beta1.ApproveAccessRequestRequest]) (*connect.Response[v1beta1.ApproveAccessRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AccessRequestService.ApproveAccessRequest is not implemented"))
+}
+
+func (UnimplementedAccessRequestServiceHandler) DenyAccessRequest(context.Context, *connect.Request[v1beta1.DenyAccessRequestRequest]) (*connect.Response[v1beta1.DenyAccessRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AccessRequestService.DenyAccessRequest is not implemented"))
+}
+
+func (UnimplementedAccessRequestServiceHandler) CancelAccessRequest(context.Context, *connect.Request[v1beta1.CancelAccessRequestRequest]) (*connect.Response[v1beta1.CancelAccessRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AccessRequestService.CancelAccessRequest is not implemented"))
+}
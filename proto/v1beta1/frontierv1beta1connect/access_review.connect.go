@@ -0,0 +1,216 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/access_review.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AccessReviewServiceName is the fully-qualified name of the AccessReviewService service.
+	AccessReviewServiceName = "raystack.frontier.v1beta1.AccessReviewService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AccessReviewServiceReviewPrincipalAccessProcedure is the fully-qualified name of the
+	// AccessReviewService's ReviewPrincipalAccess RPC.
+	AccessReviewServiceReviewPrincipalAccessProcedure = "/raystack.frontier.v1beta1.AccessReviewService/ReviewPrincipalAccess"
+	// AccessReviewServiceReviewResourceAccessProcedure is the fully-qualified name of the
+	// AccessReviewService's ReviewResourceAccess RPC.
+	AccessReviewServiceReviewResourceAccessProcedure = "/raystack.frontier.v1beta1.AccessReviewService/ReviewResourceAccess"
+	// AccessReviewServiceExportPrincipalAccessProcedure is the fully-qualified name of the
+	// AccessReviewService's ExportPrincipalAccess RPC.
+	AccessReviewServiceExportPrincipalAccessProcedure = "/raystack.frontier.v1beta1.AccessReviewService/ExportPrincipalAccess"
+	// AccessReviewServiceExportResourceAccessProcedure is the fully-qualified name of the
+	// AccessReviewService's ExportResourceAccess RPC.
+	AccessReviewServiceExportResourceAccessProcedure = "/raystack.frontier.v1beta1.AccessReviewService/ExportResourceAccess"
+)
+
+// AccessReviewServiceClient is a client for the raystack.frontier.v1beta1.AccessReviewService
+// service.
+type AccessReviewServiceClient interface {
+	// ReviewPrincipalAccess reports the roles a user or service user holds
+	// through its own policies and the policies of its groups, and its
+	// platform superuser access. The grants are paginated.
+	ReviewPrincipalAccess(context.Context, *connect.Request[v1beta1.ReviewPrincipalAccessRequest]) (*connect.Response[v1beta1.ReviewPrincipalAccessResponse], error)
+	// ReviewResourceAccess reports the users and service users with a
+	// permission on a resource and the grants they hold it through. The
+	// principals are paginated.
+	ReviewResourceAccess(context.Context, *connect.Request[v1beta1.ReviewResourceAccessRequest]) (*connect.Response[v1beta1.ReviewResourceAccessResponse], error)
+	// ExportPrincipalAccess exports the whole report of a principal as CSV
+	ExportPrincipalAccess(context.Context, *connect.Request[v1beta1.ExportPrincipalAccessRequest]) (*connect.ServerStreamForClient[httpbody.HttpBody], error)
+	// ExportResourceAccess exports the whole report of a resource as CSV
+	ExportResourceAccess(context.Context, *connect.Request[v1beta1.ExportResourceAccessRequest]) (*connect.ServerStreamForClient[httpbody.HttpBody], error)
+}
+
+// NewAccessReviewServiceClient constructs a client for the
+// raystack.frontier.v1beta1.AccessReviewService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAccessReviewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AccessReviewServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	accessReviewServiceMethods := v1beta1.File_raystack_frontier_v1beta1_access_review_proto.Services().ByName("AccessReviewService").Methods()
+	return &accessReviewServiceClient{
+		reviewPrincipalAccess: connect.NewClient[v1beta1.ReviewPrincipalAccessRequest, v1beta1.ReviewPrincipalAccessResponse](
+			httpClient,
+			baseURL+AccessReviewServiceReviewPrincipalAccessProcedure,
+			connect.WithSchema(accessReviewServiceMethods.ByName("ReviewPrincipalAccess")),
+			connect.WithClientOptions(opts...),
+		),
+		reviewResourceAccess: connect.NewClient[v1beta1.ReviewResourceAccessRequest, v1beta1.ReviewResourceAccessResponse](
+			httpClient,
+			baseURL+AccessReviewServiceReviewResourceAccessProcedure,
+			connect.WithSchema(accessReviewServiceMethods.ByName("ReviewResourceAccess")),
+			connect.WithClientOptions(opts...),
+		),
+		exportPrincipalAccess: connect.NewClient[v1beta1.ExportPrincipalAccessRequest, httpbody.HttpBody](
+			httpClient,
+			baseURL+AccessReviewServiceExportPrincipalAccessProcedure,
+			connect.WithSchema(accessReviewServiceMethods.ByName("ExportPrincipalAccess")),
+			connect.WithClientOptions(opts...),
+		),
+		exportResourceAccess: connect.NewClient[v1beta1.ExportResourceAccessRequest, httpbody.HttpBody](
+			httpClient,
+			baseURL+AccessReviewServiceExportResourceAccessProcedure,
+			connect.WithSchema(accessReviewServiceMethods.ByName("ExportResourceAccess")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// accessReviewServiceClient implements AccessReviewServiceClient.
+type accessReviewServiceClient struct {
+	reviewPrincipalAccess *connect.Client[v1beta1.ReviewPrincipalAccessRequest, v1beta1.ReviewPrincipalAccessResponse]
+	reviewResourceAccess  *connect.Client[v1beta1.ReviewResourceAccessRequest, v1beta1.ReviewResourceAccessResponse]
+	exportPrincipalAccess *connect.Client[v1beta1.ExportPrincipalAccessRequest, httpbody.HttpBody]
+	exportResourceAccess  *connect.Client[v1beta1.ExportResourceAccessRequest, httpbody.HttpBody]
+}
+
+// ReviewPrincipalAccess calls raystack.frontier.v1beta1.AccessReviewService.ReviewPrincipalAccess.
+func (c *accessReviewServiceClient) ReviewPrincipalAccess(ctx context.Context, req *connect.Request[v1beta1.ReviewPrincipalAccessRequest]) (*connect.Response[v1beta1.ReviewPrincipalAccessResponse], error) {
+	return c.reviewPrincipalAccess.CallUnary(ctx, req)
+}
+
+// ReviewResourceAccess calls raystack.frontier.v1beta1.AccessReviewService.ReviewResourceAccess.
+func (c *accessReviewServiceClient) ReviewResourceAccess(ctx context.Context, req *connect.Request[v1beta1.ReviewResourceAccessRequest]) (*connect.Response[v1beta1.ReviewResourceAccessResponse], error) {
+	return c.reviewResourceAccess.CallUnary(ctx, req)
+}
+
+// ExportPrincipalAccess calls raystack.frontier.v1beta1.AccessReviewService.ExportPrincipalAccess.
+func (c *accessReviewServiceClient) ExportPrincipalAccess(ctx context.Context, req *connect.Request[v1beta1.ExportPrincipalAccessRequest]) (*connect.ServerStreamForClient[httpbody.HttpBody], error) {
+	return c.exportPrincipalAccess.CallServerStream(ctx, req)
+}
+
+// ExportResourceAccess calls raystack.frontier.v1beta1.AccessReviewService.ExportResourceAccess.
+func (c *accessReviewServiceClient) ExportResourceAccess(ctx context.Context, req *connect.Request[v1beta1.ExportResourceAccessRequest]) (*connect.ServerStreamForClient[httpbody.HttpBody], error) {
+	return c.exportResourceAccess.CallServerStream(ctx, req)
+}
+
+// AccessReviewServiceHandler is an implementation of the
+// raystack.frontier.v1beta1.AccessReviewService service.
+type AccessReviewServiceHandler interface {
+	// ReviewPrincipalAccess reports the roles a user or service user holds
+	// through its own policies and the policies of its groups, and its
+	// platform superuser access. The grants are paginated.
+	ReviewPrincipalAccess(context.Context, *connect.Request[v1beta1.ReviewPrincipalAccessRequest]) (*connect.Response[v1beta1.ReviewPrincipalAccessResponse], error)
+	// ReviewResourceAccess reports the users and service users with a
+	// permission on a resource and the grants they hold it through. The
+	// principals are paginated.
+	ReviewResourceAccess(context.Context, *connect.Request[v1beta1.ReviewResourceAccessRequest]) (*connect.Response[v1beta1.ReviewResourceAccessResponse], error)
+	// ExportPrincipalAccess exports the whole report of a principal as CSV
+	ExportPrincipalAccess(context.Context, *connect.Request[v1beta1.ExportPrincipalAccessRequest], *connect.ServerStream[httpbody.HttpBody]) error
+	// ExportResourceAccess exports the whole report of a resource as CSV
+	ExportResourceAccess(context.Context, *connect.Request[v1beta1.ExportResourceAccessRequest], *connect.ServerStream[httpbody.HttpBody]) error
+}
+
+// NewAccessReviewServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAccessReviewServiceHandler(svc AccessReviewServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	accessReviewServiceMethods := v1beta1.File_raystack_frontier_v1beta1_access_review_proto.Services().ByName("AccessReviewService").Methods()
+	accessReviewServiceReviewPrincipalAccessHandler := connect.NewUnaryHandler(
+		AccessReviewServiceReviewPrincipalAccessProcedure,
+		svc.ReviewPrincipalAccess,
+		connect.WithSchema(accessReviewServiceMethods.ByName("ReviewPrincipalAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessReviewServiceReviewResourceAccessHandler := connect.NewUnaryHandler(
+		AccessReviewServiceReviewResourceAccessProcedure,
+		svc.ReviewResourceAccess,
+		connect.WithSchema(accessReviewServiceMethods.ByName("ReviewResourceAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessReviewServiceExportPrincipalAccessHandler := connect.NewServerStreamHandler(
+		AccessReviewServiceExportPrincipalAccessProcedure,
+		svc.ExportPrincipalAccess,
+		connect.WithSchema(accessReviewServiceMethods.ByName("ExportPrincipalAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accessReviewServiceExportResourceAccessHandler := connect.NewServerStreamHandler(
+		AccessReviewServiceExportResourceAccessProcedure,
+		svc.ExportResourceAccess,
+		connect.WithSchema(accessReviewServiceMethods.ByName("ExportResourceAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.AccessReviewService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AccessReviewServiceReviewPrincipalAccessProcedure:
+			accessReviewServiceReviewPrincipalAccessHandler.ServeHTTP(w, r)
+		case AccessReviewServiceReviewResourceAccessProcedure:
+			accessReviewServiceReviewResourceAccessHandler.ServeHTTP(w, r)
+		case AccessReviewServiceExportPrincipalAccessProcedure:
+			accessReviewServiceExportPrincipalAccessHandler.ServeHTTP(w, r)
+		case AccessReviewServiceExportResourceAccessProcedure:
+			accessReviewServiceExportResourceAccessHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAccessReviewServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAccessReviewServiceHandler struct{}
+
+func (UnimplementedAccessReviewServiceHandler) ReviewPrincipalAccess(context.Context, *connect.Request[v1beta1.ReviewPrincipalAccessRequest]) (*connect.Response[v1beta1.ReviewPrincipalAccessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AccessReviewService.ReviewPrincipalAccess is not implemented"))
+}
+
+func (UnimplementedAccessReviewServiceHandler) ReviewResourceAccess(context.Context, *connect.Request[v1beta1.ReviewResourceAccessRequest]) (*connect.Response[v1beta1.ReviewResourceAccessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AccessReviewService.ReviewResourceAccess is not implemented"))
+}
+
+func (UnimplementedAccessReviewServiceHandler) ExportPrincipalAccess(context.Context, *connect.Request[v1beta1.ExportPrincipalAccessRequest], *connect.ServerStream[httpbody.HttpBody]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AccessReviewService.ExportPrincipalAccess is not implemented"))
+}
+
+func (UnimplementedAccessReviewServiceHandler) ExportResourceAccess(context.Context, *connect.Request[v1beta1.ExportResourceAccessRequest], *connect.ServerStream[httpbody.HttpBody]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AccessReviewService.ExportResourceAccess is not implemented"))
+}
@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/audit_record.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuditRecordServiceName is the fully-qualified name of the AuditRecordService service.
+	AuditRecordServiceName = "raystack.frontier.v1beta1.AuditRecordService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuditRecordServiceVerifyAuditRecordChainProcedure is the fully-qualified name of the
+	// AuditRecordService's VerifyAuditRecordChain RPC.
+	AuditRecordServiceVerifyAuditRecordChainProcedure = "/raystack.frontier.v1beta1.AuditRecordService/VerifyAuditRecordChain"
+	// AuditRecordServiceListAuditRecordArchivesProcedure is the fully-qualified name of the
+	// AuditRecordService's ListAuditRecordArchives RPC.
+	AuditRecordServiceListAuditRecordArchivesProcedure = "/raystack.frontier.v1beta1.AuditRecordService/ListAuditRecordArchives"
+	// AuditRecordServiceGetAuditRecordArchiveProcedure is the fully-qualified name of the
+	// AuditRecordService's GetAuditRecordArchive RPC.
+	AuditRecordServiceGetAuditRecordArchiveProcedure = "/raystack.frontier.v1beta1.AuditRecordService/GetAuditRecordArchive"
+	// AuditRecordServiceRestoreAuditRecordArchiveProcedure is the fully-qualified name of the
+	// AuditRecordService's RestoreAuditRecordArchive RPC.
+	AuditRecordServiceRestoreAuditRecordArchiveProcedure = "/raystack.frontier.v1beta1.AuditRecordService/RestoreAuditRecordArchive"
+)
+
+// AuditRecordServiceClient is a client for the raystack.frontier.v1beta1.AuditRecordService
+// service.
+type AuditRecordServiceClient interface {
+	// VerifyAuditRecordChain walks the chained audit records of an organization
+	// created within a time range and reports the first record that was removed
+	// or modified
+	VerifyAuditRecordChain(context.Context, *connect.Request[v1beta1.VerifyAuditRecordChainRequest]) (*connect.Response[v1beta1.VerifyAuditRecordChainResponse], error)
+	// ListAuditRecordArchives lists the archives of an organization holding
+	// records created within a time range
+	ListAuditRecordArchives(context.Context, *connect.Request[v1beta1.ListAuditRecordArchivesRequest]) (*connect.Response[v1beta1.ListAuditRecordArchivesResponse], error)
+	// GetAuditRecordArchive returns an archive with its records, the records
+	// are checked against their hashes
+	GetAuditRecordArchive(context.Context, *connect.Request[v1beta1.GetAuditRecordArchiveRequest]) (*connect.Response[v1beta1.GetAuditRecordArchiveResponse], error)
+	// RestoreAuditRecordArchive moves the records of an archive back to the
+	// database and deletes the archive. Records still past their retention
+	// period are archived again by the next run.
+	RestoreAuditRecordArchive(context.Context, *connect.Request[v1beta1.RestoreAuditRecordArchiveRequest]) (*connect.Response[v1beta1.RestoreAuditRecordArchiveResponse], error)
+}
+
+// NewAuditRecordServiceClient constructs a client for the
+// raystack.frontier.v1beta1.AuditRecordService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuditRecordServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuditRecordServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	auditRecordServiceMethods := v1beta1.File_raystack_frontier_v1beta1_audit_record_proto.Services().ByName("AuditRecordService").Methods()
+	return &auditRecordServiceClient{
+		verifyAuditRecordChain: connect.NewClient[v1beta1.VerifyAuditRecordChainRequest, v1beta1.VerifyAuditRecordChainResponse](
+			httpClient,
+			baseURL+AuditRecordServiceVerifyAuditRecordChainProcedure,
+			connect.WithSchema(auditRecordServiceMethods.ByName("VerifyAuditRecordChain")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditRecordArchives: connect.NewClient[v1beta1.ListAuditRecordArchivesRequest, v1beta1.ListAuditRecordArchivesResponse](
+			httpClient,
+			baseURL+AuditRecordServiceListAuditRecordArchivesProcedure,
+			connect.WithSchema(auditRecordServiceMethods.ByName("ListAuditRecordArchives")),
+			connect.WithClientOptions(opts...),
+		),
+		getAuditRecordArchive: connect.NewClient[v1beta1.GetAuditRecordArchiveRequest, v1beta1.GetAuditRecordArchiveResponse](
+			httpClient,
+			baseURL+AuditRecordServiceGetAuditRecordArchiveProcedure,
+			connect.WithSchema(auditRecordServiceMethods.ByName("GetAuditRecordArchive")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreAuditRecordArchive: connect.NewClient[v1beta1.RestoreAuditRecordArchiveRequest, v1beta1.RestoreAuditRecordArchiveResponse](
+			httpClient,
+			baseURL+AuditRecordServiceRestoreAuditRecordArchiveProcedure,
+			connect.WithSchema(auditRecordServiceMethods.ByName("RestoreAuditRecordArchive")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// auditRecordServiceClient implements AuditRecordServiceClient.
+type auditRecordServiceClient struct {
+	verifyAuditRecordChain    *connect.Client[v1beta1.VerifyAuditRecordChainRequest, v1beta1.VerifyAuditRecordChainResponse]
+	listAuditRecordArchives   *connect.Client[v1beta1.ListAuditRecordArchivesRequest, v1beta1.ListAuditRecordArchivesResponse]
+	getAuditRecordArchive     *connect.Client[v1beta1.GetAuditRecordArchiveRequest, v1beta1.GetAuditRecordArchiveResponse]
+	restoreAuditRecordArchive *connect.Client[v1beta1.RestoreAuditRecordArchiveRequest, v1beta1.RestoreAuditRecordArchiveResponse]
+}
+
+// VerifyAuditRecordChain calls raystack.frontier.v1beta1.AuditRecordService.VerifyAuditRecordChain.
+func (c *auditRecordServiceClient) VerifyAuditRecordChain(ctx context.Context, req *connect.Request[v1beta1.VerifyAuditRecordChainRequest]) (*connect.Response[v1beta1.VerifyAuditRecordChainResponse], error) {
+	return c.verifyAuditRecordChain.CallUnary(ctx, req)
+}
+
+// ListAuditRecordArchives calls
+// raystack.frontier.v1beta1.AuditRecordService.ListAuditRecordArchives.
+func (c *auditRecordServiceClient) ListAuditRecordArchives(ctx context.Context, req *connect.Request[v1beta1.ListAuditRecordArchivesRequest]) (*connect.Response[v1beta1.ListAuditRecordArchivesResponse], error) {
+	return c.listAuditRecordArchives.CallUnary(ctx, req)
+}
+
+// GetAuditRecordArchive calls raystack.frontier.v1beta1.AuditRecordService.GetAuditRecordArchive.
+func (c *auditRecordServiceClient) GetAuditRecordArchive(ctx context.Context, req *connect.Request[v1beta1.GetAuditRecordArchiveRequest]) (*connect.Response[v1beta1.GetAuditRecordArchiveResponse], error) {
+	return c.getAuditRecordArchive.CallUnary(ctx, req)
+}
+
+// RestoreAuditRecordArchive calls
+// raystack.frontier.v1beta1.AuditRecordService.RestoreAuditRecordArchive.
+func (c *auditRecordServiceClient) RestoreAuditRecordArchive(ctx context.Context, req *connect.Request[v1beta1.RestoreAuditRecordArchiveRequest]) (*connect.Response[v1beta1.RestoreAuditRecordArchiveResponse], error) {
+	return c.restoreAuditRecordArchive.CallUnary(ctx, req)
+}
+
+// AuditRecordServiceHandler is an implementation of the
+// raystack.frontier.v1beta1.AuditRecordService service.
+type AuditRecordServiceHandler interface {
+	// VerifyAuditRecordChain walks the chained audit records of an organization
+	// created within a time range and reports the first record that was removed
+	// or modified
+	VerifyAuditRecordChain(context.Context, *connect.Request[v1beta1.VerifyAuditRecordChainRequest]) (*connect.Response[v1beta1.VerifyAuditRecordChainResponse], error)
+	// ListAuditRecordArchives lists the archives of an organization holding
+	// records created within a time range
+	ListAuditRecordArchives(context.Context, *connect.Request[v1beta1.ListAuditRecordArchivesRequest]) (*connect.Response[v1beta1.ListAuditRecordArchivesResponse], error)
+	// GetAuditRecordArchive returns an archive with its records, the records
+	// are checked against their hashes
+	GetAuditRecordArchive(context.Context, *connect.Request[v1beta1.GetAuditRecordArchiveRequest]) (*connect.Response[v1beta1.GetAuditRecordArchiveResponse], error)
+	// RestoreAuditRecordArchive moves the records of an archive back to the
+	// database and deletes the archive. Records still past their retention
+	// period are archived again by the next run.
+	RestoreAuditRecordArchive(context.Context, *connect.Request[v1beta1.RestoreAuditRecordArchiveRequest]) (*connect.Response[v1beta1.RestoreAuditRecordArchiveResponse], error)
+}
+
+// NewAuditRecordServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuditRecordServiceHandler(svc AuditRecordServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	auditRecordServiceMethods := v1beta1.File_raystack_frontier_v1beta1_audit_record_proto.Services().ByName("AuditRecordService").Methods()
+	auditRecordServiceVerifyAuditRecordChainHandler := connect.NewUnaryHandler(
+		AuditRecordServiceVerifyAuditRecordChainProcedure,
+		svc.VerifyAuditRecordChain,
+		connect.WithSchema(auditRecordServiceMethods.ByName("VerifyAuditRecordChain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditRecordServiceListAuditRecordArchivesHandler := connect.NewUnaryHandler(
+		AuditRecordServiceListAuditRecordArchivesProcedure,
+		svc.ListAuditRecordArchives,
+		connect.WithSchema(auditRecordServiceMethods.ByName("ListAuditRecordArchives")),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditRecordServiceGetAuditRecordArchiveHandler := connect.NewUnaryHandler(
+		AuditRecordServiceGetAuditRecordArchiveProcedure,
+		svc.GetAuditRecordArchive,
+		connect.WithSchema(auditRecordServiceMethods.ByName("GetAuditRecordArchive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditRecordServiceRestoreAuditRecordArchiveHandler := connect.NewUnaryHandler(
+		AuditRecordServiceRestoreAuditRecordArchiveProcedure,
+		svc.RestoreAuditRecordArchive,
+		connect.WithSchema(auditRecordServiceMethods.ByName("RestoreAuditRecordArchive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.AuditRecordService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuditRecordServiceVerifyAuditRecordChainProcedure:
+			auditRecordServiceVerifyAuditRecordChainHandler.ServeHTTP(w, r)
+		case AuditRecordServiceListAuditRecordArchivesProcedure:
+			auditRecordServiceListAuditRecordArchivesHandler.ServeHTTP(w, r)
+		case AuditRecordServiceGetAuditRecordArchiveProcedure:
+			auditRecordServiceGetAuditRecordArchiveHandler.ServeHTTP(w, r)
+		case AuditRecordServiceRestoreAuditRecordArchiveProcedure:
+			auditRecordServiceRestoreAuditRecordArchiveHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuditRecordServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuditRecordServiceHandler struct{}
+
+func (UnimplementedAuditRecordServiceHandler) VerifyAuditRecordChain(context.Context, *connect.Request[v1beta1.VerifyAuditRecordChainRequest]) (*connect.Response[v1beta1.VerifyAuditRecordChainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AuditRecordService.VerifyAuditRecordChain is not implemented"))
+}
+
+func (UnimplementedAuditRecordServiceHandler) ListAuditRecordArchives(context.Context, *connect.Request[v1beta1.ListAuditRecordArchivesRequest]) (*connect.Response[v1beta1.ListAuditRecordArchivesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AuditRecordService.ListAuditRecordArchives is not implemented"))
+}
+
+func (UnimplementedAuditRecordServiceHandler) GetAuditRecordArchive(context.Context, *connect.Request[v1beta1.GetAuditRecordArchiveRequest]) (*connect.Response[v1beta1.GetAuditRecordArchiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AuditRecordService.GetAuditRecordArchive is not implemented"))
+}
+
+func (UnimplementedAuditRecordServiceHandler) RestoreAuditRecordArchive(context.Context, *connect.Request[v1beta1.RestoreAuditRecordArchiveRequest]) (*connect.Response[v1beta1.RestoreAuditRecordArchiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AuditRecordService.RestoreAuditRecordArchive is not implemented"))
+}
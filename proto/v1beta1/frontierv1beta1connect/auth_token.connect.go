@@ -0,0 +1,149 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/auth_token.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuthTokenServiceName is the fully-qualified name of the AuthTokenService service.
+	AuthTokenServiceName = "raystack.frontier.v1beta1.AuthTokenService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuthTokenServiceIssueRefreshTokenProcedure is the fully-qualified name of the AuthTokenService's
+	// IssueRefreshToken RPC.
+	AuthTokenServiceIssueRefreshTokenProcedure = "/raystack.frontier.v1beta1.AuthTokenService/IssueRefreshToken"
+	// AuthTokenServiceRefreshAuthTokenProcedure is the fully-qualified name of the AuthTokenService's
+	// RefreshAuthToken RPC.
+	AuthTokenServiceRefreshAuthTokenProcedure = "/raystack.frontier.v1beta1.AuthTokenService/RefreshAuthToken"
+)
+
+// AuthTokenServiceClient is a client for the raystack.frontier.v1beta1.AuthTokenService service.
+type AuthTokenServiceClient interface {
+	// IssueRefreshToken issues a refresh token bound to the session of the
+	// current user, only the session cookie can issue one
+	IssueRefreshToken(context.Context, *connect.Request[v1beta1.IssueRefreshTokenRequest]) (*connect.Response[v1beta1.IssueRefreshTokenResponse], error)
+	// RefreshAuthToken redeems a refresh token for a new access token of its
+	// session and the refresh token replacing it. The refresh token
+	// authenticates the request, no frontier session is needed.
+	RefreshAuthToken(context.Context, *connect.Request[v1beta1.RefreshAuthTokenRequest]) (*connect.Response[v1beta1.RefreshAuthTokenResponse], error)
+}
+
+// NewAuthTokenServiceClient constructs a client for the raystack.frontier.v1beta1.AuthTokenService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuthTokenServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuthTokenServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	authTokenServiceMethods := v1beta1.File_raystack_frontier_v1beta1_auth_token_proto.Services().ByName("AuthTokenService").Methods()
+	return &authTokenServiceClient{
+		issueRefreshToken: connect.NewClient[v1beta1.IssueRefreshTokenRequest, v1beta1.IssueRefreshTokenResponse](
+			httpClient,
+			baseURL+AuthTokenServiceIssueRefreshTokenProcedure,
+			connect.WithSchema(authTokenServiceMethods.ByName("IssueRefreshToken")),
+			connect.WithClientOptions(opts...),
+		),
+		refreshAuthToken: connect.NewClient[v1beta1.RefreshAuthTokenRequest, v1beta1.RefreshAuthTokenResponse](
+			httpClient,
+			baseURL+AuthTokenServiceRefreshAuthTokenProcedure,
+			connect.WithSchema(authTokenServiceMethods.ByName("RefreshAuthToken")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// authTokenServiceClient implements AuthTokenServiceClient.
+type authTokenServiceClient struct {
+	issueRefreshToken *connect.Client[v1beta1.IssueRefreshTokenRequest, v1beta1.IssueRefreshTokenResponse]
+	refreshAuthToken  *connect.Client[v1beta1.RefreshAuthTokenRequest, v1beta1.RefreshAuthTokenResponse]
+}
+
+// IssueRefreshToken calls raystack.frontier.v1beta1.AuthTokenService.IssueRefreshToken.
+func (c *authTokenServiceClient) IssueRefreshToken(ctx context.Context, req *connect.Request[v1beta1.IssueRefreshTokenRequest]) (*connect.Response[v1beta1.IssueRefreshTokenResponse], error) {
+	return c.issueRefreshToken.CallUnary(ctx, req)
+}
+
+// RefreshAuthToken calls raystack.frontier.v1beta1.AuthTokenService.RefreshAuthToken.
+func (c *authTokenServiceClient) RefreshAuthToken(ctx context.Context, req *connect.Request[v1beta1.RefreshAuthTokenRequest]) (*connect.Response[v1beta1.RefreshAuthTokenResponse], error) {
+	return c.refreshAuthToken.CallUnary(ctx, req)
+}
+
+// AuthTokenServiceHandler is an implementation of the raystack.frontier.v1beta1.AuthTokenService
+// service.
+type AuthTokenServiceHandler interface {
+	// IssueRefreshToken issues a refresh token bound to the session of the
+	// current user, only the session cookie can issue one
+	IssueRefreshToken(context.Context, *connect.Request[v1beta1.IssueRefreshTokenRequest]) (*connect.Response[v1beta1.IssueRefreshTokenResponse], error)
+	// RefreshAuthToken redeems a refresh token for a new access token of its
+	// session and the refresh token replacing it. The refresh token
+	// authenticates the request, no frontier session is needed.
+	RefreshAuthToken(context.Context, *connect.Request[v1beta1.RefreshAuthTokenRequest]) (*connect.Response[v1beta1.RefreshAuthTokenResponse], error)
+}
+
+// NewAuthTokenServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuthTokenServiceHandler(svc AuthTokenServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	authTokenServiceMethods := v1beta1.File_raystack_frontier_v1beta1_auth_token_proto.Services().ByName("AuthTokenService").Methods()
+	authTokenServiceIssueRefreshTokenHandler := connect.NewUnaryHandler(
+		AuthTokenServiceIssueRefreshTokenProcedure,
+		svc.IssueRefreshToken,
+		connect.WithSchema(authTokenServiceMethods.ByName("IssueRefreshToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authTokenServiceRefreshAuthTokenHandler := connect.NewUnaryHandler(
+		AuthTokenServiceRefreshAuthTokenProcedure,
+		svc.RefreshAuthToken,
+		connect.WithSchema(authTokenServiceMethods.ByName("RefreshAuthToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.AuthTokenService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuthTokenServiceIssueRefreshTokenProcedure:
+			authTokenServiceIssueRefreshTokenHandler.ServeHTTP(w, r)
+		case AuthTokenServiceRefreshAuthTokenProcedure:
+			authTokenServiceRefreshAuthTokenHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuthTokenServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuthTokenServiceHandler struct{}
+
+func (UnimplementedAuthTokenServiceHandler) IssueRefreshToken(context.Context, *connect.Request[v1beta1.IssueRefreshTokenRequest]) (*connect.Response[v1beta1.IssueRefreshTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AuthTokenService.IssueRefreshToken is not implemented"))
+}
+
+func (UnimplementedAuthTokenServiceHandler) RefreshAuthToken(context.Context, *connect.Request[v1beta1.RefreshAuthTokenRequest]) (*connect.Response[v1beta1.RefreshAuthTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.AuthTokenService.RefreshAuthToken is not implemented"))
+}
@@ -0,0 +1,314 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/certification.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CertificationServiceName is the fully-qualified name of the CertificationService service.
+	CertificationServiceName = "raystack.frontier.v1beta1.CertificationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CertificationServiceCreateCertificationCampaignProcedure is the fully-qualified name of the
+	// CertificationService's CreateCertificationCampaign RPC.
+	CertificationServiceCreateCertificationCampaignProcedure = "/raystack.frontier.v1beta1.CertificationService/CreateCertificationCampaign"
+	// CertificationServiceListCertificationCampaignsProcedure is the fully-qualified name of the
+	// CertificationService's ListCertificationCampaigns RPC.
+	CertificationServiceListCertificationCampaignsProcedure = "/raystack.frontier.v1beta1.CertificationService/ListCertificationCampaigns"
+	// CertificationServiceCancelCertificationCampaignProcedure is the fully-qualified name of the
+	// CertificationService's CancelCertificationCampaign RPC.
+	CertificationServiceCancelCertificationCampaignProcedure = "/raystack.frontier.v1beta1.CertificationService/CancelCertificationCampaign"
+	// CertificationServiceListCertificationItemsProcedure is the fully-qualified name of the
+	// CertificationService's ListCertificationItems RPC.
+	CertificationServiceListCertificationItemsProcedure = "/raystack.frontier.v1beta1.CertificationService/ListCertificationItems"
+	// CertificationServiceCertifyCertificationItemProcedure is the fully-qualified name of the
+	// CertificationService's CertifyCertificationItem RPC.
+	CertificationServiceCertifyCertificationItemProcedure = "/raystack.frontier.v1beta1.CertificationService/CertifyCertificationItem"
+	// CertificationServiceRevokeCertificationItemProcedure is the fully-qualified name of the
+	// CertificationService's RevokeCertificationItem RPC.
+	CertificationServiceRevokeCertificationItemProcedure = "/raystack.frontier.v1beta1.CertificationService/RevokeCertificationItem"
+	// CertificationServiceExportCertificationCampaignProcedure is the fully-qualified name of the
+	// CertificationService's ExportCertificationCampaign RPC.
+	CertificationServiceExportCertificationCampaignProcedure = "/raystack.frontier.v1beta1.CertificationService/ExportCertificationCampaign"
+)
+
+// CertificationServiceClient is a client for the raystack.frontier.v1beta1.CertificationService
+// service.
+type CertificationServiceClient interface {
+	// CreateCertificationCampaign starts a campaign reviewing the policies of
+	// an organization till the deadline
+	CreateCertificationCampaign(context.Context, *connect.Request[v1beta1.CreateCertificationCampaignRequest]) (*connect.Response[v1beta1.CreateCertificationCampaignResponse], error)
+	// ListCertificationCampaigns lists the campaigns of an organization
+	ListCertificationCampaigns(context.Context, *connect.Request[v1beta1.ListCertificationCampaignsRequest]) (*connect.Response[v1beta1.ListCertificationCampaignsResponse], error)
+	// CancelCertificationCampaign ends an active campaign, its pending
+	// policies are left as they are
+	CancelCertificationCampaign(context.Context, *connect.Request[v1beta1.CancelCertificationCampaignRequest]) (*connect.Response[v1beta1.CancelCertificationCampaignResponse], error)
+	// ListCertificationItems lists the policies of a campaign the current user
+	// reviews
+	ListCertificationItems(context.Context, *connect.Request[v1beta1.ListCertificationItemsRequest]) (*connect.Response[v1beta1.ListCertificationItemsResponse], error)
+	// CertifyCertificationItem keeps the policy of an item
+	CertifyCertificationItem(context.Context, *connect.Request[v1beta1.CertifyCertificationItemRequest]) (*connect.Response[v1beta1.CertifyCertificationItemResponse], error)
+	// RevokeCertificationItem deletes the policy of an item
+	RevokeCertificationItem(context.Context, *connect.Request[v1beta1.RevokeCertificationItemRequest]) (*connect.Response[v1beta1.RevokeCertificationItemResponse], error)
+	// ExportCertificationCampaign exports the decisions of a campaign as CSV
+	ExportCertificationCampaign(context.Context, *connect.Request[v1beta1.ExportCertificationCampaignRequest]) (*connect.ServerStreamForClient[httpbody.HttpBody], error)
+}
+
+// NewCertificationServiceClient constructs a client for the
+// raystack.frontier.v1beta1.CertificationService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCertificationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CertificationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	certificationServiceMethods := v1beta1.File_raystack_frontier_v1beta1_certification_proto.Services().ByName("CertificationService").Methods()
+	return &certificationServiceClient{
+		createCertificationCampaign: connect.NewClient[v1beta1.CreateCertificationCampaignRequest, v1beta1.CreateCertificationCampaignResponse](
+			httpClient,
+			baseURL+CertificationServiceCreateCertificationCampaignProcedure,
+			connect.WithSchema(certificationServiceMethods.ByName("CreateCertificationCampaign")),
+			connect.WithClientOptions(opts...),
+		),
+		listCertificationCampaigns: connect.NewClient[v1beta1.ListCertificationCampaignsRequest, v1beta1.ListCertificationCampaignsResponse](
+			httpClient,
+			baseURL+CertificationServiceListCertificationCampaignsProcedure,
+			connect.WithSchema(certificationServiceMethods.ByName("ListCertificationCampaigns")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelCertificationCampaign: connect.NewClient[v1beta1.CancelCertificationCampaignRequest, v1beta1.CancelCertificationCampaignResponse](
+			httpClient,
+			baseURL+CertificationServiceCancelCertificationCampaignProcedure,
+			connect.WithSchema(certificationServiceMethods.ByName("CancelCertificationCampaign")),
+			connect.WithClientOptions(opts...),
+		),
+		listCertificationItems: connect.NewClient[v1beta1.ListCertificationItemsRequest, v1beta1.ListCertificationItemsResponse](
+			httpClient,
+			baseURL+CertificationServiceListCertificationItemsProcedure,
+			connect.WithSchema(certificationServiceMethods.ByName("ListCertificationItems")),
+			connect.WithClientOptions(opts...),
+		),
+		certifyCertificationItem: connect.NewClient[v1beta1.CertifyCertificationItemRequest, v1beta1.CertifyCertificationItemResponse](
+			httpClient,
+			baseURL+CertificationServiceCertifyCertificationItemProcedure,
+			connect.WithSchema(certificationServiceMethods.ByName("CertifyCertificationItem")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeCertificationItem: connect.NewClient[v1beta1.RevokeCertificationItemRequest, v1beta1.RevokeCertificationItemResponse](
+			httpClient,
+			baseURL+CertificationServiceRevokeCertificationItemProcedure,
+			connect.WithSchema(certificationServiceMethods.ByName("RevokeCertificationItem")),
+			connect.WithClientOptions(opts...),
+		),
+		exportCertificationCampaign: connect.NewClient[v1beta1.ExportCertificationCampaignRequest, httpbody.HttpBody](
+			httpClient,
+			baseURL+CertificationServiceExportCertificationCampaignProcedure,
+			connect.WithSchema(certificationServiceMethods.ByName("ExportCertificationCampaign")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// certificationServiceClient implements CertificationServiceClient.
+type certificationServiceClient struct {
+	createCertificationCampaign *connect.Client[v1beta1.CreateCertificationCampaignRequest, v1beta1.CreateCertificationCampaignResponse]
+	listCertificationCampaigns  *connect.Client[v1beta1.ListCertificationCampaignsRequest, v1beta1.ListCertificationCampaignsResponse]
+	cancelCertificationCampaign *connect.Client[v1beta1.CancelCertificationCampaignRequest, v1beta1.CancelCertificationCampaignResponse]
+	listCertificationItems      *connect.Client[v1beta1.ListCertificationItemsRequest, v1beta1.ListCertificationItemsResponse]
+	certifyCertificationItem    *connect.Client[v1beta1.CertifyCertificationItemRequest, v1beta1.CertifyCertificationItemResponse]
+	revokeCertificationItem     *connect.Client[v1beta1.RevokeCertificationItemRequest, v1beta1.RevokeCertificationItemResponse]
+	exportCertificationCampaign *connect.Client[v1beta1.ExportCertificationCampaignRequest, httpbody.HttpBody]
+}
+
+// CreateCertificationCampaign calls
+// raystack.frontier.v1beta1.CertificationService.CreateCertificationCampaign.
+func (c *certificationServiceClient) CreateCertificationCampaign(ctx context.Context, req *connect.Request[v1beta1.CreateCertificationCampaignRequest]) (*connect.Response[v1beta1.CreateCertificationCampaignResponse], error) {
+	return c.createCertificationCampaign.CallUnary(ctx, req)
+}
+
+// ListCertificationCampaigns calls
+// raystack.frontier.v1beta1.CertificationService.ListCertificationCampaigns.
+func (c *certificationServiceClient) ListCertificationCampaigns(ctx context.Context, req *connect.Request[v1beta1.ListCertificationCampaignsRequest]) (*connect.Response[v1beta1.ListCertificationCampaignsResponse], error) {
+	return c.listCertificationCampaigns.CallUnary(ctx, req)
+}
+
+// CancelCertificationCampaign calls
+// raystack.frontier.v1beta1.CertificationService.CancelCertificationCampaign.
+func (c *certificationServiceClient) CancelCertificationCampaign(ctx context.Context, req *connect.Request[v1beta1.CancelCertificationCampaignRequest]) (*connect.Response[v1beta1.CancelCertificationCampaignResponse], error) {
+	return c.cancelCertificationCampaign.CallUnary(ctx, req)
+}
+
+// ListCertificationItems calls
+// raystack.frontier.v1beta1.CertificationService.ListCertificationItems.
+func (c *certificationServiceClient) ListCertificationItems(ctx context.Context, req *connect.Request[v1beta1.ListCertificationItemsRequest]) (*connect.Response[v1beta1.ListCertificationItemsResponse], error) {
+	return c.listCertificationItems.CallUnary(ctx, req)
+}
+
+// CertifyCertificationItem calls
+// raystack.frontier.v1beta1.CertificationService.CertifyCertificationItem.
+func (c *certificationServiceClient) CertifyCertificationItem(ctx context.Context, req *connect.Request[v1beta1.CertifyCertificationItemRequest]) (*connect.Response[v1beta1.CertifyCertificationItemResponse], error) {
+	return c.certifyCertificationItem.CallUnary(ctx, req)
+}
+
+// RevokeCertificationItem calls
+// raystack.frontier.v1beta1.CertificationService.RevokeCertificationItem.
+func (c *certificationServiceClient) RevokeCertificationItem(ctx context.Context, req *connect.Request[v1beta1.RevokeCertificationItemRequest]) (*connect.Response[v1beta1.RevokeCertificationItemResponse], error) {
+	return c.revokeCertificationItem.CallUnary(ctx, req)
+}
+
+// ExportCertificationCampaign calls
+// raystack.frontier.v1beta1.CertificationService.ExportCertificationCampaign.
+func (c *certificationServiceClient) ExportCertificationCampaign(ctx context.Context, req *connect.Request[v1beta1.ExportCertificationCampaignRequest]) (*connect.ServerStreamForClient[httpbody.HttpBody], error) {
+	return c.exportCertificationCampaign.CallServerStream(ctx, req)
+}
+
+// CertificationServiceHandler is an implementation of the
+// raystack.frontier.v1beta1.CertificationService service.
+type CertificationServiceHandler interface {
+	// CreateCertificationCampaign starts a campaign reviewing the policies of
+	// an organization till the deadline
+	CreateCertificationCampaign(context.Context, *connect.Request[v1beta1.CreateCertificationCampaignRequest]) (*connect.Response[v1beta1.CreateCertificationCampaignResponse], error)
+	// ListCertificationCampaigns lists the campaigns of an organization
+	ListCertificationCampaigns(context.Context, *connect.Request[v1beta1.ListCertificationCampaignsRequest]) (*connect.Response[v1beta1.ListCertificationCampaignsResponse], error)
+	// CancelCertificationCampaign ends an active campaign, its pending
+	// policies are left as they are
+	CancelCertificationCampaign(context.Context, *connect.Request[v1beta1.CancelCertificationCampaignRequest]) (*connect.Response[v1beta1.CancelCertificationCampaignResponse], error)
+	// ListCertificationItems lists the policies of a campaign the current user
+	// reviews
+	ListCertificationItems(context.Context, *connect.Request[v1beta1.ListCertificationItemsRequest]) (*connect.Response[v1beta1.ListCertificationItemsResponse], error)
+	// CertifyCertificationItem keeps the policy of an item
+	CertifyCertificationItem(context.Context, *connect.Request[v1beta1.CertifyCertificationItemRequest]) (*connect.Response[v1beta1.CertifyCertificationItemResponse], error)
+	// RevokeCertificationItem deletes the policy of an item
+	RevokeCertificationItem(context.Context, *connect.Request[v1beta1.RevokeCertificationItemRequest]) (*connect.Response[v1beta1.RevokeCertificationItemResponse], error)
+	// ExportCertificationCampaign exports the decisions of a campaign as CSV
+	ExportCertificationCampaign(context.Context, *connect.Request[v1beta1.ExportCertificationCampaignRequest], *connect.ServerStream[httpbody.HttpBody]) error
+}
+
+// NewCertificationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCertificationServiceHandler(svc CertificationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	certificationServiceMethods := v1beta1.File_raystack_frontier_v1beta1_certification_proto.Services().ByName("CertificationService").Methods()
+	certificationServiceCreateCertificationCampaignHandler := connect.NewUnaryHandler(
+		CertificationServiceCreateCertificationCampaignProcedure,
+		svc.CreateCertificationCampaign,
+		connect.WithSchema(certificationServiceMethods.ByName("CreateCertificationCampaign")),
+		connect.WithHandlerOptions(opts...),
+	)
+	certificationServiceListCertificationCampaignsHandler := connect.NewUnaryHandler(
+		CertificationServiceListCertificationCampaignsProcedure,
+		svc.ListCertificationCampaigns,
+		connect.WithSchema(certificationServiceMethods.ByName("ListCertificationCampaigns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	certificationServiceCancelCertificationCampaignHandler := connect.NewUnaryHandler(
+		CertificationServiceCancelCertificationCampaignProcedure,
+		svc.CancelCertificationCampaign,
+		connect.WithSchema(certificationServiceMethods.ByName("CancelCertificationCampaign")),
+		connect.WithHandlerOptions(opts...),
+	)
+	certificationServiceListCertificationItemsHandler := connect.NewUnaryHandler(
+		CertificationServiceListCertificationItemsProcedure,
+		svc.ListCertificationItems,
+		connect.WithSchema(certificationServiceMethods.ByName("ListCertificationItems")),
+		connect.WithHandlerOptions(opts...),
+	)
+	certificationServiceCertifyCertificationItemHandler := connect.NewUnaryHandler(
+		CertificationServiceCertifyCertificationItemProcedure,
+		svc.CertifyCertificationItem,
+		connect.WithSchema(certificationServiceMethods.ByName("CertifyCertificationItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	certificationServiceRevokeCertificationItemHandler := connect.NewUnaryHandler(
+		CertificationServiceRevokeCertificationItemProcedure,
+		svc.RevokeCertificationItem,
+		connect.WithSchema(certificationServiceMethods.ByName("RevokeCertificationItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	certificationServiceExportCertificationCampaignHandler := connect.NewServerStreamHandler(
+		CertificationServiceExportCertificationCampaignProcedure,
+		svc.ExportCertificationCampaign,
+		connect.WithSchema(certificationServiceMethods.ByName("ExportCertificationCampaign")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.CertificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CertificationServiceCreateCertificationCampaignProcedure:
+			certificationServiceCreateCertificationCampaignHandler.ServeHTTP(w, r)
+		case CertificationServiceListCertificationCampaignsProcedure:
+			certificationServiceListCertificationCampaignsHandler.ServeHTTP(w, r)
+		case CertificationServiceCancelCertificationCampaignProcedure:
+			certificationServiceCancelCertificationCampaignHandler.ServeHTTP(w, r)
+		case CertificationServiceListCertificationItemsProcedure:
+			certificationServiceListCertificationItemsHandler.ServeHTTP(w, r)
+		case CertificationServiceCertifyCertificationItemProcedure:
+			certificationServiceCertifyCertificationItemHandler.ServeHTTP(w, r)
+		case CertificationServiceRevokeCertificationItemProcedure:
+			certificationServiceRevokeCertificationItemHandler.ServeHTTP(w, r)
+		case CertificationServiceExportCertificationCampaignProcedure:
+			certificationServiceExportCertificationCampaignHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCertificationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCertificationServiceHandler struct{}
+
+func (UnimplementedCertificationServiceHandler) CreateCertificationCampaign(context.Context, *connect.Request[v1beta1.CreateCertificationCampaignRequest]) (*connect.Response[v1beta1.CreateCertificationCampaignResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.CertificationService.CreateCertificationCampaign is not implemented"))
+}
+
+func (UnimplementedCertificationServiceHandler) ListCertificationCampaigns(context.Context, *connect.Request[v1beta1.ListCertificationCampaignsRequest]) (*connect.Response[v1beta1.ListCertificationCampaignsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.CertificationService.ListCertificationCampaigns is not implemented"))
+}
+
+func (UnimplementedCertificationServiceHandler) CancelCertificationCampaign(context.Context, *connect.Request[v1beta1.CancelCertificationCampaignRequest]) (*connect.Response[v1beta1.CancelCertificationCampaignResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.CertificationService.CancelCertificationCampaign is not implemented"))
+}
+
+func (UnimplementedCertificationServiceHandler) ListCertificationItems(context.Context, *connect.Request[v1beta1.ListCertificationItemsRequest]) (*connect.Response[v1beta1.ListCertificationItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.CertificationService.ListCertificationItems is not implemented"))
+}
+
+func (UnimplementedCertificationServiceHandler) CertifyCertificationItem(context.Context, *connect.Request[v1beta1.CertifyCertificationItemRequest]) (*connect.Response[v1beta1.CertifyCertificationItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.CertificationService.CertifyCertificationItem is not implemented"))
+}
+
+func (UnimplementedCertificationServiceHandler) RevokeCertificationItem(context.Context, *connect.Request[v1beta1.RevokeCertificationItemRequest]) (*connect.Response[v1beta1.RevokeCertificationItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.CertificationService.RevokeCertificationItem is not implemented"))
+}
+
+func (UnimplementedCertificationServiceHandler) ExportCertificationCampaign(context.Context, *connect.Request[v1beta1.ExportCertificationCampaignRequest], *connect.ServerStream[httpbody.HttpBody]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.CertificationService.ExportCertificationCampaign is not implemented"))
+}
@@ -0,0 +1,114 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/explain.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ExplainServiceName is the fully-qualified name of the ExplainService service.
+	ExplainServiceName = "raystack.frontier.v1beta1.ExplainService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ExplainServiceExplainPermissionProcedure is the fully-qualified name of the ExplainService's
+	// ExplainPermission RPC.
+	ExplainServiceExplainPermissionProcedure = "/raystack.frontier.v1beta1.ExplainService/ExplainPermission"
+)
+
+// ExplainServiceClient is a client for the raystack.frontier.v1beta1.ExplainService service.
+type ExplainServiceClient interface {
+	// ExplainPermission runs the permission check with tracing and maps the
+	// branches that granted the permission to frontier entities
+	ExplainPermission(context.Context, *connect.Request[v1beta1.ExplainPermissionRequest]) (*connect.Response[v1beta1.ExplainPermissionResponse], error)
+}
+
+// NewExplainServiceClient constructs a client for the raystack.frontier.v1beta1.ExplainService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewExplainServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ExplainServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	explainServiceMethods := v1beta1.File_raystack_frontier_v1beta1_explain_proto.Services().ByName("ExplainService").Methods()
+	return &explainServiceClient{
+		explainPermission: connect.NewClient[v1beta1.ExplainPermissionRequest, v1beta1.ExplainPermissionResponse](
+			httpClient,
+			baseURL+ExplainServiceExplainPermissionProcedure,
+			connect.WithSchema(explainServiceMethods.ByName("ExplainPermission")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// explainServiceClient implements ExplainServiceClient.
+type explainServiceClient struct {
+	explainPermission *connect.Client[v1beta1.ExplainPermissionRequest, v1beta1.ExplainPermissionResponse]
+}
+
+// ExplainPermission calls raystack.frontier.v1beta1.ExplainService.ExplainPermission.
+func (c *explainServiceClient) ExplainPermission(ctx context.Context, req *connect.Request[v1beta1.ExplainPermissionRequest]) (*connect.Response[v1beta1.ExplainPermissionResponse], error) {
+	return c.explainPermission.CallUnary(ctx, req)
+}
+
+// ExplainServiceHandler is an implementation of the raystack.frontier.v1beta1.ExplainService
+// service.
+type ExplainServiceHandler interface {
+	// ExplainPermission runs the permission check with tracing and maps the
+	// branches that granted the permission to frontier entities
+	ExplainPermission(context.Context, *connect.Request[v1beta1.ExplainPermissionRequest]) (*connect.Response[v1beta1.ExplainPermissionResponse], error)
+}
+
+// NewExplainServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewExplainServiceHandler(svc ExplainServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	explainServiceMethods := v1beta1.File_raystack_frontier_v1beta1_explain_proto.Services().ByName("ExplainService").Methods()
+	explainServiceExplainPermissionHandler := connect.NewUnaryHandler(
+		ExplainServiceExplainPermissionProcedure,
+		svc.ExplainPermission,
+		connect.WithSchema(explainServiceMethods.ByName("ExplainPermission")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.ExplainService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ExplainServiceExplainPermissionProcedure:
+			explainServiceExplainPermissionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedExplainServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedExplainServiceHandler struct{}
+
+func (UnimplementedExplainServiceHandler) ExplainPermission(context.Context, *connect.Request[v1beta1.ExplainPermissionRequest]) (*connect.Response[v1beta1.ExplainPermissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.ExplainService.ExplainPermission is not implemented"))
+}
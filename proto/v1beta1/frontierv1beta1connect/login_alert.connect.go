@@ -0,0 +1,116 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/login_alert.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LoginAlertServiceName is the fully-qualified name of the LoginAlertService service.
+	LoginAlertServiceName = "raystack.frontier.v1beta1.LoginAlertService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LoginAlertServiceRevokeLoginAlertSessionProcedure is the fully-qualified name of the
+	// LoginAlertService's RevokeLoginAlertSession RPC.
+	LoginAlertServiceRevokeLoginAlertSessionProcedure = "/raystack.frontier.v1beta1.LoginAlertService/RevokeLoginAlertSession"
+)
+
+// LoginAlertServiceClient is a client for the raystack.frontier.v1beta1.LoginAlertService service.
+type LoginAlertServiceClient interface {
+	// RevokeLoginAlertSession signs out the session of a login alert. A
+	// session revoked already is not an error, the link can be opened again.
+	RevokeLoginAlertSession(context.Context, *connect.Request[v1beta1.RevokeLoginAlertSessionRequest]) (*connect.Response[v1beta1.RevokeLoginAlertSessionResponse], error)
+}
+
+// NewLoginAlertServiceClient constructs a client for the
+// raystack.frontier.v1beta1.LoginAlertService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLoginAlertServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LoginAlertServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	loginAlertServiceMethods := v1beta1.File_raystack_frontier_v1beta1_login_alert_proto.Services().ByName("LoginAlertService").Methods()
+	return &loginAlertServiceClient{
+		revokeLoginAlertSession: connect.NewClient[v1beta1.RevokeLoginAlertSessionRequest, v1beta1.RevokeLoginAlertSessionResponse](
+			httpClient,
+			baseURL+LoginAlertServiceRevokeLoginAlertSessionProcedure,
+			connect.WithSchema(loginAlertServiceMethods.ByName("RevokeLoginAlertSession")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// loginAlertServiceClient implements LoginAlertServiceClient.
+type loginAlertServiceClient struct {
+	revokeLoginAlertSession *connect.Client[v1beta1.RevokeLoginAlertSessionRequest, v1beta1.RevokeLoginAlertSessionResponse]
+}
+
+// RevokeLoginAlertSession calls
+// raystack.frontier.v1beta1.LoginAlertService.RevokeLoginAlertSession.
+func (c *loginAlertServiceClient) RevokeLoginAlertSession(ctx context.Context, req *connect.Request[v1beta1.RevokeLoginAlertSessionRequest]) (*connect.Response[v1beta1.RevokeLoginAlertSessionResponse], error) {
+	return c.revokeLoginAlertSession.CallUnary(ctx, req)
+}
+
+// LoginAlertServiceHandler is an implementation of the raystack.frontier.v1beta1.LoginAlertService
+// service.
+type LoginAlertServiceHandler interface {
+	// RevokeLoginAlertSession signs out the session of a login alert. A
+	// session revoked already is not an error, the link can be opened again.
+	RevokeLoginAlertSession(context.Context, *connect.Request[v1beta1.RevokeLoginAlertSessionRequest]) (*connect.Response[v1beta1.RevokeLoginAlertSessionResponse], error)
+}
+
+// NewLoginAlertServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLoginAlertServiceHandler(svc LoginAlertServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	loginAlertServiceMethods := v1beta1.File_raystack_frontier_v1beta1_login_alert_proto.Services().ByName("LoginAlertService").Methods()
+	loginAlertServiceRevokeLoginAlertSessionHandler := connect.NewUnaryHandler(
+		LoginAlertServiceRevokeLoginAlertSessionProcedure,
+		svc.RevokeLoginAlertSession,
+		connect.WithSchema(loginAlertServiceMethods.ByName("RevokeLoginAlertSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.LoginAlertService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LoginAlertServiceRevokeLoginAlertSessionProcedure:
+			loginAlertServiceRevokeLoginAlertSessionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLoginAlertServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLoginAlertServiceHandler struct{}
+
+func (UnimplementedLoginAlertServiceHandler) RevokeLoginAlertSession(context.Context, *connect.Request[v1beta1.RevokeLoginAlertSessionRequest]) (*connect.Response[v1beta1.RevokeLoginAlertSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.LoginAlertService.RevokeLoginAlertSession is not implemented"))
+}
@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/mfa.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MFAServiceName is the fully-qualified name of the MFAService service.
+	MFAServiceName = "raystack.frontier.v1beta1.MFAService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MFAServiceGetMFAStatusProcedure is the fully-qualified name of the MFAService's GetMFAStatus RPC.
+	MFAServiceGetMFAStatusProcedure = "/raystack.frontier.v1beta1.MFAService/GetMFAStatus"
+	// MFAServiceEnrollTOTPProcedure is the fully-qualified name of the MFAService's EnrollTOTP RPC.
+	MFAServiceEnrollTOTPProcedure = "/raystack.frontier.v1beta1.MFAService/EnrollTOTP"
+	// MFAServiceConfirmTOTPProcedure is the fully-qualified name of the MFAService's ConfirmTOTP RPC.
+	MFAServiceConfirmTOTPProcedure = "/raystack.frontier.v1beta1.MFAService/ConfirmTOTP"
+	// MFAServiceVerifyMFAProcedure is the fully-qualified name of the MFAService's VerifyMFA RPC.
+	MFAServiceVerifyMFAProcedure = "/raystack.frontier.v1beta1.MFAService/VerifyMFA"
+	// MFAServiceRegenerateMFARecoveryCodesProcedure is the fully-qualified name of the MFAService's
+	// RegenerateMFARecoveryCodes RPC.
+	MFAServiceRegenerateMFARecoveryCodesProcedure = "/raystack.frontier.v1beta1.MFAService/RegenerateMFARecoveryCodes"
+	// MFAServiceDisableTOTPProcedure is the fully-qualified name of the MFAService's DisableTOTP RPC.
+	MFAServiceDisableTOTPProcedure = "/raystack.frontier.v1beta1.MFAService/DisableTOTP"
+)
+
+// MFAServiceClient is a client for the raystack.frontier.v1beta1.MFAService service.
+type MFAServiceClient interface {
+	// GetMFAStatus returns the enrollment of the user and the assurance level
+	// of the session
+	GetMFAStatus(context.Context, *connect.Request[v1beta1.GetMFAStatusRequest]) (*connect.Response[v1beta1.GetMFAStatusResponse], error)
+	// EnrollTOTP generates a new totp secret for the user to add to an
+	// authenticator app
+	EnrollTOTP(context.Context, *connect.Request[v1beta1.EnrollTOTPRequest]) (*connect.Response[v1beta1.EnrollTOTPResponse], error)
+	// ConfirmTOTP confirms the enrollment with the first code of the
+	// authenticator app, raises the session to aal2 and returns the recovery
+	// codes
+	ConfirmTOTP(context.Context, *connect.Request[v1beta1.ConfirmTOTPRequest]) (*connect.Response[v1beta1.ConfirmTOTPResponse], error)
+	// VerifyMFA verifies a totp or a recovery code and raises the session to
+	// aal2
+	VerifyMFA(context.Context, *connect.Request[v1beta1.VerifyMFARequest]) (*connect.Response[v1beta1.VerifyMFAResponse], error)
+	// RegenerateMFARecoveryCodes replaces the recovery codes of the user
+	RegenerateMFARecoveryCodes(context.Context, *connect.Request[v1beta1.RegenerateMFARecoveryCodesRequest]) (*connect.Response[v1beta1.RegenerateMFARecoveryCodesResponse], error)
+	// DisableTOTP removes the totp and the recovery codes of the user
+	DisableTOTP(context.Context, *connect.Request[v1beta1.DisableTOTPRequest]) (*connect.Response[v1beta1.DisableTOTPResponse], error)
+}
+
+// NewMFAServiceClient constructs a client for the raystack.frontier.v1beta1.MFAService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMFAServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MFAServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	mFAServiceMethods := v1beta1.File_raystack_frontier_v1beta1_mfa_proto.Services().ByName("MFAService").Methods()
+	return &mFAServiceClient{
+		getMFAStatus: connect.NewClient[v1beta1.GetMFAStatusRequest, v1beta1.GetMFAStatusResponse](
+			httpClient,
+			baseURL+MFAServiceGetMFAStatusProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("GetMFAStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		enrollTOTP: connect.NewClient[v1beta1.EnrollTOTPRequest, v1beta1.EnrollTOTPResponse](
+			httpClient,
+			baseURL+MFAServiceEnrollTOTPProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("EnrollTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTOTP: connect.NewClient[v1beta1.ConfirmTOTPRequest, v1beta1.ConfirmTOTPResponse](
+			httpClient,
+			baseURL+MFAServiceConfirmTOTPProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("ConfirmTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyMFA: connect.NewClient[v1beta1.VerifyMFARequest, v1beta1.VerifyMFAResponse](
+			httpClient,
+			baseURL+MFAServiceVerifyMFAProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("VerifyMFA")),
+			connect.WithClientOptions(opts...),
+		),
+		regenerateMFARecoveryCodes: connect.NewClient[v1beta1.RegenerateMFARecoveryCodesRequest, v1beta1.RegenerateMFARecoveryCodesResponse](
+			httpClient,
+			baseURL+MFAServiceRegenerateMFARecoveryCodesProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("RegenerateMFARecoveryCodes")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTOTP: connect.NewClient[v1beta1.DisableTOTPRequest, v1beta1.DisableTOTPResponse](
+			httpClient,
+			baseURL+MFAServiceDisableTOTPProcedure,
+			connect.WithSchema(mFAServiceMethods.ByName("DisableTOTP")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// mFAServiceClient implements MFAServiceClient.
+type mFAServiceClient struct {
+	getMFAStatus               *connect.Client[v1beta1.GetMFAStatusRequest, v1beta1.GetMFAStatusResponse]
+	enrollTOTP                 *connect.Client[v1beta1.EnrollTOTPRequest, v1beta1.EnrollTOTPResponse]
+	confirmTOTP                *connect.Client[v1beta1.ConfirmTOTPRequest, v1beta1.ConfirmTOTPResponse]
+	verifyMFA                  *connect.Client[v1beta1.VerifyMFARequest, v1beta1.VerifyMFAResponse]
+	regenerateMFARecoveryCodes *connect.Client[v1beta1.RegenerateMFARecoveryCodesRequest, v1beta1.RegenerateMFARecoveryCodesResponse]
+	disableTOTP                *connect.Client[v1beta1.DisableTOTPRequest, v1beta1.DisableTOTPResponse]
+}
+
+// GetMFAStatus calls raystack.frontier.v1beta1.MFAService.GetMFAStatus.
+func (c *mFAServiceClient) GetMFAStatus(ctx context.Context, req *connect.Request[v1beta1.GetMFAStatusRequest]) (*connect.Response[v1beta1.GetMFAStatusResponse], error) {
+	return c.getMFAStatus.CallUnary(ctx, req)
+}
+
+// EnrollTOTP calls raystack.frontier.v1beta1.MFAService.EnrollTOTP.
+func (c *mFAServiceClient) EnrollTOTP(ctx context.Context, req *connect.Request[v1beta1.EnrollTOTPRequest]) (*connect.Response[v1beta1.EnrollTOTPResponse], error) {
+	return c.enrollTOTP.CallUnary(ctx, req)
+}
+
+// ConfirmTOTP calls raystack.frontier.v1beta1.MFAService.ConfirmTOTP.
+func (c *mFAServiceClient) ConfirmTOTP(ctx context.Context, req *connect.Request[v1beta1.ConfirmTOTPRequest]) (*connect.Response[v1beta1.ConfirmTOTPResponse], error) {
+	return c.confirmTOTP.CallUnary(ctx, req)
+}
+
+// VerifyMFA calls raystack.frontier.v1beta1.MFAService.VerifyMFA.
+func (c *mFAServiceClient) VerifyMFA(ctx context.Context, req *connect.Request[v1beta1.VerifyMFARequest]) (*connect.Response[v1beta1.VerifyMFAResponse], error) {
+	return c.verifyMFA.CallUnary(ctx, req)
+}
+
+// RegenerateMFARecoveryCodes calls raystack.frontier.v1beta1.MFAService.RegenerateMFARecoveryCodes.
+func (c *mFAServiceClient) RegenerateMFARecoveryCodes(ctx context.Context, req *connect.Request[v1beta1.RegenerateMFARecoveryCodesRequest]) (*connect.Response[v1beta1.RegenerateMFARecoveryCodesResponse], error) {
+	return c.regenerateMFARecoveryCodes.CallUnary(ctx, req)
+}
+
+// DisableTOTP calls raystack.frontier.v1beta1.MFAService.DisableTOTP.
+func (c *mFAServiceClient) DisableTOTP(ctx context.Context, req *connect.Request[v1beta1.DisableTOTPRequest]) (*connect.Response[v1beta1.DisableTOTPResponse], error) {
+	return c.disableTOTP.CallUnary(ctx, req)
+}
+
+// MFAServiceHandler is an implementation of the raystack.frontier.v1beta1.MFAService service.
+type MFAServiceHandler interface {
+	// GetMFAStatus returns the enrollment of the user and the assurance level
+	// of the session
+	GetMFAStatus(context.Context, *connect.Request[v1beta1.GetMFAStatusRequest]) (*connect.Response[v1beta1.GetMFAStatusResponse], error)
+	// EnrollTOTP generates a new totp secret for the user to add to an
+	// authenticator app
+	EnrollTOTP(context.Context, *connect.Request[v1beta1.EnrollTOTPRequest]) (*connect.Response[v1beta1.EnrollTOTPResponse], error)
+	// ConfirmTOTP confirms the enrollment with the first code of the
+	// authenticator app, raises the session to aal2 and returns the recovery
+	// codes
+	ConfirmTOTP(context.Context, *connect.Request[v1beta1.ConfirmTOTPRequest]) (*connect.Response[v1beta1.ConfirmTOTPResponse], error)
+	// VerifyMFA verifies a totp or a recovery code and raises the session to
+	// aal2
+	VerifyMFA(context.Context, *connect.Request[v1beta1.VerifyMFARequest]) (*connect.Response[v1beta1.VerifyMFAResponse], error)
+	// RegenerateMFARecoveryCodes replaces the recovery codes of the user
+	RegenerateMFARecoveryCodes(context.Context, *connect.Request[v1beta1.RegenerateMFARecoveryCodesRequest]) (*connect.Response[v1beta1.RegenerateMFARecoveryCodesResponse], error)
+	// DisableTOTP removes the totp and the recovery codes of the user
+	DisableTOTP(context.Context, *connect.Request[v1beta1.DisableTOTPRequest]) (*connect.Response[v1beta1.DisableTOTPResponse], error)
+}
+
+// NewMFAServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMFAServiceHandler(svc MFAServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	mFAServiceMethods := v1beta1.File_raystack_frontier_v1beta1_mfa_proto.Services().ByName("MFAService").Methods()
+	mFAServiceGetMFAStatusHandler := connect.NewUnaryHandler(
+		MFAServiceGetMFAStatusProcedure,
+		svc.GetMFAStatus,
+		connect.WithSchema(mFAServiceMethods.ByName("GetMFAStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceEnrollTOTPHandler := connect.NewUnaryHandler(
+		MFAServiceEnrollTOTPProcedure,
+		svc.EnrollTOTP,
+		connect.WithSchema(mFAServiceMethods.ByName("EnrollTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceConfirmTOTPHandler := connect.NewUnaryHandler(
+		MFAServiceConfirmTOTPProcedure,
+		svc.ConfirmTOTP,
+		connect.WithSchema(mFAServiceMethods.ByName("ConfirmTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceVerifyMFAHandler := connect.NewUnaryHandler(
+		MFAServiceVerifyMFAProcedure,
+		svc.VerifyMFA,
+		connect.WithSchema(mFAServiceMethods.ByName("VerifyMFA")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceRegenerateMFARecoveryCodesHandler := connect.NewUnaryHandler(
+		MFAServiceRegenerateMFARecoveryCodesProcedure,
+		svc.RegenerateMFARecoveryCodes,
+		connect.WithSchema(mFAServiceMethods.ByName("RegenerateMFARecoveryCodes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mFAServiceDisableTOTPHandler := connect.NewUnaryHandler(
+		MFAServiceDisableTOTPProcedure,
+		svc.DisableTOTP,
+		connect.WithSchema(mFAServiceMethods.ByName("DisableTOTP")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.MFAService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MFAServiceGetMFAStatusProcedure:
+			mFAServiceGetMFAStatusHandler.ServeHTTP(w, r)
+		case MFAServiceEnrollTOTPProcedure:
+			mFAServiceEnrollTOTPHandler.ServeHTTP(w, r)
+		case MFAServiceConfirmTOTPProcedure:
+			mFAServiceConfirmTOTPHandler.ServeHTTP(w, r)
+		case MFAServiceVerifyMFAProcedure:
+			mFAServiceVerifyMFAHandler.ServeHTTP(w, r)
+		case MFAServiceRegenerateMFARecoveryCodesProcedure:
+			mFAServiceRegenerateMFARecoveryCodesHandler.ServeHTTP(w, r)
+		case MFAServiceDisableTOTPProcedure:
+			mFAServiceDisableTOTPHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMFAServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMFAServiceHandler struct{}
+
+func (UnimplementedMFAServiceHandler) GetMFAStatus(context.Context, *connect.Request[v1beta1.GetMFAStatusRequest]) (*connect.Response[v1beta1.GetMFAStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.MFAService.GetMFAStatus is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) EnrollTOTP(context.Context, *connect.Request[v1beta1.EnrollTOTPRequest]) (*connect.Response[v1beta1.EnrollTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.MFAService.EnrollTOTP is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) ConfirmTOTP(context.Context, *connect.Request[v1beta1.ConfirmTOTPRequest]) (*connect.Response[v1beta1.ConfirmTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.MFAService.ConfirmTOTP is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) VerifyMFA(context.Context, *connect.Request[v1beta1.VerifyMFARequest]) (*connect.Response[v1beta1.VerifyMFAResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.MFAService.VerifyMFA is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) RegenerateMFARecoveryCodes(context.Context, *connect.Request[v1beta1.RegenerateMFARecoveryCodesRequest]) (*connect.Response[v1beta1.RegenerateMFARecoveryCodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.MFAService.RegenerateMFARecoveryCodes is not implemented"))
+}
+
+func (UnimplementedMFAServiceHandler) DisableTOTP(context.Context, *connect.Request[v1beta1.DisableTOTPRequest]) (*connect.Response[v1beta1.DisableTOTPResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.MFAService.DisableTOTP is not implemented"))
+}
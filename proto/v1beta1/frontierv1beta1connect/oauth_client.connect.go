@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/oauth_client.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OAuthClientServiceName is the fully-qualified name of the OAuthClientService service.
+	OAuthClientServiceName = "raystack.frontier.v1beta1.OAuthClientService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OAuthClientServiceCreateOrganizationOAuthClientProcedure is the fully-qualified name of the
+	// OAuthClientService's CreateOrganizationOAuthClient RPC.
+	OAuthClientServiceCreateOrganizationOAuthClientProcedure = "/raystack.frontier.v1beta1.OAuthClientService/CreateOrganizationOAuthClient"
+	// OAuthClientServiceListOrganizationOAuthClientsProcedure is the fully-qualified name of the
+	// OAuthClientService's ListOrganizationOAuthClients RPC.
+	OAuthClientServiceListOrganizationOAuthClientsProcedure = "/raystack.frontier.v1beta1.OAuthClientService/ListOrganizationOAuthClients"
+	// OAuthClientServiceRotateOrganizationOAuthClientSecretProcedure is the fully-qualified name of the
+	// OAuthClientService's RotateOrganizationOAuthClientSecret RPC.
+	OAuthClientServiceRotateOrganizationOAuthClientSecretProcedure = "/raystack.frontier.v1beta1.OAuthClientService/RotateOrganizationOAuthClientSecret"
+	// OAuthClientServiceDeleteOrganizationOAuthClientProcedure is the fully-qualified name of the
+	// OAuthClientService's DeleteOrganizationOAuthClient RPC.
+	OAuthClientServiceDeleteOrganizationOAuthClientProcedure = "/raystack.frontier.v1beta1.OAuthClientService/DeleteOrganizationOAuthClient"
+)
+
+// OAuthClientServiceClient is a client for the raystack.frontier.v1beta1.OAuthClientService
+// service.
+type OAuthClientServiceClient interface {
+	// CreateOrganizationOAuthClient registers a client of an organization. The
+	// response holds the secret of a confidential client, it can't be read
+	// again.
+	CreateOrganizationOAuthClient(context.Context, *connect.Request[v1beta1.CreateOrganizationOAuthClientRequest]) (*connect.Response[v1beta1.CreateOrganizationOAuthClientResponse], error)
+	// ListOrganizationOAuthClients lists the clients of an organization
+	ListOrganizationOAuthClients(context.Context, *connect.Request[v1beta1.ListOrganizationOAuthClientsRequest]) (*connect.Response[v1beta1.ListOrganizationOAuthClientsResponse], error)
+	// RotateOrganizationOAuthClientSecret replaces the secret of a confidential
+	// client and returns the new one, the old secret stops working at once
+	RotateOrganizationOAuthClientSecret(context.Context, *connect.Request[v1beta1.RotateOrganizationOAuthClientSecretRequest]) (*connect.Response[v1beta1.RotateOrganizationOAuthClientSecretResponse], error)
+	// DeleteOrganizationOAuthClient deletes a client along with its consents
+	// and refresh tokens
+	DeleteOrganizationOAuthClient(context.Context, *connect.Request[v1beta1.DeleteOrganizationOAuthClientRequest]) (*connect.Response[v1beta1.DeleteOrganizationOAuthClientResponse], error)
+}
+
+// NewOAuthClientServiceClient constructs a client for the
+// raystack.frontier.v1beta1.OAuthClientService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOAuthClientServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OAuthClientServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	oAuthClientServiceMethods := v1beta1.File_raystack_frontier_v1beta1_oauth_client_proto.Services().ByName("OAuthClientService").Methods()
+	return &oAuthClientServiceClient{
+		createOrganizationOAuthClient: connect.NewClient[v1beta1.CreateOrganizationOAuthClientRequest, v1beta1.CreateOrganizationOAuthClientResponse](
+			httpClient,
+			baseURL+OAuthClientServiceCreateOrganizationOAuthClientProcedure,
+			connect.WithSchema(oAuthClientServiceMethods.ByName("CreateOrganizationOAuthClient")),
+			connect.WithClientOptions(opts...),
+		),
+		listOrganizationOAuthClients: connect.NewClient[v1beta1.ListOrganizationOAuthClientsRequest, v1beta1.ListOrganizationOAuthClientsResponse](
+			httpClient,
+			baseURL+OAuthClientServiceListOrganizationOAuthClientsProcedure,
+			connect.WithSchema(oAuthClientServiceMethods.ByName("ListOrganizationOAuthClients")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateOrganizationOAuthClientSecret: connect.NewClient[v1beta1.RotateOrganizationOAuthClientSecretRequest, v1beta1.RotateOrganizationOAuthClientSecretResponse](
+			httpClient,
+			baseURL+OAuthClientServiceRotateOrganizationOAuthClientSecretProcedure,
+			connect.WithSchema(oAuthClientServiceMethods.ByName("RotateOrganizationOAuthClientSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteOrganizationOAuthClient: connect.NewClient[v1beta1.DeleteOrganizationOAuthClientRequest, v1beta1.DeleteOrganizationOAuthClientResponse](
+			httpClient,
+			baseURL+OAuthClientServiceDeleteOrganizationOAuthClientProcedure,
+			connect.WithSchema(oAuthClientServiceMethods.ByName("DeleteOrganizationOAuthClient")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// oAuthClientServiceClient implements OAuthClientServiceClient.
+type oAuthClientServiceClient struct {
+	createOrganizationOAuthClient       *connect.Client[v1beta1.CreateOrganizationOAuthClientRequest, v1beta1.CreateOrganizationOAuthClientResponse]
+	listOrganizationOAuthClients        *connect.Client[v1beta1.ListOrganizationOAuthClientsRequest, v1beta1.ListOrganizationOAuthClientsResponse]
+	rotateOrganizationOAuthClientSecret *connect.Client[v1beta1.RotateOrganizationOAuthClientSecretRequest, v1beta1.RotateOrganizationOAuthClientSecretResponse]
+	deleteOrganizationOAuthClient       *connect.Client[v1beta1.DeleteOrganizationOAuthClientRequest, v1beta1.DeleteOrganizationOAuthClientResponse]
+}
+
+// CreateOrganizationOAuthClient calls
+// raystack.frontier.v1beta1.OAuthClientService.CreateOrganizationOAuthClient.
+func (c *oAuthClientServiceClient) CreateOrganizationOAuthClient(ctx context.Context, req *connect.Request[v1beta1.CreateOrganizationOAuthClientRequest]) (*connect.Response[v1beta1.CreateOrganizationOAuthClientResponse], error) {
+	return c.createOrganizationOAuthClient.CallUnary(ctx, req)
+}
+
+// ListOrganizationOAuthClients calls
+// raystack.frontier.v1beta1.OAuthClientService.ListOrganizationOAuthClients.
+func (c *oAuthClientServiceClient) ListOrganizationOAuthClients(ctx context.Context, req *connect.Request[v1beta1.ListOrganizationOAuthClientsRequest]) (*connect.Response[v1beta1.ListOrganizationOAuthClientsResponse], error) {
+	return c.listOrganizationOAuthClients.CallUnary(ctx, req)
+}
+
+// RotateOrganizationOAuthClientSecret calls
+// raystack.frontier.v1beta1.OAuthClientService.RotateOrganizationOAuthClientSecret.
+func (c *oAuthClientServiceClient) RotateOrganizationOAuthClientSecret(ctx context.Context, req *connect.Request[v1beta1.RotateOrganizationOAuthClientSecretRequest]) (*connect.Response[v1beta1.RotateOrganizationOAuthClientSecretResponse], error) {
+	return c.rotateOrganizationOAuthClientSecret.CallUnary(ctx, req)
+}
+
+// DeleteOrganizationOAuthClient calls
+// raystack.frontier.v1beta1.OAuthClientService.DeleteOrganizationOAuthClient.
+func (c *oAuthClientServiceClient) DeleteOrganizationOAuthClient(ctx context.Context, req *connect.Request[v1beta1.DeleteOrganizationOAuthClientRequest]) (*connect.Response[v1beta1.DeleteOrganizationOAuthClientResponse], error) {
+	return c.deleteOrganizationOAuthClient.CallUnary(ctx, req)
+}
+
+// OAuthClientServiceHandler is an implementation of the
+// raystack.frontier.v1beta1.OAuthClientService service.
+type OAuthClientServiceHandler interface {
+	// CreateOrganizationOAuthClient registers a client of an organization. The
+	// response holds the secret of a confidential client, it can't be read
+	// again.
+	CreateOrganizationOAuthClient(context.Context, *connect.Request[v1beta1.CreateOrganizationOAuthClientRequest]) (*connect.Response[v1beta1.CreateOrganizationOAuthClientResponse], error)
+	// ListOrganizationOAuthClients lists the clients of an organization
+	ListOrganizationOAuthClients(context.Context, *connect.Request[v1beta1.ListOrganizationOAuthClientsRequest]) (*connect.Response[v1beta1.ListOrganizationOAuthClientsResponse], error)
+	// RotateOrganizationOAuthClientSecret replaces the secret of a confidential
+	// client and returns the new one, the old secret stops working at once
+	RotateOrganizationOAuthClientSecret(context.Context, *connect.Request[v1beta1.RotateOrganizationOAuthClientSecretRequest]) (*connect.Response[v1beta1.RotateOrganizationOAuthClientSecretResponse], error)
+	// DeleteOrganizationOAuthClient deletes a client along with its consents
+	// and refresh tokens
+	DeleteOrganizationOAuthClient(context.Context, *connect.Request[v1beta1.DeleteOrganizationOAuthClientRequest]) (*connect.Response[v1beta1.DeleteOrganizationOAuthClientResponse], error)
+}
+
+// NewOAuthClientServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOAuthClientServiceHandler(svc OAuthClientServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	oAuthClientServiceMethods := v1beta1.File_raystack_frontier_v1beta1_oauth_client_proto.Services().ByName("OAuthClientService").Methods()
+	oAuthClientServiceCreateOrganizationOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthClientServiceCreateOrganizationOAuthClientProcedure,
+		svc.CreateOrganizationOAuthClient,
+		connect.WithSchema(oAuthClientServiceMethods.ByName("CreateOrganizationOAuthClient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthClientServiceListOrganizationOAuthClientsHandler := connect.NewUnaryHandler(
+		OAuthClientServiceListOrganizationOAuthClientsProcedure,
+		svc.ListOrganizationOAuthClients,
+		connect.WithSchema(oAuthClientServiceMethods.ByName("ListOrganizationOAuthClients")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthClientServiceRotateOrganizationOAuthClientSecretHandler := connect.NewUnaryHandler(
+		OAuthClientServiceRotateOrganizationOAuthClientSecretProcedure,
+		svc.RotateOrganizationOAuthClientSecret,
+		connect.WithSchema(oAuthClientServiceMethods.ByName("RotateOrganizationOAuthClientSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthClientServiceDeleteOrganizationOAuthClientHandler := connect.NewUnaryHandler(
+		OAuthClientServiceDeleteOrganizationOAuthClientProcedure,
+		svc.DeleteOrganizationOAuthClient,
+		connect.WithSchema(oAuthClientServiceMethods.ByName("DeleteOrganizationOAuthClient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.OAuthClientService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OAuthClientServiceCreateOrganizationOAuthClientProcedure:
+			oAuthClientServiceCreateOrganizationOAuthClientHandler.ServeHTTP(w, r)
+		case OAuthClientServiceListOrganizationOAuthClientsProcedure:
+			oAuthClientServiceListOrganizationOAuthClientsHandler.ServeHTTP(w, r)
+		case OAuthClientServiceRotateOrganizationOAuthClientSecretProcedure:
+			oAuthClientServiceRotateOrganizationOAuthClientSecretHandler.ServeHTTP(w, r)
+		case OAuthClientServiceDeleteOrganizationOAuthClientProcedure:
+			oAuthClientServiceDeleteOrganizationOAuthClientHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOAuthClientServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOAuthClientServiceHandler struct{}
+
+func (UnimplementedOAuthClientServiceHandler) CreateOrganizationOAuthClient(context.Context, *connect.Request[v1beta1.CreateOrganizationOAuthClientRequest]) (*connect.Response[v1beta1.CreateOrganizationOAuthClientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.OAuthClientService.CreateOrganizationOAuthClient is not implemented"))
+}
+
+func (UnimplementedOAuthClientServiceHandler) ListOrganizationOAuthClients(context.Context, *connect.Request[v1beta1.ListOrganizationOAuthClientsRequest]) (*connect.Response[v1beta1.ListOrganizationOAuthClientsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.OAuthClientService.ListOrganizationOAuthClients is not implemented"))
+}
+
+func (UnimplementedOAuthClientServiceHandler) RotateOrganizationOAuthClientSecret(context.Context, *connect.Request[v1beta1.RotateOrganizationOAuthClientSecretRequest]) (*connect.Response[v1beta1.RotateOrganizationOAuthClientSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.OAuthClientService.RotateOrganizationOAuthClientSecret is not implemented"))
+}
+
+func (UnimplementedOAuthClientServiceHandler) DeleteOrganizationOAuthClient(context.Context, *connect.Request[v1beta1.DeleteOrganizationOAuthClientRequest]) (*connect.Response[v1beta1.DeleteOrganizationOAuthClientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.OAuthClientService.DeleteOrganizationOAuthClient is not implemented"))
+}
@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/oauth_consent.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// OAuthConsentServiceName is the fully-qualified name of the OAuthConsentService service.
+	OAuthConsentServiceName = "raystack.frontier.v1beta1.OAuthConsentService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// OAuthConsentServiceGetOAuthConsentRequestProcedure is the fully-qualified name of the
+	// OAuthConsentService's GetOAuthConsentRequest RPC.
+	OAuthConsentServiceGetOAuthConsentRequestProcedure = "/raystack.frontier.v1beta1.OAuthConsentService/GetOAuthConsentRequest"
+	// OAuthConsentServiceDecideOAuthConsentProcedure is the fully-qualified name of the
+	// OAuthConsentService's DecideOAuthConsent RPC.
+	OAuthConsentServiceDecideOAuthConsentProcedure = "/raystack.frontier.v1beta1.OAuthConsentService/DecideOAuthConsent"
+)
+
+// OAuthConsentServiceClient is a client for the raystack.frontier.v1beta1.OAuthConsentService
+// service.
+type OAuthConsentServiceClient interface {
+	// GetOAuthConsentRequest returns the pending authorization of the current
+	// user, the client asking for it and the scopes it asks for
+	GetOAuthConsentRequest(context.Context, *connect.Request[v1beta1.GetOAuthConsentRequestRequest]) (*connect.Response[v1beta1.GetOAuthConsentRequestResponse], error)
+	// DecideOAuthConsent approves or denies a pending authorization of the
+	// current user and returns the url the user is sent back to the client with
+	DecideOAuthConsent(context.Context, *connect.Request[v1beta1.DecideOAuthConsentRequest]) (*connect.Response[v1beta1.DecideOAuthConsentResponse], error)
+}
+
+// NewOAuthConsentServiceClient constructs a client for the
+// raystack.frontier.v1beta1.OAuthConsentService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewOAuthConsentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) OAuthConsentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	oAuthConsentServiceMethods := v1beta1.File_raystack_frontier_v1beta1_oauth_consent_proto.Services().ByName("OAuthConsentService").Methods()
+	return &oAuthConsentServiceClient{
+		getOAuthConsentRequest: connect.NewClient[v1beta1.GetOAuthConsentRequestRequest, v1beta1.GetOAuthConsentRequestResponse](
+			httpClient,
+			baseURL+OAuthConsentServiceGetOAuthConsentRequestProcedure,
+			connect.WithSchema(oAuthConsentServiceMethods.ByName("GetOAuthConsentRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		decideOAuthConsent: connect.NewClient[v1beta1.DecideOAuthConsentRequest, v1beta1.DecideOAuthConsentResponse](
+			httpClient,
+			baseURL+OAuthConsentServiceDecideOAuthConsentProcedure,
+			connect.WithSchema(oAuthConsentServiceMethods.ByName("DecideOAuthConsent")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// oAuthConsentServiceClient implements OAuthConsentServiceClient.
+type oAuthConsentServiceClient struct {
+	getOAuthConsentRequest *connect.Client[v1beta1.GetOAuthConsentRequestRequest, v1beta1.GetOAuthConsentRequestResponse]
+	decideOAuthConsent     *connect.Client[v1beta1.DecideOAuthConsentRequest, v1beta1.DecideOAuthConsentResponse]
+}
+
+// GetOAuthConsentRequest calls
+// raystack.frontier.v1beta1.OAuthConsentService.GetOAuthConsentRequest.
+func (c *oAuthConsentServiceClient) GetOAuthConsentRequest(ctx context.Context, req *connect.Request[v1beta1.GetOAuthConsentRequestRequest]) (*connect.Response[v1beta1.GetOAuthConsentRequestResponse], error) {
+	return c.getOAuthConsentRequest.CallUnary(ctx, req)
+}
+
+// DecideOAuthConsent calls raystack.frontier.v1beta1.OAuthConsentService.DecideOAuthConsent.
+func (c *oAuthConsentServiceClient) DecideOAuthConsent(ctx context.Context, req *connect.Request[v1beta1.DecideOAuthConsentRequest]) (*connect.Response[v1beta1.DecideOAuthConsentResponse], error) {
+	return c.decideOAuthConsent.CallUnary(ctx, req)
+}
+
+// OAuthConsentServiceHandler is an implementation of the
+// raystack.frontier.v1beta1.OAuthConsentService service.
+type OAuthConsentServiceHandler interface {
+	// GetOAuthConsentRequest returns the pending authorization of the current
+	// user, the client asking for it and the scopes it asks for
+	GetOAuthConsentRequest(context.Context, *connect.Request[v1beta1.GetOAuthConsentRequestRequest]) (*connect.Response[v1beta1.GetOAuthConsentRequestResponse], error)
+	// DecideOAuthConsent approves or denies a pending authorization of the
+	// current user and returns the url the user is sent back to the client with
+	DecideOAuthConsent(context.Context, *connect.Request[v1beta1.DecideOAuthConsentRequest]) (*connect.Response[v1beta1.DecideOAuthConsentResponse], error)
+}
+
+// NewOAuthConsentServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewOAuthConsentServiceHandler(svc OAuthConsentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	oAuthConsentServiceMethods := v1beta1.File_raystack_frontier_v1beta1_oauth_consent_proto.Services().ByName("OAuthConsentService").Methods()
+	oAuthConsentServiceGetOAuthConsentRequestHandler := connect.NewUnaryHandler(
+		OAuthConsentServiceGetOAuthConsentRequestProcedure,
+		svc.GetOAuthConsentRequest,
+		connect.WithSchema(oAuthConsentServiceMethods.ByName("GetOAuthConsentRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oAuthConsentServiceDecideOAuthConsentHandler := connect.NewUnaryHandler(
+		OAuthConsentServiceDecideOAuthConsentProcedure,
+		svc.DecideOAuthConsent,
+		connect.WithSchema(oAuthConsentServiceMethods.ByName("DecideOAuthConsent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.OAuthConsentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case OAuthConsentServiceGetOAuthConsentRequestProcedure:
+			oAuthConsentServiceGetOAuthConsentRequestHandler.ServeHTTP(w, r)
+		case OAuthConsentServiceDecideOAuthConsentProcedure:
+			oAuthConsentServiceDecideOAuthConsentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedOAuthConsentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedOAuthConsentServiceHandler struct{}
+
+func (UnimplementedOAuthConsentServiceHandler) GetOAuthConsentRequest(context.Context, *connect.Request[v1beta1.GetOAuthConsentRequestRequest]) (*connect.Response[v1beta1.GetOAuthConsentRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.OAuthConsentService.GetOAuthConsentRequest is not implemented"))
+}
+
+func (UnimplementedOAuthConsentServiceHandler) DecideOAuthConsent(context.Context, *connect.Request[v1beta1.DecideOAuthConsentRequest]) (*connect.Response[v1beta1.DecideOAuthConsentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.OAuthConsentService.DecideOAuthConsent is not implemented"))
+}
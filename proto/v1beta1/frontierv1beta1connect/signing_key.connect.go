@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/signing_key.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SigningKeyServiceName is the fully-qualified name of the SigningKeyService service.
+	SigningKeyServiceName = "raystack.frontier.v1beta1.SigningKeyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SigningKeyServiceRotateSigningKeyProcedure is the fully-qualified name of the SigningKeyService's
+	// RotateSigningKey RPC.
+	SigningKeyServiceRotateSigningKeyProcedure = "/raystack.frontier.v1beta1.SigningKeyService/RotateSigningKey"
+)
+
+// SigningKeyServiceClient is a client for the raystack.frontier.v1beta1.SigningKeyService service.
+type SigningKeyServiceClient interface {
+	// RotateSigningKey publishes a key replacing the key signing access tokens.
+	// The key signs tokens a sync interval later, once every running server
+	// loaded it. A pending key published for that long replaces the active key
+	// at once. The replaced key is published till the tokens it signed expire.
+	RotateSigningKey(context.Context, *connect.Request[v1beta1.RotateSigningKeyRequest]) (*connect.Response[v1beta1.RotateSigningKeyResponse], error)
+}
+
+// NewSigningKeyServiceClient constructs a client for the
+// raystack.frontier.v1beta1.SigningKeyService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSigningKeyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SigningKeyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	signingKeyServiceMethods := v1beta1.File_raystack_frontier_v1beta1_signing_key_proto.Services().ByName("SigningKeyService").Methods()
+	return &signingKeyServiceClient{
+		rotateSigningKey: connect.NewClient[v1beta1.RotateSigningKeyRequest, v1beta1.RotateSigningKeyResponse](
+			httpClient,
+			baseURL+SigningKeyServiceRotateSigningKeyProcedure,
+			connect.WithSchema(signingKeyServiceMethods.ByName("RotateSigningKey")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// signingKeyServiceClient implements SigningKeyServiceClient.
+type signingKeyServiceClient struct {
+	rotateSigningKey *connect.Client[v1beta1.RotateSigningKeyRequest, v1beta1.RotateSigningKeyResponse]
+}
+
+// RotateSigningKey calls raystack.frontier.v1beta1.SigningKeyService.RotateSigningKey.
+func (c *signingKeyServiceClient) RotateSigningKey(ctx context.Context, req *connect.Request[v1beta1.RotateSigningKeyRequest]) (*connect.Response[v1beta1.RotateSigningKeyResponse], error) {
+	return c.rotateSigningKey.CallUnary(ctx, req)
+}
+
+// SigningKeyServiceHandler is an implementation of the raystack.frontier.v1beta1.SigningKeyService
+// service.
+type SigningKeyServiceHandler interface {
+	// RotateSigningKey publishes a key replacing the key signing access tokens.
+	// The key signs tokens a sync interval later, once every running server
+	// loaded it. A pending key published for that long replaces the active key
+	// at once. The replaced key is published till the tokens it signed expire.
+	RotateSigningKey(context.Context, *connect.Request[v1beta1.RotateSigningKeyRequest]) (*connect.Response[v1beta1.RotateSigningKeyResponse], error)
+}
+
+// NewSigningKeyServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSigningKeyServiceHandler(svc SigningKeyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	signingKeyServiceMethods := v1beta1.File_raystack_frontier_v1beta1_signing_key_proto.Services().ByName("SigningKeyService").Methods()
+	signingKeyServiceRotateSigningKeyHandler := connect.NewUnaryHandler(
+		SigningKeyServiceRotateSigningKeyProcedure,
+		svc.RotateSigningKey,
+		connect.WithSchema(signingKeyServiceMethods.ByName("RotateSigningKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.SigningKeyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SigningKeyServiceRotateSigningKeyProcedure:
+			signingKeyServiceRotateSigningKeyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSigningKeyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSigningKeyServiceHandler struct{}
+
+func (UnimplementedSigningKeyServiceHandler) RotateSigningKey(context.Context, *connect.Request[v1beta1.RotateSigningKeyRequest]) (*connect.Response[v1beta1.RotateSigningKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.SigningKeyService.RotateSigningKey is not implemented"))
+}
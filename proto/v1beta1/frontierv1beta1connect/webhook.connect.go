@@ -0,0 +1,576 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: raystack/frontier/v1beta1/webhook.proto
+
+package frontierv1beta1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1beta1 "github.com/raystack/frontier/proto/v1beta1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WebhookServiceName is the fully-qualified name of the WebhookService service.
+	WebhookServiceName = "raystack.frontier.v1beta1.WebhookService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WebhookServiceCreateOrganizationWebhookProcedure is the fully-qualified name of the
+	// WebhookService's CreateOrganizationWebhook RPC.
+	WebhookServiceCreateOrganizationWebhookProcedure = "/raystack.frontier.v1beta1.WebhookService/CreateOrganizationWebhook"
+	// WebhookServiceListOrganizationWebhooksProcedure is the fully-qualified name of the
+	// WebhookService's ListOrganizationWebhooks RPC.
+	WebhookServiceListOrganizationWebhooksProcedure = "/raystack.frontier.v1beta1.WebhookService/ListOrganizationWebhooks"
+	// WebhookServiceUpdateOrganizationWebhookProcedure is the fully-qualified name of the
+	// WebhookService's UpdateOrganizationWebhook RPC.
+	WebhookServiceUpdateOrganizationWebhookProcedure = "/raystack.frontier.v1beta1.WebhookService/UpdateOrganizationWebhook"
+	// WebhookServiceDeleteOrganizationWebhookProcedure is the fully-qualified name of the
+	// WebhookService's DeleteOrganizationWebhook RPC.
+	WebhookServiceDeleteOrganizationWebhookProcedure = "/raystack.frontier.v1beta1.WebhookService/DeleteOrganizationWebhook"
+	// WebhookServiceRotateWebhookSecretProcedure is the fully-qualified name of the WebhookService's
+	// RotateWebhookSecret RPC.
+	WebhookServiceRotateWebhookSecretProcedure = "/raystack.frontier.v1beta1.WebhookService/RotateWebhookSecret"
+	// WebhookServiceRevokeWebhookSecretProcedure is the fully-qualified name of the WebhookService's
+	// RevokeWebhookSecret RPC.
+	WebhookServiceRevokeWebhookSecretProcedure = "/raystack.frontier.v1beta1.WebhookService/RevokeWebhookSecret"
+	// WebhookServiceGetWebhookOptionsProcedure is the fully-qualified name of the WebhookService's
+	// GetWebhookOptions RPC.
+	WebhookServiceGetWebhookOptionsProcedure = "/raystack.frontier.v1beta1.WebhookService/GetWebhookOptions"
+	// WebhookServiceUpdateWebhookFiltersProcedure is the fully-qualified name of the WebhookService's
+	// UpdateWebhookFilters RPC.
+	WebhookServiceUpdateWebhookFiltersProcedure = "/raystack.frontier.v1beta1.WebhookService/UpdateWebhookFilters"
+	// WebhookServiceUpdateWebhookPayloadFormatProcedure is the fully-qualified name of the
+	// WebhookService's UpdateWebhookPayloadFormat RPC.
+	WebhookServiceUpdateWebhookPayloadFormatProcedure = "/raystack.frontier.v1beta1.WebhookService/UpdateWebhookPayloadFormat"
+	// WebhookServiceSendTestWebhookEventProcedure is the fully-qualified name of the WebhookService's
+	// SendTestWebhookEvent RPC.
+	WebhookServiceSendTestWebhookEventProcedure = "/raystack.frontier.v1beta1.WebhookService/SendTestWebhookEvent"
+	// WebhookServiceListWebhookDeliveriesProcedure is the fully-qualified name of the WebhookService's
+	// ListWebhookDeliveries RPC.
+	WebhookServiceListWebhookDeliveriesProcedure = "/raystack.frontier.v1beta1.WebhookService/ListWebhookDeliveries"
+	// WebhookServiceGetWebhookDeliveryProcedure is the fully-qualified name of the WebhookService's
+	// GetWebhookDelivery RPC.
+	WebhookServiceGetWebhookDeliveryProcedure = "/raystack.frontier.v1beta1.WebhookService/GetWebhookDelivery"
+	// WebhookServiceListWebhookDeliveryAttemptsProcedure is the fully-qualified name of the
+	// WebhookService's ListWebhookDeliveryAttempts RPC.
+	WebhookServiceListWebhookDeliveryAttemptsProcedure = "/raystack.frontier.v1beta1.WebhookService/ListWebhookDeliveryAttempts"
+	// WebhookServiceRedeliverWebhookDeliveryProcedure is the fully-qualified name of the
+	// WebhookService's RedeliverWebhookDelivery RPC.
+	WebhookServiceRedeliverWebhookDeliveryProcedure = "/raystack.frontier.v1beta1.WebhookService/RedeliverWebhookDelivery"
+	// WebhookServiceRedeliverWebhookDeliveriesProcedure is the fully-qualified name of the
+	// WebhookService's RedeliverWebhookDeliveries RPC.
+	WebhookServiceRedeliverWebhookDeliveriesProcedure = "/raystack.frontier.v1beta1.WebhookService/RedeliverWebhookDeliveries"
+)
+
+// WebhookServiceClient is a client for the raystack.frontier.v1beta1.WebhookService service.
+type WebhookServiceClient interface {
+	// CreateOrganizationWebhook registers a webhook receiving the events of an
+	// organization. The response holds the signing secret of the webhook.
+	CreateOrganizationWebhook(context.Context, *connect.Request[v1beta1.CreateOrganizationWebhookRequest]) (*connect.Response[v1beta1.CreateOrganizationWebhookResponse], error)
+	// ListOrganizationWebhooks lists the webhooks of an organization
+	ListOrganizationWebhooks(context.Context, *connect.Request[v1beta1.ListOrganizationWebhooksRequest]) (*connect.Response[v1beta1.ListOrganizationWebhooksResponse], error)
+	// UpdateOrganizationWebhook updates a webhook of an organization
+	UpdateOrganizationWebhook(context.Context, *connect.Request[v1beta1.UpdateOrganizationWebhookRequest]) (*connect.Response[v1beta1.UpdateOrganizationWebhookResponse], error)
+	// DeleteOrganizationWebhook deletes a webhook of an organization
+	DeleteOrganizationWebhook(context.Context, *connect.Request[v1beta1.DeleteOrganizationWebhookRequest]) (*connect.Response[v1beta1.DeleteOrganizationWebhookResponse], error)
+	// RotateWebhookSecret adds a new signing secret to a webhook and returns its
+	// value. Events are signed with every secret until the old one is revoked.
+	RotateWebhookSecret(context.Context, *connect.Request[v1beta1.RotateWebhookSecretRequest]) (*connect.Response[v1beta1.RotateWebhookSecretResponse], error)
+	// RevokeWebhookSecret removes a signing secret from a webhook, the last
+	// secret of a webhook can't be revoked
+	RevokeWebhookSecret(context.Context, *connect.Request[v1beta1.RevokeWebhookSecretRequest]) (*connect.Response[v1beta1.RevokeWebhookSecretResponse], error)
+	// GetWebhookOptions returns the delivery options of a webhook that are not
+	// part of the webhook itself
+	GetWebhookOptions(context.Context, *connect.Request[v1beta1.GetWebhookOptionsRequest]) (*connect.Response[v1beta1.GetWebhookOptionsResponse], error)
+	// UpdateWebhookFilters replaces the filters of a webhook, an empty map
+	// removes them
+	UpdateWebhookFilters(context.Context, *connect.Request[v1beta1.UpdateWebhookFiltersRequest]) (*connect.Response[v1beta1.UpdateWebhookFiltersResponse], error)
+	// UpdateWebhookPayloadFormat changes the format events are sent in, queued
+	// deliveries keep the format they were rendered in
+	UpdateWebhookPayloadFormat(context.Context, *connect.Request[v1beta1.UpdateWebhookPayloadFormatRequest]) (*connect.Response[v1beta1.UpdateWebhookPayloadFormatResponse], error)
+	// SendTestWebhookEvent synchronously sends a signed app.webhook.test event
+	// to a webhook, also when it is disabled, and returns the outcome. The event
+	// is not queued or retried.
+	SendTestWebhookEvent(context.Context, *connect.Request[v1beta1.SendTestWebhookEventRequest]) (*connect.Response[v1beta1.SendTestWebhookEventResponse], error)
+	// ListWebhookDeliveries lists the deliveries of events to webhooks, most
+	// recent first
+	ListWebhookDeliveries(context.Context, *connect.Request[v1beta1.ListWebhookDeliveriesRequest]) (*connect.Response[v1beta1.ListWebhookDeliveriesResponse], error)
+	// GetWebhookDelivery returns a delivery with its payload
+	GetWebhookDelivery(context.Context, *connect.Request[v1beta1.GetWebhookDeliveryRequest]) (*connect.Response[v1beta1.GetWebhookDeliveryResponse], error)
+	// ListWebhookDeliveryAttempts lists every attempt made for a delivery,
+	// oldest first
+	ListWebhookDeliveryAttempts(context.Context, *connect.Request[v1beta1.ListWebhookDeliveryAttemptsRequest]) (*connect.Response[v1beta1.ListWebhookDeliveryAttemptsResponse], error)
+	// RedeliverWebhookDelivery queues a delivery to be sent again with a fresh
+	// attempt budget
+	RedeliverWebhookDelivery(context.Context, *connect.Request[v1beta1.RedeliverWebhookDeliveryRequest]) (*connect.Response[v1beta1.RedeliverWebhookDeliveryResponse], error)
+	// RedeliverWebhookDeliveries queues every delivery created within a time
+	// range to be sent again, e.g. after an outage of the webhook
+	RedeliverWebhookDeliveries(context.Context, *connect.Request[v1beta1.RedeliverWebhookDeliveriesRequest]) (*connect.Response[v1beta1.RedeliverWebhookDeliveriesResponse], error)
+}
+
+// NewWebhookServiceClient constructs a client for the raystack.frontier.v1beta1.WebhookService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhookServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WebhookServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	webhookServiceMethods := v1beta1.File_raystack_frontier_v1beta1_webhook_proto.Services().ByName("WebhookService").Methods()
+	return &webhookServiceClient{
+		createOrganizationWebhook: connect.NewClient[v1beta1.CreateOrganizationWebhookRequest, v1beta1.CreateOrganizationWebhookResponse](
+			httpClient,
+			baseURL+WebhookServiceCreateOrganizationWebhookProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("CreateOrganizationWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listOrganizationWebhooks: connect.NewClient[v1beta1.ListOrganizationWebhooksRequest, v1beta1.ListOrganizationWebhooksResponse](
+			httpClient,
+			baseURL+WebhookServiceListOrganizationWebhooksProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListOrganizationWebhooks")),
+			connect.WithClientOptions(opts...),
+		),
+		updateOrganizationWebhook: connect.NewClient[v1beta1.UpdateOrganizationWebhookRequest, v1beta1.UpdateOrganizationWebhookResponse](
+			httpClient,
+			baseURL+WebhookServiceUpdateOrganizationWebhookProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("UpdateOrganizationWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteOrganizationWebhook: connect.NewClient[v1beta1.DeleteOrganizationWebhookRequest, v1beta1.DeleteOrganizationWebhookResponse](
+			httpClient,
+			baseURL+WebhookServiceDeleteOrganizationWebhookProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("DeleteOrganizationWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateWebhookSecret: connect.NewClient[v1beta1.RotateWebhookSecretRequest, v1beta1.RotateWebhookSecretResponse](
+			httpClient,
+			baseURL+WebhookServiceRotateWebhookSecretProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("RotateWebhookSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeWebhookSecret: connect.NewClient[v1beta1.RevokeWebhookSecretRequest, v1beta1.RevokeWebhookSecretResponse](
+			httpClient,
+			baseURL+WebhookServiceRevokeWebhookSecretProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("RevokeWebhookSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhookOptions: connect.NewClient[v1beta1.GetWebhookOptionsRequest, v1beta1.GetWebhookOptionsResponse](
+			httpClient,
+			baseURL+WebhookServiceGetWebhookOptionsProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("GetWebhookOptions")),
+			connect.WithClientOptions(opts...),
+		),
+		updateWebhookFilters: connect.NewClient[v1beta1.UpdateWebhookFiltersRequest, v1beta1.UpdateWebhookFiltersResponse](
+			httpClient,
+			baseURL+WebhookServiceUpdateWebhookFiltersProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("UpdateWebhookFilters")),
+			connect.WithClientOptions(opts...),
+		),
+		updateWebhookPayloadFormat: connect.NewClient[v1beta1.UpdateWebhookPayloadFormatRequest, v1beta1.UpdateWebhookPayloadFormatResponse](
+			httpClient,
+			baseURL+WebhookServiceUpdateWebhookPayloadFormatProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("UpdateWebhookPayloadFormat")),
+			connect.WithClientOptions(opts...),
+		),
+		sendTestWebhookEvent: connect.NewClient[v1beta1.SendTestWebhookEventRequest, v1beta1.SendTestWebhookEventResponse](
+			httpClient,
+			baseURL+WebhookServiceSendTestWebhookEventProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("SendTestWebhookEvent")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[v1beta1.ListWebhookDeliveriesRequest, v1beta1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+WebhookServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhookDelivery: connect.NewClient[v1beta1.GetWebhookDeliveryRequest, v1beta1.GetWebhookDeliveryResponse](
+			httpClient,
+			baseURL+WebhookServiceGetWebhookDeliveryProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("GetWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveryAttempts: connect.NewClient[v1beta1.ListWebhookDeliveryAttemptsRequest, v1beta1.ListWebhookDeliveryAttemptsResponse](
+			httpClient,
+			baseURL+WebhookServiceListWebhookDeliveryAttemptsProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeliveryAttempts")),
+			connect.WithClientOptions(opts...),
+		),
+		redeliverWebhookDelivery: connect.NewClient[v1beta1.RedeliverWebhookDeliveryRequest, v1beta1.RedeliverWebhookDeliveryResponse](
+			httpClient,
+			baseURL+WebhookServiceRedeliverWebhookDeliveryProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("RedeliverWebhookDelivery")),
+			connect.WithClientOptions(opts...),
+		),
+		redeliverWebhookDeliveries: connect.NewClient[v1beta1.RedeliverWebhookDeliveriesRequest, v1beta1.RedeliverWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+WebhookServiceRedeliverWebhookDeliveriesProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("RedeliverWebhookDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// webhookServiceClient implements WebhookServiceClient.
+type webhookServiceClient struct {
+	createOrganizationWebhook   *connect.Client[v1beta1.CreateOrganizationWebhookRequest, v1beta1.CreateOrganizationWebhookResponse]
+	listOrganizationWebhooks    *connect.Client[v1beta1.ListOrganizationWebhooksRequest, v1beta1.ListOrganizationWebhooksResponse]
+	updateOrganizationWebhook   *connect.Client[v1beta1.UpdateOrganizationWebhookRequest, v1beta1.UpdateOrganizationWebhookResponse]
+	deleteOrganizationWebhook   *connect.Client[v1beta1.DeleteOrganizationWebhookRequest, v1beta1.DeleteOrganizationWebhookResponse]
+	rotateWebhookSecret         *connect.Client[v1beta1.RotateWebhookSecretRequest, v1beta1.RotateWebhookSecretResponse]
+	revokeWebhookSecret         *connect.Client[v1beta1.RevokeWebhookSecretRequest, v1beta1.RevokeWebhookSecretResponse]
+	getWebhookOptions           *connect.Client[v1beta1.GetWebhookOptionsRequest, v1beta1.GetWebhookOptionsResponse]
+	updateWebhookFilters        *connect.Client[v1beta1.UpdateWebhookFiltersRequest, v1beta1.UpdateWebhookFiltersResponse]
+	updateWebhookPayloadFormat  *connect.Client[v1beta1.UpdateWebhookPayloadFormatRequest, v1beta1.UpdateWebhookPayloadFormatResponse]
+	sendTestWebhookEvent        *connect.Client[v1beta1.SendTestWebhookEventRequest, v1beta1.SendTestWebhookEventResponse]
+	listWebhookDeliveries       *connect.Client[v1beta1.ListWebhookDeliveriesRequest, v1beta1.ListWebhookDeliveriesResponse]
+	getWebhookDelivery          *connect.Client[v1beta1.GetWebhookDeliveryRequest, v1beta1.GetWebhookDeliveryResponse]
+	listWebhookDeliveryAttempts *connect.Client[v1beta1.ListWebhookDeliveryAttemptsRequest, v1beta1.ListWebhookDeliveryAttemptsResponse]
+	redeliverWebhookDelivery    *connect.Client[v1beta1.RedeliverWebhookDeliveryRequest, v1beta1.RedeliverWebhookDeliveryResponse]
+	redeliverWebhookDeliveries  *connect.Client[v1beta1.RedeliverWebhookDeliveriesRequest, v1beta1.RedeliverWebhookDeliveriesResponse]
+}
+
+// CreateOrganizationWebhook calls
+// raystack.frontier.v1beta1.WebhookService.CreateOrganizationWebhook.
+func (c *webhookServiceClient) CreateOrganizationWebhook(ctx context.Context, req *connect.Request[v1beta1.CreateOrganizationWebhookRequest]) (*connect.Response[v1beta1.CreateOrganizationWebhookResponse], error) {
+	return c.createOrganizationWebhook.CallUnary(ctx, req)
+}
+
+// ListOrganizationWebhooks calls raystack.frontier.v1beta1.WebhookService.ListOrganizationWebhooks.
+func (c *webhookServiceClient) ListOrganizationWebhooks(ctx context.Context, req *connect.Request[v1beta1.ListOrganizationWebhooksRequest]) (*connect.Response[v1beta1.ListOrganizationWebhooksResponse], error) {
+	return c.listOrganizationWebhooks.CallUnary(ctx, req)
+}
+
+// UpdateOrganizationWebhook calls
+// raystack.frontier.v1beta1.WebhookService.UpdateOrganizationWebhook.
+func (c *webhookServiceClient) UpdateOrganizationWebhook(ctx context.Context, req *connect.Request[v1beta1.UpdateOrganizationWebhookRequest]) (*connect.Response[v1beta1.UpdateOrganizationWebhookResponse], error) {
+	return c.updateOrganizationWebhook.CallUnary(ctx, req)
+}
+
+// DeleteOrganizationWebhook calls
+// raystack.frontier.v1beta1.WebhookService.DeleteOrganizationWebhook.
+func (c *webhookServiceClient) DeleteOrganizationWebhook(ctx context.Context, req *connect.Request[v1beta1.DeleteOrganizationWebhookRequest]) (*connect.Response[v1beta1.DeleteOrganizationWebhookResponse], error) {
+	return c.deleteOrganizationWebhook.CallUnary(ctx, req)
+}
+
+// RotateWebhookSecret calls raystack.frontier.v1beta1.WebhookService.RotateWebhookSecret.
+func (c *webhookServiceClient) RotateWebhookSecret(ctx context.Context, req *connect.Request[v1beta1.RotateWebhookSecretRequest]) (*connect.Response[v1beta1.RotateWebhookSecretResponse], error) {
+	return c.rotateWebhookSecret.CallUnary(ctx, req)
+}
+
+// RevokeWebhookSecret calls raystack.frontier.v1beta1.WebhookService.RevokeWebhookSecret.
+func (c *webhookServiceClient) RevokeWebhookSecret(ctx context.Context, req *connect.Request[v1beta1.RevokeWebhookSecretRequest]) (*connect.Response[v1beta1.RevokeWebhookSecretResponse], error) {
+	return c.revokeWebhookSecret.CallUnary(ctx, req)
+}
+
+// GetWebhookOptions calls raystack.frontier.v1beta1.WebhookService.GetWebhookOptions.
+func (c *webhookServiceClient) GetWebhookOptions(ctx context.Context, req *connect.Request[v1beta1.GetWebhookOptionsRequest]) (*connect.Response[v1beta1.GetWebhookOptionsResponse], error) {
+	return c.getWebhookOptions.CallUnary(ctx, req)
+}
+
+// UpdateWebhookFilters calls raystack.frontier.v1beta1.WebhookService.UpdateWebhookFilters.
+func (c *webhookServiceClient) UpdateWebhookFilters(ctx context.Context, req *connect.Request[v1beta1.UpdateWebhookFiltersRequest]) (*connect.Response[v1beta1.UpdateWebhookFiltersResponse], error) {
+	return c.updateWebhookFilters.CallUnary(ctx, req)
+}
+
+// UpdateWebhookPayloadFormat calls
+// raystack.frontier.v1beta1.WebhookService.UpdateWebhookPayloadFormat.
+func (c *webhookServiceClient) UpdateWebhookPayloadFormat(ctx context.Context, req *connect.Request[v1beta1.UpdateWebhookPayloadFormatRequest]) (*connect.Response[v1beta1.UpdateWebhookPayloadFormatResponse], error) {
+	return c.updateWebhookPayloadFormat.CallUnary(ctx, req)
+}
+
+// SendTestWebhookEvent calls raystack.frontier.v1beta1.WebhookService.SendTestWebhookEvent.
+func (c *webhookServiceClient) SendTestWebhookEvent(ctx context.Context, req *connect.Request[v1beta1.SendTestWebhookEventRequest]) (*connect.Response[v1beta1.SendTestWebhookEventResponse], error) {
+	return c.sendTestWebhookEvent.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls raystack.frontier.v1beta1.WebhookService.ListWebhookDeliveries.
+func (c *webhookServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1beta1.ListWebhookDeliveriesRequest]) (*connect.Response[v1beta1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// GetWebhookDelivery calls raystack.frontier.v1beta1.WebhookService.GetWebhookDelivery.
+func (c *webhookServiceClient) GetWebhookDelivery(ctx context.Context, req *connect.Request[v1beta1.GetWebhookDeliveryRequest]) (*connect.Response[v1beta1.GetWebhookDeliveryResponse], error) {
+	return c.getWebhookDelivery.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveryAttempts calls
+// raystack.frontier.v1beta1.WebhookService.ListWebhookDeliveryAttempts.
+func (c *webhookServiceClient) ListWebhookDeliveryAttempts(ctx context.Context, req *connect.Request[v1beta1.ListWebhookDeliveryAttemptsRequest]) (*connect.Response[v1beta1.ListWebhookDeliveryAttemptsResponse], error) {
+	return c.listWebhookDeliveryAttempts.CallUnary(ctx, req)
+}
+
+// RedeliverWebhookDelivery calls raystack.frontier.v1beta1.WebhookService.RedeliverWebhookDelivery.
+func (c *webhookServiceClient) RedeliverWebhookDelivery(ctx context.Context, req *connect.Request[v1beta1.RedeliverWebhookDeliveryRequest]) (*connect.Response[v1beta1.RedeliverWebhookDeliveryResponse], error) {
+	return c.redeliverWebhookDelivery.CallUnary(ctx, req)
+}
+
+// RedeliverWebhookDeliveries calls
+// raystack.frontier.v1beta1.WebhookService.RedeliverWebhookDeliveries.
+func (c *webhookServiceClient) RedeliverWebhookDeliveries(ctx context.Context, req *connect.Request[v1beta1.RedeliverWebhookDeliveriesRequest]) (*connect.Response[v1beta1.RedeliverWebhookDeliveriesResponse], error) {
+	return c.redeliverWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// WebhookServiceHandler is an implementation of the raystack.frontier.v1beta1.WebhookService
+// service.
+type WebhookServiceHandler interface {
+	// CreateOrganizationWebhook registers a webhook receiving the events of an
+	// organization. The response holds the signing secret of the webhook.
+	CreateOrganizationWebhook(context.Context, *connect.Request[v1beta1.CreateOrganizationWebhookRequest]) (*connect.Response[v1beta1.CreateOrganizationWebhookResponse], error)
+	// ListOrganizationWebhooks lists the webhooks of an organization
+	ListOrganizationWebhooks(context.Context, *connect.Request[v1beta1.ListOrganizationWebhooksRequest]) (*connect.Response[v1beta1.ListOrganizationWebhooksResponse], error)
+	// UpdateOrganizationWebhook updates a webhook of an organization
+	UpdateOrganizationWebhook(context.Context, *connect.Request[v1beta1.UpdateOrganizationWebhookRequest]) (*connect.Response[v1beta1.UpdateOrganizationWebhookResponse], error)
+	// DeleteOrganizationWebhook deletes a webhook of an organization
+	DeleteOrganizationWebhook(context.Context, *connect.Request[v1beta1.DeleteOrganizationWebhookRequest]) (*connect.Response[v1beta1.DeleteOrganizationWebhookResponse], error)
+	// RotateWebhookSecret adds a new signing secret to a webhook and returns its
+	// value. Events are signed with every secret until the old one is revoked.
+	RotateWebhookSecret(context.Context, *connect.Request[v1beta1.RotateWebhookSecretRequest]) (*connect.Response[v1beta1.RotateWebhookSecretResponse], error)
+	// RevokeWebhookSecret removes a signing secret from a webhook, the last
+	// secret of a webhook can't be revoked
+	RevokeWebhookSecret(context.Context, *connect.Request[v1beta1.RevokeWebhookSecretRequest]) (*connect.Response[v1beta1.RevokeWebhookSecretResponse], error)
+	// GetWebhookOptions returns the delivery options of a webhook that are not
+	// part of the webhook itself
+	GetWebhookOptions(context.Context, *connect.Request[v1beta1.GetWebhookOptionsRequest]) (*connect.Response[v1beta1.GetWebhookOptionsResponse], error)
+	// UpdateWebhookFilters replaces the filters of a webhook, an empty map
+	// removes them
+	UpdateWebhookFilters(context.Context, *connect.Request[v1beta1.UpdateWebhookFiltersRequest]) (*connect.Response[v1beta1.UpdateWebhookFiltersResponse], error)
+	// UpdateWebhookPayloadFormat changes the format events are sent in, queued
+	// deliveries keep the format they were rendered in
+	UpdateWebhookPayloadFormat(context.Context, *connect.Request[v1beta1.UpdateWebhookPayloadFormatRequest]) (*connect.Response[v1beta1.UpdateWebhookPayloadFormatResponse], error)
+	// SendTestWebhookEvent synchronously sends a signed app.webhook.test event
+	// to a webhook, also when it is disabled, and returns the outcome. The event
+	// is not queued or retried.
+	SendTestWebhookEvent(context.Context, *connect.Request[v1beta1.SendTestWebhookEventRequest]) (*connect.Response[v1beta1.SendTestWebhookEventResponse], error)
+	// ListWebhookDeliveries lists the deliveries of events to webhooks, most
+	// recent first
+	ListWebhookDeliveries(context.Context, *connect.Request[v1beta1.ListWebhookDeliveriesRequest]) (*connect.Response[v1beta1.ListWebhookDeliveriesResponse], error)
+	// GetWebhookDelivery returns a delivery with its payload
+	GetWebhookDelivery(context.Context, *connect.Request[v1beta1.GetWebhookDeliveryRequest]) (*connect.Response[v1beta1.GetWebhookDeliveryResponse], error)
+	// ListWebhookDeliveryAttempts lists every attempt made for a delivery,
+	// oldest first
+	ListWebhookDeliveryAttempts(context.Context, *connect.Request[v1beta1.ListWebhookDeliveryAttemptsRequest]) (*connect.Response[v1beta1.ListWebhookDeliveryAttemptsResponse], error)
+	// RedeliverWebhookDelivery queues a delivery to be sent again with a fresh
+	// attempt budget
+	RedeliverWebhookDelivery(context.Context, *connect.Request[v1beta1.RedeliverWebhookDeliveryRequest]) (*connect.Response[v1beta1.RedeliverWebhookDeliveryResponse], error)
+	// RedeliverWebhookDeliveries queues every delivery created within a time
+	// range to be sent again, e.g. after an outage of the webhook
+	RedeliverWebhookDeliveries(context.Context, *connect.Request[v1beta1.RedeliverWebhookDeliveriesRequest]) (*connect.Response[v1beta1.RedeliverWebhookDeliveriesResponse], error)
+}
+
+// NewWebhookServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhookServiceHandler(svc WebhookServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	webhookServiceMethods := v1beta1.File_raystack_frontier_v1beta1_webhook_proto.Services().ByName("WebhookService").Methods()
+	webhookServiceCreateOrganizationWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceCreateOrganizationWebhookProcedure,
+		svc.CreateOrganizationWebhook,
+		connect.WithSchema(webhookServiceMethods.ByName("CreateOrganizationWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListOrganizationWebhooksHandler := connect.NewUnaryHandler(
+		WebhookServiceListOrganizationWebhooksProcedure,
+		svc.ListOrganizationWebhooks,
+		connect.WithSchema(webhookServiceMethods.ByName("ListOrganizationWebhooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceUpdateOrganizationWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceUpdateOrganizationWebhookProcedure,
+		svc.UpdateOrganizationWebhook,
+		connect.WithSchema(webhookServiceMethods.ByName("UpdateOrganizationWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceDeleteOrganizationWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceDeleteOrganizationWebhookProcedure,
+		svc.DeleteOrganizationWebhook,
+		connect.WithSchema(webhookServiceMethods.ByName("DeleteOrganizationWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceRotateWebhookSecretHandler := connect.NewUnaryHandler(
+		WebhookServiceRotateWebhookSecretProcedure,
+		svc.RotateWebhookSecret,
+		connect.WithSchema(webhookServiceMethods.ByName("RotateWebhookSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceRevokeWebhookSecretHandler := connect.NewUnaryHandler(
+		WebhookServiceRevokeWebhookSecretProcedure,
+		svc.RevokeWebhookSecret,
+		connect.WithSchema(webhookServiceMethods.ByName("RevokeWebhookSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetWebhookOptionsHandler := connect.NewUnaryHandler(
+		WebhookServiceGetWebhookOptionsProcedure,
+		svc.GetWebhookOptions,
+		connect.WithSchema(webhookServiceMethods.ByName("GetWebhookOptions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceUpdateWebhookFiltersHandler := connect.NewUnaryHandler(
+		WebhookServiceUpdateWebhookFiltersProcedure,
+		svc.UpdateWebhookFilters,
+		connect.WithSchema(webhookServiceMethods.ByName("UpdateWebhookFilters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceUpdateWebhookPayloadFormatHandler := connect.NewUnaryHandler(
+		WebhookServiceUpdateWebhookPayloadFormatProcedure,
+		svc.UpdateWebhookPayloadFormat,
+		connect.WithSchema(webhookServiceMethods.ByName("UpdateWebhookPayloadFormat")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceSendTestWebhookEventHandler := connect.NewUnaryHandler(
+		WebhookServiceSendTestWebhookEventProcedure,
+		svc.SendTestWebhookEvent,
+		connect.WithSchema(webhookServiceMethods.ByName("SendTestWebhookEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		WebhookServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetWebhookDeliveryHandler := connect.NewUnaryHandler(
+		WebhookServiceGetWebhookDeliveryProcedure,
+		svc.GetWebhookDelivery,
+		connect.WithSchema(webhookServiceMethods.ByName("GetWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListWebhookDeliveryAttemptsHandler := connect.NewUnaryHandler(
+		WebhookServiceListWebhookDeliveryAttemptsProcedure,
+		svc.ListWebhookDeliveryAttempts,
+		connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeliveryAttempts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceRedeliverWebhookDeliveryHandler := connect.NewUnaryHandler(
+		WebhookServiceRedeliverWebhookDeliveryProcedure,
+		svc.RedeliverWebhookDelivery,
+		connect.WithSchema(webhookServiceMethods.ByName("RedeliverWebhookDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceRedeliverWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		WebhookServiceRedeliverWebhookDeliveriesProcedure,
+		svc.RedeliverWebhookDeliveries,
+		connect.WithSchema(webhookServiceMethods.ByName("RedeliverWebhookDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/raystack.frontier.v1beta1.WebhookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WebhookServiceCreateOrganizationWebhookProcedure:
+			webhookServiceCreateOrganizationWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceListOrganizationWebhooksProcedure:
+			webhookServiceListOrganizationWebhooksHandler.ServeHTTP(w, r)
+		case WebhookServiceUpdateOrganizationWebhookProcedure:
+			webhookServiceUpdateOrganizationWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceDeleteOrganizationWebhookProcedure:
+			webhookServiceDeleteOrganizationWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceRotateWebhookSecretProcedure:
+			webhookServiceRotateWebhookSecretHandler.ServeHTTP(w, r)
+		case WebhookServiceRevokeWebhookSecretProcedure:
+			webhookServiceRevokeWebhookSecretHandler.ServeHTTP(w, r)
+		case WebhookServiceGetWebhookOptionsProcedure:
+			webhookServiceGetWebhookOptionsHandler.ServeHTTP(w, r)
+		case WebhookServiceUpdateWebhookFiltersProcedure:
+			webhookServiceUpdateWebhookFiltersHandler.ServeHTTP(w, r)
+		case WebhookServiceUpdateWebhookPayloadFormatProcedure:
+			webhookServiceUpdateWebhookPayloadFormatHandler.ServeHTTP(w, r)
+		case WebhookServiceSendTestWebhookEventProcedure:
+			webhookServiceSendTestWebhookEventHandler.ServeHTTP(w, r)
+		case WebhookServiceListWebhookDeliveriesProcedure:
+			webhookServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case WebhookServiceGetWebhookDeliveryProcedure:
+			webhookServiceGetWebhookDeliveryHandler.ServeHTTP(w, r)
+		case WebhookServiceListWebhookDeliveryAttemptsProcedure:
+			webhookServiceListWebhookDeliveryAttemptsHandler.ServeHTTP(w, r)
+		case WebhookServiceRedeliverWebhookDeliveryProcedure:
+			webhookServiceRedeliverWebhookDeliveryHandler.ServeHTTP(w, r)
+		case WebhookServiceRedeliverWebhookDeliveriesProcedure:
+			webhookServiceRedeliverWebhookDeliveriesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWebhookServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhookServiceHandler struct{}
+
+func (UnimplementedWebhookServiceHandler) CreateOrganizationWebhook(context.Context, *connect.Request[v1beta1.CreateOrganizationWebhookRequest]) (*connect.Response[v1beta1.CreateOrganizationWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.CreateOrganizationWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListOrganizationWebhooks(context.Context, *connect.Request[v1beta1.ListOrganizationWebhooksRequest]) (*connect.Response[v1beta1.ListOrganizationWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.ListOrganizationWebhooks is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) UpdateOrganizationWebhook(context.Context, *connect.Request[v1beta1.UpdateOrganizationWebhookRequest]) (*connect.Response[v1beta1.UpdateOrganizationWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.UpdateOrganizationWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) DeleteOrganizationWebhook(context.Context, *connect.Request[v1beta1.DeleteOrganizationWebhookRequest]) (*connect.Response[v1beta1.DeleteOrganizationWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.DeleteOrganizationWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) RotateWebhookSecret(context.Context, *connect.Request[v1beta1.RotateWebhookSecretRequest]) (*connect.Response[v1beta1.RotateWebhookSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.RotateWebhookSecret is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) RevokeWebhookSecret(context.Context, *connect.Request[v1beta1.RevokeWebhookSecretRequest]) (*connect.Response[v1beta1.RevokeWebhookSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.RevokeWebhookSecret is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetWebhookOptions(context.Context, *connect.Request[v1beta1.GetWebhookOptionsRequest]) (*connect.Response[v1beta1.GetWebhookOptionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.GetWebhookOptions is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) UpdateWebhookFilters(context.Context, *connect.Request[v1beta1.UpdateWebhookFiltersRequest]) (*connect.Response[v1beta1.UpdateWebhookFiltersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.UpdateWebhookFilters is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) UpdateWebhookPayloadFormat(context.Context, *connect.Request[v1beta1.UpdateWebhookPayloadFormatRequest]) (*connect.Response[v1beta1.UpdateWebhookPayloadFormatResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.UpdateWebhookPayloadFormat is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) SendTestWebhookEvent(context.Context, *connect.Request[v1beta1.SendTestWebhookEventRequest]) (*connect.Response[v1beta1.SendTestWebhookEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.SendTestWebhookEvent is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1beta1.ListWebhookDeliveriesRequest]) (*connect.Response[v1beta1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetWebhookDelivery(context.Context, *connect.Request[v1beta1.GetWebhookDeliveryRequest]) (*connect.Response[v1beta1.GetWebhookDeliveryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.GetWebhookDelivery is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListWebhookDeliveryAttempts(context.Context, *connect.Request[v1beta1.ListWebhookDeliveryAttemptsRequest]) (*connect.Response[v1beta1.ListWebhookDeliveryAttemptsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.ListWebhookDeliveryAttempts is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) RedeliverWebhookDelivery(context.Context, *connect.Request[v1beta1.RedeliverWebhookDeliveryRequest]) (*connect.Response[v1beta1.RedeliverWebhookDeliveryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.RedeliverWebhookDelivery is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) RedeliverWebhookDeliveries(context.Context, *connect.Request[v1beta1.RedeliverWebhookDeliveriesRequest]) (*connect.Response[v1beta1.RedeliverWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("raystack.frontier.v1beta1.WebhookService.RedeliverWebhookDeliveries is not implemented"))
+}
@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: raystack/frontier/v1beta1/login_alert.proto
+
+package frontierv1beta1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RevokeLoginAlertSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// token is the token query parameter of the link
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *RevokeLoginAlertSessionRequest) Reset() {
+	*x = RevokeLoginAlertSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raystack_frontier_v1beta1_login_alert_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeLoginAlertSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLoginAlertSessionRequest) ProtoMessage() {}
+
+func (x *RevokeLoginAlertSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_frontier_v1beta1_login_alert_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLoginAlertSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeLoginAlertSessionRequest) Descriptor() ([]byte, []int) {
+	return file_raystack_frontier_v1beta1_login_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RevokeLoginAlertSessionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevokeLoginAlertSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeLoginAlertSessionResponse) Reset() {
+	*x = RevokeLoginAlertSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_raystack_frontier_v1beta1_login_alert_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeLoginAlertSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeLoginAlertSessionResponse) ProtoMessage() {}
+
+func (x *RevokeLoginAlertSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_raystack_frontier_v1beta1_login_alert_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeLoginAlertSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeLoginAlertSessionResponse) Descriptor() ([]byte, []int) {
+	return file_raystack_frontier_v1beta1_login_alert_proto_rawDescGZIP(), []int{1}
+}
+
+var File_raystack_frontier_v1beta1_login_alert_proto protoreflect.FileDescriptor
+
+var file_raystack_frontier_v1beta1_login_alert_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x72, 0x61, 0x79, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x66, 0x72, 0x6f, 0x6e, 0x74,
+	0x69, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x72,
+	0x61, 0x79, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3f, 0x0a, 0x1e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x21, 0x0a, 0x1f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa8, 0x01, 0x0a, 0x11, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x92, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e, 0x72, 0x61,
+	0x79, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x72, 0x61, 0x79, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x2e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x79, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x69, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x3b, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x69, 0x65, 0x72, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_raystack_frontier_v1beta1_login_alert_proto_rawDescOnce sync.Once
+	file_raystack_frontier_v1beta1_login_alert_proto_rawDescData = file_raystack_frontier_v1beta1_login_alert_proto_rawDesc
+)
+
+func file_raystack_frontier_v1beta1_login_alert_proto_rawDescGZIP() []byte {
+	file_raystack_frontier_v1beta1_login_alert_proto_rawDescOnce.Do(func() {
+		file_raystack_frontier_v1beta1_login_alert_proto_rawDescData = protoimpl.X.CompressGZIP(file_raystack_frontier_v1beta1_login_alert_proto_rawDescData)
+	})
+	return file_raystack_frontier_v1beta1_login_alert_proto_rawDescData
+}
+
+var file_raystack_frontier_v1beta1_login_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_raystack_frontier_v1beta1_login_alert_proto_goTypes = []interface{}{
+	(*RevokeLoginAlertSessionRequest)(nil),  // 0: raystack.frontier.v1beta1.RevokeLoginAlertSessionRequest
+	(*RevokeLoginAlertSessionResponse)(nil), // 1: raystack.frontier.v1beta1.RevokeLoginAlertSessionResponse
+}
+var file_raystack_frontier_v1beta1_login_alert_proto_depIdxs = []int32{
+	0, // 0: raystack.frontier.v1beta1.LoginAlertService.RevokeLoginAlertSession:input_type -> raystack.frontier.v1beta1.RevokeLoginAlertSessionRequest
+	1, // 1: raystack.frontier.v1beta1.LoginAlertService.RevokeLoginAlertSession:output_type -> raystack.frontier.v1beta1.RevokeLoginAlertSessionResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_raystack_frontier_v1beta1_login_alert_proto_init() }
+func file_raystack_frontier_v1beta1_login_alert_proto_init() {
+	if File_raystack_frontier_v1beta1_login_alert_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_raystack_frontier_v1beta1_login_alert_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeLoginAlertSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_raystack_frontier_v1beta1_login_alert_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeLoginAlertSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_raystack_frontier_v1beta1_login_alert_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_raystack_frontier_v1beta1_login_alert_proto_goTypes,
+		DependencyIndexes: file_raystack_frontier_v1beta1_login_alert_proto_depIdxs,
+		MessageInfos:      file_raystack_frontier_v1beta1_login_alert_proto_msgTypes,
+	}.Build()
+	File_raystack_frontier_v1beta1_login_alert_proto = out.File
+	file_raystack_frontier_v1beta1_login_alert_proto_rawDesc = nil
+	file_raystack_frontier_v1beta1_login_alert_proto_goTypes = nil
+	file_raystack_frontier_v1beta1_login_alert_proto_depIdxs = nil
+}
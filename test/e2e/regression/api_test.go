@@ -27,6 +27,7 @@ import (
 	"github.com/raystack/frontier/internal/bootstrap/schema"
 
 	"github.com/raystack/frontier/core/preference"
+	corewebhook "github.com/raystack/frontier/core/webhook"
 
 	"connectrpc.com/connect"
 
@@ -91,6 +92,8 @@ func (s *APIRegressionTestSuite) SetupSuite() {
 				},
 				TestUsers: testusers.Config{Enabled: true, Domain: "raystack.org", OTP: testbench.TestOTP},
 			},
+			// the webhook receivers of the tests listen on loopback
+			Webhook: corewebhook.Config{Delivery: corewebhook.DeliveryConfig{AllowPrivateNetworks: true}},
 		},
 	}
 